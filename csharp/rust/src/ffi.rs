@@ -148,6 +148,8 @@ pub(crate) unsafe fn create_connection_request(
         inflight_requests_limit: None,
         otel_endpoint: None,
         otel_span_flush_interval_ms: None,
+        tls_certificates: None,
+        connection_event_listener: None,
     }
 }
 
@@ -4,33 +4,42 @@
 use glide_core::client::Client as GlideClient;
 use glide_core::cluster_scan_container::get_cluster_scan_cursor;
 use glide_core::command_request::SimpleRoutes;
-use glide_core::command_request::{Routes, SlotTypes};
+use glide_core::command_request::{command, Batch, Routes, ScriptInvocation, SlotTypes};
 use glide_core::connection_request;
 use glide_core::errors;
 use glide_core::errors::RequestErrorType;
 use glide_core::request_type::RequestType;
+use glide_core::scripts_container;
 use glide_core::ConnectionRequest;
 use protobuf::Message;
+use redis::aio::{ConnectionEvent, ConnectionEventListener};
 use redis::cluster_routing::{
     MultipleNodeRoutingInfo, Route, RoutingInfo, SingleNodeRoutingInfo, SlotAddr,
 };
 use redis::cluster_routing::{ResponsePolicy, Routable};
 use redis::ObjectType;
 use redis::ScanStateRC;
-use redis::{ClusterScanArgs, RedisError};
-use redis::{Cmd, RedisResult, Value};
+use redis::{ClusterScanArgs, ErrorKind, PipelineRetryStrategy, RedisError};
+use redis::{Cmd, PushInfo, RedisResult, Value};
 use std::ffi::CStr;
 use std::future::Future;
 use std::slice::from_raw_parts;
 use std::str;
-use std::sync::Arc;
+use std::str::FromStr;
+use std::sync::{Arc, OnceLock};
+use std::time::Duration;
 use std::{
     ffi::{c_void, CString},
     mem,
     os::raw::{c_char, c_double, c_long, c_ulong},
 };
+use telemetrylib::{
+    GlideOpenTelemetry, GlideOpenTelemetryConfigBuilder, GlideOpenTelemetryTraceExporter,
+    GlideSpan, GlideSpanStatus,
+};
 use tokio::runtime::Builder;
 use tokio::runtime::Runtime;
+use tokio::sync::mpsc;
 
 /// The struct represents the response of the command.
 ///
@@ -102,6 +111,7 @@ pub enum ResponseType {
     Array = 5,
     Map = 6,
     Sets = 7,
+    Error = 8,
 }
 
 /// Success callback that is called when a command succeeds.
@@ -126,6 +136,66 @@ pub type FailureCallback = unsafe extern "C" fn(
     error_type: RequestErrorType,
 ) -> ();
 
+/// The kind of a pub/sub message received from the server.
+#[repr(C)]
+#[derive(Debug, Clone, Copy)]
+pub enum PushKind {
+    /// A message published to a channel the client subscribed to.
+    PushMessage = 0,
+    /// A message published to a channel matching a pattern the client subscribed to.
+    PushPMessage = 1,
+    /// A message published to a shard channel the client subscribed to.
+    PushSMessage = 2,
+}
+
+/// Pub/sub callback that is called when a pub/sub message is received.
+///
+/// The callback needs to copy the given values synchronously, since they will be dropped by Rust once the callback returns. The callback should return quickly in order not to exhaust the client's thread pool.
+///
+/// `client_id` is a baton-pass back to the caller language to uniquely identify the client which received the message.
+/// `kind` is the kind of the received message.
+/// `message` and `channel` are the published message and the channel it was published to.
+/// `pattern` is the pattern matched by the channel. It is null, with a length of 0, unless `kind` is `PushPMessage`.
+/// The values are managed by Rust and are freed when the callback returns control back to the caller.
+pub type PubSubCallback = unsafe extern "C" fn(
+    client_id: usize,
+    kind: PushKind,
+    message: *const u8,
+    message_len: i64,
+    channel: *const u8,
+    channel_len: i64,
+    pattern: *const u8,
+    pattern_len: i64,
+) -> ();
+
+/// The kind of a change in the state of the connections of a client.
+#[repr(C)]
+#[derive(Debug, Clone, Copy)]
+pub enum ConnectionEventKind {
+    /// A connection to a node was established for the first time.
+    ConnectionEventConnected = 0,
+    /// The connection to a node was lost. The client tries to reconnect in the background.
+    ConnectionEventDisconnected = 1,
+    /// The connection to a node was reestablished after it was lost.
+    ConnectionEventReconnected = 2,
+    /// The topology of the cluster changed.
+    ConnectionEventTopologyChanged = 3,
+}
+
+/// Connection event callback that is called when the state of the connections of a client changes.
+///
+/// The callback needs to copy the given address synchronously, since it will be dropped by Rust once the callback returns. The callback should return quickly in order not to delay the detection of other events.
+///
+/// `client_id` is a baton-pass back to the caller language to uniquely identify the client whose connections changed.
+/// `kind` is the kind of the event.
+/// `address` is the address of the node, in the `host:port` format. It is null, with a length of 0, if `kind` is `ConnectionEventTopologyChanged`.
+pub type ConnectionEventCallback = unsafe extern "C" fn(
+    client_id: usize,
+    kind: ConnectionEventKind,
+    address: *const u8,
+    address_len: i64,
+) -> ();
+
 /// The connection response.
 ///
 /// It contains either a connection or an error. It is represented as a struct instead of a union for ease of use in the wrapper language.
@@ -251,6 +321,8 @@ pub struct ClientAdapter {
 struct CommandExecutionCore {
     client: GlideClient,
     client_type: ClientType,
+    /// Whether the number of inflight requests is limited, which is only the case when a limit was configured.
+    limit_inflight_requests: bool,
 }
 
 impl ClientAdapter {
@@ -258,10 +330,25 @@ impl ClientAdapter {
     ///
     /// For async clients, spawns the future and returns null immediately.
     /// For sync clients, blocks on the future and returns a `CommandResult`.
+    ///
+    /// If an inflight requests limit was configured, requests are rejected without being sent when the limit is reached.
     fn execute_command<Fut>(&self, channel: usize, request_future: Fut) -> *mut CommandResult
     where
         Fut: Future<Output = RedisResult<Value>> + Send + 'static,
     {
+        let limit_inflight_requests = self.core.limit_inflight_requests;
+        if limit_inflight_requests && !self.core.client.reserve_inflight_request() {
+            return self.handle_inflight_requests_limit(channel);
+        }
+        let client = self.core.client.clone();
+        let request_future = async move {
+            let result = request_future.await;
+            if limit_inflight_requests {
+                client.release_inflight_request();
+            }
+            result
+        };
+
         match self.core.client_type {
             ClientType::AsyncClient {
                 success_callback,
@@ -357,6 +444,34 @@ impl ClientAdapter {
         }
     }
 
+    /// Reports a request which was rejected because the maximum number of inflight requests was reached.
+    ///
+    /// For async clients, invokes the failure callback and returns null.
+    /// For sync clients, returns a `CommandResult` with the error.
+    fn handle_inflight_requests_limit(&self, channel: usize) -> *mut CommandResult {
+        let c_err_str = CString::into_raw(
+            CString::new("Reached maximum inflight requests")
+                .expect("Couldn't convert error message to CString"),
+        );
+        let error_type = RequestErrorType::InflightRequestsLimit;
+        match self.core.client_type {
+            ClientType::AsyncClient {
+                success_callback: _,
+                failure_callback,
+            } => {
+                unsafe { (failure_callback)(channel, c_err_str, error_type) };
+                std::ptr::null_mut()
+            }
+            ClientType::SyncClient => Box::into_raw(Box::new(CommandResult {
+                response: std::ptr::null_mut(),
+                command_error: Box::into_raw(Box::new(CommandError {
+                    command_error_message: c_err_str,
+                    command_error_type: error_type,
+                })),
+            })),
+        }
+    }
+
     /// Invokes the asynchronous failure callback with an error.
     ///
     /// This function is used in async client flows to report command execution failures
@@ -372,9 +487,86 @@ impl ClientAdapter {
     }
 }
 
+/// Forwards the pub/sub messages received by the client to the `pubsub_callback`.
+///
+/// Subscription confirmations and other push notifications are not forwarded.
+async fn push_manager_loop(
+    mut push_rx: mpsc::UnboundedReceiver<PushInfo>,
+    pubsub_callback: PubSubCallback,
+    client_id: usize,
+) {
+    while let Some(push_msg) = push_rx.recv().await {
+        let (kind, pattern, channel, message) = match (push_msg.kind, push_msg.data.as_slice()) {
+            (redis::PushKind::Message, [channel, message]) => {
+                (PushKind::PushMessage, None, channel, message)
+            }
+            (redis::PushKind::SMessage, [channel, message]) => {
+                (PushKind::PushSMessage, None, channel, message)
+            }
+            (redis::PushKind::PMessage, [pattern, channel, message]) => {
+                (PushKind::PushPMessage, Some(pattern), channel, message)
+            }
+            _ => continue,
+        };
+        let (Value::BulkString(channel), Value::BulkString(message)) = (channel, message) else {
+            continue;
+        };
+        let (pattern_ptr, pattern_len) = match pattern {
+            Some(Value::BulkString(pattern)) => (pattern.as_ptr(), pattern.len() as i64),
+            Some(_) => continue,
+            None => (std::ptr::null(), 0),
+        };
+        unsafe {
+            (pubsub_callback)(
+                client_id,
+                kind,
+                message.as_ptr(),
+                message.len() as i64,
+                channel.as_ptr(),
+                channel.len() as i64,
+                pattern_ptr,
+                pattern_len,
+            )
+        };
+    }
+}
+
+/// Returns a listener forwarding the connection events of a client to the `connection_event_callback`.
+fn connection_event_listener(
+    connection_event_callback: ConnectionEventCallback,
+    client_id: usize,
+) -> ConnectionEventListener {
+    Arc::new(move |event| {
+        let (kind, address) = match &event {
+            ConnectionEvent::Connected(address) => {
+                (ConnectionEventKind::ConnectionEventConnected, Some(address))
+            }
+            ConnectionEvent::Disconnected(address) => (
+                ConnectionEventKind::ConnectionEventDisconnected,
+                Some(address),
+            ),
+            ConnectionEvent::Reconnected(address) => (
+                ConnectionEventKind::ConnectionEventReconnected,
+                Some(address),
+            ),
+            ConnectionEvent::TopologyChanged => {
+                (ConnectionEventKind::ConnectionEventTopologyChanged, None)
+            }
+        };
+        let (address_ptr, address_len) = match address {
+            Some(address) => (address.as_ptr(), address.len() as i64),
+            None => (std::ptr::null(), 0),
+        };
+        unsafe { (connection_event_callback)(client_id, kind, address_ptr, address_len) };
+    })
+}
+
 fn create_client_internal(
     connection_request_bytes: &[u8],
     client_type: ClientType,
+    pubsub_callback: Option<PubSubCallback>,
+    connection_event_callback: Option<ConnectionEventCallback>,
+    client_id: usize,
 ) -> Result<ClientAdapter, String> {
     let request = connection_request::ConnectionRequest::parse_from_bytes(connection_request_bytes)
         .map_err(|err| err.to_string())?;
@@ -388,12 +580,24 @@ fn create_client_internal(
             let redis_error = err.into();
             errors::error_message(&redis_error)
         })?;
+    let push_tx = pubsub_callback.map(|pubsub_callback| {
+        let (push_tx, push_rx) = mpsc::unbounded_channel();
+        runtime.spawn(push_manager_loop(push_rx, pubsub_callback, client_id));
+        push_tx
+    });
+    let mut request = ConnectionRequest::from(request);
+    let limit_inflight_requests = request.inflight_requests_limit.is_some();
+    request.connection_event_listener =
+        connection_event_callback.map(|connection_event_callback| {
+            connection_event_listener(connection_event_callback, client_id)
+        });
     let client = runtime
-        .block_on(GlideClient::new(ConnectionRequest::from(request), None))
+        .block_on(GlideClient::new(request, push_tx))
         .map_err(|err| err.to_string())?;
     let core = Arc::new(CommandExecutionCore {
         client,
         client_type,
+        limit_inflight_requests,
     });
     Ok(ClientAdapter { runtime, core })
 }
@@ -406,6 +610,9 @@ fn create_client_internal(
 /// `connection_request_len` is the number of bytes in `connection_request_bytes`.
 /// `success_callback` is the callback that will be called when a command succeeds.
 /// `failure_callback` is the callback that will be called when a command fails.
+/// `pubsub_callback` is the callback that will be called when a pub/sub message is received. It may be null if the client has no subscriptions.
+/// `connection_event_callback` is the callback that will be called when the state of the connections of the client changes. It may be null if the caller isn't interested in these events.
+/// `client_id` is passed back to the `pubsub_callback` and to the `connection_event_callback` to identify the client.
 ///
 /// # Safety
 ///
@@ -414,17 +621,27 @@ fn create_client_internal(
 /// * The `conn_ptr` pointer in the returned `ConnectionResponse` must live while the client is open/active and must be explicitly freed by calling [`close_client``].
 /// * The `connection_error_message` pointer in the returned `ConnectionResponse` must live until the returned `ConnectionResponse` pointer is passed to [`free_connection_response``].
 /// * Both the `success_callback` and `failure_callback` function pointers need to live while the client is open/active. The caller is responsible for freeing both callbacks.
+/// * The `pubsub_callback` and `connection_event_callback` function pointers, if not null, need to live while the client is open/active.
 // TODO: Consider making this async
 #[no_mangle]
 pub unsafe extern "C" fn create_client(
     connection_request_bytes: *const u8,
     connection_request_len: usize,
     client_type: *const ClientType,
+    pubsub_callback: Option<PubSubCallback>,
+    connection_event_callback: Option<ConnectionEventCallback>,
+    client_id: usize,
 ) -> *const ConnectionResponse {
     let request_bytes =
         unsafe { std::slice::from_raw_parts(connection_request_bytes, connection_request_len) };
     let client_type = unsafe { &*client_type };
-    let response = match create_client_internal(request_bytes, client_type.clone()) {
+    let response = match create_client_internal(
+        request_bytes,
+        client_type.clone(),
+        pubsub_callback,
+        connection_event_callback,
+        client_id,
+    ) {
         Err(err) => ConnectionResponse {
             conn_ptr: std::ptr::null(),
             connection_error_message: CString::into_raw(
@@ -502,6 +719,7 @@ pub extern "C" fn get_response_type_string(response_type: ResponseType) -> *cons
         ResponseType::Array => c"Array",
         ResponseType::Map => c"Map",
         ResponseType::Sets => c"Sets",
+        ResponseType::Error => c"Error",
     };
     c_str.as_ptr()
 }
@@ -722,6 +940,15 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
             command_response.response_type = ResponseType::Sets;
             Ok(command_response)
         }
+        Value::ServerError(err) => {
+            // Server errors are only returned as values by batches executed without `raise_on_error`.
+            let message = errors::error_message(&err.into());
+            let (vec_ptr, len) = convert_vec_to_pointer(message.into_bytes());
+            command_response.string_value = vec_ptr as *mut c_char;
+            command_response.string_value_len = len;
+            command_response.response_type = ResponseType::Error;
+            Ok(command_response)
+        }
         // TODO: Add support for other return types.
         _ => todo!(),
     };
@@ -743,8 +970,10 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
 /// * `route_bytes` is an optional array of bytes that will be parsed into a Protobuf `Routes` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
 /// * `route_bytes_len` is the number of bytes in `route_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
 /// * `route_bytes_len` must be 0 if `route_bytes` is null.
+/// * `span_ptr` must be 0 or a span pointer returned by [`create_otel_span`] which was not yet dropped by [`drop_otel_span`].
 /// * This function should only be called should with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
 #[no_mangle]
+#[allow(clippy::too_many_arguments)]
 pub unsafe extern "C" fn command(
     client_adapter_ptr: *const c_void,
     channel: usize,
@@ -754,6 +983,7 @@ pub unsafe extern "C" fn command(
     args_len: *const c_ulong,
     route_bytes: *const u8,
     route_bytes_len: usize,
+    span_ptr: u64,
 ) -> *mut CommandResult {
     let client_adapter = unsafe {
         // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
@@ -783,11 +1013,393 @@ pub unsafe extern "C" fn command(
         Routes::default()
     };
 
+    let child_span = unsafe { add_child_otel_span(span_ptr, "send_command") };
     let mut client = client_adapter.core.client.clone();
     client_adapter.execute_command(channel, async move {
-        client
+        let result = client
             .send_command(&cmd, get_route(route, Some(&cmd)))
-            .await
+            .await;
+        end_otel_span(child_span, &result);
+        result
+    })
+}
+
+/// Executes a batch of commands, either atomically as a transaction or as a non-atomic pipeline.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must not be `null` and must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `client_adapter_ptr` must be able to be safely casted to a valid [`Arc<ClientAdapter>`] via [`Arc::from_raw`]. See the safety documentation of [`std::sync::Arc::from_raw`].
+/// * `channel` must be Go channel pointer and must be valid until either `success_callback` or `failure_callback` is finished.
+/// * `batch_bytes` must point to `batch_bytes_len` consecutive properly initialized bytes. It must be a well-formed Protobuf `Batch` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
+/// * `batch_bytes_len` is the number of bytes in `batch_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
+/// * `route_bytes` is an optional array of bytes that will be parsed into a Protobuf `Routes` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
+/// * `route_bytes_len` is the number of bytes in `route_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
+/// * `route_bytes_len` must be 0 if `route_bytes` is null.
+/// * `span_ptr` must be 0 or a span pointer returned by [`create_batch_otel_span`] which was not yet dropped by [`drop_otel_span`].
+/// * This function should only be called should with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
+#[no_mangle]
+pub unsafe extern "C" fn batch(
+    client_adapter_ptr: *const c_void,
+    channel: usize,
+    batch_bytes: *const u8,
+    batch_bytes_len: usize,
+    route_bytes: *const u8,
+    route_bytes_len: usize,
+    span_ptr: u64,
+) -> *mut CommandResult {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+
+    let b_bytes = unsafe { std::slice::from_raw_parts(batch_bytes, batch_bytes_len) };
+    let batch = match Batch::parse_from_bytes(b_bytes) {
+        Ok(batch) => batch,
+        Err(err) => {
+            return client_adapter.handle_error(
+                RedisError::from((
+                    ErrorKind::ClientError,
+                    "Failed to parse the batch",
+                    err.to_string(),
+                )),
+                channel,
+            );
+        }
+    };
+
+    // Build the pipeline outside of the task to ensure that the batch passed from "go" is still valid
+    let mut pipeline = redis::Pipeline::with_capacity(batch.commands.len());
+    if batch.is_atomic {
+        pipeline.atomic();
+    }
+    for batch_command in batch.commands.iter() {
+        let request_type: RequestType = batch_command.request_type.into();
+        let Some(mut cmd) = request_type.get_command() else {
+            return client_adapter.handle_error(
+                RedisError::from((
+                    ErrorKind::ClientError,
+                    "Received invalid request type in the batch",
+                )),
+                channel,
+            );
+        };
+        if let Some(command::Args::ArgsArray(args_array)) = &batch_command.args {
+            for arg in args_array.args.iter() {
+                cmd.arg(arg.as_ref());
+            }
+        }
+        pipeline.add_command(cmd);
+    }
+
+    let route = if !route_bytes.is_null() {
+        let r_bytes = unsafe { std::slice::from_raw_parts(route_bytes, route_bytes_len) };
+        Routes::parse_from_bytes(r_bytes).unwrap()
+    } else {
+        Routes::default()
+    };
+
+    let child_span = unsafe { add_child_otel_span(span_ptr, "send_batch") };
+    let mut client = client_adapter.core.client.clone();
+    client_adapter.execute_command(channel, async move {
+        let routing = get_route(route, None);
+        let raise_on_error = batch.raise_on_error.unwrap_or_default();
+        let result = if batch.is_atomic {
+            client
+                .send_transaction(&pipeline, routing, batch.timeout, raise_on_error)
+                .await
+        } else {
+            client
+                .send_pipeline(
+                    &pipeline,
+                    routing,
+                    raise_on_error,
+                    batch.timeout,
+                    PipelineRetryStrategy {
+                        retry_server_error: batch.retry_server_error.unwrap_or_default(),
+                        retry_connection_error: batch.retry_connection_error.unwrap_or_default(),
+                    },
+                )
+                .await
+        };
+        end_otel_span(child_span, &result);
+        result
+    })
+}
+
+/// The runtime of the OpenTelemetry exporter, which is kept for the lifetime of the process once OpenTelemetry is
+/// initialized.
+static OTEL_RUNTIME: OnceLock<Runtime> = OnceLock::new();
+
+/// Initializes OpenTelemetry for the process, exporting the spans of the commands to the given collector endpoint.
+///
+/// The endpoint is a URL with one of the `http`, `https`, `grpc` or `file` schemes. With the `file` scheme, the spans are
+/// appended to the `spans.json` file in the directory of the URL path.
+///
+/// Returns null on success, or an error message which must be freed with [`free_error_message`]. OpenTelemetry can only
+/// be initialized once per process.
+///
+/// # Safety
+///
+/// * `endpoint` must be a valid null-terminated C string. It must be allocated by the caller and subsequently freed by the caller after this function returns.
+#[no_mangle]
+pub unsafe extern "C" fn init_open_telemetry(
+    endpoint: *const c_char,
+    span_flush_interval_ms: u64,
+) -> *const c_char {
+    let endpoint = unsafe { CStr::from_ptr(endpoint) };
+    let result = (|| {
+        if OTEL_RUNTIME.get().is_some() {
+            return Err("OpenTelemetry is already initialized".to_string());
+        }
+        let endpoint = endpoint.to_str().map_err(|err| err.to_string())?;
+        let trace_exporter =
+            GlideOpenTelemetryTraceExporter::from_str(endpoint).map_err(|err| err.to_string())?;
+        let config = GlideOpenTelemetryConfigBuilder::default()
+            .with_flush_interval(Duration::from_millis(span_flush_interval_ms))
+            .with_trace_exporter(trace_exporter)
+            .build();
+        let runtime = Builder::new_multi_thread()
+            .worker_threads(1)
+            .thread_name("GLIDE OpenTelemetry")
+            .enable_all()
+            .build()
+            .map_err(|err| err.to_string())?;
+        {
+            // the exporter is spawned on the current runtime
+            let _runtime_guard = runtime.enter();
+            GlideOpenTelemetry::initialise(config).map_err(|err| err.to_string())?;
+        }
+        OTEL_RUNTIME
+            .set(runtime)
+            .map_err(|_| "OpenTelemetry is already initialized".to_string())
+    })();
+    match result {
+        Ok(()) => std::ptr::null(),
+        Err(message) => CString::into_raw(
+            CString::new(message).expect("Couldn't convert error message to CString"),
+        ),
+    }
+}
+
+/// Returns the name of the command of the given request type, such as `SET` or `CLIENT INFO`.
+fn command_name(request_type: RequestType) -> Option<String> {
+    request_type
+        .get_command()
+        .and_then(|cmd| cmd.command())
+        .map(|name| String::from_utf8_lossy(&name).to_string())
+}
+
+/// Returns the name of the command of the given request type, such as `SET` or `CLIENT INFO`, or null if the request type
+/// has no fixed command, like a custom command.
+///
+/// The returned name must be freed by calling [`free_command_name`].
+#[no_mangle]
+pub extern "C" fn get_command_name(request_type: RequestType) -> *mut c_char {
+    command_name(request_type)
+        .and_then(|name| CString::new(name).ok())
+        .map_or(std::ptr::null_mut(), CString::into_raw)
+}
+
+/// Deallocates a command name returned by [`get_command_name`].
+///
+/// # Panics
+///
+/// This function panics when called with a null `name`.
+///
+/// # Safety
+///
+/// * `free_command_name` can only be called once per name. Calling it twice is undefined behavior, since the address will be freed twice.
+/// * `name` must be obtained from [`get_command_name`].
+#[no_mangle]
+pub unsafe extern "C" fn free_command_name(name: *mut c_char) {
+    assert!(!name.is_null());
+    drop(unsafe { CString::from_raw(name) });
+}
+
+/// Creates an OpenTelemetry span for a command of the given type, to be passed to [`command`].
+///
+/// Returns a pointer to the span, which must be ended and freed by calling [`drop_otel_span`], or 0 if the request type
+/// is invalid.
+#[no_mangle]
+pub extern "C" fn create_otel_span(request_type: RequestType) -> u64 {
+    let Some(name) = command_name(request_type) else {
+        return 0;
+    };
+    Box::into_raw(Box::new(GlideOpenTelemetry::new_span(&name))) as u64
+}
+
+/// Creates an OpenTelemetry span for a batch, to be passed to [`batch`].
+///
+/// Returns a pointer to the span, which must be ended and freed by calling [`drop_otel_span`].
+#[no_mangle]
+pub extern "C" fn create_batch_otel_span() -> u64 {
+    Box::into_raw(Box::new(GlideOpenTelemetry::new_span("Batch"))) as u64
+}
+
+/// Creates an OpenTelemetry span for a script invocation, to be passed to [`invoke_script`].
+///
+/// Returns a pointer to the span, which must be ended and freed by calling [`drop_otel_span`].
+#[no_mangle]
+pub extern "C" fn create_script_otel_span() -> u64 {
+    Box::into_raw(Box::new(GlideOpenTelemetry::new_span("InvokeScript"))) as u64
+}
+
+/// Ends and frees a span created by [`create_otel_span`], [`create_batch_otel_span`] or [`create_script_otel_span`].
+///
+/// # Safety
+///
+/// * `span_ptr` must be 0 or a span pointer returned by [`create_otel_span`], [`create_batch_otel_span`] or
+///   [`create_script_otel_span`].
+/// * `drop_otel_span` can only be called once per span pointer.
+#[no_mangle]
+pub unsafe extern "C" fn drop_otel_span(span_ptr: u64) {
+    if span_ptr == 0 {
+        return;
+    }
+    let span = unsafe { Box::from_raw(span_ptr as *mut GlideSpan) };
+    span.end();
+}
+
+/// Adds a child span with the given name to the span of `span_ptr`, if there is one.
+///
+/// # Safety
+///
+/// * `span_ptr` must be 0 or a span pointer which was not yet dropped by [`drop_otel_span`].
+unsafe fn add_child_otel_span(span_ptr: u64, name: &str) -> Option<GlideSpan> {
+    if span_ptr == 0 {
+        return None;
+    }
+    let span = unsafe { &*(span_ptr as *const GlideSpan) };
+    Some(span.add_span(name))
+}
+
+/// Ends a child span, setting its status according to the result of the request.
+fn end_otel_span(span: Option<GlideSpan>, result: &RedisResult<Value>) {
+    let Some(span) = span else {
+        return;
+    };
+    match result {
+        Ok(_) => span.set_status(GlideSpanStatus::Ok),
+        Err(err) => span.set_status(GlideSpanStatus::Error(err.to_string())),
+    }
+    span.end();
+}
+
+/// Stores a script in the script container, so that it can be invoked with [`invoke_script`] by its hash.
+///
+/// Returns the SHA1 hash of the script. The returned hash must be freed by calling [`free_script_hash`].
+///
+/// # Safety
+///
+/// * `script_bytes` must point to `script_bytes_len` consecutive properly initialized bytes. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
+/// * `script_bytes_len` is the number of bytes in `script_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
+#[no_mangle]
+pub unsafe extern "C" fn store_script(
+    script_bytes: *const u8,
+    script_bytes_len: usize,
+) -> *mut c_char {
+    let script = unsafe { std::slice::from_raw_parts(script_bytes, script_bytes_len) };
+    let hash = scripts_container::add_script(script);
+    CString::new(hash)
+        .expect("Couldn't convert script hash to CString")
+        .into_raw()
+}
+
+/// Deallocates a script hash returned by [`store_script`].
+///
+/// # Panics
+///
+/// This function panics when called with a null `hash`.
+///
+/// # Safety
+///
+/// * `free_script_hash` can only be called once per hash. Calling it twice is undefined behavior, since the address will be freed twice.
+/// * `hash` must be obtained from [`store_script`].
+#[no_mangle]
+pub unsafe extern "C" fn free_script_hash(hash: *mut c_char) {
+    assert!(!hash.is_null());
+    drop(unsafe { CString::from_raw(hash) });
+}
+
+/// Removes a script from the script container. Scripts stored more than once are kept until they are removed as many
+/// times as they were stored.
+///
+/// Invoking the script with [`invoke_script`] after it was removed fails if the script is not cached by the server.
+///
+/// # Safety
+///
+/// * `hash` must not be `null` and must point to a valid null-terminated string. It must be freed by the caller after this function returns.
+#[no_mangle]
+pub unsafe extern "C" fn drop_script(hash: *const c_char) {
+    let hash = unsafe { CStr::from_ptr(hash) }.to_string_lossy();
+    scripts_container::remove_script(&hash);
+}
+
+/// Invokes a script stored with [`store_script`] using EVALSHA. If the script is not cached by the server, it is loaded with
+/// SCRIPT LOAD and invoked again.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must not be `null` and must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `client_adapter_ptr` must be able to be safely casted to a valid [`Arc<ClientAdapter>`] via [`Arc::from_raw`]. See the safety documentation of [`std::sync::Arc::from_raw`].
+/// * `channel` must be Go channel pointer and must be valid until either `success_callback` or `failure_callback` is finished.
+/// * `script_bytes` must point to `script_bytes_len` consecutive properly initialized bytes. It must be a well-formed Protobuf `ScriptInvocation` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
+/// * `script_bytes_len` is the number of bytes in `script_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
+/// * `route_bytes` is an optional array of bytes that will be parsed into a Protobuf `Routes` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
+/// * `route_bytes_len` is the number of bytes in `route_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
+/// * `route_bytes_len` must be 0 if `route_bytes` is null.
+/// * `span_ptr` must be 0 or a span pointer returned by [`create_script_otel_span`] which was not yet dropped by [`drop_otel_span`].
+/// * This function should only be called should with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
+#[no_mangle]
+pub unsafe extern "C" fn invoke_script(
+    client_adapter_ptr: *const c_void,
+    channel: usize,
+    script_bytes: *const u8,
+    script_bytes_len: usize,
+    route_bytes: *const u8,
+    route_bytes_len: usize,
+    span_ptr: u64,
+) -> *mut CommandResult {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+
+    let s_bytes = unsafe { std::slice::from_raw_parts(script_bytes, script_bytes_len) };
+    let script = match ScriptInvocation::parse_from_bytes(s_bytes) {
+        Ok(script) => script,
+        Err(err) => {
+            return client_adapter.handle_error(
+                RedisError::from((
+                    ErrorKind::ClientError,
+                    "Failed to parse the script invocation",
+                    err.to_string(),
+                )),
+                channel,
+            );
+        }
+    };
+
+    let route = if !route_bytes.is_null() {
+        let r_bytes = unsafe { std::slice::from_raw_parts(route_bytes, route_bytes_len) };
+        Routes::parse_from_bytes(r_bytes).unwrap()
+    } else {
+        Routes::default()
+    };
+
+    let child_span = unsafe { add_child_otel_span(span_ptr, "send_script") };
+    let mut client = client_adapter.core.client.clone();
+    client_adapter.execute_command(channel, async move {
+        let keys: Vec<&[u8]> = script.keys.iter().map(|key| key.as_ref()).collect();
+        let args: Vec<&[u8]> = script.args.iter().map(|arg| arg.as_ref()).collect();
+        let result = client
+            .invoke_script(&script.hash, &keys, &args, get_route(route, None))
+            .await;
+        end_otel_span(child_span, &result);
+        result
     })
 }
 
@@ -1087,3 +1699,112 @@ pub unsafe extern "C" fn update_connection_password(
             .await
     })
 }
+
+/// The level of a log, matching [`logger_core::Level`].
+#[repr(C)]
+#[derive(Debug, Clone, Copy)]
+pub enum LogLevel {
+    ErrorLevel = 0,
+    WarnLevel = 1,
+    InfoLevel = 2,
+    DebugLevel = 3,
+    TraceLevel = 4,
+    OffLevel = 5,
+}
+
+impl From<LogLevel> for logger_core::Level {
+    fn from(level: LogLevel) -> Self {
+        match level {
+            LogLevel::ErrorLevel => logger_core::Level::Error,
+            LogLevel::WarnLevel => logger_core::Level::Warn,
+            LogLevel::InfoLevel => logger_core::Level::Info,
+            LogLevel::DebugLevel => logger_core::Level::Debug,
+            LogLevel::TraceLevel => logger_core::Level::Trace,
+            LogLevel::OffLevel => logger_core::Level::Off,
+        }
+    }
+}
+
+impl From<logger_core::Level> for LogLevel {
+    fn from(level: logger_core::Level) -> Self {
+        match level {
+            logger_core::Level::Error => LogLevel::ErrorLevel,
+            logger_core::Level::Warn => LogLevel::WarnLevel,
+            logger_core::Level::Info => LogLevel::InfoLevel,
+            logger_core::Level::Debug => LogLevel::DebugLevel,
+            logger_core::Level::Trace => LogLevel::TraceLevel,
+            logger_core::Level::Off => LogLevel::OffLevel,
+        }
+    }
+}
+
+/// Log callback that is called for each log of the core, once set by [`set_log_callback`].
+///
+/// The callback is called synchronously on the thread emitting the log, so it should return quickly.
+///
+/// `level` is the level of the log.
+/// `message` is the message of the log, which is not null-terminated and is freed by Rust when the callback returns.
+pub type LogCallback =
+    unsafe extern "C" fn(level: LogLevel, message: *const u8, message_len: i64) -> ();
+
+/// Initializes the logger of the core, or replaces its configuration, and returns the level of the logger.
+///
+/// The logs of the given level or above are written to a file postfixed with `file_name`, or to the console if
+/// `file_name` is null. If `level` is null, the default level of the core is used.
+///
+/// # Safety
+///
+/// * `level` must be null or a valid pointer to a [`LogLevel`].
+/// * `file_name` must be null or a valid null-terminated C string.
+#[no_mangle]
+pub unsafe extern "C" fn init_logger(level: *const LogLevel, file_name: *const c_char) -> LogLevel {
+    let level = unsafe { level.as_ref() }.map(|level| (*level).into());
+    let file_name = (!file_name.is_null()).then(|| {
+        unsafe { CStr::from_ptr(file_name) }
+            .to_string_lossy()
+            .into_owned()
+    });
+    logger_core::init(level, file_name.as_deref()).into()
+}
+
+/// Forwards the logs of the core to `callback` instead of the console or a log file, and returns the level of the logger.
+///
+/// Only the logs of the given level or above are forwarded. If `level` is null, the default level of the core is used.
+/// The callback is replaced by the next call to [`init_logger`] or [`set_log_callback`].
+///
+/// # Safety
+///
+/// * `level` must be null or a valid pointer to a [`LogLevel`].
+/// * `callback` must be a valid function pointer.
+#[no_mangle]
+pub unsafe extern "C" fn set_log_callback(
+    level: *const LogLevel,
+    callback: LogCallback,
+) -> LogLevel {
+    let level = unsafe { level.as_ref() }.map(|level| (*level).into());
+    logger_core::set_log_callback(
+        level,
+        Box::new(move |level: logger_core::Level, message: &str| unsafe {
+            callback(level.into(), message.as_ptr(), message.len() as i64)
+        }),
+    )
+    .into()
+}
+
+/// Logs the given message with the given level and identifier, consistently with the logs of the core.
+///
+/// The log is ignored if its level is below the level of the logger.
+///
+/// # Safety
+///
+/// * `identifier` and `message` must be valid null-terminated C strings.
+#[no_mangle]
+pub unsafe extern "C" fn log_message(
+    level: LogLevel,
+    identifier: *const c_char,
+    message: *const c_char,
+) {
+    let identifier = unsafe { CStr::from_ptr(identifier) }.to_string_lossy();
+    let message = unsafe { CStr::from_ptr(message) }.to_string_lossy();
+    logger_core::log(level.into(), identifier, message);
+}
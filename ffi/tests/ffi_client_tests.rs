@@ -176,6 +176,7 @@ fn execute_command(
             args_len_ptr,
             route_bytes,
             route_len,
+            0,
         )
     };
     if command_res_ptr.is_null() {
@@ -241,8 +242,13 @@ fn test_ffi_client_command_execution(#[values(false, true)] async_client: bool)
         ClientType::SyncClient
     }));
     unsafe {
-        let response_ptr =
-            create_client(connection_request_ptr, connection_request_len, client_type);
+        let response_ptr = create_client(
+            connection_request_ptr,
+            connection_request_len,
+            client_type,
+            None,
+            0,
+        );
 
         assert!(!response_ptr.is_null(), "Failed to create client");
         let response = &*response_ptr;
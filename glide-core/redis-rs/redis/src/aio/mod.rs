@@ -16,6 +16,7 @@ use std::net::SocketAddr;
 #[cfg(unix)]
 use std::path::Path;
 use std::pin::Pin;
+use std::sync::Arc;
 use std::time::Duration;
 
 #[cfg(feature = "tls-rustls")]
@@ -118,6 +119,24 @@ impl Clone for Box<dyn DisconnectNotifier> {
     }
 }
 
+/// A change in the state of the connections of a client, reported to a [`ConnectionEventListener`].
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub enum ConnectionEvent {
+    /// A connection to the node with the given address was established for the first time.
+    Connected(String),
+    /// The connection to the node with the given address was lost. The client tries to reconnect in the background.
+    Disconnected(String),
+    /// The connection to the node with the given address was reestablished after it was lost.
+    Reconnected(String),
+    /// The topology of the cluster changed, such as after a failover or a slot migration.
+    TopologyChanged,
+}
+
+/// Listener notified of the [`ConnectionEvent`]s of a client.
+///
+/// The listener is called synchronously on the task which detected the event, so it should return quickly.
+pub type ConnectionEventListener = Arc<dyn Fn(ConnectionEvent) + Send + Sync>;
+
 // Helper function to extract and update availability zone from INFO command
 async fn update_az_from_info<C>(con: &mut C) -> RedisResult<()>
 where
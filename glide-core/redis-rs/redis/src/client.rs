@@ -1,7 +1,7 @@
 use std::time::Duration;
 
 #[cfg(feature = "aio")]
-use crate::aio::DisconnectNotifier;
+use crate::aio::{ConnectionEvent, ConnectionEventListener, DisconnectNotifier};
 
 use crate::{
     connection::{connect, Connection, ConnectionInfo, ConnectionLike, IntoConnectionInfo},
@@ -94,6 +94,19 @@ pub struct GlideConnectionOptions {
     /// This optional field sets the maximum duration to wait when attempting to establish
     /// a connection. If `None`, the connection will use `DEFAULT_CONNECTION_TIMEOUT`.
     pub connection_timeout: Option<Duration>,
+    #[cfg(feature = "aio")]
+    /// Listener notified when connections are established, lost and reestablished.
+    pub connection_event_listener: Option<ConnectionEventListener>,
+}
+
+#[cfg(feature = "aio")]
+impl GlideConnectionOptions {
+    /// Notifies the connection event listener, if set, of the given event.
+    pub fn notify_connection_event(&self, event: ConnectionEvent) {
+        if let Some(listener) = &self.connection_event_listener {
+            listener(event);
+        }
+    }
 }
 
 /// To enable async support you need to enable the feature: `tokio-comp`
@@ -65,7 +65,7 @@ use strum_macros::Display;
 use tokio::task::JoinHandle;
 
 #[cfg(feature = "tokio-comp")]
-use crate::aio::DisconnectNotifier;
+use crate::aio::{ConnectionEvent, DisconnectNotifier};
 use telemetrylib::Telemetry;
 
 use crate::{
@@ -410,6 +410,8 @@ pub(crate) struct InnerCore<C> {
     subscriptions_by_address: TokioRwLock<HashMap<String, PubSubSubscriptionInfo>>,
     unassigned_subscriptions: TokioRwLock<PubSubSubscriptionInfo>,
     glide_connection_options: GlideConnectionOptions,
+    // the addresses whose loss of connection was reported, until their connection is reestablished
+    disconnected_addresses: Mutex<HashSet<String>>,
 }
 
 pub(crate) type Core<C> = Arc<InnerCore<C>>;
@@ -441,6 +443,31 @@ where
             .map_err(|_| RedisError::from((ErrorKind::ClientError, MUTEX_WRITE_ERR)))
     }
 
+    // Reports the loss of the connection to the given address, unless it was already reported.
+    fn notify_disconnected(&self, address: &str) {
+        if self
+            .disconnected_addresses
+            .lock()
+            .unwrap()
+            .insert(address.to_string())
+        {
+            self.glide_connection_options
+                .notify_connection_event(ConnectionEvent::Disconnected(address.to_string()));
+        }
+    }
+
+    // Reports a connection established to the given address, as a reconnection if its loss was reported.
+    fn notify_connected(&self, address: &str) {
+        let was_disconnected = self.disconnected_addresses.lock().unwrap().remove(address);
+        let address = address.to_string();
+        self.glide_connection_options
+            .notify_connection_event(if was_disconnected {
+                ConnectionEvent::Reconnected(address)
+            } else {
+                ConnectionEvent::Connected(address)
+            });
+    }
+
     // return epoch of node
     pub(crate) async fn address_epoch(&self, node_address: &str) -> Result<u64, RedisError> {
         let command = cmd("CLUSTER").arg("INFO").to_owned();
@@ -1103,6 +1130,7 @@ where
             disconnect_notifier,
             discover_az,
             connection_timeout: Some(cluster_params.connection_timeout),
+            connection_event_listener: cluster_params.connection_event_listener.clone(),
         };
 
         let connections = Self::create_initial_connections(
@@ -1134,6 +1162,7 @@ where
             ),
             subscriptions_by_address: TokioRwLock::new(Default::default()),
             glide_connection_options,
+            disconnected_addresses: Mutex::new(HashSet::new()),
         });
         let mut connection = ClusterConnInner {
             inner,
@@ -1273,6 +1302,10 @@ where
             )));
         }
         info!("Connected to initial nodes:\n{}", connections.0);
+        for connection in connections.0 .0.iter() {
+            glide_connection_options
+                .notify_connection_event(ConnectionEvent::Connected(connection.key().clone()));
+        }
         Ok(connections.0)
     }
 
@@ -1445,6 +1478,12 @@ where
                 .expect(MUTEX_READ_ERR)
                 .remove_node(&address);
 
+            // the events of the management connections are not reported, since they are not used by user requests
+            let report_events = conn_type != RefreshConnectionType::OnlyManagementConnection;
+            if report_events && node_option.is_some() {
+                inner.notify_disconnected(&address);
+            }
+
             if !check_existing_conn {
                 node_option = None;
             }
@@ -1480,6 +1519,9 @@ where
                             "Succeeded to refresh connection for node {}.",
                             address_clone_for_task
                         );
+                        if report_events {
+                            inner_clone.notify_connected(&address_clone_for_task);
+                        }
                         inner_clone
                             .conn_lock
                             .read()
@@ -1977,6 +2019,7 @@ where
             .fold(
                 ConnectionsMap(DashMap::with_capacity(nodes_len)),
                 |connections, (addr, node)| async {
+                    let is_new_connection = node.is_none();
                     let mut cluster_params = inner
                         .get_cluster_param(|params| params.clone())
                         .expect(MUTEX_READ_ERR);
@@ -1992,6 +2035,9 @@ where
                     )
                     .await;
                     if let Ok(node) = node {
+                        if is_new_connection {
+                            inner.notify_connected(&addr);
+                        }
                         connections.0.insert(addr, node);
                     }
                     connections
@@ -2008,12 +2054,22 @@ where
         let read_from_replicas = inner
             .get_cluster_param(|params| params.read_from_replicas.clone())
             .expect(MUTEX_READ_ERR);
+        // the topology discovered when the client is created is not a change
+        let previous_topology_hash = write_guard.get_current_topology_hash();
+        let topology_changed =
+            previous_topology_hash != 0 && previous_topology_hash != topology_hash;
         *write_guard = ConnectionsContainer::new(
             new_slots,
             new_connections,
             read_from_replicas,
             topology_hash,
         );
+        drop(write_guard);
+        if topology_changed {
+            inner
+                .glide_connection_options
+                .notify_connection_event(ConnectionEvent::TopologyChanged);
+        }
         Ok(())
     }
 
@@ -19,6 +19,8 @@ use crate::tls::TlsConnParams;
 #[cfg(not(feature = "tls-rustls"))]
 use crate::connection::TlsConnParams;
 
+#[cfg(feature = "cluster-async")]
+use crate::aio::ConnectionEventListener;
 #[cfg(feature = "cluster-async")]
 use crate::cluster_async;
 
@@ -51,6 +53,8 @@ struct BuilderParams {
     protocol: ProtocolVersion,
     pubsub_subscriptions: Option<PubSubSubscriptionInfo>,
     open_telemetry_config: Option<GlideOpenTelemetryConfig>,
+    #[cfg(feature = "cluster-async")]
+    connection_event_listener: Option<ConnectionEventListener>,
 }
 
 #[derive(Clone)]
@@ -150,6 +154,8 @@ pub struct ClusterParams {
     pub(crate) response_timeout: Duration,
     pub(crate) protocol: ProtocolVersion,
     pub(crate) pubsub_subscriptions: Option<PubSubSubscriptionInfo>,
+    #[cfg(feature = "cluster-async")]
+    pub(crate) connection_event_listener: Option<ConnectionEventListener>,
 }
 
 impl ClusterParams {
@@ -182,6 +188,8 @@ impl ClusterParams {
             response_timeout: value.response_timeout.unwrap_or(Duration::MAX),
             protocol: value.protocol,
             pubsub_subscriptions: value.pubsub_subscriptions,
+            #[cfg(feature = "cluster-async")]
+            connection_event_listener: value.connection_event_listener,
         })
     }
 }
@@ -525,6 +533,17 @@ impl ClusterClientBuilder {
         self.builder_params.pubsub_subscriptions = Some(pubsub_subscriptions);
         self
     }
+
+    /// Sets the listener notified when the connections to the cluster nodes are established, lost and reestablished,
+    /// and when the topology of the cluster changes.
+    #[cfg(feature = "cluster-async")]
+    pub fn connection_event_listener(
+        mut self,
+        connection_event_listener: ConnectionEventListener,
+    ) -> ClusterClientBuilder {
+        self.builder_params.connection_event_listener = Some(connection_event_listener);
+        self
+    }
 }
 
 /// This is a Redis Cluster client.
@@ -498,7 +498,7 @@ pub mod sentinel;
 mod tls;
 
 #[cfg(feature = "tls-rustls")]
-pub use crate::tls::{ClientTlsConfig, TlsCertificates};
+pub use crate::tls::{retrieve_tls_certificates, ClientTlsConfig, TlsCertificates, TlsConnParams};
 
 mod client;
 mod cmd;
@@ -56,9 +56,8 @@ pub(crate) fn inner_build_with_tls(
     Ok(Client { connection_info })
 }
 
-pub(crate) fn retrieve_tls_certificates(
-    certificates: TlsCertificates,
-) -> RedisResult<TlsConnParams> {
+/// Parses the PEM formatted certificates into the parameters used by TLS connections.
+pub fn retrieve_tls_certificates(certificates: TlsCertificates) -> RedisResult<TlsConnParams> {
     let TlsCertificates {
         client_tls,
         root_cert,
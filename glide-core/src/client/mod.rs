@@ -97,6 +97,7 @@ pub(super) fn get_redis_connection_info(
 pub(super) fn get_connection_info(
     address: &NodeAddress,
     tls_mode: TlsMode,
+    tls_params: Option<redis::TlsConnParams>,
     redis_connection_info: redis::RedisConnectionInfo,
 ) -> redis::ConnectionInfo {
     let addr = if tls_mode != TlsMode::NoTls {
@@ -104,7 +105,7 @@ pub(super) fn get_connection_info(
             host: address.host.to_string(),
             port: get_port(address),
             insecure: tls_mode == TlsMode::InsecureTls,
-            tls_params: None,
+            tls_params,
         }
     } else {
         redis::ConnectionAddr::Tcp(address.host.to_string(), get_port(address))
@@ -705,7 +706,7 @@ async fn create_cluster_client(
     let initial_nodes: Vec<_> = request
         .addresses
         .into_iter()
-        .map(|address| get_connection_info(&address, tls_mode, redis_connection_info.clone()))
+        .map(|address| get_connection_info(&address, tls_mode, None, redis_connection_info.clone()))
         .collect();
     let periodic_topology_checks = match request.periodic_checks {
         Some(PeriodicCheck::Disabled) => None,
@@ -734,6 +735,10 @@ async fn create_cluster_client(
         builder = builder.client_name(client_name);
     }
     if tls_mode != TlsMode::NoTls {
+        // the certificates are set first, since setting them also sets the TLS mode to secure
+        if let Some(tls_certificates) = request.tls_certificates {
+            builder = builder.certs(tls_certificates);
+        }
         let tls = if tls_mode == TlsMode::SecureTls {
             redis::cluster::TlsMode::Secure
         } else {
@@ -744,6 +749,9 @@ async fn create_cluster_client(
     if let Some(pubsub_subscriptions) = redis_connection_info.pubsub_subscriptions.clone() {
         builder = builder.pubsub_subscriptions(pubsub_subscriptions);
     }
+    if let Some(connection_event_listener) = request.connection_event_listener {
+        builder = builder.connection_event_listener(connection_event_listener);
+    }
 
     // Always use with Glide
     builder = builder.periodic_connections_checks(Some(CONNECTION_CHECKS_INTERVAL));
@@ -861,6 +869,17 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
             )
         })
         .unwrap_or_default();
+    let tls_certificates = request
+        .tls_certificates
+        .as_ref()
+        .map(|tls_certificates| {
+            format!(
+                "\nTLS certificates: custom root certificates: {}, client certificate: {}",
+                tls_certificates.root_cert.is_some(),
+                tls_certificates.client_tls.is_some()
+            )
+        })
+        .unwrap_or_default();
     let cluster_mode = if request.cluster_mode_enabled {
         "\nCluster mode"
     } else {
@@ -927,7 +946,7 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
     );
 
     format!(
-        "\nAddresses: {addresses}{tls_mode}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
+        "\nAddresses: {addresses}{tls_mode}{tls_certificates}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
     )
 }
 
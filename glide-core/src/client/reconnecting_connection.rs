@@ -5,7 +5,9 @@ use crate::retry_strategies::RetryStrategy;
 use async_trait::async_trait;
 use futures_intrusive::sync::ManualResetEvent;
 use logger_core::{log_debug, log_error, log_trace, log_warn};
-use redis::aio::{DisconnectNotifier, MultiplexedConnection};
+use redis::aio::{
+    ConnectionEvent, ConnectionEventListener, DisconnectNotifier, MultiplexedConnection,
+};
 use redis::{GlideConnectionOptions, PushInfo, RedisConnectionInfo, RedisError, RedisResult};
 use std::fmt;
 use std::sync::atomic::{AtomicBool, Ordering};
@@ -122,6 +124,7 @@ async fn create_connection(
     push_sender: Option<mpsc::UnboundedSender<PushInfo>>,
     discover_az: bool,
     connection_timeout: Duration,
+    connection_event_listener: Option<ConnectionEventListener>,
 ) -> Result<ReconnectingConnection, (ReconnectingConnection, RedisError)> {
     let client = {
         let guard = connection_backend
@@ -138,6 +141,7 @@ async fn create_connection(
         )),
         discover_az,
         connection_timeout: Some(connection_timeout),
+        connection_event_listener,
     };
 
     let action = || async {
@@ -159,13 +163,15 @@ async fn create_connection(
                 ),
             );
             Telemetry::incr_total_connections(1);
-            Ok(ReconnectingConnection {
+            let connection = ReconnectingConnection {
                 inner: Arc::new(InnerReconnectingConnection {
                     state: Mutex::new(ConnectionState::Connected(connection)),
                     backend: connection_backend,
                 }),
                 connection_options,
-            })
+            };
+            connection.notify_connection_event(ConnectionEvent::Connected);
+            Ok(connection)
         }
         Err(err) => {
             log_warn(
@@ -194,11 +200,13 @@ async fn create_connection(
 fn get_client(
     address: &NodeAddress,
     tls_mode: TlsMode,
+    tls_params: Option<redis::TlsConnParams>,
     redis_connection_info: redis::RedisConnectionInfo,
 ) -> redis::Client {
     redis::Client::open(super::get_connection_info(
         address,
         tls_mode,
+        tls_params,
         redis_connection_info,
     ))
     .unwrap() // can unwrap, because [open] fails only on trying to convert input to ConnectionInfo, and we pass ConnectionInfo.
@@ -224,21 +232,24 @@ impl ConnectionBackend {
 }
 
 impl ReconnectingConnection {
+    #[allow(clippy::too_many_arguments)]
     pub(super) async fn new(
         address: &NodeAddress,
         connection_retry_strategy: RetryStrategy,
         redis_connection_info: RedisConnectionInfo,
         tls_mode: TlsMode,
+        tls_params: Option<redis::TlsConnParams>,
         push_sender: Option<mpsc::UnboundedSender<PushInfo>>,
         discover_az: bool,
         connection_timeout: Duration,
+        connection_event_listener: Option<ConnectionEventListener>,
     ) -> Result<ReconnectingConnection, (ReconnectingConnection, RedisError)> {
         log_debug(
             "connection creation",
             format!("Attempting connection to {address}"),
         );
 
-        let connection_info = get_client(address, tls_mode, redis_connection_info);
+        let connection_info = get_client(address, tls_mode, tls_params, redis_connection_info);
         let backend = ConnectionBackend {
             connection_info: RwLock::new(connection_info),
             connection_available_signal: ManualResetEvent::new(true),
@@ -250,6 +261,7 @@ impl ReconnectingConnection {
             push_sender,
             discover_az,
             connection_timeout,
+            connection_event_listener,
         )
         .await
     }
@@ -263,6 +275,12 @@ impl ReconnectingConnection {
             .to_string()
     }
 
+    /// Notifies the connection event listener, if set, of an event of the connection to this node.
+    fn notify_connection_event(&self, event: fn(String) -> ConnectionEvent) {
+        self.connection_options
+            .notify_connection_event(event(self.node_address()));
+    }
+
     pub(super) fn is_dropped(&self) -> bool {
         self.inner
             .backend
@@ -320,6 +338,7 @@ impl ReconnectingConnection {
             // Attempting to reconnect a connection that was dropped (for any reason) - update the telemetry by reducing
             // the number of opened connections by 1, it will be incremented by 1 after a successful re-connect
             Telemetry::decr_total_connections(1);
+            self.notify_connection_event(ConnectionEvent::Disconnected);
         }
 
         // The reconnect task is spawned instead of awaited here, so that the reconnect attempt will continue in the
@@ -361,6 +380,13 @@ impl ReconnectingConnection {
                             *guard = ConnectionState::Connected(connection);
                         }
                         Telemetry::incr_total_connections(1);
+                        connection_clone.notify_connection_event(
+                            if reason == ReconnectReason::ConnectionDropped {
+                                ConnectionEvent::Reconnected
+                            } else {
+                                ConnectionEvent::Connected
+                            },
+                        );
                         return;
                     }
                     Err(_) => tokio::time::sleep(sleep_duration).await,
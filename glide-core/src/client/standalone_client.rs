@@ -10,7 +10,7 @@ use futures::{future, stream, StreamExt};
 use logger_core::log_debug;
 use logger_core::log_warn;
 use rand::Rng;
-use redis::aio::ConnectionLike;
+use redis::aio::{ConnectionEventListener, ConnectionLike};
 use redis::cluster_routing::{self, is_readonly_cmd, ResponsePolicy, Routable, RoutingInfo};
 use redis::{PushInfo, RedisError, RedisResult, Value};
 use std::sync::atomic::AtomicUsize;
@@ -127,6 +127,14 @@ impl StandaloneClient {
         let retry_strategy = RetryStrategy::new(connection_request.connection_retry_strategy);
 
         let tls_mode = connection_request.tls_mode;
+        let tls_params = match connection_request.tls_certificates.clone() {
+            Some(tls_certificates) if tls_mode.unwrap_or(TlsMode::NoTls) != TlsMode::NoTls => Some(
+                redis::retrieve_tls_certificates(tls_certificates).map_err(|err| {
+                    StandaloneClientConnectionError::FailedConnection(vec![(None, err)])
+                })?,
+            ),
+            _ => None,
+        };
         let node_count = connection_request.addresses.len();
         // randomize pubsub nodes, maybe a batter option is to always use the primary
         let pubsub_node_index = rand::thread_rng().gen_range(0..node_count);
@@ -153,9 +161,11 @@ impl StandaloneClient {
                         &pubsub_connection_info
                     },
                     tls_mode.unwrap_or(TlsMode::NoTls),
+                    &tls_params,
                     &push_sender,
                     discover_az,
                     connection_timeout,
+                    &connection_request.connection_event_listener,
                 )
                 .await
                 .map_err(|err| (format!("{}:{}", address.host, address.port), err))
@@ -624,23 +634,28 @@ impl StandaloneClient {
     }
 }
 
+#[allow(clippy::too_many_arguments)]
 async fn get_connection_and_replication_info(
     address: &NodeAddress,
     retry_strategy: &RetryStrategy,
     connection_info: &redis::RedisConnectionInfo,
     tls_mode: TlsMode,
+    tls_params: &Option<redis::TlsConnParams>,
     push_sender: &Option<mpsc::UnboundedSender<PushInfo>>,
     discover_az: bool,
     connection_timeout: Duration,
+    connection_event_listener: &Option<ConnectionEventListener>,
 ) -> Result<(ReconnectingConnection, Value), (ReconnectingConnection, RedisError)> {
     let result = ReconnectingConnection::new(
         address,
         retry_strategy.clone(),
         connection_info.clone(),
         tls_mode,
+        tls_params.clone(),
         push_sender.clone(),
         discover_az,
         connection_timeout,
+        connection_event_listener.clone(),
     )
     .await;
     let reconnecting_connection = match result {
@@ -27,6 +27,8 @@ pub struct ConnectionRequest {
     pub inflight_requests_limit: Option<u32>,
     pub otel_endpoint: Option<String>,
     pub otel_span_flush_interval_ms: Option<u64>,
+    pub tls_certificates: Option<redis::TlsCertificates>,
+    pub connection_event_listener: Option<redis::aio::ConnectionEventListener>,
 }
 
 pub struct AuthenticationInfo {
@@ -229,6 +231,20 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
         let otel_endpoint = chars_to_string_option(&value.opentelemetry_config.collector_end_point);
         let otel_span_flush_interval_ms = value.opentelemetry_config.span_flush_interval;
 
+        let root_cert = (!value.root_certs.is_empty()).then(|| value.root_certs.to_vec());
+        let client_tls =
+            (!value.client_cert.is_empty() || !value.client_key.is_empty()).then(|| {
+                redis::ClientTlsConfig {
+                    client_cert: value.client_cert.to_vec(),
+                    client_key: value.client_key.to_vec(),
+                }
+            });
+        let tls_certificates =
+            (root_cert.is_some() || client_tls.is_some()).then_some(redis::TlsCertificates {
+                client_tls,
+                root_cert,
+            });
+
         ConnectionRequest {
             read_from,
             client_name,
@@ -246,6 +262,8 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
             inflight_requests_limit,
             otel_endpoint,
             otel_span_flush_interval_ms,
+            tls_certificates,
+            connection_event_listener: None,
         }
     }
 }
@@ -7,7 +7,14 @@ use sha1_smol::Sha1;
 use std::collections::HashMap;
 use std::sync::{Arc, Mutex};
 
-static CONTAINER: Lazy<Mutex<HashMap<String, Arc<BytesMut>>>> =
+/// A stored script, with the number of its owners. Scripts with the same code share a single entry, which is removed
+/// once all of its owners removed it.
+struct ScriptEntry {
+    script: Arc<BytesMut>,
+    ref_count: usize,
+}
+
+static CONTAINER: Lazy<Mutex<HashMap<String, ScriptEntry>>> =
     Lazy::new(|| Mutex::new(HashMap::new()));
 
 pub fn add_script(script: &[u8]) -> String {
@@ -21,12 +28,21 @@ pub fn add_script(script: &[u8]) -> String {
     CONTAINER
         .lock()
         .unwrap()
-        .insert(hash.clone(), Arc::new(script.into()));
+        .entry(hash.clone())
+        .or_insert_with(|| ScriptEntry {
+            script: Arc::new(script.into()),
+            ref_count: 0,
+        })
+        .ref_count += 1;
     hash
 }
 
 pub fn get_script(hash: &str) -> Option<Arc<BytesMut>> {
-    CONTAINER.lock().unwrap().get(hash).cloned()
+    CONTAINER
+        .lock()
+        .unwrap()
+        .get(hash)
+        .map(|entry| entry.script.clone())
 }
 
 pub fn remove_script(hash: &str) {
@@ -34,5 +50,33 @@ pub fn remove_script(hash: &str) {
         "script lifetime",
         format!("Removed script with hash: `{hash}`"),
     );
-    CONTAINER.lock().unwrap().remove(hash);
+    let mut container = CONTAINER.lock().unwrap();
+    if let Some(entry) = container.get_mut(hash) {
+        entry.ref_count -= 1;
+        if entry.ref_count == 0 {
+            container.remove(hash);
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_script_is_kept_until_all_owners_removed_it() {
+        let code = b"return 'scripts_container test'";
+        let hash = add_script(code);
+        assert_eq!(add_script(code), hash);
+
+        remove_script(&hash);
+        assert_eq!(get_script(&hash).unwrap().as_ref(), code);
+
+        remove_script(&hash);
+        assert!(get_script(&hash).is_none());
+
+        // removing a script which is not stored has no effect
+        remove_script(&hash);
+        assert!(get_script(&hash).is_none());
+    }
 }
@@ -226,6 +226,9 @@ async fn write_result(
                     RequestErrorType::ExecAbort => response::RequestErrorType::ExecAbort,
                     RequestErrorType::Timeout => response::RequestErrorType::Timeout,
                     RequestErrorType::Disconnect => response::RequestErrorType::Disconnect,
+                    RequestErrorType::InflightRequestsLimit => {
+                        response::RequestErrorType::Unspecified
+                    }
                 }
                 .into(),
                 message: error_message.into(),
@@ -83,6 +83,9 @@ fn parse_endpoint(endpoint: &str) -> Result<GlideOpenTelemetryTraceExporter, Err
             url.host_str().unwrap_or("127.0.0.1"),
             url.port().unwrap_or(80)
         ))), // gRPC endpoint
+        "file" => Ok(GlideOpenTelemetryTraceExporter::File(PathBuf::from(
+            url.path(),
+        ))), // local directory
         _ => Err(Error::new(ErrorKind::InvalidInput, endpoint)),
     }
 }
@@ -439,6 +442,15 @@ mod tests {
         });
     }
 
+    #[test]
+    fn test_parse_file_endpoint() {
+        let exporter = GlideOpenTelemetryTraceExporter::from_str("file:///tmp/traces").unwrap();
+        assert!(
+            matches!(exporter, GlideOpenTelemetryTraceExporter::File(path) if path == PathBuf::from("/tmp/traces"))
+        );
+        assert!(GlideOpenTelemetryTraceExporter::from_str("ftp://test.com").is_err());
+    }
+
     #[test]
     fn test_span_http_exporter() {
         let runtime = tokio::runtime::Builder::new_current_thread()
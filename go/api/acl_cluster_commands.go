@@ -0,0 +1,24 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands of the "ACL" group for cluster client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#server
+type AclClusterCommands interface {
+	AclLogWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[[]AclLogEntry], error)
+
+	AclLogWithCountWithRoute(
+		ctx context.Context,
+		count int64,
+		route options.RouteOption,
+	) (ClusterValue[[]AclLogEntry], error)
+}
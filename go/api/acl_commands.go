@@ -0,0 +1,48 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands of the "ACL" group for standalone and cluster clients.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#server
+type AclCommands interface {
+	AclSetUser(ctx context.Context, username string, rules *options.AclRuleBuilder) (string, error)
+
+	AclGetUser(ctx context.Context, username string) (Result[AclUserInfo], error)
+
+	AclDelUser(ctx context.Context, usernames []string) (int64, error)
+
+	AclList(ctx context.Context) ([]string, error)
+
+	AclUsers(ctx context.Context) ([]string, error)
+
+	AclCat(ctx context.Context) ([]string, error)
+
+	AclCatWithCategory(ctx context.Context, category string) ([]string, error)
+
+	AclDryRun(ctx context.Context, username string, command string, args []string) (string, error)
+
+	AclGenPass(ctx context.Context) (string, error)
+
+	AclGenPassWithBits(ctx context.Context, bits int64) (string, error)
+
+	AclLog(ctx context.Context) ([]AclLogEntry, error)
+
+	AclLogWithCount(ctx context.Context, count int64) ([]AclLogEntry, error)
+
+	AclLogReset(ctx context.Context) (string, error)
+
+	AclLoad(ctx context.Context) (string, error)
+
+	AclSave(ctx context.Context) (string, error)
+
+	AclWhoami(ctx context.Context) (string, error)
+}
@@ -0,0 +1,240 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func ExampleGlideClient_AclSetUser() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	rules := options.NewAclRuleBuilder().
+		Reset().
+		On().
+		AddPassword("secret").
+		KeyPattern("tenant1:*").
+		AllowCategory("read")
+	result, err := client.AclSetUser(context.Background(), "tenant1", rules)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.AclDelUser(context.Background(), []string{"tenant1"})
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_AclSetUser() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	rules := options.NewAclRuleBuilder().
+		Reset().
+		On().
+		AddPassword("secret").
+		KeyPattern("tenant1:*").
+		AllowCategory("read")
+	result, err := client.AclSetUser(context.Background(), "tenant1", rules)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.AclDelUser(context.Background(), []string{"tenant1"})
+
+	// Output: OK
+}
+
+func ExampleGlideClient_AclGetUser() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	rules := options.NewAclRuleBuilder().Reset().On().NoPass().KeyPattern("tenant1:*").AllowCommand("get")
+	client.AclSetUser(context.Background(), "tenant1", rules)
+	result, err := client.AclGetUser(context.Background(), "tenant1")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value().Commands)
+	fmt.Println(result.Value().Keys)
+	client.AclDelUser(context.Background(), []string{"tenant1"})
+
+	// Output:
+	// -@all +get
+	// ~tenant1:*
+}
+
+func ExampleGlideClusterClient_AclGetUser() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	result, err := client.AclGetUser(context.Background(), "unknown")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsNil())
+
+	// Output: true
+}
+
+func ExampleGlideClient_AclDelUser() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	client.AclSetUser(context.Background(), "tenant1", options.NewAclRuleBuilder())
+	result, err := client.AclDelUser(context.Background(), []string{"tenant1", "unknown"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 1
+}
+
+func ExampleGlideClusterClient_AclDelUser() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	client.AclSetUser(context.Background(), "tenant1", options.NewAclRuleBuilder())
+	result, err := client.AclDelUser(context.Background(), []string{"tenant1", "unknown"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 1
+}
+
+func ExampleGlideClient_AclDryRun() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	rules := options.NewAclRuleBuilder().Reset().On().NoPass().AllKeys().AllowCommand("get")
+	client.AclSetUser(context.Background(), "tenant1", rules)
+	allowed, err := client.AclDryRun(context.Background(), "tenant1", "get", []string{"key"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	denied, err := client.AclDryRun(context.Background(), "tenant1", "set", []string{"key", "value"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(allowed)
+	fmt.Println(denied)
+	client.AclDelUser(context.Background(), []string{"tenant1"})
+
+	// Output:
+	// OK
+	// User tenant1 has no permissions to run the 'set' command
+}
+
+func ExampleGlideClient_AclGenPass() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	result, err := client.AclGenPass(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result))
+
+	// Output: 64
+}
+
+func ExampleGlideClusterClient_AclGenPassWithBits() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	result, err := client.AclGenPassWithBits(context.Background(), 32)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result))
+
+	// Output: 8
+}
+
+func ExampleGlideClient_AclWhoami() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	result, err := client.AclWhoami(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: default
+}
+
+func ExampleGlideClusterClient_AclWhoami() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	result, err := client.AclWhoami(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: default
+}
+
+func ExampleGlideClient_AclLog() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+
+	client.AclLogReset(context.Background())
+	client.AclSetUser(context.Background(), "tenant1", options.NewAclRuleBuilder().Reset().On().AddPassword("secret"))
+	// an authentication with a wrong password is logged
+	client.CustomCommand(context.Background(), []string{"AUTH", "tenant1", "wrong"})
+	result, err := client.AclLog(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result[0].Reason, result[0].Username)
+	client.AclDelUser(context.Background(), []string{"tenant1"})
+
+	// Output: auth tenant1
+}
+
+func ExampleGlideClusterClient_AclLogWithRoute() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	client.AclLogReset(context.Background())
+	result, err := client.AclLogWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, entries := range result.MultiValue() {
+		fmt.Println(len(entries))
+		break
+	}
+
+	// Output: 0
+}
+
+func ExampleGlideClusterClient_AclLogReset() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	result, err := client.AclLogReset(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func TestParseAclLogEntries(t *testing.T) {
+	// RESP2 entries are flat arrays, with the age as a string
+	entries, err := parseAclLogEntries([]any{
+		[]any{"count", int64(2), "reason", "auth", "username", "tenant1", "age-seconds", "1.5"},
+		map[string]any{"count": int64(1), "reason": "command", "object": "get", "age-seconds": float64(3)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []AclLogEntry{
+		{Count: 2, Reason: "auth", Username: "tenant1", AgeSeconds: 1.5},
+		{Count: 1, Reason: "command", Object: "get", AgeSeconds: 3},
+	}, entries)
+
+	_, err = parseAclLogEntries([]any{[]any{"count"}})
+	assert.Error(t, err)
+	_, err = parseAclLogEntries("entries")
+	assert.Error(t, err)
+}
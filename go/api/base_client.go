@@ -14,13 +14,19 @@ package api
 //
 // void successCallback(void *channelPtr, struct CommandResponse *message);
 // void failureCallback(void *channelPtr, char *errMessage, RequestErrorType errType);
+// void pubSubCallback(uintptr_t clientId, PushKind kind, uint8_t *message, int64_t messageLen, uint8_t *channel,
+// int64_t channelLen, uint8_t *pattern, int64_t patternLen);
+// void connectionEventCallback(uintptr_t clientId, ConnectionEventKind kind, uint8_t *address, int64_t addressLen);
 import "C"
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/api/config"
@@ -32,6 +38,11 @@ import (
 )
 
 // BaseClient defines an interface for methods common to both [GlideClientCommands] and [GlideClusterClientCommands].
+//
+// Every command takes a [context.Context] as its first argument. If the context is cancelled or its deadline expires
+// before the response is received, the command returns immediately with ctx.Err(). The request itself is not
+// aborted on the server, and its response is discarded once it arrives or the client is closed. From then on, the request
+// is no longer counted in the pending requests of the client.
 type BaseClient interface {
 	StringCommands
 	HashCommands
@@ -44,8 +55,13 @@ type BaseClient interface {
 	BitmapCommands
 	GeoSpatialCommands
 	ScriptingAndFunctionBaseCommands
+	PubSubBaseCommands
+	AclCommands
 	// Close terminates the client by closing all associated resources.
 	Close()
+	// Statistics returns a snapshot of the statistics of the requests sent by the client. See [ClientStatistics] for
+	// details.
+	Statistics() ClientStatistics
 }
 
 const OK = "OK"
@@ -72,12 +88,26 @@ func failureCallback(channelPtr unsafe.Pointer, cErrorMessage *C.char, cErrorTyp
 
 type clientConfiguration interface {
 	toProtobuf() (*protobuf.ConnectionRequest, error)
+	subscriptionConfig() *baseSubscriptionConfig
+	statisticsExporter() StatisticsExporter
+	interceptors() []Interceptor
+	connectionEventListener() ConnectionEventListener
 }
 
 type baseClient struct {
-	pending    map[unsafe.Pointer]struct{}
+	pending map[unsafe.Pointer]struct{}
+	// The requests whose context was done before their response was received. They are still in flight, but no longer
+	// counted as pending.
+	abandoned  map[unsafe.Pointer]struct{}
 	coreClient unsafe.Pointer
 	mu         sync.Mutex
+	// The handler of the received pub/sub messages, nil if the client has no subscriptions.
+	messageHandler *messageHandler
+	// The handler of the connection events, nil if the client has no connection event listener.
+	connectionEventHandler *connectionEventHandler
+	statistics             *clientStatistics
+	// The chain of the interceptors of the commands, nil if the client has no interceptors.
+	interceptor Interceptor
 }
 
 // buildAsyncClientType safely initializes a C.ClientType with an AsyncClient_Body.
@@ -129,8 +159,17 @@ func buildAsyncClientType(successCb C.SuccessCallback, failureCb C.FailureCallba
 	return clientType, nil
 }
 
+// lastClientId is the id of the last client created with callbacks for its pub/sub messages or connection events. Ids are
+// passed to the Rust library and back to the callbacks instead of Go pointers, which must not be retained by C code.
+var lastClientId atomic.Uintptr
+
+func newClientId() uintptr {
+	return lastClientId.Add(1)
+}
+
 // Creates a connection by invoking the `create_client` function from Rust library via FFI.
-// Passes the pointers to callback functions which will be invoked when the command succeeds or fails.
+// Passes the pointers to callback functions which will be invoked when the command succeeds or fails, when a pub/sub
+// message is received, or when the state of the connections changes.
 // Once the connection is established, this function invokes `free_connection_response` exposed by rust library to free the
 // connection_response to avoid any memory leaks.
 func createClient(config clientConfiguration) (*baseClient, error) {
@@ -154,22 +193,53 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 		return nil, &errors.ClosingError{Msg: err.Error()}
 	}
 
+	clientId := newClientId()
+	var handler *messageHandler
+	var pubSubCb C.PubSubCallback
+	if subscriptions := config.subscriptionConfig(); subscriptions != nil {
+		handler = newMessageHandler(clientId, subscriptions)
+		pubSubCb = (C.PubSubCallback)(unsafe.Pointer(C.pubSubCallback))
+	}
+	var eventHandler *connectionEventHandler
+	var connectionEventCb C.ConnectionEventCallback
+	if listener := config.connectionEventListener(); listener != nil {
+		eventHandler = newConnectionEventHandler(clientId, listener)
+		connectionEventCb = (C.ConnectionEventCallback)(unsafe.Pointer(C.connectionEventCallback))
+	}
+
 	cResponse := (*C.struct_ConnectionResponse)(
 		C.create_client(
 			(*C.uchar)(requestBytes),
 			C.uintptr_t(byteCount),
 			&clientType,
+			pubSubCb,
+			connectionEventCb,
+			C.uintptr_t(clientId),
 		),
 	)
 	defer C.free_connection_response(cResponse)
 
 	cErr := cResponse.connection_error_message
 	if cErr != nil {
+		if handler != nil {
+			handler.close()
+		}
+		if eventHandler != nil {
+			eventHandler.close()
+		}
 		message := C.GoString(cErr)
 		return nil, &errors.ConnectionError{Msg: message}
 	}
 
-	return &baseClient{coreClient: cResponse.conn_ptr, pending: make(map[unsafe.Pointer]struct{})}, nil
+	return &baseClient{
+		coreClient:             cResponse.conn_ptr,
+		pending:                make(map[unsafe.Pointer]struct{}),
+		abandoned:              make(map[unsafe.Pointer]struct{}),
+		messageHandler:         handler,
+		connectionEventHandler: eventHandler,
+		statistics:             newClientStatistics(config.statisticsExporter()),
+		interceptor:            chainInterceptors(config.interceptors()),
+	}, nil
 }
 
 // Close terminates the client by closing all associated resources.
@@ -183,6 +253,12 @@ func (client *baseClient) Close() {
 
 	C.close_client(client.coreClient)
 	client.coreClient = nil
+	if client.messageHandler != nil {
+		client.messageHandler.close()
+	}
+	if client.connectionEventHandler != nil {
+		client.connectionEventHandler.close()
+	}
 
 	// iterating the channel map while holding the lock guarantees those unsafe.Pointers is still valid
 	// because holding the lock guarantees the owner of the unsafe.Pointer hasn't exit.
@@ -191,10 +267,30 @@ func (client *baseClient) Close() {
 		resultChannel <- payload{value: nil, error: &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}}
 	}
 	client.pending = nil
+	// the callbacks of the in-flight requests are not invoked once the client is closed, so the abandoned requests must be
+	// released here as well
+	for channelPtr := range client.abandoned {
+		resultChannel := *(*chan payload)(channelPtr)
+		resultChannel <- payload{value: nil, error: &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}}
+	}
+	client.abandoned = nil
+}
+
+// Statistics returns a snapshot of the statistics of the requests sent by the client. See [ClientStatistics] for details.
+func (client *baseClient) Statistics() ClientStatistics {
+	client.mu.Lock()
+	pendingRequests := len(client.pending)
+	client.mu.Unlock()
+
+	return client.statistics.snapshot(pendingRequests)
 }
 
-func (client *baseClient) executeCommand(requestType C.RequestType, args []string) (*C.struct_CommandResponse, error) {
-	return client.executeCommandWithRoute(requestType, args, nil)
+func (client *baseClient) executeCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+) (*C.struct_CommandResponse, error) {
+	return client.executeCommandWithRoute(ctx, requestType, args, nil)
 }
 
 func slotTypeToProtobuf(slotType config.SlotType) (protobuf.SlotTypes, error) {
@@ -272,10 +368,28 @@ func routeToProtobuf(route config.Route) (*protobuf.Routes, error) {
 }
 
 func (client *baseClient) executeCommandWithRoute(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if client.interceptor != nil {
+		return client.executeInterceptedCommand(ctx, requestType, args, route)
+	}
+	return client.sendCommand(ctx, requestType, args, route)
+}
+
+func (client *baseClient) sendCommand(
+	ctx context.Context,
 	requestType C.RequestType,
 	args []string,
 	route config.Route,
 ) (*C.struct_CommandResponse, error) {
+	start := time.Now()
+	if err := ctx.Err(); err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+
 	var cArgsPtr *C.uintptr_t = nil
 	var argLengthsPtr *C.ulong = nil
 	if len(args) > 0 {
@@ -289,11 +403,11 @@ func (client *baseClient) executeCommandWithRoute(
 	if route != nil {
 		routeProto, err := routeToProtobuf(route)
 		if err != nil {
-			return nil, &errors.RequestError{Msg: "ExecuteCommand failed due to invalid route"}
+			return nil, client.rejectRequest(start, &errors.RequestError{Msg: "ExecuteCommand failed due to invalid route"})
 		}
 		msg, err := proto.Marshal(routeProto)
 		if err != nil {
-			return nil, err
+			return nil, client.rejectRequest(start, err)
 		}
 
 		routeBytesCount = C.uintptr_t(len(msg))
@@ -306,12 +420,18 @@ func (client *baseClient) executeCommandWithRoute(
 
 	pinner := pinner{}
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
+
+	var spanPtr C.uint64_t
+	if shouldSampleOpenTelemetrySpan() {
+		spanPtr = C.create_otel_span(uint32(requestType))
+		defer C.drop_otel_span(spanPtr)
+	}
 
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
-		return nil, &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
+		pinner.Unpin()
+		return nil, client.rejectRequest(start, &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."})
 	}
 	client.pending[resultChannelPtr] = struct{}{}
 	C.command(
@@ -323,21 +443,229 @@ func (client *baseClient) executeCommandWithRoute(
 		argLengthsPtr,
 		routeBytesPtr,
 		routeBytesCount,
+		spanPtr,
+	)
+	client.mu.Unlock()
+
+	return client.waitForResponse(ctx, start, resultChannel, resultChannelPtr, &pinner)
+}
+
+func (client *baseClient) executeBatch(
+	ctx context.Context,
+	batch *Batch,
+	raiseOnError bool,
+	timeout uint32,
+	retryStrategy *options.ClusterBatchRetryStrategy,
+	route config.Route,
+) ([]any, error) {
+	start := time.Now()
+	if err := ctx.Err(); err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+
+	batchProto, err := batch.toProtobuf(raiseOnError, timeout)
+	if err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+	if retryStrategy != nil {
+		batchProto.RetryServerError = &retryStrategy.RetryServerError
+		batchProto.RetryConnectionError = &retryStrategy.RetryConnectionError
+	}
+	batchBytes, err := proto.Marshal(batchProto)
+	if err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+	batchBytesPtr := (*C.uchar)(C.CBytes(batchBytes))
+	defer C.free(unsafe.Pointer(batchBytesPtr))
+
+	var routeBytesPtr *C.uchar = nil
+	var routeBytesCount C.uintptr_t = 0
+	if route != nil {
+		routeProto, err := routeToProtobuf(route)
+		if err != nil {
+			return nil, client.rejectRequest(start, &errors.RequestError{Msg: "Batch failed due to invalid route"})
+		}
+		msg, err := proto.Marshal(routeProto)
+		if err != nil {
+			return nil, client.rejectRequest(start, err)
+		}
+
+		routeBytesCount = C.uintptr_t(len(msg))
+		routeBytesPtr = (*C.uchar)(C.CBytes(msg))
+		defer C.free(unsafe.Pointer(routeBytesPtr))
+	}
+
+	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
+	resultChannel := make(chan payload, 1)
+	resultChannelPtr := unsafe.Pointer(&resultChannel)
+
+	pinner := pinner{}
+	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
+
+	var spanPtr C.uint64_t
+	if shouldSampleOpenTelemetrySpan() {
+		spanPtr = C.create_batch_otel_span()
+		defer C.drop_otel_span(spanPtr)
+	}
+
+	client.mu.Lock()
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		pinner.Unpin()
+		return nil, client.rejectRequest(start, &errors.ClosingError{Msg: "Batch failed. The client is closed."})
+	}
+	client.pending[resultChannelPtr] = struct{}{}
+	C.batch(
+		client.coreClient,
+		C.uintptr_t(pinnedChannelPtr),
+		batchBytesPtr,
+		C.uintptr_t(len(batchBytes)),
+		routeBytesPtr,
+		routeBytesCount,
+		spanPtr,
 	)
 	client.mu.Unlock()
 
-	payload := <-resultChannel
+	response, err := client.waitForResponse(ctx, start, resultChannel, resultChannelPtr, &pinner)
+	if err != nil {
+		return nil, err
+	}
+	return batch.convertResponse(response)
+}
+
+// executeScript invokes a script stored in the script container of the core by its hash.
+func (client *baseClient) executeScript(
+	ctx context.Context,
+	hash string,
+	keys []string,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	start := time.Now()
+	if err := ctx.Err(); err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+
+	invocation := &protobuf.ScriptInvocation{Hash: hash}
+	for _, key := range keys {
+		invocation.Keys = append(invocation.Keys, []byte(key))
+	}
+	for _, arg := range args {
+		invocation.Args = append(invocation.Args, []byte(arg))
+	}
+	scriptBytes, err := proto.Marshal(invocation)
+	if err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+	scriptBytesPtr := (*C.uchar)(C.CBytes(scriptBytes))
+	defer C.free(unsafe.Pointer(scriptBytesPtr))
+
+	var routeBytesPtr *C.uchar = nil
+	var routeBytesCount C.uintptr_t = 0
+	if route != nil {
+		routeProto, err := routeToProtobuf(route)
+		if err != nil {
+			return nil, client.rejectRequest(start, &errors.RequestError{Msg: "InvokeScript failed due to invalid route"})
+		}
+		msg, err := proto.Marshal(routeProto)
+		if err != nil {
+			return nil, client.rejectRequest(start, err)
+		}
+
+		routeBytesCount = C.uintptr_t(len(msg))
+		routeBytesPtr = (*C.uchar)(C.CBytes(msg))
+		defer C.free(unsafe.Pointer(routeBytesPtr))
+	}
+
+	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
+	resultChannel := make(chan payload, 1)
+	resultChannelPtr := unsafe.Pointer(&resultChannel)
+
+	pinner := pinner{}
+	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
+
+	var spanPtr C.uint64_t
+	if shouldSampleOpenTelemetrySpan() {
+		spanPtr = C.create_script_otel_span()
+		defer C.drop_otel_span(spanPtr)
+	}
 
 	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		pinner.Unpin()
+		return nil, client.rejectRequest(start, &errors.ClosingError{Msg: "InvokeScript failed. The client is closed."})
 	}
+	client.pending[resultChannelPtr] = struct{}{}
+	C.invoke_script(
+		client.coreClient,
+		C.uintptr_t(pinnedChannelPtr),
+		scriptBytesPtr,
+		C.uintptr_t(len(scriptBytes)),
+		routeBytesPtr,
+		routeBytesCount,
+		spanPtr,
+	)
 	client.mu.Unlock()
 
-	if payload.error != nil {
-		return nil, payload.error
+	return client.waitForResponse(ctx, start, resultChannel, resultChannelPtr, &pinner)
+}
+
+// waitForResponse blocks until the response of an in-flight request arrives or ctx is done, whichever happens first.
+// If ctx is done first, ctx.Err() is returned and the response is released in the background once it arrives. The
+// request, started at the given time, is recorded in the statistics of the client.
+func (client *baseClient) waitForResponse(
+	ctx context.Context,
+	start time.Time,
+	resultChannel chan payload,
+	resultChannelPtr unsafe.Pointer,
+	pinner *pinner,
+) (*C.struct_CommandResponse, error) {
+	select {
+	case <-ctx.Done():
+		// The request is no longer pending for the caller, but it is still in flight, so the channel stays pinned until
+		// its response is received, or until the client is closed, and the response memory allocated by rust is freed.
+		client.mu.Lock()
+		if client.pending != nil {
+			delete(client.pending, resultChannelPtr)
+			client.abandoned[resultChannelPtr] = struct{}{}
+		}
+		client.mu.Unlock()
+		go func() {
+			payload := <-resultChannel
+			client.completeRequest(resultChannelPtr, pinner)
+			if payload.value != nil {
+				C.free_command_response(payload.value)
+			}
+		}()
+		client.statistics.recordRequest(time.Since(start), ctx.Err())
+		return nil, ctx.Err()
+	case payload := <-resultChannel:
+		client.completeRequest(resultChannelPtr, pinner)
+		client.statistics.recordRequest(time.Since(start), payload.error)
+		if payload.error != nil {
+			return nil, payload.error
+		}
+		return payload.value, nil
+	}
+}
+
+// rejectRequest records a request which failed before it was submitted to the core in the statistics of the client, and
+// returns its error.
+func (client *baseClient) rejectRequest(start time.Time, err error) error {
+	client.statistics.recordRequest(time.Since(start), err)
+	return err
+}
+
+// completeRequest removes a request from the pending or the abandoned set once its response has been received.
+func (client *baseClient) completeRequest(resultChannelPtr unsafe.Pointer, pinner *pinner) {
+	client.mu.Lock()
+	if client.pending != nil {
+		delete(client.pending, resultChannelPtr)
+		delete(client.abandoned, resultChannelPtr)
 	}
-	return payload.value, nil
+	client.mu.Unlock()
+	pinner.Unpin()
 }
 
 // Zero copying conversion from go's []string into C pointers
@@ -356,19 +684,31 @@ func toCStrings(args []string) ([]C.uintptr_t, []C.ulong) {
 	return cStrings, stringLengths
 }
 
-func (client *baseClient) submitConnectionPasswordUpdate(password string, immediateAuth bool) (Result[string], error) {
+func (client *baseClient) submitConnectionPasswordUpdate(
+	ctx context.Context,
+	password string,
+	immediateAuth bool,
+) (Result[string], error) {
+	start := time.Now()
+	if err := ctx.Err(); err != nil {
+		return CreateNilStringResult(), client.rejectRequest(start, err)
+	}
+
 	// Create a channel to receive the result
 	resultChannel := make(chan payload, 1)
 	resultChannelPtr := unsafe.Pointer(&resultChannel)
 
 	pinner := pinner{}
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
 
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
-		return CreateNilStringResult(), &errors.ClosingError{Msg: "UpdatePassword failed. The client is closed."}
+		pinner.Unpin()
+		return CreateNilStringResult(), client.rejectRequest(
+			start,
+			&errors.ClosingError{Msg: "UpdatePassword failed. The client is closed."},
+		)
 	}
 	client.pending[resultChannelPtr] = struct{}{}
 
@@ -381,19 +721,12 @@ func (client *baseClient) submitConnectionPasswordUpdate(password string, immedi
 	client.mu.Unlock()
 
 	// Wait for response
-	payload := <-resultChannel
-
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
-
-	if payload.error != nil {
-		return CreateNilStringResult(), payload.error
+	response, err := client.waitForResponse(ctx, start, resultChannel, resultChannelPtr, &pinner)
+	if err != nil {
+		return CreateNilStringResult(), err
 	}
 
-	return handleStringOrNilResponse(payload.value)
+	return handleStringOrNilResponse(response)
 }
 
 // Update the current connection with a new password.
@@ -420,8 +753,12 @@ func (client *baseClient) submitConnectionPasswordUpdate(password string, immedi
 // Return value:
 //
 //	`"OK"` response on success.
-func (client *baseClient) UpdateConnectionPassword(password string, immediateAuth bool) (Result[string], error) {
-	return client.submitConnectionPasswordUpdate(password, immediateAuth)
+func (client *baseClient) UpdateConnectionPassword(
+	ctx context.Context,
+	password string,
+	immediateAuth bool,
+) (Result[string], error) {
+	return client.submitConnectionPasswordUpdate(ctx, password, immediateAuth)
 }
 
 // Update the current connection by removing the password.
@@ -440,8 +777,8 @@ func (client *baseClient) UpdateConnectionPassword(password string, immediateAut
 // Return value:
 //
 //	`"OK"` response on success.
-func (client *baseClient) ResetConnectionPassword() (Result[string], error) {
-	return client.submitConnectionPasswordUpdate("", false)
+func (client *baseClient) ResetConnectionPassword(ctx context.Context) (Result[string], error) {
+	return client.submitConnectionPasswordUpdate(ctx, "", false)
 }
 
 // Set the given key with the given value. The return value is a response from Valkey containing the string "OK".
@@ -458,8 +795,31 @@ func (client *baseClient) ResetConnectionPassword() (Result[string], error) {
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/set/
-func (client *baseClient) Set(key string, value string) (string, error) {
-	result, err := client.executeCommand(C.Set, []string{key, value})
+func (client *baseClient) Set(ctx context.Context, key string, value string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Set, []string{key, value})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+
+	return handleStringResponse(result)
+}
+
+// Sets the given binary key with the given binary value. This is the binary-safe version of [baseClient.Set].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key   - The key to store.
+//	value - The value to store with the given key.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/set/
+func (client *baseClient) SetBytes(ctx context.Context, key []byte, value []byte) (string, error) {
+	result, err := client.executeCommand(ctx, C.Set, []string{utils.BytesToString(key), utils.BytesToString(value)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -488,13 +848,18 @@ func (client *baseClient) Set(key string, value string) (string, error) {
 //	If SetOptions.returnOldValue is set, return the old value as a String.
 //
 // [valkey.io]: https://valkey.io/commands/set/
-func (client *baseClient) SetWithOptions(key string, value string, options options.SetOptions) (Result[string], error) {
+func (client *baseClient) SetWithOptions(
+	ctx context.Context,
+	key string,
+	value string,
+	options options.SetOptions,
+) (Result[string], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
 
-	result, err := client.executeCommand(C.Set, append([]string{key, value}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.Set, append([]string{key, value}, optionArgs...))
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -516,8 +881,8 @@ func (client *baseClient) SetWithOptions(key string, value string, options optio
 //	If key exists, returns the value of key as a String. Otherwise, return [api.CreateNilStringResult()].
 //
 // [valkey.io]: https://valkey.io/commands/get/
-func (client *baseClient) Get(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.Get, []string{key})
+func (client *baseClient) Get(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Get, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -525,6 +890,28 @@ func (client *baseClient) Get(key string) (Result[string], error) {
 	return handleStringOrNilResponse(result)
 }
 
+// Gets the binary value associated with the given binary key. This is the binary-safe version of [baseClient.Get].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key to be retrieved from the database.
+//
+// Return value:
+//
+//	If key exists, returns the value of key as a byte slice. Otherwise, returns [api.CreateNilBytesResult()].
+//
+// [valkey.io]: https://valkey.io/commands/get/
+func (client *baseClient) GetBytes(ctx context.Context, key []byte) (Result[[]byte], error) {
+	result, err := client.executeCommand(ctx, C.Get, []string{utils.BytesToString(key)})
+	if err != nil {
+		return CreateNilBytesResult(), err
+	}
+
+	return handleBytesOrNilResponse(result)
+}
+
 // Get string value associated with the given key, or an empty string is returned [api.CreateNilStringResult()] if no such
 // value exists.
 //
@@ -539,8 +926,8 @@ func (client *baseClient) Get(key string) (Result[string], error) {
 //	If key exists, returns the value of key as a Result[string]. Otherwise, return [api.CreateNilStringResult()].
 //
 // [valkey.io]: https://valkey.io/commands/getex/
-func (client *baseClient) GetEx(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.GetEx, []string{key})
+func (client *baseClient) GetEx(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.GetEx, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -562,13 +949,17 @@ func (client *baseClient) GetEx(key string) (Result[string], error) {
 //	If key exists, returns the value of key as a Result[string]. Otherwise, return [api.CreateNilStringResult()].
 //
 // [valkey.io]: https://valkey.io/commands/getex/
-func (client *baseClient) GetExWithOptions(key string, options options.GetExOptions) (Result[string], error) {
+func (client *baseClient) GetExWithOptions(
+	ctx context.Context,
+	key string,
+	options options.GetExOptions,
+) (Result[string], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
 
-	result, err := client.executeCommand(C.GetEx, append([]string{key}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.GetEx, append([]string{key}, optionArgs...))
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -596,8 +987,8 @@ func (client *baseClient) GetExWithOptions(key string, options options.GetExOpti
 //	`"OK"` on success.
 //
 // [valkey.io]: https://valkey.io/commands/mset/
-func (client *baseClient) MSet(keyValueMap map[string]string) (string, error) {
-	result, err := client.executeCommand(C.MSet, utils.MapToString(keyValueMap))
+func (client *baseClient) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	result, err := client.executeCommand(ctx, C.MSet, utils.MapToString(keyValueMap))
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -626,8 +1017,8 @@ func (client *baseClient) MSet(keyValueMap map[string]string) (string, error) {
 //	A bool containing true, if all keys were set. false, if no key was set.
 //
 // [valkey.io]: https://valkey.io/commands/msetnx/
-func (client *baseClient) MSetNX(keyValueMap map[string]string) (bool, error) {
-	result, err := client.executeCommand(C.MSetNX, utils.MapToString(keyValueMap))
+func (client *baseClient) MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.MSetNX, utils.MapToString(keyValueMap))
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -656,8 +1047,8 @@ func (client *baseClient) MSetNX(keyValueMap map[string]string) (bool, error) {
 //	If a key is not found, its corresponding value in the list will be a [api.CreateNilStringResult()]
 //
 // [valkey.io]: https://valkey.io/commands/mget/
-func (client *baseClient) MGet(keys []string) ([]Result[string], error) {
-	result, err := client.executeCommand(C.MGet, keys)
+func (client *baseClient) MGet(ctx context.Context, keys []string) ([]Result[string], error) {
+	result, err := client.executeCommand(ctx, C.MGet, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -665,6 +1056,36 @@ func (client *baseClient) MGet(keys []string) ([]Result[string], error) {
 	return handleStringOrNilArrayResponse(result)
 }
 
+// Retrieves the binary values of multiple binary keys. This is the binary-safe version of [baseClient.MGet].
+//
+// Note: In cluster mode, if keys in `keys` map to different hash slots, the command will be split across these slots and
+// executed separately for each, as described for [baseClient.MGet].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	keys - A list of keys to retrieve values for.
+//
+// Return value:
+//
+//	An array of values corresponding to the provided keys.
+//	If a key is not found, its corresponding value in the list will be a [api.CreateNilBytesResult()].
+//
+// [valkey.io]: https://valkey.io/commands/mget/
+func (client *baseClient) MGetBytes(ctx context.Context, keys [][]byte) ([]Result[[]byte], error) {
+	args := make([]string, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, utils.BytesToString(key))
+	}
+	result, err := client.executeCommand(ctx, C.MGet, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleBytesOrNilArrayResponse(result)
+}
+
 // Increments the number stored at key by one. If key does not exist, it is set to 0 before performing the operation.
 //
 // See [valkey.io] for details.
@@ -678,8 +1099,8 @@ func (client *baseClient) MGet(keys []string) ([]Result[string], error) {
 //	The value of `key` after the increment.
 //
 // [valkey.io]: https://valkey.io/commands/incr/
-func (client *baseClient) Incr(key string) (int64, error) {
-	result, err := client.executeCommand(C.Incr, []string{key})
+func (client *baseClient) Incr(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Incr, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -701,8 +1122,8 @@ func (client *baseClient) Incr(key string) (int64, error) {
 //	The value of `key` after the increment.
 //
 // [valkey.io]: https://valkey.io/commands/incrby/
-func (client *baseClient) IncrBy(key string, amount int64) (int64, error) {
-	result, err := client.executeCommand(C.IncrBy, []string{key, utils.IntToString(amount)})
+func (client *baseClient) IncrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.IncrBy, []string{key, utils.IntToString(amount)})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -726,8 +1147,9 @@ func (client *baseClient) IncrBy(key string, amount int64) (int64, error) {
 //	The value of key after the increment.
 //
 // [valkey.io]: https://valkey.io/commands/incrbyfloat/
-func (client *baseClient) IncrByFloat(key string, amount float64) (float64, error) {
+func (client *baseClient) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.IncrByFloat,
 		[]string{key, utils.FloatToString(amount)},
 	)
@@ -751,8 +1173,8 @@ func (client *baseClient) IncrByFloat(key string, amount float64) (float64, erro
 //	The value of `key` after the decrement.
 //
 // [valkey.io]: https://valkey.io/commands/decr/
-func (client *baseClient) Decr(key string) (int64, error) {
-	result, err := client.executeCommand(C.Decr, []string{key})
+func (client *baseClient) Decr(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Decr, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -774,8 +1196,8 @@ func (client *baseClient) Decr(key string) (int64, error) {
 //	The value of `key` after the decrement.
 //
 // [valkey.io]: https://valkey.io/commands/decrby/
-func (client *baseClient) DecrBy(key string, amount int64) (int64, error) {
-	result, err := client.executeCommand(C.DecrBy, []string{key, utils.IntToString(amount)})
+func (client *baseClient) DecrBy(ctx context.Context, key string, amount int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.DecrBy, []string{key, utils.IntToString(amount)})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -797,8 +1219,8 @@ func (client *baseClient) DecrBy(key string, amount int64) (int64, error) {
 //	If key does not exist, it is treated as an empty string, and the command returns `0`.
 //
 // [valkey.io]: https://valkey.io/commands/strlen/
-func (client *baseClient) Strlen(key string) (int64, error) {
-	result, err := client.executeCommand(C.Strlen, []string{key})
+func (client *baseClient) Strlen(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Strlen, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -824,8 +1246,8 @@ func (client *baseClient) Strlen(key string) (int64, error) {
 //	The length of the string stored at `key` after it was modified.
 //
 // [valkey.io]: https://valkey.io/commands/setrange/
-func (client *baseClient) SetRange(key string, offset int, value string) (int64, error) {
-	result, err := client.executeCommand(C.SetRange, []string{key, strconv.Itoa(offset), value})
+func (client *baseClient) SetRange(ctx context.Context, key string, offset int, value string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SetRange, []string{key, strconv.Itoa(offset), value})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -851,8 +1273,8 @@ func (client *baseClient) SetRange(key string, offset int, value string) (int64,
 //	A substring extracted from the value stored at key. Returns empty string if the offset is out of bounds.
 //
 // [valkey.io]: https://valkey.io/commands/getrange/
-func (client *baseClient) GetRange(key string, start int, end int) (string, error) {
-	result, err := client.executeCommand(C.GetRange, []string{key, strconv.Itoa(start), strconv.Itoa(end)})
+func (client *baseClient) GetRange(ctx context.Context, key string, start int, end int) (string, error) {
+	result, err := client.executeCommand(ctx, C.GetRange, []string{key, strconv.Itoa(start), strconv.Itoa(end)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -875,8 +1297,8 @@ func (client *baseClient) GetRange(key string, start int, end int) (string, erro
 //	The length of the string after appending the value.
 //
 // [valkey.io]: https://valkey.io/commands/append/
-func (client *baseClient) Append(key string, value string) (int64, error) {
-	result, err := client.executeCommand(C.Append, []string{key, value})
+func (client *baseClient) Append(ctx context.Context, key string, value string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Append, []string{key, value})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -910,8 +1332,8 @@ func (client *baseClient) Append(key string, value string) (int64, error) {
 //	An empty string is returned if the keys do not exist or have no common subsequences.
 //
 // [valkey.io]: https://valkey.io/commands/lcs/
-func (client *baseClient) LCS(key1 string, key2 string) (string, error) {
-	result, err := client.executeCommand(C.LCS, []string{key1, key2})
+func (client *baseClient) LCS(ctx context.Context, key1 string, key2 string) (string, error) {
+	result, err := client.executeCommand(ctx, C.LCS, []string{key1, key2})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -939,8 +1361,8 @@ func (client *baseClient) LCS(key1 string, key2 string) (string, error) {
 //	The total length of all the longest common subsequences the 2 strings.
 //
 // [valkey.io]: https://valkey.io/commands/lcs/
-func (client *baseClient) LCSLen(key1, key2 string) (int64, error) {
-	result, err := client.executeCommand(C.LCS, []string{key1, key2, options.LCSLenCommand})
+func (client *baseClient) LCSLen(ctx context.Context, key1, key2 string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LCS, []string{key1, key2, options.LCSLenCommand})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -975,12 +1397,16 @@ func (client *baseClient) LCSLen(key1, key2 string) (int64, error) {
 //	     of the common subsequences in the strings held by key1 and key2.
 //
 // [valkey.io]: https://valkey.io/commands/lcs/
-func (client *baseClient) LCSWithOptions(key1, key2 string, opts options.LCSIdxOptions) (map[string]interface{}, error) {
+func (client *baseClient) LCSWithOptions(
+	ctx context.Context,
+	key1, key2 string,
+	opts options.LCSIdxOptions,
+) (map[string]interface{}, error) {
 	optArgs, err := opts.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.executeCommand(C.LCS, append([]string{key1, key2}, optArgs...))
+	response, err := client.executeCommand(ctx, C.LCS, append([]string{key1, key2}, optArgs...))
 	if err != nil {
 		return nil, err
 	}
@@ -999,12 +1425,12 @@ func (client *baseClient) LCSWithOptions(key1, key2 string, opts options.LCSIdxO
 //	If key does not exist, returns a [api.NilResult[string]] (api.CreateNilStringResult()).
 //
 // [valkey.io]: https://valkey.io/commands/getdel/
-func (client *baseClient) GetDel(key string) (Result[string], error) {
+func (client *baseClient) GetDel(ctx context.Context, key string) (Result[string], error) {
 	if key == "" {
 		return CreateNilStringResult(), &errors.RequestError{Msg: "key is required"}
 	}
 
-	result, err := client.executeCommand(C.GetDel, []string{key})
+	result, err := client.executeCommand(ctx, C.GetDel, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -1012,6 +1438,34 @@ func (client *baseClient) GetDel(key string) (Result[string], error) {
 	return handleStringOrNilResponse(result)
 }
 
+// Gets the binary value associated with the given binary key and deletes the key. This is the binary-safe version of
+// [baseClient.GetDel].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key to get and delete.
+//
+// Return value:
+//
+//	If key exists, returns the value of the key as a byte slice and deletes the key.
+//	If key does not exist, returns [api.CreateNilBytesResult()].
+//
+// [valkey.io]: https://valkey.io/commands/getdel/
+func (client *baseClient) GetDelBytes(ctx context.Context, key []byte) (Result[[]byte], error) {
+	if len(key) == 0 {
+		return CreateNilBytesResult(), &errors.RequestError{Msg: "key is required"}
+	}
+
+	result, err := client.executeCommand(ctx, C.GetDel, []string{utils.BytesToString(key)})
+	if err != nil {
+		return CreateNilBytesResult(), err
+	}
+
+	return handleBytesOrNilResponse(result)
+}
+
 // HGet returns the value associated with field in the hash stored at key.
 //
 // See [valkey.io] for details.
@@ -1027,8 +1481,8 @@ func (client *baseClient) GetDel(key string) (Result[string], error) {
 //	present in the hash or key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hget/
-func (client *baseClient) HGet(key string, field string) (Result[string], error) {
-	result, err := client.executeCommand(C.HGet, []string{key, field})
+func (client *baseClient) HGet(ctx context.Context, key string, field string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.HGet, []string{key, field})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -1036,6 +1490,31 @@ func (client *baseClient) HGet(key string, field string) (Result[string], error)
 	return handleStringOrNilResponse(result)
 }
 
+// Returns the binary value associated with field in the hash stored at key. This is the binary-safe version of
+// [baseClient.HGet].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key   - The key of the hash.
+//	field - The field in the hash stored at key to retrieve from the database.
+//
+// Return value:
+//
+//	The value associated with field, or [api.CreateNilBytesResult()] when field is not present in the hash or key does not
+//	exist.
+//
+// [valkey.io]: https://valkey.io/commands/hget/
+func (client *baseClient) HGetBytes(ctx context.Context, key []byte, field []byte) (Result[[]byte], error) {
+	result, err := client.executeCommand(ctx, C.HGet, []string{utils.BytesToString(key), utils.BytesToString(field)})
+	if err != nil {
+		return CreateNilBytesResult(), err
+	}
+
+	return handleBytesOrNilResponse(result)
+}
+
 // HGetAll returns all fields and values of the hash stored at key.
 //
 // See [valkey.io] for details.
@@ -1049,8 +1528,8 @@ func (client *baseClient) HGet(key string, field string) (Result[string], error)
 //	A map of all fields and their values as Result[string] in the hash, or an empty map when key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hgetall/
-func (client *baseClient) HGetAll(key string) (map[string]string, error) {
-	result, err := client.executeCommand(C.HGetAll, []string{key})
+func (client *baseClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	result, err := client.executeCommand(ctx, C.HGetAll, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -1058,13 +1537,36 @@ func (client *baseClient) HGetAll(key string) (map[string]string, error) {
 	return handleStringToStringMapResponse(result)
 }
 
-// HMGet returns the values associated with the specified fields in the hash stored at key.
+// Returns all binary fields and values of the hash stored at key. This is the binary-safe version of
+// [baseClient.HGetAll].
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	key    - The key of the hash.
+//	key - The key of the hash.
+//
+// Return value:
+//
+//	A slice of all fields of the hash with their values, or an empty slice when key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/hgetall/
+func (client *baseClient) HGetAllBytes(ctx context.Context, key []byte) ([]FieldAndValue, error) {
+	result, err := client.executeCommand(ctx, C.HGetAll, []string{utils.BytesToString(key)})
+	if err != nil {
+		return nil, err
+	}
+
+	return handleFieldAndValueArrayResponse(result)
+}
+
+// HMGet returns the values associated with the specified fields in the hash stored at key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key    - The key of the hash.
 //	fields - The fields in the hash stored at key to retrieve from the database.
 //
 // Return value:
@@ -1077,8 +1579,8 @@ func (client *baseClient) HGetAll(key string) (map[string]string, error) {
 //	If key does not exist, returns an empty string array.
 //
 // [valkey.io]: https://valkey.io/commands/hmget/
-func (client *baseClient) HMGet(key string, fields []string) ([]Result[string], error) {
-	result, err := client.executeCommand(C.HMGet, append([]string{key}, fields...))
+func (client *baseClient) HMGet(ctx context.Context, key string, fields []string) ([]Result[string], error) {
+	result, err := client.executeCommand(ctx, C.HMGet, append([]string{key}, fields...))
 	if err != nil {
 		return nil, err
 	}
@@ -1086,6 +1588,36 @@ func (client *baseClient) HMGet(key string, fields []string) ([]Result[string],
 	return handleStringOrNilArrayResponse(result)
 }
 
+// Returns the binary values associated with the specified fields in the hash stored at key. This is the binary-safe
+// version of [baseClient.HMGet].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key    - The key of the hash.
+//	fields - The fields in the hash stored at key to retrieve from the database.
+//
+// Return value:
+//
+//	An array of values associated with the given fields, in the same order as they are requested.
+//	For every field that does not exist in the hash, a [api.CreateNilBytesResult()] is returned.
+//
+// [valkey.io]: https://valkey.io/commands/hmget/
+func (client *baseClient) HMGetBytes(ctx context.Context, key []byte, fields [][]byte) ([]Result[[]byte], error) {
+	args := make([]string, 0, len(fields)+1)
+	args = append(args, utils.BytesToString(key))
+	for _, field := range fields {
+		args = append(args, utils.BytesToString(field))
+	}
+	result, err := client.executeCommand(ctx, C.HMGet, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleBytesOrNilArrayResponse(result)
+}
+
 // HSet sets the specified fields to their respective values in the hash stored at key.
 // This command overwrites the values of specified fields that exist in the hash.
 // If key doesn't exist, a new key holding a hash is created.
@@ -1102,8 +1634,37 @@ func (client *baseClient) HMGet(key string, fields []string) ([]Result[string],
 //	The number of fields that were added or updated.
 //
 // [valkey.io]: https://valkey.io/commands/hset/
-func (client *baseClient) HSet(key string, values map[string]string) (int64, error) {
-	result, err := client.executeCommand(C.HSet, utils.ConvertMapToKeyValueStringArray(key, values))
+func (client *baseClient) HSet(ctx context.Context, key string, values map[string]string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.HSet, utils.ConvertMapToKeyValueStringArray(key, values))
+	if err != nil {
+		return defaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
+}
+
+// Sets the specified binary fields to their respective binary values in the hash stored at key. This is the binary-safe
+// version of [baseClient.HSet].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key    - The key of the hash.
+//	values - The fields to set in the hash with their values.
+//
+// Return value:
+//
+//	The number of fields that were added or updated.
+//
+// [valkey.io]: https://valkey.io/commands/hset/
+func (client *baseClient) HSetBytes(ctx context.Context, key []byte, values []FieldAndValue) (int64, error) {
+	args := make([]string, 0, 2*len(values)+1)
+	args = append(args, utils.BytesToString(key))
+	for _, value := range values {
+		args = append(args, utils.BytesToString(value.Field), utils.BytesToString(value.Value))
+	}
+	result, err := client.executeCommand(ctx, C.HSet, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1129,8 +1690,8 @@ func (client *baseClient) HSet(key string, values map[string]string) (int64, err
 //	false if field already exists in the hash and no operation was performed.
 //
 // [valkey.io]: https://valkey.io/commands/hsetnx/
-func (client *baseClient) HSetNX(key string, field string, value string) (bool, error) {
-	result, err := client.executeCommand(C.HSetNX, []string{key, field, value})
+func (client *baseClient) HSetNX(ctx context.Context, key string, field string, value string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.HSetNX, []string{key, field, value})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -1154,8 +1715,8 @@ func (client *baseClient) HSetNX(key string, field string, value string) (bool,
 //	The number of fields that were removed from the hash, not including specified but non-existing fields.
 //
 // [valkey.io]: https://valkey.io/commands/hdel/
-func (client *baseClient) HDel(key string, fields []string) (int64, error) {
-	result, err := client.executeCommand(C.HDel, append([]string{key}, fields...))
+func (client *baseClient) HDel(ctx context.Context, key string, fields []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.HDel, append([]string{key}, fields...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1177,8 +1738,8 @@ func (client *baseClient) HDel(key string, fields []string) (int64, error) {
 //	If key holds a value that is not a hash, an error is returned.
 //
 // [valkey.io]: https://valkey.io/commands/hlen/
-func (client *baseClient) HLen(key string) (int64, error) {
-	result, err := client.executeCommand(C.HLen, []string{key})
+func (client *baseClient) HLen(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.HLen, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1199,8 +1760,8 @@ func (client *baseClient) HLen(key string) (int64, error) {
 //	A slice containing all the values in the hash, or an empty slice when key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hvals/
-func (client *baseClient) HVals(key string) ([]string, error) {
-	result, err := client.executeCommand(C.HVals, []string{key})
+func (client *baseClient) HVals(ctx context.Context, key string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.HVals, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -1223,8 +1784,8 @@ func (client *baseClient) HVals(key string) ([]string, error) {
 //	false if the hash does not contain the field, or if the key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hexists/
-func (client *baseClient) HExists(key string, field string) (bool, error) {
-	result, err := client.executeCommand(C.HExists, []string{key, field})
+func (client *baseClient) HExists(ctx context.Context, key string, field string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.HExists, []string{key, field})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -1245,8 +1806,8 @@ func (client *baseClient) HExists(key string, field string) (bool, error) {
 //	A slice containing all the field names in the hash, or an empty slice when key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hkeys/
-func (client *baseClient) HKeys(key string) ([]string, error) {
-	result, err := client.executeCommand(C.HKeys, []string{key})
+func (client *baseClient) HKeys(ctx context.Context, key string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.HKeys, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -1269,8 +1830,8 @@ func (client *baseClient) HKeys(key string) ([]string, error) {
 //	The length of the string value associated with field, or `0` when field or key do not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hstrlen/
-func (client *baseClient) HStrLen(key string, field string) (int64, error) {
-	result, err := client.executeCommand(C.HStrlen, []string{key, field})
+func (client *baseClient) HStrLen(ctx context.Context, key string, field string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.HStrlen, []string{key, field})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1295,8 +1856,8 @@ func (client *baseClient) HStrLen(key string, field string) (int64, error) {
 //	The value of `field` in the hash stored at `key` after the increment.
 //
 // [valkey.io]: https://valkey.io/commands/hincrby/
-func (client *baseClient) HIncrBy(key string, field string, increment int64) (int64, error) {
-	result, err := client.executeCommand(C.HIncrBy, []string{key, field, utils.IntToString(increment)})
+func (client *baseClient) HIncrBy(ctx context.Context, key string, field string, increment int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.HIncrBy, []string{key, field, utils.IntToString(increment)})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1321,8 +1882,8 @@ func (client *baseClient) HIncrBy(key string, field string, increment int64) (in
 //	The value of `field` in the hash stored at `key` after the increment.
 //
 // [valkey.io]: https://valkey.io/commands/hincrbyfloat/
-func (client *baseClient) HIncrByFloat(key string, field string, increment float64) (float64, error) {
-	result, err := client.executeCommand(C.HIncrByFloat, []string{key, field, utils.FloatToString(increment)})
+func (client *baseClient) HIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error) {
+	result, err := client.executeCommand(ctx, C.HIncrByFloat, []string{key, field, utils.FloatToString(increment)})
 	if err != nil {
 		return defaultFloatResponse, err
 	}
@@ -1349,8 +1910,8 @@ func (client *baseClient) HIncrByFloat(key string, field string, increment float
 //	and the value is at odd indices.
 //
 // [valkey.io]: https://valkey.io/commands/hscan/
-func (client *baseClient) HScan(key string, cursor string) (string, []string, error) {
-	result, err := client.executeCommand(C.HScan, []string{key, cursor})
+func (client *baseClient) HScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	result, err := client.executeCommand(ctx, C.HScan, []string{key, cursor})
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -1378,6 +1939,7 @@ func (client *baseClient) HScan(key string, cursor string) (string, []string, er
 //
 // [valkey.io]: https://valkey.io/commands/hscan/
 func (client *baseClient) HScanWithOptions(
+	ctx context.Context,
 	key string,
 	cursor string,
 	options options.HashScanOptions,
@@ -1387,7 +1949,7 @@ func (client *baseClient) HScanWithOptions(
 		return DefaultStringResponse, nil, err
 	}
 
-	result, err := client.executeCommand(C.HScan, append([]string{key, cursor}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.HScan, append([]string{key, cursor}, optionArgs...))
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -1412,8 +1974,8 @@ func (client *baseClient) HScanWithOptions(
 //	  the key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hrandfield/
-func (client *baseClient) HRandField(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.HRandField, []string{key})
+func (client *baseClient) HRandField(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.HRandField, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -1440,8 +2002,8 @@ func (client *baseClient) HRandField(key string) (Result[string], error) {
 //	   or an empty array when the key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/hrandfield/
-func (client *baseClient) HRandFieldWithCount(key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(C.HRandField, []string{key, utils.IntToString(count)})
+func (client *baseClient) HRandFieldWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.HRandField, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
 	}
@@ -1470,8 +2032,8 @@ func (client *baseClient) HRandFieldWithCount(key string, count int64) ([]string
 //	  If the hash does not exist or is empty, the response will be an empty array.
 //
 // [valkey.io]: https://valkey.io/commands/hrandfield/
-func (client *baseClient) HRandFieldWithCountWithValues(key string, count int64) ([][]string, error) {
-	result, err := client.executeCommand(C.HRandField, []string{key, utils.IntToString(count), options.WithValuesKeyword})
+func (client *baseClient) HRandFieldWithCountWithValues(ctx context.Context, key string, count int64) ([][]string, error) {
+	result, err := client.executeCommand(ctx, C.HRandField, []string{key, utils.IntToString(count), options.WithValuesKeyword})
 	if err != nil {
 		return nil, err
 	}
@@ -1494,8 +2056,8 @@ func (client *baseClient) HRandFieldWithCountWithValues(key string, count int64)
 //	The length of the list after the push operation.
 //
 // [valkey.io]: https://valkey.io/commands/lpush/
-func (client *baseClient) LPush(key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(C.LPush, append([]string{key}, elements...))
+func (client *baseClient) LPush(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LPush, append([]string{key}, elements...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1518,8 +2080,8 @@ func (client *baseClient) LPush(key string, elements []string) (int64, error) {
 //	If key does not exist, [api.CreateNilStringResult()] will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/lpop/
-func (client *baseClient) LPop(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.LPop, []string{key})
+func (client *baseClient) LPop(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.LPop, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -1542,8 +2104,8 @@ func (client *baseClient) LPop(key string) (Result[string], error) {
 //	If key does not exist, nil will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/lpop/
-func (client *baseClient) LPopCount(key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(C.LPop, []string{key, utils.IntToString(count)})
+func (client *baseClient) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.LPop, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
 	}
@@ -1567,8 +2129,8 @@ func (client *baseClient) LPopCount(key string, count int64) ([]string, error) {
 //	not in the list.
 //
 // [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPos(key string, element string) (Result[int64], error) {
-	result, err := client.executeCommand(C.LPos, []string{key, element})
+func (client *baseClient) LPos(ctx context.Context, key string, element string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.LPos, []string{key, element})
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -1592,12 +2154,17 @@ func (client *baseClient) LPos(key string, element string) (Result[int64], error
 //	The Result[int64] containing the index of element, or [api.CreateNilInt64Result()] if element is not in the list.
 //
 // [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPosWithOptions(key string, element string, options options.LPosOptions) (Result[int64], error) {
+func (client *baseClient) LPosWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	options options.LPosOptions,
+) (Result[int64], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
-	result, err := client.executeCommand(C.LPos, append([]string{key, element}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.LPos, append([]string{key, element}, optionArgs...))
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -1620,8 +2187,8 @@ func (client *baseClient) LPosWithOptions(key string, element string, options op
 //	An array that holds the indices of the matching elements within the list.
 //
 // [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPosCount(key string, element string, count int64) ([]int64, error) {
-	result, err := client.executeCommand(C.LPos, []string{key, element, options.CountKeyword, utils.IntToString(count)})
+func (client *baseClient) LPosCount(ctx context.Context, key string, element string, count int64) ([]int64, error) {
+	result, err := client.executeCommand(ctx, C.LPos, []string{key, element, options.CountKeyword, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
 	}
@@ -1647,6 +2214,7 @@ func (client *baseClient) LPosCount(key string, element string, count int64) ([]
 //
 // [valkey.io]: https://valkey.io/commands/lpos/
 func (client *baseClient) LPosCountWithOptions(
+	ctx context.Context,
 	key string,
 	element string,
 	count int64,
@@ -1657,6 +2225,7 @@ func (client *baseClient) LPosCountWithOptions(
 		return nil, err
 	}
 	result, err := client.executeCommand(
+		ctx,
 		C.LPos,
 		append([]string{key, element, options.CountKeyword, utils.IntToString(count)}, optionArgs...),
 	)
@@ -1683,8 +2252,8 @@ func (client *baseClient) LPosCountWithOptions(
 //	The length of the list after the push operation.
 //
 // [valkey.io]: https://valkey.io/commands/rpush/
-func (client *baseClient) RPush(key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(C.RPush, append([]string{key}, elements...))
+func (client *baseClient) RPush(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.RPush, append([]string{key}, elements...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1706,8 +2275,8 @@ func (client *baseClient) RPush(key string, elements []string) (int64, error) {
 //	The number of members that were added to the set, excluding members already present.
 //
 // [valkey.io]: https://valkey.io/commands/sadd/
-func (client *baseClient) SAdd(key string, members []string) (int64, error) {
-	result, err := client.executeCommand(C.SAdd, append([]string{key}, members...))
+func (client *baseClient) SAdd(ctx context.Context, key string, members []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SAdd, append([]string{key}, members...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1729,8 +2298,8 @@ func (client *baseClient) SAdd(key string, members []string) (int64, error) {
 //	The number of members that were removed from the set, excluding non-existing members.
 //
 // [valkey.io]: https://valkey.io/commands/srem/
-func (client *baseClient) SRem(key string, members []string) (int64, error) {
-	result, err := client.executeCommand(C.SRem, append([]string{key}, members...))
+func (client *baseClient) SRem(ctx context.Context, key string, members []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SRem, append([]string{key}, members...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1754,8 +2323,8 @@ func (client *baseClient) SRem(key string, members []string) (int64, error) {
 //	The number of elements in the resulting set.
 //
 // [valkey.io]: https://valkey.io/commands/sunionstore/
-func (client *baseClient) SUnionStore(destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(C.SUnionStore, append([]string{destination}, keys...))
+func (client *baseClient) SUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SUnionStore, append([]string{destination}, keys...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1777,8 +2346,8 @@ func (client *baseClient) SUnionStore(destination string, keys []string) (int64,
 //	Returns an empty collection if key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/smembers/
-func (client *baseClient) SMembers(key string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(C.SMembers, []string{key})
+func (client *baseClient) SMembers(ctx context.Context, key string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SMembers, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -1799,8 +2368,8 @@ func (client *baseClient) SMembers(key string) (map[string]struct{}, error) {
 //	The cardinality (number of elements) of the set, or `0` if the key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/scard/
-func (client *baseClient) SCard(key string) (int64, error) {
-	result, err := client.executeCommand(C.SCard, []string{key})
+func (client *baseClient) SCard(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SCard, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1823,8 +2392,8 @@ func (client *baseClient) SCard(key string) (int64, error) {
 //	If key doesn't exist, it is treated as an empty set and the method returns false.
 //
 // [valkey.io]: https://valkey.io/commands/sismember/
-func (client *baseClient) SIsMember(key string, member string) (bool, error) {
-	result, err := client.executeCommand(C.SIsMember, []string{key, member})
+func (client *baseClient) SIsMember(ctx context.Context, key string, member string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.SIsMember, []string{key, member})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -1848,8 +2417,8 @@ func (client *baseClient) SIsMember(key string, member string) (bool, error) {
 //	If a key does not exist, it is treated as an empty set.
 //
 // [valkey.io]: https://valkey.io/commands/sdiff/
-func (client *baseClient) SDiff(keys []string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(C.SDiff, keys)
+func (client *baseClient) SDiff(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SDiff, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -1874,8 +2443,8 @@ func (client *baseClient) SDiff(keys []string) (map[string]struct{}, error) {
 //	The number of elements in the resulting set.
 //
 // [valkey.io]: https://valkey.io/commands/sdiffstore/
-func (client *baseClient) SDiffStore(destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(C.SDiffStore, append([]string{destination}, keys...))
+func (client *baseClient) SDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SDiffStore, append([]string{destination}, keys...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1899,8 +2468,8 @@ func (client *baseClient) SDiffStore(destination string, keys []string) (int64,
 //	If one or more sets do not exist, an empty collection will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/sinter/
-func (client *baseClient) SInter(keys []string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(C.SInter, keys)
+func (client *baseClient) SInter(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SInter, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -1924,8 +2493,8 @@ func (client *baseClient) SInter(keys []string) (map[string]struct{}, error) {
 //	The number of elements in the resulting set.
 //
 // [valkey.io]: https://valkey.io/commands/sinterstore/
-func (client *baseClient) SInterStore(destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(C.SInterStore, append([]string{destination}, keys...))
+func (client *baseClient) SInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SInterStore, append([]string{destination}, keys...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1952,8 +2521,8 @@ func (client *baseClient) SInterStore(destination string, keys []string) (int64,
 //	The cardinality of the intersection result. If one or more sets do not exist, `0` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/sintercard/
-func (client *baseClient) SInterCard(keys []string) (int64, error) {
-	result, err := client.executeCommand(C.SInterCard, append([]string{strconv.Itoa(len(keys))}, keys...))
+func (client *baseClient) SInterCard(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SInterCard, append([]string{strconv.Itoa(len(keys))}, keys...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -1983,14 +2552,14 @@ func (client *baseClient) SInterCard(keys []string) (int64, error) {
 //	If the intersection cardinality reaches 'limit' partway through the computation, returns 'limit' as the cardinality.
 //
 // [valkey.io]: https://valkey.io/commands/sintercard/
-func (client *baseClient) SInterCardLimit(keys []string, limit int64) (int64, error) {
+func (client *baseClient) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
 	args := utils.Concat(
 		[]string{utils.IntToString(int64(len(keys)))},
 		keys,
 		[]string{options.LimitKeyword, utils.IntToString(limit)},
 	)
 
-	result, err := client.executeCommand(C.SInterCard, args)
+	result, err := client.executeCommand(ctx, C.SInterCard, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2012,8 +2581,8 @@ func (client *baseClient) SInterCardLimit(keys []string, limit int64) (int64, er
 //	Returns api.CreateNilStringResult() if key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/srandmember/
-func (client *baseClient) SRandMember(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.SRandMember, []string{key})
+func (client *baseClient) SRandMember(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.SRandMember, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -2035,8 +2604,8 @@ func (client *baseClient) SRandMember(key string) (Result[string], error) {
 //	Returns a NilResult if key does not exist.
 //
 // [valkey.io]: https://valkey.io/commands/spop/
-func (client *baseClient) SPop(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.SPop, []string{key})
+func (client *baseClient) SPop(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.SPop, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -2057,8 +2626,8 @@ func (client *baseClient) SPop(key string) (Result[string], error) {
 //	A []bool containing whether each member is a member of the set stored at key.
 //
 // [valkey.io]: https://valkey.io/commands/smismember/
-func (client *baseClient) SMIsMember(key string, members []string) ([]bool, error) {
-	result, err := client.executeCommand(C.SMIsMember, append([]string{key}, members...))
+func (client *baseClient) SMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
+	result, err := client.executeCommand(ctx, C.SMIsMember, append([]string{key}, members...))
 	if err != nil {
 		return nil, err
 	}
@@ -2082,8 +2651,8 @@ func (client *baseClient) SMIsMember(key string, members []string) ([]bool, erro
 //	If none of the sets exist, an empty collection will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/sunion/
-func (client *baseClient) SUnion(keys []string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(C.SUnion, keys)
+func (client *baseClient) SUnion(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SUnion, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -2111,8 +2680,8 @@ func (client *baseClient) SUnion(keys []string) (map[string]struct{}, error) {
 //	The second element is always an array of the subset of the set held in `key`.
 //
 // [valkey.io]: https://valkey.io/commands/sscan/
-func (client *baseClient) SScan(key string, cursor string) (string, []string, error) {
-	result, err := client.executeCommand(C.SScan, []string{key, cursor})
+func (client *baseClient) SScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	result, err := client.executeCommand(ctx, C.SScan, []string{key, cursor})
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -2141,6 +2710,7 @@ func (client *baseClient) SScan(key string, cursor string) (string, []string, er
 //
 // [valkey.io]: https://valkey.io/commands/sscan/
 func (client *baseClient) SScanWithOptions(
+	ctx context.Context,
 	key string,
 	cursor string,
 	options options.BaseScanOptions,
@@ -2150,7 +2720,7 @@ func (client *baseClient) SScanWithOptions(
 		return DefaultStringResponse, nil, err
 	}
 
-	result, err := client.executeCommand(C.SScan, append([]string{key, cursor}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.SScan, append([]string{key, cursor}, optionArgs...))
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -2175,8 +2745,8 @@ func (client *baseClient) SScanWithOptions(
 //	`true` on success, or `false` if the `source` set does not exist or the element is not a member of the source set.
 //
 // [valkey.io]: https://valkey.io/commands/smove/
-func (client *baseClient) SMove(source string, destination string, member string) (bool, error) {
-	result, err := client.executeCommand(C.SMove, []string{source, destination, member})
+func (client *baseClient) SMove(ctx context.Context, source string, destination string, member string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.SMove, []string{source, destination, member})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -2204,8 +2774,8 @@ func (client *baseClient) SMove(source string, destination string, member string
 //	If key does not exist an empty array will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/lrange/
-func (client *baseClient) LRange(key string, start int64, end int64) ([]string, error) {
-	result, err := client.executeCommand(C.LRange, []string{key, utils.IntToString(start), utils.IntToString(end)})
+func (client *baseClient) LRange(ctx context.Context, key string, start int64, end int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.LRange, []string{key, utils.IntToString(start), utils.IntToString(end)})
 	if err != nil {
 		return nil, err
 	}
@@ -2231,8 +2801,8 @@ func (client *baseClient) LRange(key string, start int64, end int64) ([]string,
 //	If index is out of range or if key does not exist, [api.CreateNilStringResult()] is returned.
 //
 // [valkey.io]: https://valkey.io/commands/lindex/
-func (client *baseClient) LIndex(key string, index int64) (Result[string], error) {
-	result, err := client.executeCommand(C.LIndex, []string{key, utils.IntToString(index)})
+func (client *baseClient) LIndex(ctx context.Context, key string, index int64) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.LIndex, []string{key, utils.IntToString(index)})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -2262,8 +2832,8 @@ func (client *baseClient) LIndex(key string, index int64) (Result[string], error
 //	If key does not exist, `"OK"` will be returned without changes to the database.
 //
 // [valkey.io]: https://valkey.io/commands/ltrim/
-func (client *baseClient) LTrim(key string, start int64, end int64) (string, error) {
-	result, err := client.executeCommand(C.LTrim, []string{key, utils.IntToString(start), utils.IntToString(end)})
+func (client *baseClient) LTrim(ctx context.Context, key string, start int64, end int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.LTrim, []string{key, utils.IntToString(start), utils.IntToString(end)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -2285,8 +2855,8 @@ func (client *baseClient) LTrim(key string, start int64, end int64) (string, err
 //	If `key` does not exist, it is interpreted as an empty list and `0` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/llen/
-func (client *baseClient) LLen(key string) (int64, error) {
-	result, err := client.executeCommand(C.LLen, []string{key})
+func (client *baseClient) LLen(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LLen, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2314,8 +2884,8 @@ func (client *baseClient) LLen(key string) (int64, error) {
 //	If `key` does not exist, `0` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/lrem/
-func (client *baseClient) LRem(key string, count int64, element string) (int64, error) {
-	result, err := client.executeCommand(C.LRem, []string{key, utils.IntToString(count), element})
+func (client *baseClient) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LRem, []string{key, utils.IntToString(count), element})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2338,8 +2908,8 @@ func (client *baseClient) LRem(key string, count int64, element string) (int64,
 //	If key does not exist, [api.CreateNilStringResult()] will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/rpop/
-func (client *baseClient) RPop(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.RPop, []string{key})
+func (client *baseClient) RPop(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.RPop, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -2362,8 +2932,8 @@ func (client *baseClient) RPop(key string) (Result[string], error) {
 //	If key does not exist, nil will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/rpop/
-func (client *baseClient) RPopCount(key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(C.RPop, []string{key, utils.IntToString(count)})
+func (client *baseClient) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.RPop, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
 	}
@@ -2390,6 +2960,7 @@ func (client *baseClient) RPopCount(key string, count int64) ([]string, error) {
 //
 // [valkey.io]: https://valkey.io/commands/linsert/
 func (client *baseClient) LInsert(
+	ctx context.Context,
 	key string,
 	insertPosition options.InsertPosition,
 	pivot string,
@@ -2401,6 +2972,7 @@ func (client *baseClient) LInsert(
 	}
 
 	result, err := client.executeCommand(
+		ctx,
 		C.LInsert,
 		[]string{key, insertPositionStr, pivot, element},
 	)
@@ -2434,8 +3006,8 @@ func (client *baseClient) LInsert(
 //
 // [valkey.io]: https://valkey.io/commands/blpop/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BLPop(keys []string, timeoutSecs float64) ([]string, error) {
-	result, err := client.executeCommand(C.BLPop, append(keys, utils.FloatToString(timeoutSecs)))
+func (client *baseClient) BLPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.BLPop, append(keys, utils.FloatToString(timeoutSecs)))
 	if err != nil {
 		return nil, err
 	}
@@ -2466,8 +3038,8 @@ func (client *baseClient) BLPop(keys []string, timeoutSecs float64) ([]string, e
 //
 // [valkey.io]: https://valkey.io/commands/brpop/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BRPop(keys []string, timeoutSecs float64) ([]string, error) {
-	result, err := client.executeCommand(C.BRPop, append(keys, utils.FloatToString(timeoutSecs)))
+func (client *baseClient) BRPop(ctx context.Context, keys []string, timeoutSecs float64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.BRPop, append(keys, utils.FloatToString(timeoutSecs)))
 	if err != nil {
 		return nil, err
 	}
@@ -2490,8 +3062,8 @@ func (client *baseClient) BRPop(keys []string, timeoutSecs float64) ([]string, e
 //	The length of the list after the push operation.
 //
 // [valkey.io]: https://valkey.io/commands/rpushx/
-func (client *baseClient) RPushX(key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(C.RPushX, append([]string{key}, elements...))
+func (client *baseClient) RPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.RPushX, append([]string{key}, elements...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2514,8 +3086,8 @@ func (client *baseClient) RPushX(key string, elements []string) (int64, error) {
 //	The length of the list after the push operation.
 //
 // [valkey.io]: https://valkey.io/commands/rpushx/
-func (client *baseClient) LPushX(key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(C.LPushX, append([]string{key}, elements...))
+func (client *baseClient) LPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LPushX, append([]string{key}, elements...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2541,7 +3113,11 @@ func (client *baseClient) LPushX(key string, elements []string) (int64, error) {
 //	A map of key name mapped array of popped element.
 //
 // [valkey.io]: https://valkey.io/commands/lmpop/
-func (client *baseClient) LMPop(keys []string, listDirection options.ListDirection) (map[string][]string, error) {
+func (client *baseClient) LMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection options.ListDirection,
+) (map[string][]string, error) {
 	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
 		return nil, err
@@ -2557,7 +3133,7 @@ func (client *baseClient) LMPop(keys []string, listDirection options.ListDirecti
 	args = append(args, strconv.Itoa(len(keys)))
 	args = append(args, keys...)
 	args = append(args, listDirectionStr)
-	result, err := client.executeCommand(C.LMPop, args)
+	result, err := client.executeCommand(ctx, C.LMPop, args)
 	if err != nil {
 		return nil, err
 	}
@@ -2585,6 +3161,7 @@ func (client *baseClient) LMPop(keys []string, listDirection options.ListDirecti
 //
 // [valkey.io]: https://valkey.io/commands/lmpop/
 func (client *baseClient) LMPopCount(
+	ctx context.Context,
 	keys []string,
 	listDirection options.ListDirection,
 	count int64,
@@ -2604,7 +3181,7 @@ func (client *baseClient) LMPopCount(
 	args = append(args, strconv.Itoa(len(keys)))
 	args = append(args, keys...)
 	args = append(args, listDirectionStr, options.CountKeyword, utils.IntToString(count))
-	result, err := client.executeCommand(C.LMPop, args)
+	result, err := client.executeCommand(ctx, C.LMPop, args)
 	if err != nil {
 		return nil, err
 	}
@@ -2639,6 +3216,7 @@ func (client *baseClient) LMPopCount(
 // [valkey.io]: https://valkey.io/commands/blmpop/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
 func (client *baseClient) BLMPop(
+	ctx context.Context,
 	keys []string,
 	listDirection options.ListDirection,
 	timeoutSecs float64,
@@ -2658,7 +3236,7 @@ func (client *baseClient) BLMPop(
 	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
 	args = append(args, keys...)
 	args = append(args, listDirectionStr)
-	result, err := client.executeCommand(C.BLMPop, args)
+	result, err := client.executeCommand(ctx, C.BLMPop, args)
 	if err != nil {
 		return nil, err
 	}
@@ -2696,6 +3274,7 @@ func (client *baseClient) BLMPop(
 // [valkey.io]: https://valkey.io/commands/blmpop/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
 func (client *baseClient) BLMPopCount(
+	ctx context.Context,
 	keys []string,
 	listDirection options.ListDirection,
 	count int64,
@@ -2716,7 +3295,7 @@ func (client *baseClient) BLMPopCount(
 	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
 	args = append(args, keys...)
 	args = append(args, listDirectionStr, options.CountKeyword, utils.IntToString(count))
-	result, err := client.executeCommand(C.BLMPop, args)
+	result, err := client.executeCommand(ctx, C.BLMPop, args)
 	if err != nil {
 		return nil, err
 	}
@@ -2742,8 +3321,8 @@ func (client *baseClient) BLMPopCount(
 //	`"OK"`.
 //
 // [valkey.io]: https://valkey.io/commands/lset/
-func (client *baseClient) LSet(key string, index int64, element string) (string, error) {
-	result, err := client.executeCommand(C.LSet, []string{key, utils.IntToString(index), element})
+func (client *baseClient) LSet(ctx context.Context, key string, index int64, element string) (string, error) {
+	result, err := client.executeCommand(ctx, C.LSet, []string{key, utils.IntToString(index), element})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -2769,6 +3348,7 @@ func (client *baseClient) LSet(key string, index int64, element string) (string,
 //
 // [valkey.io]: https://valkey.io/commands/lmove/
 func (client *baseClient) LMove(
+	ctx context.Context,
 	source string,
 	destination string,
 	whereFrom options.ListDirection,
@@ -2783,7 +3363,7 @@ func (client *baseClient) LMove(
 		return CreateNilStringResult(), err
 	}
 
-	result, err := client.executeCommand(C.LMove, []string{source, destination, whereFromStr, whereToStr})
+	result, err := client.executeCommand(ctx, C.LMove, []string{source, destination, whereFromStr, whereToStr})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -2822,6 +3402,7 @@ func (client *baseClient) LMove(
 // [valkey.io]: https://valkey.io/commands/blmove/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
 func (client *baseClient) BLMove(
+	ctx context.Context,
 	source string,
 	destination string,
 	whereFrom options.ListDirection,
@@ -2838,6 +3419,7 @@ func (client *baseClient) BLMove(
 	}
 
 	result, err := client.executeCommand(
+		ctx,
 		C.BLMove,
 		[]string{source, destination, whereFromStr, whereToStr, utils.FloatToString(timeoutSecs)},
 	)
@@ -2868,8 +3450,8 @@ func (client *baseClient) BLMove(
 //	Returns the number of keys that were removed.
 //
 // [valkey.io]: https://valkey.io/commands/del/
-func (client *baseClient) Del(keys []string) (int64, error) {
-	result, err := client.executeCommand(C.Del, keys)
+func (client *baseClient) Del(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Del, keys)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2897,8 +3479,8 @@ func (client *baseClient) Del(keys []string) (int64, error) {
 //	Returns the number of existing keys.
 //
 // [valkey.io]: https://valkey.io/commands/exists/
-func (client *baseClient) Exists(keys []string) (int64, error) {
-	result, err := client.executeCommand(C.Exists, keys)
+func (client *baseClient) Exists(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Exists, keys)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -2923,8 +3505,8 @@ func (client *baseClient) Exists(keys []string) (int64, error) {
 //	or operation skipped due to the provided arguments.
 //
 // [valkey.io]: https://valkey.io/commands/expire/
-func (client *baseClient) Expire(key string, seconds int64) (bool, error) {
-	result, err := client.executeCommand(C.Expire, []string{key, utils.IntToString(seconds)})
+func (client *baseClient) Expire(ctx context.Context, key string, seconds int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Expire, []string{key, utils.IntToString(seconds)})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -2951,12 +3533,17 @@ func (client *baseClient) Expire(key string, seconds int64) (bool, error) {
 //	or operation skipped due to the provided arguments.
 //
 // [valkey.io]: https://valkey.io/commands/expire/
-func (client *baseClient) ExpireWithOptions(key string, seconds int64, expireCondition options.ExpireCondition) (bool, error) {
+func (client *baseClient) ExpireWithOptions(
+	ctx context.Context,
+	key string,
+	seconds int64,
+	expireCondition options.ExpireCondition,
+) (bool, error) {
 	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
 		return defaultBoolResponse, err
 	}
-	result, err := client.executeCommand(C.Expire, []string{key, utils.IntToString(seconds), expireConditionStr})
+	result, err := client.executeCommand(ctx, C.Expire, []string{key, utils.IntToString(seconds), expireConditionStr})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -2983,8 +3570,8 @@ func (client *baseClient) ExpireWithOptions(key string, seconds int64, expireCon
 //	or operation skipped due to the provided arguments.
 //
 // [valkey.io]: https://valkey.io/commands/expireat/
-func (client *baseClient) ExpireAt(key string, unixTimestampInSeconds int64) (bool, error) {
-	result, err := client.executeCommand(C.ExpireAt, []string{key, utils.IntToString(unixTimestampInSeconds)})
+func (client *baseClient) ExpireAt(ctx context.Context, key string, unixTimestampInSeconds int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.ExpireAt, []string{key, utils.IntToString(unixTimestampInSeconds)})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -3015,6 +3602,7 @@ func (client *baseClient) ExpireAt(key string, unixTimestampInSeconds int64) (bo
 //
 // [valkey.io]: https://valkey.io/commands/expireat/
 func (client *baseClient) ExpireAtWithOptions(
+	ctx context.Context,
 	key string,
 	unixTimestampInSeconds int64,
 	expireCondition options.ExpireCondition,
@@ -3024,6 +3612,7 @@ func (client *baseClient) ExpireAtWithOptions(
 		return defaultBoolResponse, err
 	}
 	result, err := client.executeCommand(
+		ctx,
 		C.ExpireAt,
 		[]string{key, utils.IntToString(unixTimestampInSeconds), expireConditionStr},
 	)
@@ -3049,8 +3638,8 @@ func (client *baseClient) ExpireAtWithOptions(
 //	or operation skipped due to the provided arguments.
 //
 // [valkey.io]: https://valkey.io/commands/pexpire/
-func (client *baseClient) PExpire(key string, milliseconds int64) (bool, error) {
-	result, err := client.executeCommand(C.PExpire, []string{key, utils.IntToString(milliseconds)})
+func (client *baseClient) PExpire(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.PExpire, []string{key, utils.IntToString(milliseconds)})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -3075,6 +3664,7 @@ func (client *baseClient) PExpire(key string, milliseconds int64) (bool, error)
 //
 // [valkey.io]: https://valkey.io/commands/pexpire/
 func (client *baseClient) PExpireWithOptions(
+	ctx context.Context,
 	key string,
 	milliseconds int64,
 	expireCondition options.ExpireCondition,
@@ -3083,7 +3673,7 @@ func (client *baseClient) PExpireWithOptions(
 	if err != nil {
 		return defaultBoolResponse, err
 	}
-	result, err := client.executeCommand(C.PExpire, []string{key, utils.IntToString(milliseconds), expireConditionStr})
+	result, err := client.executeCommand(ctx, C.PExpire, []string{key, utils.IntToString(milliseconds), expireConditionStr})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -3109,8 +3699,8 @@ func (client *baseClient) PExpireWithOptions(
 //	or operation skipped due to the provided arguments.
 //
 // [valkey.io]: https://valkey.io/commands/pexpireat/
-func (client *baseClient) PExpireAt(key string, unixTimestampInMilliSeconds int64) (bool, error) {
-	result, err := client.executeCommand(C.PExpireAt, []string{key, utils.IntToString(unixTimestampInMilliSeconds)})
+func (client *baseClient) PExpireAt(ctx context.Context, key string, unixTimestampInMilliSeconds int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.PExpireAt, []string{key, utils.IntToString(unixTimestampInMilliSeconds)})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -3137,6 +3727,7 @@ func (client *baseClient) PExpireAt(key string, unixTimestampInMilliSeconds int6
 //
 // [valkey.io]: https://valkey.io/commands/pexpireat/
 func (client *baseClient) PExpireAtWithOptions(
+	ctx context.Context,
 	key string,
 	unixTimestampInMilliSeconds int64,
 	expireCondition options.ExpireCondition,
@@ -3146,6 +3737,7 @@ func (client *baseClient) PExpireAtWithOptions(
 		return defaultBoolResponse, err
 	}
 	result, err := client.executeCommand(
+		ctx,
 		C.PExpireAt,
 		[]string{key, utils.IntToString(unixTimestampInMilliSeconds), expireConditionStr},
 	)
@@ -3168,8 +3760,8 @@ func (client *baseClient) PExpireAtWithOptions(
 //	`-2` if key does not exist or `-1` is key exists but has no associated expiration.
 //
 // [valkey.io]: https://valkey.io/commands/expiretime/
-func (client *baseClient) ExpireTime(key string) (int64, error) {
-	result, err := client.executeCommand(C.ExpireTime, []string{key})
+func (client *baseClient) ExpireTime(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ExpireTime, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3190,8 +3782,8 @@ func (client *baseClient) ExpireTime(key string) (int64, error) {
 //	`-2` if key does not exist or `-1` is key exists but has no associated expiration.
 //
 // [valkey.io]: https://valkey.io/commands/pexpiretime/
-func (client *baseClient) PExpireTime(key string) (int64, error) {
-	result, err := client.executeCommand(C.PExpireTime, []string{key})
+func (client *baseClient) PExpireTime(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PExpireTime, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3211,8 +3803,8 @@ func (client *baseClient) PExpireTime(key string) (int64, error) {
 //	`-2` if key does not exist, or `-1` if key exists but has no associated expiration.
 //
 // [valkey.io]: https://valkey.io/commands/ttl/
-func (client *baseClient) TTL(key string) (int64, error) {
-	result, err := client.executeCommand(C.TTL, []string{key})
+func (client *baseClient) TTL(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.TTL, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3232,8 +3824,8 @@ func (client *baseClient) TTL(key string) (int64, error) {
 //	`-2` if key does not exist, or `-1` if key exists but has no associated expiration.
 //
 // [valkey.io]: https://valkey.io/commands/pttl/
-func (client *baseClient) PTTL(key string) (int64, error) {
-	result, err := client.executeCommand(C.PTTL, []string{key})
+func (client *baseClient) PTTL(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PTTL, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3257,8 +3849,8 @@ func (client *baseClient) PTTL(key string) (int64, error) {
 //	altered, then returns `1`. Otherwise, returns `0`.
 //
 // [valkey.io]: https://valkey.io/commands/pfadd/
-func (client *baseClient) PfAdd(key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(C.PfAdd, append([]string{key}, elements...))
+func (client *baseClient) PfAdd(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PfAdd, append([]string{key}, elements...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3288,8 +3880,8 @@ func (client *baseClient) PfAdd(key string, elements []string) (int64, error) {
 //	The cardinality of a key that does not exist is `0`.
 //
 // [valkey.io]: https://valkey.io/commands/pfcount/
-func (client *baseClient) PfCount(keys []string) (int64, error) {
-	result, err := client.executeCommand(C.PfCount, keys)
+func (client *baseClient) PfCount(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PfCount, keys)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3315,8 +3907,8 @@ func (client *baseClient) PfCount(keys []string) (int64, error) {
 //	If the HyperLogLog values is successfully merged  it returns "OK".
 //
 // [valkey.io]: https://valkey.io/commands/pfmerge/
-func (client *baseClient) PfMerge(destination string, sourceKeys []string) (string, error) {
-	result, err := client.executeCommand(C.PfMerge, append([]string{destination}, sourceKeys...))
+func (client *baseClient) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	result, err := client.executeCommand(ctx, C.PfMerge, append([]string{destination}, sourceKeys...))
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -3345,8 +3937,8 @@ func (client *baseClient) PfMerge(destination string, sourceKeys []string) (stri
 //	Return the number of keys that were unlinked.
 //
 // [valkey.io]: https://valkey.io/commands/unlink/
-func (client *baseClient) Unlink(keys []string) (int64, error) {
-	result, err := client.executeCommand(C.Unlink, keys)
+func (client *baseClient) Unlink(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Unlink, keys)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3366,8 +3958,8 @@ func (client *baseClient) Unlink(keys []string) (int64, error) {
 //	If the key exists, the type of the stored value is returned. Otherwise, a "none" string is returned.
 //
 // [valkey.io]: https://valkey.io/commands/type/
-func (client *baseClient) Type(key string) (string, error) {
-	result, err := client.executeCommand(C.Type, []string{key})
+func (client *baseClient) Type(ctx context.Context, key string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Type, []string{key})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -3394,8 +3986,101 @@ func (client *baseClient) Type(key string) (string, error) {
 //	The number of keys that were updated.
 //
 // [valkey.io]: Https://valkey.io/commands/touch/
-func (client *baseClient) Touch(keys []string) (int64, error) {
-	result, err := client.executeCommand(C.Touch, keys)
+func (client *baseClient) Touch(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Touch, keys)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
+}
+
+// Posts a message to the given channel.
+//
+// In cluster mode, the message is propagated to all the nodes of the cluster, and is received by the clients subscribed
+// to the channel on any node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	channel - The channel to publish the message to.
+//	message - The message to publish.
+//
+// Return value:
+//
+//	The number of clients that received the message. In cluster mode, only the clients connected to the node which
+//	executed the command are counted.
+//
+// [valkey.io]: https://valkey.io/commands/publish/
+func (client *baseClient) Publish(ctx context.Context, channel string, message string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Publish, []string{channel, message})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
+}
+
+// Lists the currently active channels.
+//
+// In cluster mode, the command is routed to all nodes, and their results are combined.
+//
+// See [valkey.io] for details.
+//
+// Return value:
+//
+//	An array of the active channels. A channel is active if it has one or more subscribers, not counting the clients
+//	subscribed to patterns.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-channels/
+func (client *baseClient) PubSubChannels(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubChannels, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringArrayResponse(result)
+}
+
+// Lists the currently active channels matching the given pattern.
+//
+// In cluster mode, the command is routed to all nodes, and their results are combined.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	pattern - A glob-style pattern to match the active channels.
+//
+// Return value:
+//
+//	An array of the active channels matching the pattern.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-channels/
+func (client *baseClient) PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubChannels, []string{pattern})
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringArrayResponse(result)
+}
+
+// Returns the number of unique patterns that are subscribed to by clients.
+//
+// In cluster mode, the command is routed to all nodes, and their results are summed.
+//
+// See [valkey.io] for details.
+//
+// Return value:
+//
+//	The number of unique patterns. Patterns subscribed to by clients connected to different nodes are counted once for
+//	each node.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-numpat/
+func (client *baseClient) PubSubNumPat(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PubSubNumPat, []string{})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3403,6 +4088,60 @@ func (client *baseClient) Touch(keys []string) (int64, error) {
 	return handleIntResponse(result)
 }
 
+// Returns the number of subscribers, not counting the clients subscribed to patterns, for the given channels.
+//
+// In cluster mode, the command is routed to all nodes, and the numbers of subscribers of each channel are summed.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	channels - The channels to count the subscribers of.
+//
+// Return value:
+//
+//	A map where the keys are the channels and the values are their numbers of subscribers.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-numsub/
+func (client *baseClient) PubSubNumSub(ctx context.Context, channels []string) (map[string]int64, error) {
+	result, err := client.executeCommand(ctx, C.PubSubNumSub, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringIntMapResponse(result)
+}
+
+// Marks the given keys to be watched for conditional execution of a transaction. Transactions will only execute commands
+// if the watched keys are not modified before execution of the transaction. If a watched key is modified, the execution of
+// the transaction fails with an [errors.TransactionAbortedError].
+//
+// Note:
+//
+//	In cluster mode, if keys in keys map to different hash slots, the command
+//	will be split across these slots and executed separately for each. As the keys of a
+//	transaction must map to the same hash slot, the watched keys should map to that slot too.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	keys - The keys to watch.
+//
+// Return value:
+//
+//	A simple `"OK"` response.
+//
+// [valkey.io]: https://valkey.io/commands/watch/
+func (client *baseClient) Watch(ctx context.Context, keys []string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Watch, keys)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+
+	return handleStringResponse(result)
+}
+
 // Renames key to new key.
 //
 //	If new Key already exists it is overwritten.
@@ -3421,8 +4160,8 @@ func (client *baseClient) Touch(keys []string) (int64, error) {
 //	If the key was successfully renamed, return "OK". If key does not exist, an error is thrown.
 //
 // [valkey.io]: https://valkey.io/commands/rename/
-func (client *baseClient) Rename(key string, newKey string) (string, error) {
-	result, err := client.executeCommand(C.Rename, []string{key, newKey})
+func (client *baseClient) Rename(ctx context.Context, key string, newKey string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Rename, []string{key, newKey})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -3445,8 +4184,8 @@ func (client *baseClient) Rename(key string, newKey string) (string, error) {
 //	`true` if key was renamed to `newKey`, `false` if `newKey` already exists.
 //
 // [valkey.io]: https://valkey.io/commands/renamenx/
-func (client *baseClient) RenameNX(key string, newKey string) (bool, error) {
-	result, err := client.executeCommand(C.RenameNX, []string{key, newKey})
+func (client *baseClient) RenameNX(ctx context.Context, key string, newKey string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.RenameNX, []string{key, newKey})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -3467,8 +4206,8 @@ func (client *baseClient) RenameNX(key string, newKey string) (bool, error) {
 //	The id of the added entry.
 //
 // [valkey.io]: https://valkey.io/commands/xadd/
-func (client *baseClient) XAdd(key string, values [][]string) (Result[string], error) {
-	return client.XAddWithOptions(key, values, *options.NewXAddOptions())
+func (client *baseClient) XAdd(ctx context.Context, key string, values [][]string) (Result[string], error) {
+	return client.XAddWithOptions(ctx, key, values, *options.NewXAddOptions())
 }
 
 // Adds an entry to the specified stream stored at `key`. If the `key` doesn't exist, the stream is created.
@@ -3487,6 +4226,7 @@ func (client *baseClient) XAdd(key string, values [][]string) (Result[string], e
 //
 // [valkey.io]: https://valkey.io/commands/xadd/
 func (client *baseClient) XAddWithOptions(
+	ctx context.Context,
 	key string,
 	values [][]string,
 	options options.XAddOptions,
@@ -3508,7 +4248,7 @@ func (client *baseClient) XAddWithOptions(
 		args = append(args, pair...)
 	}
 
-	result, err := client.executeCommand(C.XAdd, args)
+	result, err := client.executeCommand(ctx, C.XAdd, args)
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -3533,8 +4273,8 @@ func (client *baseClient) XAddWithOptions(
 //	a key does not exist or does not contain requiested entries.
 //
 // [valkey.io]: https://valkey.io/commands/xread/
-func (client *baseClient) XRead(keysAndIds map[string]string) (map[string]map[string][][]string, error) {
-	return client.XReadWithOptions(keysAndIds, *options.NewXReadOptions())
+func (client *baseClient) XRead(ctx context.Context, keysAndIds map[string]string) (map[string]map[string][][]string, error) {
+	return client.XReadWithOptions(ctx, keysAndIds, *options.NewXReadOptions())
 }
 
 // Reads entries from the given streams.
@@ -3557,6 +4297,7 @@ func (client *baseClient) XRead(keysAndIds map[string]string) (map[string]map[st
 //
 // [valkey.io]: https://valkey.io/commands/xread/
 func (client *baseClient) XReadWithOptions(
+	ctx context.Context,
 	keysAndIds map[string]string,
 	opts options.XReadOptions,
 ) (map[string]map[string][][]string, error) {
@@ -3565,7 +4306,7 @@ func (client *baseClient) XReadWithOptions(
 		return nil, err
 	}
 
-	result, err := client.executeCommand(C.XRead, args)
+	result, err := client.executeCommand(ctx, C.XRead, args)
 	if err != nil {
 		return nil, err
 	}
@@ -3594,11 +4335,12 @@ func (client *baseClient) XReadWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/xreadgroup/
 func (client *baseClient) XReadGroup(
+	ctx context.Context,
 	group string,
 	consumer string,
 	keysAndIds map[string]string,
 ) (map[string]map[string][][]string, error) {
-	return client.XReadGroupWithOptions(group, consumer, keysAndIds, *options.NewXReadGroupOptions())
+	return client.XReadGroupWithOptions(ctx, group, consumer, keysAndIds, *options.NewXReadGroupOptions())
 }
 
 // Reads entries from the given streams owned by a consumer group.
@@ -3623,6 +4365,7 @@ func (client *baseClient) XReadGroup(
 //
 // [valkey.io]: https://valkey.io/commands/xreadgroup/
 func (client *baseClient) XReadGroupWithOptions(
+	ctx context.Context,
 	group string,
 	consumer string,
 	keysAndIds map[string]string,
@@ -3633,7 +4376,7 @@ func (client *baseClient) XReadGroupWithOptions(
 		return nil, err
 	}
 
-	result, err := client.executeCommand(C.XReadGroup, args)
+	result, err := client.executeCommand(ctx, C.XReadGroup, args)
 	if err != nil {
 		return nil, err
 	}
@@ -3680,10 +4423,12 @@ func createStreamCommandArgs(
 //
 // [valkey.io]: https://valkey.io/commands/zadd/
 func (client *baseClient) ZAdd(
+	ctx context.Context,
 	key string,
 	membersScoreMap map[string]float64,
 ) (int64, error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.ZAdd,
 		append([]string{key}, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
 	)
@@ -3710,6 +4455,7 @@ func (client *baseClient) ZAdd(
 //
 // [valkey.io]: https://valkey.io/commands/zadd/
 func (client *baseClient) ZAddWithOptions(
+	ctx context.Context,
 	key string,
 	membersScoreMap map[string]float64,
 	opts options.ZAddOptions,
@@ -3720,6 +4466,7 @@ func (client *baseClient) ZAddWithOptions(
 	}
 	commandArgs := append([]string{key}, optionArgs...)
 	result, err := client.executeCommand(
+		ctx,
 		C.ZAdd,
 		append(commandArgs, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
 	)
@@ -3730,13 +4477,13 @@ func (client *baseClient) ZAddWithOptions(
 	return handleIntResponse(result)
 }
 
-func (client *baseClient) zAddIncrBase(key string, opts *options.ZAddOptions) (Result[float64], error) {
+func (client *baseClient) zAddIncrBase(ctx context.Context, key string, opts *options.ZAddOptions) (Result[float64], error) {
 	optionArgs, err := opts.ToArgs()
 	if err != nil {
 		return CreateNilFloat64Result(), err
 	}
 
-	result, err := client.executeCommand(C.ZAdd, append([]string{key}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.ZAdd, append([]string{key}, optionArgs...))
 	if err != nil {
 		return CreateNilFloat64Result(), err
 	}
@@ -3760,6 +4507,7 @@ func (client *baseClient) zAddIncrBase(key string, opts *options.ZAddOptions) (R
 //
 // [valkey.io]: https://valkey.io/commands/zadd/
 func (client *baseClient) ZAddIncr(
+	ctx context.Context,
 	key string,
 	member string,
 	increment float64,
@@ -3769,7 +4517,7 @@ func (client *baseClient) ZAddIncr(
 		return CreateNilFloat64Result(), err
 	}
 
-	return client.zAddIncrBase(key, options)
+	return client.zAddIncrBase(ctx, key, options)
 }
 
 // Adds one or more members to a sorted set, or updates their scores. Creates the key if it doesn't exist.
@@ -3790,6 +4538,7 @@ func (client *baseClient) ZAddIncr(
 //
 // [valkey.io]: https://valkey.io/commands/zadd/
 func (client *baseClient) ZAddIncrWithOptions(
+	ctx context.Context,
 	key string,
 	member string,
 	increment float64,
@@ -3800,7 +4549,7 @@ func (client *baseClient) ZAddIncrWithOptions(
 		return CreateNilFloat64Result(), err
 	}
 
-	return client.zAddIncrBase(key, incrOpts)
+	return client.zAddIncrBase(ctx, key, incrOpts)
 }
 
 // Increments the score of member in the sorted set stored at key by increment.
@@ -3821,8 +4570,8 @@ func (client *baseClient) ZAddIncrWithOptions(
 //	The new score of member.
 //
 // [valkey.io]: https://valkey.io/commands/zincrby/
-func (client *baseClient) ZIncrBy(key string, increment float64, member string) (float64, error) {
-	result, err := client.executeCommand(C.ZIncrBy, []string{key, utils.FloatToString(increment), member})
+func (client *baseClient) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+	result, err := client.executeCommand(ctx, C.ZIncrBy, []string{key, utils.FloatToString(increment), member})
 	if err != nil {
 		return defaultFloatResponse, err
 	}
@@ -3846,8 +4595,8 @@ func (client *baseClient) ZIncrBy(key string, increment float64, member string)
 //	command returns an empty map.
 //
 // [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMin(key string) (map[string]float64, error) {
-	result, err := client.executeCommand(C.ZPopMin, []string{key})
+func (client *baseClient) ZPopMin(ctx context.Context, key string) (map[string]float64, error) {
+	result, err := client.executeCommand(ctx, C.ZPopMin, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -3871,12 +4620,16 @@ func (client *baseClient) ZPopMin(key string) (map[string]float64, error) {
 //	command returns an empty map.
 //
 // [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMinWithOptions(key string, options options.ZPopOptions) (map[string]float64, error) {
+func (client *baseClient) ZPopMinWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
 	optArgs, err := options.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	result, err := client.executeCommand(C.ZPopMin, append([]string{key}, optArgs...))
+	result, err := client.executeCommand(ctx, C.ZPopMin, append([]string{key}, optArgs...))
 	if err != nil {
 		return nil, err
 	}
@@ -3899,8 +4652,8 @@ func (client *baseClient) ZPopMinWithOptions(key string, options options.ZPopOpt
 //	command returns an empty map.
 //
 // [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMax(key string) (map[string]float64, error) {
-	result, err := client.executeCommand(C.ZPopMax, []string{key})
+func (client *baseClient) ZPopMax(ctx context.Context, key string) (map[string]float64, error) {
+	result, err := client.executeCommand(ctx, C.ZPopMax, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -3924,12 +4677,16 @@ func (client *baseClient) ZPopMax(key string) (map[string]float64, error) {
 //	command returns an empty map.
 //
 // [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMaxWithOptions(key string, options options.ZPopOptions) (map[string]float64, error) {
+func (client *baseClient) ZPopMaxWithOptions(
+	ctx context.Context,
+	key string,
+	options options.ZPopOptions,
+) (map[string]float64, error) {
 	optArgs, err := options.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	result, err := client.executeCommand(C.ZPopMax, append([]string{key}, optArgs...))
+	result, err := client.executeCommand(ctx, C.ZPopMax, append([]string{key}, optArgs...))
 	if err != nil {
 		return nil, err
 	}
@@ -3952,8 +4709,8 @@ func (client *baseClient) ZPopMaxWithOptions(key string, options options.ZPopOpt
 //	If `key` does not exist, it is treated as an empty sorted set, and this command returns `0`.
 //
 // [valkey.io]: https://valkey.io/commands/zrem/
-func (client *baseClient) ZRem(key string, members []string) (int64, error) {
-	result, err := client.executeCommand(C.ZRem, append([]string{key}, members...))
+func (client *baseClient) ZRem(ctx context.Context, key string, members []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ZRem, append([]string{key}, members...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -3975,8 +4732,8 @@ func (client *baseClient) ZRem(key string, members []string) (int64, error) {
 //	If `key` holds a value that is not a sorted set, an error is returned.
 //
 // [valkey.io]: https://valkey.io/commands/zcard/
-func (client *baseClient) ZCard(key string) (int64, error) {
-	result, err := client.executeCommand(C.ZCard, []string{key})
+func (client *baseClient) ZCard(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ZCard, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -4009,8 +4766,12 @@ func (client *baseClient) ZCard(key string) (int64, error) {
 // [valkey.io]: https://valkey.io/commands/bzpopmin/
 //
 // [blocking commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BZPopMin(keys []string, timeoutSecs float64) (Result[KeyWithMemberAndScore], error) {
-	result, err := client.executeCommand(C.BZPopMin, append(keys, utils.FloatToString(timeoutSecs)))
+func (client *baseClient) BZPopMin(
+	ctx context.Context,
+	keys []string,
+	timeoutSecs float64,
+) (Result[KeyWithMemberAndScore], error) {
+	result, err := client.executeCommand(ctx, C.BZPopMin, append(keys, utils.FloatToString(timeoutSecs)))
 	if err != nil {
 		return CreateNilKeyWithMemberAndScoreResult(), err
 	}
@@ -4050,6 +4811,7 @@ func (client *baseClient) BZPopMin(keys []string, timeoutSecs float64) (Result[K
 // [valkey.io]: https://valkey.io/commands/bzmpop/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
 func (client *baseClient) BZMPop(
+	ctx context.Context,
 	keys []string,
 	scoreFilter options.ScoreFilter,
 	timeoutSecs float64,
@@ -4071,7 +4833,7 @@ func (client *baseClient) BZMPop(
 	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
 	args = append(args, keys...)
 	args = append(args, scoreFilterStr)
-	result, err := client.executeCommand(C.BZMPop, args)
+	result, err := client.executeCommand(ctx, C.BZMPop, args)
 	if err != nil {
 		return CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
@@ -4112,6 +4874,7 @@ func (client *baseClient) BZMPop(
 // [valkey.io]: https://valkey.io/commands/bzmpop/
 // [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
 func (client *baseClient) BZMPopWithOptions(
+	ctx context.Context,
 	keys []string,
 	scoreFilter options.ScoreFilter,
 	timeoutSecs float64,
@@ -4139,7 +4902,7 @@ func (client *baseClient) BZMPopWithOptions(
 		return CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
 	args = append(args, optionArgs...)
-	result, err := client.executeCommand(C.BZMPop, args)
+	result, err := client.executeCommand(ctx, C.BZMPop, args)
 	if err != nil {
 		return CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
@@ -4168,7 +4931,7 @@ func (client *baseClient) BZMPopWithOptions(
 //	If `key` does not exist, it is treated as an empty sorted set, and the command returns an empty array.
 //
 // [valkey.io]: https://valkey.io/commands/zrange/
-func (client *baseClient) ZRange(key string, rangeQuery options.ZRangeQuery) ([]string, error) {
+func (client *baseClient) ZRange(ctx context.Context, key string, rangeQuery options.ZRangeQuery) ([]string, error) {
 	args := make([]string, 0, 10)
 	args = append(args, key)
 	queryArgs, err := rangeQuery.ToArgs()
@@ -4176,7 +4939,7 @@ func (client *baseClient) ZRange(key string, rangeQuery options.ZRangeQuery) ([]
 		return nil, err
 	}
 	args = append(args, queryArgs...)
-	result, err := client.executeCommand(C.ZRange, args)
+	result, err := client.executeCommand(ctx, C.ZRange, args)
 	if err != nil {
 		return nil, err
 	}
@@ -4203,6 +4966,7 @@ func (client *baseClient) ZRange(key string, rangeQuery options.ZRangeQuery) ([]
 //
 // [valkey.io]: https://valkey.io/commands/zrange/
 func (client *baseClient) ZRangeWithScores(
+	ctx context.Context,
 	key string,
 	rangeQuery options.ZRangeQueryWithScores,
 ) (map[string]float64, error) {
@@ -4214,7 +4978,7 @@ func (client *baseClient) ZRangeWithScores(
 	}
 	args = append(args, queryArgs...)
 	args = append(args, options.WithScoresKeyword)
-	result, err := client.executeCommand(C.ZRange, args)
+	result, err := client.executeCommand(ctx, C.ZRange, args)
 	if err != nil {
 		return nil, err
 	}
@@ -4247,6 +5011,7 @@ func (client *baseClient) ZRangeWithScores(
 //
 // [valkey.io]: https://valkey.io/commands/zrangestore/
 func (client *baseClient) ZRangeStore(
+	ctx context.Context,
 	destination string,
 	key string,
 	rangeQuery options.ZRangeQuery,
@@ -4259,7 +5024,7 @@ func (client *baseClient) ZRangeStore(
 		return defaultIntResponse, err
 	}
 	args = append(args, rqArgs...)
-	result, err := client.executeCommand(C.ZRangeStore, args)
+	result, err := client.executeCommand(ctx, C.ZRangeStore, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -4279,8 +5044,8 @@ func (client *baseClient) ZRangeStore(
 //	`false` if key does not exist or does not have an associated timeout, `true` if the timeout has been removed.
 //
 // [valkey.io]: https://valkey.io/commands/persist/
-func (client *baseClient) Persist(key string) (bool, error) {
-	result, err := client.executeCommand(C.Persist, []string{key})
+func (client *baseClient) Persist(ctx context.Context, key string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Persist, []string{key})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -4303,12 +5068,12 @@ func (client *baseClient) Persist(key string) (bool, error) {
 //	The number of members in the specified score range.
 //
 // [valkey.io]: https://valkey.io/commands/zcount/
-func (client *baseClient) ZCount(key string, rangeOptions options.ZCountRange) (int64, error) {
+func (client *baseClient) ZCount(ctx context.Context, key string, rangeOptions options.ZCountRange) (int64, error) {
 	zCountRangeArgs, err := rangeOptions.ToArgs()
 	if err != nil {
 		return defaultIntResponse, err
 	}
-	result, err := client.executeCommand(C.ZCount, append([]string{key}, zCountRangeArgs...))
+	result, err := client.executeCommand(ctx, C.ZCount, append([]string{key}, zCountRangeArgs...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -4333,8 +5098,8 @@ func (client *baseClient) ZCount(key string, rangeOptions options.ZCountRange) (
 //	`nil` will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/zrank/
-func (client *baseClient) ZRank(key string, member string) (Result[int64], error) {
-	result, err := client.executeCommand(C.ZRank, []string{key, member})
+func (client *baseClient) ZRank(ctx context.Context, key string, member string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ZRank, []string{key, member})
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -4358,8 +5123,12 @@ func (client *baseClient) ZRank(key string, member string) (Result[int64], error
 //	`nil` will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/zrank/
-func (client *baseClient) ZRankWithScore(key string, member string) (Result[int64], Result[float64], error) {
-	result, err := client.executeCommand(C.ZRank, []string{key, member, options.WithScoreKeyword})
+func (client *baseClient) ZRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (Result[int64], Result[float64], error) {
+	result, err := client.executeCommand(ctx, C.ZRank, []string{key, member, options.WithScoreKeyword})
 	if err != nil {
 		return CreateNilInt64Result(), CreateNilFloat64Result(), err
 	}
@@ -4385,8 +5154,8 @@ func (client *baseClient) ZRankWithScore(key string, member string) (Result[int6
 //	`nil` will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/zrevrank/
-func (client *baseClient) ZRevRank(key string, member string) (Result[int64], error) {
-	result, err := client.executeCommand(C.ZRevRank, []string{key, member})
+func (client *baseClient) ZRevRank(ctx context.Context, key string, member string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ZRevRank, []string{key, member})
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -4411,8 +5180,12 @@ func (client *baseClient) ZRevRank(key string, member string) (Result[int64], er
 //	`nil` will be returned.s
 //
 // [valkey.io]: https://valkey.io/commands/zrevrank/
-func (client *baseClient) ZRevRankWithScore(key string, member string) (Result[int64], Result[float64], error) {
-	result, err := client.executeCommand(C.ZRevRank, []string{key, member, options.WithScoreKeyword})
+func (client *baseClient) ZRevRankWithScore(
+	ctx context.Context,
+	key string,
+	member string,
+) (Result[int64], Result[float64], error) {
+	result, err := client.executeCommand(ctx, C.ZRevRank, []string{key, member, options.WithScoreKeyword})
 	if err != nil {
 		return CreateNilInt64Result(), CreateNilFloat64Result(), err
 	}
@@ -4433,12 +5206,12 @@ func (client *baseClient) ZRevRankWithScore(key string, member string) (Result[i
 //	The number of entries deleted from the stream.
 //
 // [valkey.io]: https://valkey.io/commands/xtrim/
-func (client *baseClient) XTrim(key string, options options.XTrimOptions) (int64, error) {
+func (client *baseClient) XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error) {
 	xTrimArgs, err := options.ToArgs()
 	if err != nil {
 		return defaultIntResponse, err
 	}
-	result, err := client.executeCommand(C.XTrim, append([]string{key}, xTrimArgs...))
+	result, err := client.executeCommand(ctx, C.XTrim, append([]string{key}, xTrimArgs...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -4458,8 +5231,8 @@ func (client *baseClient) XTrim(key string, options options.XTrimOptions) (int64
 //	The number of entries in the stream. If `key` does not exist, return 0.
 //
 // [valkey.io]: https://valkey.io/commands/xlen/
-func (client *baseClient) XLen(key string) (int64, error) {
-	result, err := client.executeCommand(C.XLen, []string{key})
+func (client *baseClient) XLen(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XLen, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -4495,13 +5268,14 @@ func (client *baseClient) XLen(key string) (int64, error) {
 //
 // [valkey.io]: https://valkey.io/commands/xautoclaim/
 func (client *baseClient) XAutoClaim(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
 	minIdleTime int64,
 	start string,
 ) (XAutoClaimResponse, error) {
-	return client.XAutoClaimWithOptions(key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
+	return client.XAutoClaimWithOptions(ctx, key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
 }
 
 // Transfers ownership of pending stream entries that match the specified criteria.
@@ -4534,6 +5308,7 @@ func (client *baseClient) XAutoClaim(
 //
 // [valkey.io]: https://valkey.io/commands/xautoclaim/
 func (client *baseClient) XAutoClaimWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
@@ -4547,7 +5322,7 @@ func (client *baseClient) XAutoClaimWithOptions(
 		return XAutoClaimResponse{}, err
 	}
 	args = append(args, optArgs...)
-	result, err := client.executeCommand(C.XAutoClaim, args)
+	result, err := client.executeCommand(ctx, C.XAutoClaim, args)
 	if err != nil {
 		return XAutoClaimResponse{}, err
 	}
@@ -4583,13 +5358,14 @@ func (client *baseClient) XAutoClaimWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/xautoclaim/
 func (client *baseClient) XAutoClaimJustId(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
 	minIdleTime int64,
 	start string,
 ) (XAutoClaimJustIdResponse, error) {
-	return client.XAutoClaimJustIdWithOptions(key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
+	return client.XAutoClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
 }
 
 // Transfers ownership of pending stream entries that match the specified criteria.
@@ -4622,6 +5398,7 @@ func (client *baseClient) XAutoClaimJustId(
 //
 // [valkey.io]: https://valkey.io/commands/xautoclaim/
 func (client *baseClient) XAutoClaimJustIdWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
@@ -4636,7 +5413,7 @@ func (client *baseClient) XAutoClaimJustIdWithOptions(
 	}
 	args = append(args, optArgs...)
 	args = append(args, options.JustIdKeyword)
-	result, err := client.executeCommand(C.XAutoClaim, args)
+	result, err := client.executeCommand(ctx, C.XAutoClaim, args)
 	if err != nil {
 		return XAutoClaimJustIdResponse{}, err
 	}
@@ -4658,8 +5435,8 @@ func (client *baseClient) XAutoClaimJustIdWithOptions(
 //	of entries in `ids`, if the specified `ids` don't exist in the stream.
 //
 // [valkey.io]: https://valkey.io/commands/xdel/
-func (client *baseClient) XDel(key string, ids []string) (int64, error) {
-	result, err := client.executeCommand(C.XDel, append([]string{key}, ids...))
+func (client *baseClient) XDel(ctx context.Context, key string, ids []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XDel, append([]string{key}, ids...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -4681,8 +5458,8 @@ func (client *baseClient) XDel(key string, ids []string) (int64, error) {
 //	If `key` does not exist, `nil` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/zscore/
-func (client *baseClient) ZScore(key string, member string) (Result[float64], error) {
-	result, err := client.executeCommand(C.ZScore, []string{key, member})
+func (client *baseClient) ZScore(ctx context.Context, key string, member string) (Result[float64], error) {
+	result, err := client.executeCommand(ctx, C.ZScore, []string{key, member})
 	if err != nil {
 		return CreateNilFloat64Result(), err
 	}
@@ -4708,8 +5485,8 @@ func (client *baseClient) ZScore(key string, member string) (Result[float64], er
 //	The array is a flattened series of `string` pairs, where the value is at even indices and the score is at odd indices.
 //
 // [valkey.io]: https://valkey.io/commands/zscan/
-func (client *baseClient) ZScan(key string, cursor string) (string, []string, error) {
-	result, err := client.executeCommand(C.ZScan, []string{key, cursor})
+func (client *baseClient) ZScan(ctx context.Context, key string, cursor string) (string, []string, error) {
+	result, err := client.executeCommand(ctx, C.ZScan, []string{key, cursor})
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -4736,6 +5513,7 @@ func (client *baseClient) ZScan(key string, cursor string) (string, []string, er
 //
 // [valkey.io]: https://valkey.io/commands/zscan/
 func (client *baseClient) ZScanWithOptions(
+	ctx context.Context,
 	key string,
 	cursor string,
 	options options.ZScanOptions,
@@ -4745,7 +5523,7 @@ func (client *baseClient) ZScanWithOptions(
 		return DefaultStringResponse, nil, err
 	}
 
-	result, err := client.executeCommand(C.ZScan, append([]string{key, cursor}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.ZScan, append([]string{key, cursor}, optionArgs...))
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -4773,8 +5551,8 @@ func (client *baseClient) ZScanWithOptions(
 //	MessageCount - The number of pending messages for this consumer.
 //
 // [valkey.io]: https://valkey.io/commands/xpending/
-func (client *baseClient) XPending(key string, group string) (XPendingSummary, error) {
-	result, err := client.executeCommand(C.XPending, []string{key, group})
+func (client *baseClient) XPending(ctx context.Context, key string, group string) (XPendingSummary, error) {
+	result, err := client.executeCommand(ctx, C.XPending, []string{key, group})
 	if err != nil {
 		return XPendingSummary{}, err
 	}
@@ -4803,6 +5581,7 @@ func (client *baseClient) XPending(key string, group string) (XPendingSummary, e
 //
 // [valkey.io]: https://valkey.io/commands/xpending/
 func (client *baseClient) XPendingWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	opts options.XPendingOptions,
@@ -4810,7 +5589,7 @@ func (client *baseClient) XPendingWithOptions(
 	optionArgs, _ := opts.ToArgs()
 	args := append([]string{key, group}, optionArgs...)
 
-	result, err := client.executeCommand(C.XPending, args)
+	result, err := client.executeCommand(ctx, C.XPending, args)
 	if err != nil {
 		return nil, err
 	}
@@ -4833,8 +5612,8 @@ func (client *baseClient) XPendingWithOptions(
 //	`"OK"`.
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-create/
-func (client *baseClient) XGroupCreate(key string, group string, id string) (string, error) {
-	return client.XGroupCreateWithOptions(key, group, id, *options.NewXGroupCreateOptions())
+func (client *baseClient) XGroupCreate(ctx context.Context, key string, group string, id string) (string, error) {
+	return client.XGroupCreateWithOptions(ctx, key, group, id, *options.NewXGroupCreateOptions())
 }
 
 // Creates a new consumer group uniquely identified by `group` for the stream stored at `key`.
@@ -4855,6 +5634,7 @@ func (client *baseClient) XGroupCreate(key string, group string, id string) (str
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-create/
 func (client *baseClient) XGroupCreateWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	id string,
@@ -4862,7 +5642,7 @@ func (client *baseClient) XGroupCreateWithOptions(
 ) (string, error) {
 	optionArgs, _ := opts.ToArgs()
 	args := append([]string{key, group, id}, optionArgs...)
-	result, err := client.executeCommand(C.XGroupCreate, args)
+	result, err := client.executeCommand(ctx, C.XGroupCreate, args)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -4883,8 +5663,8 @@ func (client *baseClient) XGroupCreateWithOptions(
 //	Return OK if successfully create a key with a value </code>.
 //
 // [valkey.io]: https://valkey.io/commands/restore/
-func (client *baseClient) Restore(key string, ttl int64, value string) (Result[string], error) {
-	return client.RestoreWithOptions(key, ttl, value, *options.NewRestoreOptions())
+func (client *baseClient) Restore(ctx context.Context, key string, ttl int64, value string) (Result[string], error) {
+	return client.RestoreWithOptions(ctx, key, ttl, value, *options.NewRestoreOptions())
 }
 
 // Create a key associated with a value that is obtained by
@@ -4902,14 +5682,14 @@ func (client *baseClient) Restore(key string, ttl int64, value string) (Result[s
 //	Return OK if successfully create a key with a value.
 //
 // [valkey.io]: https://valkey.io/commands/restore/
-func (client *baseClient) RestoreWithOptions(key string, ttl int64,
+func (client *baseClient) RestoreWithOptions(ctx context.Context, key string, ttl int64,
 	value string, options options.RestoreOptions,
 ) (Result[string], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
-	result, err := client.executeCommand(C.Restore, append([]string{
+	result, err := client.executeCommand(ctx, C.Restore, append([]string{
 		key,
 		utils.IntToString(ttl), value,
 	}, optionArgs...))
@@ -4931,8 +5711,8 @@ func (client *baseClient) RestoreWithOptions(key string, ttl int64,
 //	If key does not exist, null will be returned.
 //
 // [valkey.io]: https://valkey.io/commands/dump/
-func (client *baseClient) Dump(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.Dump, []string{key})
+func (client *baseClient) Dump(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Dump, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -4955,8 +5735,8 @@ func (client *baseClient) Dump(key string) (Result[string], error) {
 //	key as a String. Otherwise, returns `null`.
 //
 // [valkey.io]: https://valkey.io/commands/object-encoding/
-func (client *baseClient) ObjectEncoding(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.ObjectEncoding, []string{key})
+func (client *baseClient) ObjectEncoding(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.ObjectEncoding, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -4975,8 +5755,8 @@ func (client *baseClient) ObjectEncoding(key string) (Result[string], error) {
 //	The provided message
 //
 // [valkey.io]: https://valkey.io/commands/echo/
-func (client *baseClient) Echo(message string) (Result[string], error) {
-	result, err := client.executeCommand(C.Echo, []string{message})
+func (client *baseClient) Echo(ctx context.Context, message string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Echo, []string{message})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -4997,8 +5777,8 @@ func (client *baseClient) Echo(message string) (Result[string], error) {
 //	`true` if the consumer group is destroyed. Otherwise, `false`.
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-destroy/
-func (client *baseClient) XGroupDestroy(key string, group string) (bool, error) {
-	result, err := client.executeCommand(C.XGroupDestroy, []string{key, group})
+func (client *baseClient) XGroupDestroy(ctx context.Context, key string, group string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.XGroupDestroy, []string{key, group})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -5020,8 +5800,8 @@ func (client *baseClient) XGroupDestroy(key string, group string) (bool, error)
 //	`"OK"`.
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-setid/
-func (client *baseClient) XGroupSetId(key string, group string, id string) (string, error) {
-	return client.XGroupSetIdWithOptions(key, group, id, *options.NewXGroupSetIdOptionsOptions())
+func (client *baseClient) XGroupSetId(ctx context.Context, key string, group string, id string) (string, error) {
+	return client.XGroupSetIdWithOptions(ctx, key, group, id, *options.NewXGroupSetIdOptionsOptions())
 }
 
 // Sets the last delivered ID for a consumer group.
@@ -5041,6 +5821,7 @@ func (client *baseClient) XGroupSetId(key string, group string, id string) (stri
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-setid/
 func (client *baseClient) XGroupSetIdWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	id string,
@@ -5048,7 +5829,7 @@ func (client *baseClient) XGroupSetIdWithOptions(
 ) (string, error) {
 	optionArgs, _ := opts.ToArgs()
 	args := append([]string{key, group, id}, optionArgs...)
-	result, err := client.executeCommand(C.XGroupSetId, args)
+	result, err := client.executeCommand(ctx, C.XGroupSetId, args)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -5072,12 +5853,13 @@ func (client *baseClient) XGroupSetIdWithOptions(
 //	If `rangeQuery.Start` is greater than `rangeQuery.End`, `0` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/zremrangebylex/
-func (client *baseClient) ZRemRangeByLex(key string, rangeQuery options.RangeByLex) (int64, error) {
+func (client *baseClient) ZRemRangeByLex(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
 	queryArgs, err := rangeQuery.ToArgsRemRange()
 	if err != nil {
 		return defaultIntResponse, err
 	}
 	result, err := client.executeCommand(
+		ctx,
 		C.ZRemRangeByLex, append([]string{key}, queryArgs...))
 	if err != nil {
 		return defaultIntResponse, err
@@ -5102,8 +5884,12 @@ func (client *baseClient) ZRemRangeByLex(key string, rangeQuery options.RangeByL
 //	If `start` is greater than `stop`, `0` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/zremrangebyrank/
-func (client *baseClient) ZRemRangeByRank(key string, start int64, stop int64) (int64, error) {
-	result, err := client.executeCommand(C.ZRemRangeByRank, []string{key, utils.IntToString(start), utils.IntToString(stop)})
+func (client *baseClient) ZRemRangeByRank(ctx context.Context, key string, start int64, stop int64) (int64, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.ZRemRangeByRank,
+		[]string{key, utils.IntToString(start), utils.IntToString(stop)},
+	)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5127,12 +5913,12 @@ func (client *baseClient) ZRemRangeByRank(key string, start int64, stop int64) (
 //	If `rangeQuery.Start` is greater than `rangeQuery.End`, `0` is returned.
 //
 // [valkey.io]: https://valkey.io/commands/zremrangebyscore/
-func (client *baseClient) ZRemRangeByScore(key string, rangeQuery options.RangeByScore) (int64, error) {
+func (client *baseClient) ZRemRangeByScore(ctx context.Context, key string, rangeQuery options.RangeByScore) (int64, error) {
 	queryArgs, err := rangeQuery.ToArgsRemRange()
 	if err != nil {
 		return defaultIntResponse, err
 	}
-	result, err := client.executeCommand(C.ZRemRangeByScore, append([]string{key}, queryArgs...))
+	result, err := client.executeCommand(ctx, C.ZRemRangeByScore, append([]string{key}, queryArgs...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5153,8 +5939,8 @@ func (client *baseClient) ZRemRangeByScore(key string, rangeQuery options.RangeB
 //	If the sorted set does not exist or is empty, the response will be `nil`.
 //
 // [valkey.io]: https://valkey.io/commands/zrandmember/
-func (client *baseClient) ZRandMember(key string) (Result[string], error) {
-	result, err := client.executeCommand(C.ZRandMember, []string{key})
+func (client *baseClient) ZRandMember(ctx context.Context, key string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.ZRandMember, []string{key})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -5177,8 +5963,8 @@ func (client *baseClient) ZRandMember(key string) (Result[string], error) {
 //	If the sorted set does not exist or is empty, the response will be an empty array.
 //
 // [valkey.io]: https://valkey.io/commands/zrandmember/
-func (client *baseClient) ZRandMemberWithCount(key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(C.ZRandMember, []string{key, utils.IntToString(count)})
+func (client *baseClient) ZRandMemberWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.ZRandMember, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
 	}
@@ -5201,8 +5987,16 @@ func (client *baseClient) ZRandMemberWithCount(key string, count int64) ([]strin
 //	If the sorted set does not exist or is empty, the response will be an empty array.
 //
 // [valkey.io]: https://valkey.io/commands/zrandmember/
-func (client *baseClient) ZRandMemberWithCountWithScores(key string, count int64) ([]MemberAndScore, error) {
-	result, err := client.executeCommand(C.ZRandMember, []string{key, utils.IntToString(count), options.WithScoresKeyword})
+func (client *baseClient) ZRandMemberWithCountWithScores(
+	ctx context.Context,
+	key string,
+	count int64,
+) ([]MemberAndScore, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.ZRandMember,
+		[]string{key, utils.IntToString(count), options.WithScoresKeyword},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -5226,8 +6020,8 @@ func (client *baseClient) ZRandMemberWithCountWithScores(key string, count int64
 //	If a member does not exist in the sorted set, the corresponding value in the list will be `nil`.
 //
 // [valkey.io]: https://valkey.io/commands/zmscore/
-func (client *baseClient) ZMScore(key string, members []string) ([]Result[float64], error) {
-	response, err := client.executeCommand(C.ZMScore, append([]string{key}, members...))
+func (client *baseClient) ZMScore(ctx context.Context, key string, members []string) ([]Result[float64], error) {
+	response, err := client.executeCommand(ctx, C.ZMScore, append([]string{key}, members...))
 	if err != nil {
 		return nil, err
 	}
@@ -5246,8 +6040,8 @@ func (client *baseClient) ZMScore(key string, members []string) ([]Result[float6
 //	object stored at key as a long. Otherwise, returns `nil`.
 //
 // [valkey.io]: https://valkey.io/commands/object-freq/
-func (client *baseClient) ObjectFreq(key string) (Result[int64], error) {
-	result, err := client.executeCommand(C.ObjectFreq, []string{key})
+func (client *baseClient) ObjectFreq(ctx context.Context, key string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ObjectFreq, []string{key})
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -5265,8 +6059,8 @@ func (client *baseClient) ObjectFreq(key string) (Result[int64], error) {
 //	If key exists, returns the idle time in seconds. Otherwise, returns `nil`.
 //
 // [valkey.io]: https://valkey.io/commands/object-idletime/
-func (client *baseClient) ObjectIdleTime(key string) (Result[int64], error) {
-	result, err := client.executeCommand(C.ObjectIdleTime, []string{key})
+func (client *baseClient) ObjectIdleTime(ctx context.Context, key string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ObjectIdleTime, []string{key})
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -5285,8 +6079,8 @@ func (client *baseClient) ObjectIdleTime(key string) (Result[int64], error) {
 //	Otherwise, returns `nil`.
 //
 // [valkey.io]: https://valkey.io/commands/object-refcount/
-func (client *baseClient) ObjectRefCount(key string) (Result[int64], error) {
-	result, err := client.executeCommand(C.ObjectRefCount, []string{key})
+func (client *baseClient) ObjectRefCount(ctx context.Context, key string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ObjectRefCount, []string{key})
 	if err != nil {
 		return CreateNilInt64Result(), err
 	}
@@ -5307,8 +6101,8 @@ func (client *baseClient) ObjectRefCount(key string) (Result[int64], error) {
 //	An Array of sorted elements.
 //
 // [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) Sort(key string) ([]Result[string], error) {
-	result, err := client.executeCommand(C.Sort, []string{key})
+func (client *baseClient) Sort(ctx context.Context, key string) ([]Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Sort, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -5341,12 +6135,16 @@ func (client *baseClient) Sort(key string) ([]Result[string], error) {
 //	An Array of sorted elements.
 //
 // [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) SortWithOptions(key string, options options.SortOptions) ([]Result[string], error) {
+func (client *baseClient) SortWithOptions(
+	ctx context.Context,
+	key string,
+	options options.SortOptions,
+) ([]Result[string], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	result, err := client.executeCommand(C.Sort, append([]string{key}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.Sort, append([]string{key}, optionArgs...))
 	if err != nil {
 		return nil, err
 	}
@@ -5367,8 +6165,8 @@ func (client *baseClient) SortWithOptions(key string, options options.SortOption
 //	An Array of sorted elements.
 //
 // [valkey.io]: https://valkey.io/commands/sort_ro/
-func (client *baseClient) SortReadOnly(key string) ([]Result[string], error) {
-	result, err := client.executeCommand(C.SortReadOnly, []string{key})
+func (client *baseClient) SortReadOnly(ctx context.Context, key string) ([]Result[string], error) {
+	result, err := client.executeCommand(ctx, C.SortReadOnly, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -5401,12 +6199,16 @@ func (client *baseClient) SortReadOnly(key string) ([]Result[string], error) {
 //	An Array of sorted elements.
 //
 // [valkey.io]: https://valkey.io/commands/sort_ro/
-func (client *baseClient) SortReadOnlyWithOptions(key string, options options.SortOptions) ([]Result[string], error) {
+func (client *baseClient) SortReadOnlyWithOptions(
+	ctx context.Context,
+	key string,
+	options options.SortOptions,
+) ([]Result[string], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	result, err := client.executeCommand(C.SortReadOnly, append([]string{key}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.SortReadOnly, append([]string{key}, optionArgs...))
 	if err != nil {
 		return nil, err
 	}
@@ -5439,8 +6241,8 @@ func (client *baseClient) SortReadOnlyWithOptions(key string, options options.So
 //	The number of elements in the sorted key stored at destination.
 //
 // [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) SortStore(key string, destination string) (int64, error) {
-	result, err := client.executeCommand(C.Sort, []string{key, options.StoreKeyword, destination})
+func (client *baseClient) SortStore(ctx context.Context, key string, destination string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Sort, []string{key, options.StoreKeyword, destination})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5478,6 +6280,7 @@ func (client *baseClient) SortStore(key string, destination string) (int64, erro
 //
 // [valkey.io]: https://valkey.io/commands/sort/
 func (client *baseClient) SortStoreWithOptions(
+	ctx context.Context,
 	key string,
 	destination string,
 	opts options.SortOptions,
@@ -5486,7 +6289,7 @@ func (client *baseClient) SortStoreWithOptions(
 	if err != nil {
 		return defaultIntResponse, err
 	}
-	result, err := client.executeCommand(C.Sort, append([]string{key, options.StoreKeyword, destination}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.Sort, append([]string{key, options.StoreKeyword, destination}, optionArgs...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5510,11 +6313,12 @@ func (client *baseClient) SortStoreWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-createconsumer/
 func (client *baseClient) XGroupCreateConsumer(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
 ) (bool, error) {
-	result, err := client.executeCommand(C.XGroupCreateConsumer, []string{key, group, consumer})
+	result, err := client.executeCommand(ctx, C.XGroupCreateConsumer, []string{key, group, consumer})
 	if err != nil {
 		return false, err
 	}
@@ -5537,11 +6341,12 @@ func (client *baseClient) XGroupCreateConsumer(
 //
 // [valkey.io]: https://valkey.io/commands/xgroup-delconsumer/
 func (client *baseClient) XGroupDelConsumer(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
 ) (int64, error) {
-	result, err := client.executeCommand(C.XGroupDelConsumer, []string{key, group, consumer})
+	result, err := client.executeCommand(ctx, C.XGroupDelConsumer, []string{key, group, consumer})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5565,8 +6370,8 @@ func (client *baseClient) XGroupDelConsumer(
 //	The number of messages that were successfully acknowledged.
 //
 // [valkey.io]: https://valkey.io/commands/xack/
-func (client *baseClient) XAck(key string, group string, ids []string) (int64, error) {
-	result, err := client.executeCommand(C.XAck, append([]string{key, group}, ids...))
+func (client *baseClient) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XAck, append([]string{key, group}, ids...))
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5591,8 +6396,8 @@ func (client *baseClient) XAck(key string, group string, ids []string) (int64, e
 //	The bit value that was previously stored at offset.
 //
 // [valkey.io]: https://valkey.io/commands/setbit/
-func (client *baseClient) SetBit(key string, offset int64, value int64) (int64, error) {
-	result, err := client.executeCommand(C.SetBit, []string{key, utils.IntToString(offset), utils.IntToString(value)})
+func (client *baseClient) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SetBit, []string{key, utils.IntToString(offset), utils.IntToString(value)})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5614,8 +6419,8 @@ func (client *baseClient) SetBit(key string, offset int64, value int64) (int64,
 //	offset exceeds the length of the string.
 //
 // [valkey.io]: https://valkey.io/commands/getbit/
-func (client *baseClient) GetBit(key string, offset int64) (int64, error) {
-	result, err := client.executeCommand(C.GetBit, []string{key, utils.IntToString(offset)})
+func (client *baseClient) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.GetBit, []string{key, utils.IntToString(offset)})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5637,8 +6442,12 @@ func (client *baseClient) GetBit(key string, offset int64) (int64, error) {
 //	The number of replicas reached by all the writes performed in the context of the current connection.
 //
 // [valkey.io]: https://valkey.io/commands/wait/
-func (client *baseClient) Wait(numberOfReplicas int64, timeout int64) (int64, error) {
-	result, err := client.executeCommand(C.Wait, []string{utils.IntToString(numberOfReplicas), utils.IntToString(timeout)})
+func (client *baseClient) Wait(ctx context.Context, numberOfReplicas int64, timeout int64) (int64, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.Wait,
+		[]string{utils.IntToString(numberOfReplicas), utils.IntToString(timeout)},
+	)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5657,8 +6466,8 @@ func (client *baseClient) Wait(numberOfReplicas int64, timeout int64) (int64, er
 //	treated as an empty string.
 //
 // [valkey.io]: https://valkey.io/commands/bitcount/
-func (client *baseClient) BitCount(key string) (int64, error) {
-	result, err := client.executeCommand(C.BitCount, []string{key})
+func (client *baseClient) BitCount(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.BitCount, []string{key})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5682,7 +6491,12 @@ func (client *baseClient) BitCount(key string) (int64, error) {
 //	The size of the string stored in destination.
 //
 // [valkey.io]: https://valkey.io/commands/bitop/
-func (client *baseClient) BitOp(bitwiseOperation options.BitOpType, destination string, keys []string) (int64, error) {
+func (client *baseClient) BitOp(
+	ctx context.Context,
+	bitwiseOperation options.BitOpType,
+	destination string,
+	keys []string,
+) (int64, error) {
 	bitOp, err := options.NewBitOp(bitwiseOperation, destination, keys)
 	if err != nil {
 		return defaultIntResponse, err
@@ -5691,7 +6505,7 @@ func (client *baseClient) BitOp(bitwiseOperation options.BitOpType, destination
 	if err != nil {
 		return defaultIntResponse, err
 	}
-	result, err := client.executeCommand(C.BitOp, args)
+	result, err := client.executeCommand(ctx, C.BitOp, args)
 	if err != nil {
 		return defaultIntResponse, &errors.RequestError{Msg: "Bitop command execution failed"}
 	}
@@ -5715,13 +6529,13 @@ func (client *baseClient) BitOp(bitwiseOperation options.BitOpType, destination
 //	Returns zero if the key is missing as it is treated as an empty string.
 //
 // [valkey.io]: https://valkey.io/commands/bitcount/
-func (client *baseClient) BitCountWithOptions(key string, opts options.BitCountOptions) (int64, error) {
+func (client *baseClient) BitCountWithOptions(ctx context.Context, key string, opts options.BitCountOptions) (int64, error) {
 	optionArgs, err := opts.ToArgs()
 	if err != nil {
 		return defaultIntResponse, err
 	}
 	commandArgs := append([]string{key}, optionArgs...)
-	result, err := client.executeCommand(C.BitCount, commandArgs)
+	result, err := client.executeCommand(ctx, C.BitCount, commandArgs)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5747,13 +6561,14 @@ func (client *baseClient) BitCountWithOptions(key string, opts options.BitCountO
 //
 // [valkey.io]: https://valkey.io/commands/xclaim/
 func (client *baseClient) XClaim(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
 	minIdleTime int64,
 	ids []string,
 ) (map[string][][]string, error) {
-	return client.XClaimWithOptions(key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+	return client.XClaimWithOptions(ctx, key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
 }
 
 // Changes the ownership of a pending message.
@@ -5776,6 +6591,7 @@ func (client *baseClient) XClaim(
 //
 // [valkey.io]: https://valkey.io/commands/xclaim/
 func (client *baseClient) XClaimWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
@@ -5789,7 +6605,7 @@ func (client *baseClient) XClaimWithOptions(
 		return nil, err
 	}
 	args = append(args, optionArgs...)
-	result, err := client.executeCommand(C.XClaim, args)
+	result, err := client.executeCommand(ctx, C.XClaim, args)
 	if err != nil {
 		return nil, err
 	}
@@ -5816,13 +6632,14 @@ func (client *baseClient) XClaimWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/xclaim/
 func (client *baseClient) XClaimJustId(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
 	minIdleTime int64,
 	ids []string,
 ) ([]string, error) {
-	return client.XClaimJustIdWithOptions(key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+	return client.XClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
 }
 
 // Changes the ownership of a pending message. This function returns an `array` with
@@ -5845,6 +6662,7 @@ func (client *baseClient) XClaimJustId(
 //
 // [valkey.io]: https://valkey.io/commands/xclaim/
 func (client *baseClient) XClaimJustIdWithOptions(
+	ctx context.Context,
 	key string,
 	group string,
 	consumer string,
@@ -5859,7 +6677,7 @@ func (client *baseClient) XClaimJustIdWithOptions(
 	}
 	args = append(args, optionArgs...)
 	args = append(args, options.JustIdKeyword)
-	result, err := client.executeCommand(C.XClaim, args)
+	result, err := client.executeCommand(ctx, C.XClaim, args)
 	if err != nil {
 		return nil, err
 	}
@@ -5879,8 +6697,8 @@ func (client *baseClient) XClaimJustIdWithOptions(
 //	the string held at key. If bit is not found, a -1 is returned.
 //
 // [valkey.io]: https://valkey.io/commands/bitpos/
-func (client *baseClient) BitPos(key string, bit int64) (int64, error) {
-	result, err := client.executeCommand(C.BitPos, []string{key, utils.IntToString(bit)})
+func (client *baseClient) BitPos(ctx context.Context, key string, bit int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.BitPos, []string{key, utils.IntToString(bit)})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5901,13 +6719,18 @@ func (client *baseClient) BitPos(key string, bit int64) (int64, error) {
 //	the string held at key. If bit is not found, a -1 is returned.
 //
 // [valkey.io]: https://valkey.io/commands/bitpos/
-func (client *baseClient) BitPosWithOptions(key string, bit int64, bitposOptions options.BitPosOptions) (int64, error) {
+func (client *baseClient) BitPosWithOptions(
+	ctx context.Context,
+	key string,
+	bit int64,
+	bitposOptions options.BitPosOptions,
+) (int64, error) {
 	optionArgs, err := bitposOptions.ToArgs()
 	if err != nil {
 		return defaultIntResponse, err
 	}
 	commandArgs := append([]string{key, utils.IntToString(bit)}, optionArgs...)
-	result, err := client.executeCommand(C.BitPos, commandArgs)
+	result, err := client.executeCommand(ctx, C.BitPos, commandArgs)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -5931,8 +6754,8 @@ func (client *baseClient) BitPosWithOptions(key string, bit int64, bitposOptions
 //	`true` if source was copied, `false` if source was not copied.
 //
 // [valkey.io]: https://valkey.io/commands/copy/
-func (client *baseClient) Copy(source string, destination string) (bool, error) {
-	result, err := client.executeCommand(C.Copy, []string{source, destination})
+func (client *baseClient) Copy(ctx context.Context, source string, destination string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Copy, []string{source, destination})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -5959,6 +6782,7 @@ func (client *baseClient) Copy(source string, destination string) (bool, error)
 //
 // [valkey.io]: https://valkey.io/commands/copy/
 func (client *baseClient) CopyWithOptions(
+	ctx context.Context,
 	source string,
 	destination string,
 	options options.CopyOptions,
@@ -5967,7 +6791,7 @@ func (client *baseClient) CopyWithOptions(
 	if err != nil {
 		return defaultBoolResponse, err
 	}
-	result, err := client.executeCommand(C.Copy, append([]string{
+	result, err := client.executeCommand(ctx, C.Copy, append([]string{
 		source, destination,
 	}, optionArgs...))
 	if err != nil {
@@ -6000,11 +6824,12 @@ func (client *baseClient) CopyWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/xrange/
 func (client *baseClient) XRange(
+	ctx context.Context,
 	key string,
 	start options.StreamBoundary,
 	end options.StreamBoundary,
 ) ([]XRangeResponse, error) {
-	return client.XRangeWithOptions(key, start, end, *options.NewXRangeOptions())
+	return client.XRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
 }
 
 // Returns stream entries matching a given range of IDs.
@@ -6029,6 +6854,7 @@ func (client *baseClient) XRange(
 //
 // [valkey.io]: https://valkey.io/commands/xrange/
 func (client *baseClient) XRangeWithOptions(
+	ctx context.Context,
 	key string,
 	start options.StreamBoundary,
 	end options.StreamBoundary,
@@ -6040,7 +6866,7 @@ func (client *baseClient) XRangeWithOptions(
 		return nil, err
 	}
 	args = append(args, optionArgs...)
-	result, err := client.executeCommand(C.XRange, args)
+	result, err := client.executeCommand(ctx, C.XRange, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6069,11 +6895,12 @@ func (client *baseClient) XRangeWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/xrevrange/
 func (client *baseClient) XRevRange(
+	ctx context.Context,
 	key string,
 	start options.StreamBoundary,
 	end options.StreamBoundary,
 ) ([]XRangeResponse, error) {
-	return client.XRevRangeWithOptions(key, start, end, *options.NewXRangeOptions())
+	return client.XRevRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
 }
 
 // Returns stream entries matching a given range of IDs in reverse order.
@@ -6100,6 +6927,7 @@ func (client *baseClient) XRevRange(
 //
 // [valkey.io]: https://valkey.io/commands/xrevrange/
 func (client *baseClient) XRevRangeWithOptions(
+	ctx context.Context,
 	key string,
 	start options.StreamBoundary,
 	end options.StreamBoundary,
@@ -6111,7 +6939,7 @@ func (client *baseClient) XRevRangeWithOptions(
 		return nil, err
 	}
 	args = append(args, optionArgs...)
-	result, err := client.executeCommand(C.XRevRange, args)
+	result, err := client.executeCommand(ctx, C.XRevRange, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6131,8 +6959,8 @@ func (client *baseClient) XRevRangeWithOptions(
 //	A stream information for the given `key`. See the example for a sample response.
 //
 // [valkey.io]: https://valkey.io/commands/xinfo-stream/
-func (client *baseClient) XInfoStream(key string) (map[string]interface{}, error) {
-	result, err := client.executeCommand(C.XInfoStream, []string{key})
+func (client *baseClient) XInfoStream(ctx context.Context, key string) (map[string]interface{}, error) {
+	result, err := client.executeCommand(ctx, C.XInfoStream, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -6154,6 +6982,7 @@ func (client *baseClient) XInfoStream(key string) (map[string]interface{}, error
 //
 // [valkey.io]: https://valkey.io/commands/xinfo-stream/
 func (client *baseClient) XInfoStreamFullWithOptions(
+	ctx context.Context,
 	key string,
 	opts *options.XInfoStreamOptions,
 ) (map[string]interface{}, error) {
@@ -6165,7 +6994,7 @@ func (client *baseClient) XInfoStreamFullWithOptions(
 		}
 		args = append(args, optionArgs...)
 	}
-	result, err := client.executeCommand(C.XInfoStream, args)
+	result, err := client.executeCommand(ctx, C.XInfoStream, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6188,8 +7017,8 @@ func (client *baseClient) XInfoStreamFullWithOptions(
 //	of a consumer for the given consumer group of the stream at `key`.
 //
 // [valkey.io]: https://valkey.io/commands/xinfo-consumers/
-func (client *baseClient) XInfoConsumers(key string, group string) ([]XInfoConsumerInfo, error) {
-	response, err := client.executeCommand(C.XInfoConsumers, []string{key, group})
+func (client *baseClient) XInfoConsumers(ctx context.Context, key string, group string) ([]XInfoConsumerInfo, error) {
+	response, err := client.executeCommand(ctx, C.XInfoConsumers, []string{key, group})
 	if err != nil {
 		return nil, err
 	}
@@ -6210,8 +7039,8 @@ func (client *baseClient) XInfoConsumers(key string, group string) ([]XInfoConsu
 //	attributes of a consumer group for the stream at `key`.
 //
 // [valkey.io]: https://valkey.io/commands/xinfo-groups/
-func (client *baseClient) XInfoGroups(key string) ([]XInfoGroupInfo, error) {
-	response, err := client.executeCommand(C.XInfoGroups, []string{key})
+func (client *baseClient) XInfoGroups(ctx context.Context, key string) ([]XInfoGroupInfo, error) {
+	response, err := client.executeCommand(ctx, C.XInfoGroups, []string{key})
 	if err != nil {
 		return nil, err
 	}
@@ -6247,7 +7076,11 @@ func (client *baseClient) XInfoGroups(key string) ([]XInfoGroupInfo, error) {
 //	    a result based on the specified overflow type (WRAP, SAT, FAIL).
 //
 // [valkey.io]: https://valkey.io/commands/bitfield/
-func (client *baseClient) BitField(key string, subCommands []options.BitFieldSubCommands) ([]Result[int64], error) {
+func (client *baseClient) BitField(
+	ctx context.Context,
+	key string,
+	subCommands []options.BitFieldSubCommands,
+) ([]Result[int64], error) {
 	args := make([]string, 0, 10)
 	args = append(args, key)
 
@@ -6259,7 +7092,7 @@ func (client *baseClient) BitField(key string, subCommands []options.BitFieldSub
 		args = append(args, cmdArgs...)
 	}
 
-	result, err := client.executeCommand(C.BitField, args)
+	result, err := client.executeCommand(ctx, C.BitField, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6285,7 +7118,11 @@ func (client *baseClient) BitField(key string, subCommands []options.BitFieldSub
 //	  - BitFieldGet returns the value in the binary representation of the string.
 //
 // [valkey.io]: https://valkey.io/commands/bitfield_ro/
-func (client *baseClient) BitFieldRO(key string, commands []options.BitFieldROCommands) ([]Result[int64], error) {
+func (client *baseClient) BitFieldRO(
+	ctx context.Context,
+	key string,
+	commands []options.BitFieldROCommands,
+) ([]Result[int64], error) {
 	args := make([]string, 0, 10)
 	args = append(args, key)
 
@@ -6297,7 +7134,7 @@ func (client *baseClient) BitFieldRO(key string, commands []options.BitFieldROCo
 		args = append(args, cmdArgs...)
 	}
 
-	result, err := client.executeCommand(C.BitFieldReadOnly, args)
+	result, err := client.executeCommand(ctx, C.BitFieldReadOnly, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6313,8 +7150,8 @@ func (client *baseClient) BitFieldRO(key string, commands []options.BitFieldROCo
 //	The returned array is in a [UNIX TIME, Microseconds already elapsed] format.
 //
 // [valkey.io]: https://valkey.io/commands/time/
-func (client *baseClient) Time() ([]string, error) {
-	result, err := client.executeCommand(C.Time, []string{})
+func (client *baseClient) Time(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.Time, []string{})
 	if err != nil {
 		return nil, err
 	}
@@ -6339,12 +7176,12 @@ func (client *baseClient) Time() ([]string, error) {
 //	The resulting sorted set from the intersection.
 //
 // [valkey.io]: https://valkey.io/commands/zinter/
-func (client *baseClient) ZInter(keys options.KeyArray) ([]string, error) {
+func (client *baseClient) ZInter(ctx context.Context, keys options.KeyArray) ([]string, error) {
 	args, err := keys.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	result, err := client.executeCommand(C.ZInter, args)
+	result, err := client.executeCommand(ctx, C.ZInter, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6375,6 +7212,7 @@ func (client *baseClient) ZInter(keys options.KeyArray) ([]string, error) {
 //
 // [valkey.io]: https://valkey.io/commands/zinter/
 func (client *baseClient) ZInterWithScores(
+	ctx context.Context,
 	keysOrWeightedKeys options.KeysOrWeightedKeys,
 	zInterOptions options.ZInterOptions,
 ) (map[string]float64, error) {
@@ -6388,7 +7226,7 @@ func (client *baseClient) ZInterWithScores(
 	}
 	args = append(args, optionsArgs...)
 	args = append(args, options.WithScoresKeyword)
-	result, err := client.executeCommand(C.ZInter, args)
+	result, err := client.executeCommand(ctx, C.ZInter, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6417,8 +7255,12 @@ func (client *baseClient) ZInterWithScores(
 //	The number of elements in the resulting sorted set stored at `destination`.
 //
 // [valkey.io]: https://valkey.io/commands/zinterstore/
-func (client *baseClient) ZInterStore(destination string, keysOrWeightedKeys options.KeysOrWeightedKeys) (int64, error) {
-	return client.ZInterStoreWithOptions(destination, keysOrWeightedKeys, *options.NewZInterOptions())
+func (client *baseClient) ZInterStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return client.ZInterStoreWithOptions(ctx, destination, keysOrWeightedKeys, *options.NewZInterOptions())
 }
 
 // Computes the intersection of sorted sets given by the specified `keysOrWeightedKeys`
@@ -6447,6 +7289,7 @@ func (client *baseClient) ZInterStore(destination string, keysOrWeightedKeys opt
 //
 // [valkey.io]: https://valkey.io/commands/zinterstore/
 func (client *baseClient) ZInterStoreWithOptions(
+	ctx context.Context,
 	destination string,
 	keysOrWeightedKeys options.KeysOrWeightedKeys,
 	zInterOptions options.ZInterOptions,
@@ -6461,7 +7304,7 @@ func (client *baseClient) ZInterStoreWithOptions(
 		return defaultIntResponse, err
 	}
 	args = append(args, optionsArgs...)
-	result, err := client.executeCommand(C.ZInterStore, args)
+	result, err := client.executeCommand(ctx, C.ZInterStore, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -6490,9 +7333,9 @@ func (client *baseClient) ZInterStoreWithOptions(
 //	command returns an empty array.
 //
 // [valkey.io]: https://valkey.io/commands/zdiff/
-func (client *baseClient) ZDiff(keys []string) ([]string, error) {
+func (client *baseClient) ZDiff(ctx context.Context, keys []string) ([]string, error) {
 	args := append([]string{}, strconv.Itoa(len(keys)))
-	result, err := client.executeCommand(C.ZDiff, append(args, keys...))
+	result, err := client.executeCommand(ctx, C.ZDiff, append(args, keys...))
 	if err != nil {
 		return nil, err
 	}
@@ -6516,10 +7359,10 @@ func (client *baseClient) ZDiff(keys []string) ([]string, error) {
 //	command returns an empty `Map`.
 //
 // [valkey.io]: https://valkey.io/commands/zdiff/
-func (client *baseClient) ZDiffWithScores(keys []string) (map[string]float64, error) {
+func (client *baseClient) ZDiffWithScores(ctx context.Context, keys []string) (map[string]float64, error) {
 	args := append([]string{}, strconv.Itoa(len(keys)))
 	args = append(args, keys...)
-	result, err := client.executeCommand(C.ZDiff, append(args, options.WithScoresKeyword))
+	result, err := client.executeCommand(ctx, C.ZDiff, append(args, options.WithScoresKeyword))
 	if err != nil {
 		return nil, err
 	}
@@ -6548,8 +7391,9 @@ func (client *baseClient) ZDiffWithScores(keys []string) (map[string]float64, er
 //	The number of members in the resulting sorted set stored at `destination`.
 //
 // [valkey.io]: https://valkey.io/commands/zdiffstore/
-func (client *baseClient) ZDiffStore(destination string, keys []string) (int64, error) {
+func (client *baseClient) ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.ZDiffStore,
 		append([]string{destination, strconv.Itoa(len(keys))}, keys...),
 	)
@@ -6579,12 +7423,12 @@ func (client *baseClient) ZDiffStore(destination string, keys []string) (int64,
 //	The resulting sorted set from the union.
 //
 // [valkey.io]: https://valkey.io/commands/zunion/
-func (client *baseClient) ZUnion(keys options.KeyArray) ([]string, error) {
+func (client *baseClient) ZUnion(ctx context.Context, keys options.KeyArray) ([]string, error) {
 	args, err := keys.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	result, err := client.executeCommand(C.ZUnion, args)
+	result, err := client.executeCommand(ctx, C.ZUnion, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6615,6 +7459,7 @@ func (client *baseClient) ZUnion(keys options.KeyArray) ([]string, error) {
 //
 // [valkey.io]: https://valkey.io/commands/zunion/
 func (client *baseClient) ZUnionWithScores(
+	ctx context.Context,
 	keysOrWeightedKeys options.KeysOrWeightedKeys,
 	zUnionOptions *options.ZUnionOptions,
 ) (map[string]float64, error) {
@@ -6628,7 +7473,7 @@ func (client *baseClient) ZUnionWithScores(
 	}
 	args = append(args, optionsArgs...)
 	args = append(args, options.WithScoresKeyword)
-	result, err := client.executeCommand(C.ZUnion, args)
+	result, err := client.executeCommand(ctx, C.ZUnion, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6659,8 +7504,12 @@ func (client *baseClient) ZUnionWithScores(
 //	The number of elements in the resulting sorted set stored at `destination`.
 //
 // [valkey.io]: https://valkey.io/commands/zunionstore/
-func (client *baseClient) ZUnionStore(destination string, keysOrWeightedKeys options.KeysOrWeightedKeys) (int64, error) {
-	return client.ZUnionStoreWithOptions(destination, keysOrWeightedKeys, nil)
+func (client *baseClient) ZUnionStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return client.ZUnionStoreWithOptions(ctx, destination, keysOrWeightedKeys, nil)
 }
 
 // Computes the union of sorted sets given by the specified `KeysOrWeightedKeys`, and
@@ -6691,6 +7540,7 @@ func (client *baseClient) ZUnionStore(destination string, keysOrWeightedKeys opt
 //
 // [valkey.io]: https://valkey.io/commands/zunionstore/
 func (client *baseClient) ZUnionStoreWithOptions(
+	ctx context.Context,
 	destination string,
 	keysOrWeightedKeys options.KeysOrWeightedKeys,
 	zUnionOptions *options.ZUnionOptions,
@@ -6707,7 +7557,7 @@ func (client *baseClient) ZUnionStoreWithOptions(
 		}
 		args = append(args, optionsArgs...)
 	}
-	result, err := client.executeCommand(C.ZUnionStore, args)
+	result, err := client.executeCommand(ctx, C.ZUnionStore, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -6727,8 +7577,8 @@ func (client *baseClient) ZUnionStoreWithOptions(
 //	The cardinality of the intersection of the sorted sets.
 //
 // [valkey.io]: https://valkey.io/commands/zintercard/
-func (client *baseClient) ZInterCard(keys []string) (int64, error) {
-	return client.ZInterCardWithOptions(keys, nil)
+func (client *baseClient) ZInterCard(ctx context.Context, keys []string) (int64, error) {
+	return client.ZInterCardWithOptions(ctx, keys, nil)
 }
 
 // Returns the cardinality of the intersection of the sorted sets specified by `keys`.
@@ -6747,7 +7597,11 @@ func (client *baseClient) ZInterCard(keys []string) (int64, error) {
 //	The cardinality of the intersection of the sorted sets.
 //
 // [valkey.io]: https://valkey.io/commands/zintercard/
-func (client *baseClient) ZInterCardWithOptions(keys []string, options *options.ZInterCardOptions) (int64, error) {
+func (client *baseClient) ZInterCardWithOptions(
+	ctx context.Context,
+	keys []string,
+	options *options.ZInterCardOptions,
+) (int64, error) {
 	args := append([]string{strconv.Itoa(len(keys))}, keys...)
 	if options != nil {
 		optionsArgs, err := options.ToArgs()
@@ -6756,7 +7610,7 @@ func (client *baseClient) ZInterCardWithOptions(keys []string, options *options.
 		}
 		args = append(args, optionsArgs...)
 	}
-	result, err := client.executeCommand(C.ZInterCard, args)
+	result, err := client.executeCommand(ctx, C.ZInterCard, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -6779,10 +7633,10 @@ func (client *baseClient) ZInterCardWithOptions(keys []string, options *options.
 //	The number of elements in the sorted set at key with a value between min and max.
 //
 // [valkey.io]: https://valkey.io/commands/zlexcount/
-func (client *baseClient) ZLexCount(key string, rangeQuery *options.RangeByLex) (int64, error) {
+func (client *baseClient) ZLexCount(ctx context.Context, key string, rangeQuery *options.RangeByLex) (int64, error) {
 	args := []string{key}
 	args = append(args, rangeQuery.ToArgsLexCount()...)
-	result, err := client.executeCommand(C.ZLexCount, args)
+	result, err := client.executeCommand(ctx, C.ZLexCount, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -6805,8 +7659,13 @@ func (client *baseClient) ZLexCount(key string, rangeQuery *options.RangeByLex)
 //	The number of elements added to the sorted set.
 //
 // [valkey.io]: https://valkey.io/commands/geoadd/
-func (client *baseClient) GeoAdd(key string, membersToGeospatialData map[string]options.GeospatialData) (int64, error) {
+func (client *baseClient) GeoAdd(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+) (int64, error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.GeoAdd,
 		append([]string{key}, options.MapGeoDataToArray(membersToGeospatialData)...),
 	)
@@ -6834,6 +7693,7 @@ func (client *baseClient) GeoAdd(key string, membersToGeospatialData map[string]
 //
 // [valkey.io]: https://valkey.io/commands/geoadd/
 func (client *baseClient) GeoAddWithOptions(
+	ctx context.Context,
 	key string,
 	membersToGeospatialData map[string]options.GeospatialData,
 	geoAddOptions options.GeoAddOptions,
@@ -6845,7 +7705,7 @@ func (client *baseClient) GeoAddWithOptions(
 	}
 	args = append(args, optionsArgs...)
 	args = append(args, options.MapGeoDataToArray(membersToGeospatialData)...)
-	result, err := client.executeCommand(C.GeoAdd, args)
+	result, err := client.executeCommand(ctx, C.GeoAdd, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -6869,8 +7729,9 @@ func (client *baseClient) GeoAddWithOptions(
 //	for that member.
 //
 // [valkey.io]: https://valkey.io/commands/geohash/
-func (client *baseClient) GeoHash(key string, members []string) ([]string, error) {
+func (client *baseClient) GeoHash(ctx context.Context, key string, members []string) ([]string, error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.GeoHash,
 		append([]string{key}, members...),
 	)
@@ -6896,10 +7757,10 @@ func (client *baseClient) GeoHash(key string, members []string) ([]string, error
 //	If a member does not exist, its position will be `nil`.
 //
 // [valkey.io]: https://valkey.io/commands/geopos/
-func (client *baseClient) GeoPos(key string, members []string) ([][]float64, error) {
+func (client *baseClient) GeoPos(ctx context.Context, key string, members []string) ([][]float64, error) {
 	args := []string{key}
 	args = append(args, members...)
-	result, err := client.executeCommand(C.GeoPos, args)
+	result, err := client.executeCommand(ctx, C.GeoPos, args)
 	if err != nil {
 		return nil, err
 	}
@@ -6924,8 +7785,9 @@ func (client *baseClient) GeoPos(key string, members []string) ([][]float64, err
 //	unit is meters, see - [options.Meters]
 //
 // [valkey.io]: https://valkey.io/commands/geodist/
-func (client *baseClient) GeoDist(key string, member1 string, member2 string) (Result[float64], error) {
+func (client *baseClient) GeoDist(ctx context.Context, key string, member1 string, member2 string) (Result[float64], error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.GeoDist,
 		[]string{key, member1, member2},
 	)
@@ -6954,12 +7816,14 @@ func (client *baseClient) GeoDist(key string, member1 string, member2 string) (R
 //
 // [valkey.io]: https://valkey.io/commands/geodist/
 func (client *baseClient) GeoDistWithUnit(
+	ctx context.Context,
 	key string,
 	member1 string,
 	member2 string,
 	unit options.GeoUnit,
 ) (Result[float64], error) {
 	result, err := client.executeCommand(
+		ctx,
 		C.GeoDist,
 		[]string{key, member1, member2, string(unit)},
 	)
@@ -7002,6 +7866,7 @@ func (client *baseClient) GeoDistWithUnit(
 //
 // [valkey.io]: https://valkey.io/commands/geosearch/
 func (client *baseClient) GeoSearchWithFullOptions(
+	ctx context.Context,
 	key string,
 	searchFrom options.GeoSearchOrigin,
 	searchByShape options.GeoSearchShape,
@@ -7029,7 +7894,7 @@ func (client *baseClient) GeoSearchWithFullOptions(
 		return nil, err
 	}
 	args = append(args, resultOptionsArgs...)
-	result, err := client.executeCommand(C.GeoSearch, args)
+	result, err := client.executeCommand(ctx, C.GeoSearch, args)
 	if err != nil {
 		return nil, err
 	}
@@ -7063,6 +7928,7 @@ func (client *baseClient) GeoSearchWithFullOptions(
 //
 // [valkey.io]: https://valkey.io/commands/geosearch/
 func (client *baseClient) GeoSearchWithResultOptions(
+	ctx context.Context,
 	key string,
 	searchFrom options.GeoSearchOrigin,
 	searchByShape options.GeoSearchShape,
@@ -7085,7 +7951,7 @@ func (client *baseClient) GeoSearchWithResultOptions(
 	}
 	args = append(args, resultOptionsArgs...)
 
-	result, err := client.executeCommand(C.GeoSearch, args)
+	result, err := client.executeCommand(ctx, C.GeoSearch, args)
 	if err != nil {
 		return nil, err
 	}
@@ -7124,12 +7990,14 @@ func (client *baseClient) GeoSearchWithResultOptions(
 //
 // [valkey.io]: https://valkey.io/commands/geosearch/
 func (client *baseClient) GeoSearchWithInfoOptions(
+	ctx context.Context,
 	key string,
 	searchFrom options.GeoSearchOrigin,
 	searchByShape options.GeoSearchShape,
 	infoOptions options.GeoSearchInfoOptions,
 ) ([]options.Location, error) {
 	return client.GeoSearchWithFullOptions(
+		ctx,
 		key,
 		searchFrom,
 		searchByShape,
@@ -7164,11 +8032,13 @@ func (client *baseClient) GeoSearchWithInfoOptions(
 //
 // [valkey.io]: https://valkey.io/commands/geosearch/
 func (client *baseClient) GeoSearch(
+	ctx context.Context,
 	key string,
 	searchFrom options.GeoSearchOrigin,
 	searchByShape options.GeoSearchShape,
 ) ([]string, error) {
 	return client.GeoSearchWithResultOptions(
+		ctx,
 		key,
 		searchFrom,
 		searchByShape,
@@ -7209,6 +8079,7 @@ func (client *baseClient) GeoSearch(
 //
 // [valkey.io]: https://valkey.io/commands/geosearchstore/
 func (client *baseClient) GeoSearchStoreWithFullOptions(
+	ctx context.Context,
 	destinationKey string,
 	sourceKey string,
 	searchFrom options.GeoSearchOrigin,
@@ -7238,7 +8109,7 @@ func (client *baseClient) GeoSearchStoreWithFullOptions(
 	}
 	args = append(args, infoOptionsArgs...)
 
-	result, err := client.executeCommand(C.GeoSearchStore, args)
+	result, err := client.executeCommand(ctx, C.GeoSearchStore, args)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -7276,12 +8147,14 @@ func (client *baseClient) GeoSearchStoreWithFullOptions(
 //
 // [valkey.io]: https://valkey.io/commands/geosearchstore/
 func (client *baseClient) GeoSearchStore(
+	ctx context.Context,
 	destinationKey string,
 	sourceKey string,
 	searchFrom options.GeoSearchOrigin,
 	searchByShape options.GeoSearchShape,
 ) (int64, error) {
 	return client.GeoSearchStoreWithFullOptions(
+		ctx,
 		destinationKey,
 		sourceKey,
 		searchFrom,
@@ -7323,6 +8196,7 @@ func (client *baseClient) GeoSearchStore(
 //
 // [valkey.io]: https://valkey.io/commands/geosearchstore/
 func (client *baseClient) GeoSearchStoreWithResultOptions(
+	ctx context.Context,
 	destinationKey string,
 	sourceKey string,
 	searchFrom options.GeoSearchOrigin,
@@ -7330,6 +8204,7 @@ func (client *baseClient) GeoSearchStoreWithResultOptions(
 	resultOptions options.GeoSearchResultOptions,
 ) (int64, error) {
 	return client.GeoSearchStoreWithFullOptions(
+		ctx,
 		destinationKey,
 		sourceKey,
 		searchFrom,
@@ -7371,6 +8246,7 @@ func (client *baseClient) GeoSearchStoreWithResultOptions(
 //
 // [valkey.io]: https://valkey.io/commands/geosearchstore/
 func (client *baseClient) GeoSearchStoreWithInfoOptions(
+	ctx context.Context,
 	destinationKey string,
 	sourceKey string,
 	searchFrom options.GeoSearchOrigin,
@@ -7378,6 +8254,7 @@ func (client *baseClient) GeoSearchStoreWithInfoOptions(
 	infoOptions options.GeoSearchStoreInfoOptions,
 ) (int64, error) {
 	return client.GeoSearchStoreWithFullOptions(
+		ctx,
 		destinationKey,
 		sourceKey,
 		searchFrom,
@@ -7406,13 +8283,13 @@ func (client *baseClient) GeoSearchStoreWithInfoOptions(
 //	The library name that was loaded.
 //
 // [valkey.io]: https://valkey.io/commands/function-load/
-func (client *baseClient) FunctionLoad(libraryCode string, replace bool) (string, error) {
+func (client *baseClient) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
 	args := []string{}
 	if replace {
 		args = append(args, options.ReplaceKeyword)
 	}
 	args = append(args, libraryCode)
-	result, err := client.executeCommand(C.FunctionLoad, args)
+	result, err := client.executeCommand(ctx, C.FunctionLoad, args)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -7432,8 +8309,8 @@ func (client *baseClient) FunctionLoad(libraryCode string, replace bool) (string
 //	`OK`
 //
 // [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *baseClient) FunctionFlush() (string, error) {
-	result, err := client.executeCommand(C.FunctionFlush, []string{})
+func (client *baseClient) FunctionFlush(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -7453,8 +8330,8 @@ func (client *baseClient) FunctionFlush() (string, error) {
 //	`OK`
 //
 // [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *baseClient) FunctionFlushSync() (string, error) {
-	result, err := client.executeCommand(C.FunctionFlush, []string{string(options.SYNC)})
+func (client *baseClient) FunctionFlushSync(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{string(options.SYNC)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -7474,130 +8351,326 @@ func (client *baseClient) FunctionFlushSync() (string, error) {
 //	`OK`
 //
 // [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *baseClient) FunctionFlushAsync() (string, error) {
-	result, err := client.executeCommand(C.FunctionFlush, []string{string(options.ASYNC)})
+func (client *baseClient) FunctionFlushAsync(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{string(options.ASYNC)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
 	return handleStringResponse(result)
 }
 
-// Invokes a previously loaded function.
-// The command will be routed to a primary random node.
-// To route to a replica please refer to [FCallReadOnly].
+// Returns information about the function libraries.
+// In cluster mode, the command is routed to a random node.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
+//	ctx - The context for controlling the command execution.
+//	query - Filters the listed libraries and sets whether their code is included. See [options.FunctionListQuery].
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	A slice of [LibraryInfo] describing the libraries and their functions.
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *baseClient) FCall(function string) (any, error) {
-	result, err := client.executeCommand(C.FCall, []string{function, utils.IntToString(0)})
+// [valkey.io]: https://valkey.io/commands/function-list/
+func (client *baseClient) FunctionList(ctx context.Context, query options.FunctionListQuery) ([]LibraryInfo, error) {
+	result, err := client.executeCommand(ctx, C.FunctionList, query.ToArgs())
 	if err != nil {
 		return nil, err
 	}
-	return handleAnyResponse(result)
+	return handleFunctionListResponse(result)
 }
 
-// Invokes a previously loaded read-only function.
-// This command is routed depending on the client's {@link ReadFrom} strategy.
+// Returns information about the function currently running and the available execution engines.
+// The command is routed to all nodes.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
+//	ctx - The context for controlling the command execution.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	A map of the node addresses to their [FunctionStatsResult].
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *baseClient) FCallReadOnly(function string) (any, error) {
-	result, err := client.executeCommand(C.FCallReadOnly, []string{function, utils.IntToString(0)})
+// [valkey.io]: https://valkey.io/commands/function-stats/
+func (client *baseClient) FunctionStats(ctx context.Context) (map[string]FunctionStatsResult, error) {
+	result, err := client.executeCommand(ctx, C.FunctionStats, []string{})
 	if err != nil {
 		return nil, err
 	}
-	return handleAnyResponse(result)
+	return handleFunctionStatsResponse(result)
 }
 
-// Invokes a previously loaded function.
-// This command is routed to primary nodes only.
-// To route to a replica please refer to [FCallReadOnly].
+// Deletes a library and all its functions.
+// In cluster mode, the command is routed to all primary nodes.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
-//	keys - An `array` of keys accessed by the function. To ensure the correct
-//	   execution of functions, both in standalone and clustered deployments, all names of keys
-//	   that a function accesses must be explicitly provided as `keys`.
-//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	ctx - The context for controlling the command execution.
+//	libName - The name of the library to delete.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *baseClient) FCallWithKeysAndArgs(
-	function string,
-	keys []string,
-	args []string,
-) (any, error) {
-	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
-	cmdArgs = append(cmdArgs, keys...)
-	cmdArgs = append(cmdArgs, args...)
-	result, err := client.executeCommand(C.FCall, cmdArgs)
+// [valkey.io]: https://valkey.io/commands/function-delete/
+func (client *baseClient) FunctionDelete(ctx context.Context, libName string) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionDelete, []string{libName})
 	if err != nil {
-		return nil, err
+		return DefaultStringResponse, err
 	}
-	return handleAnyResponse(result)
+	return handleStringResponse(result)
 }
 
-// Invokes a previously loaded read-only function.
-// This command is routed depending on the client's {@link ReadFrom} strategy.
-//
-// Note: When in cluster mode, all `keys` must map to the same hash slot.
+// Kills the function currently running, assuming no write operation was yet performed by the function.
+// The command is routed to all nodes, and succeeds if the function is killed on one of them.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
-//	keys - An `array` of keys accessed by the function. To ensure the correct
-//	   execution of functions, both in standalone and clustered deployments, all names of keys
-//	   that a function accesses must be explicitly provided as `keys`.
-//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	ctx - The context for controlling the command execution.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/function-kill/
+func (client *baseClient) FunctionKill(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionKill, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the serialized payload of all the loaded libraries, which can be restored with [baseClient.FunctionRestore].
+// In cluster mode, the command is routed to a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The serialized payload of all the loaded libraries.
+//
+// [valkey.io]: https://valkey.io/commands/function-dump/
+func (client *baseClient) FunctionDump(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionDump, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Restores the libraries from a payload returned by [baseClient.FunctionDump]. The restore fails if one of the libraries
+// already exists.
+// In cluster mode, the command is routed to all primary nodes.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized payload of the libraries.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *baseClient) FunctionRestore(ctx context.Context, payload string) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionRestore, []string{payload})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Restores the libraries from a payload returned by [baseClient.FunctionDump], handling the existing libraries with the
+// given policy.
+// In cluster mode, the command is routed to all primary nodes.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized payload of the libraries.
+//	policy - The policy for the existing libraries, could be [options.AppendPolicy], [options.FlushPolicy] or
+//	    [options.ReplacePolicy].
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *baseClient) FunctionRestoreWithPolicy(
+	ctx context.Context,
+	payload string,
+	policy options.FunctionRestorePolicy,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionRestore, []string{payload, string(policy)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Invokes a previously loaded function.
+// The command will be routed to a primary random node.
+// To route to a replica please refer to [FCallReadOnly].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *baseClient) FCall(ctx context.Context, function string) (any, error) {
+	result, err := client.executeCommand(ctx, C.FCall, []string{function, utils.IntToString(0)})
+	if err != nil {
+		return nil, err
+	}
+	return handleAnyResponse(result)
+}
+
+// Invokes a previously loaded read-only function.
+// This command is routed depending on the client's {@link ReadFrom} strategy.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *baseClient) FCallReadOnly(ctx context.Context, function string) (any, error) {
+	result, err := client.executeCommand(ctx, C.FCallReadOnly, []string{function, utils.IntToString(0)})
+	if err != nil {
+		return nil, err
+	}
+	return handleAnyResponse(result)
+}
+
+// Invokes a previously loaded function.
+// This command is routed to primary nodes only.
+// To route to a replica please refer to [FCallReadOnly].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//	keys - An `array` of keys accessed by the function. To ensure the correct
+//	   execution of functions, both in standalone and clustered deployments, all names of keys
+//	   that a function accesses must be explicitly provided as `keys`.
+//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *baseClient) FCallWithKeysAndArgs(
+	ctx context.Context,
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
+	cmdArgs = append(cmdArgs, keys...)
+	cmdArgs = append(cmdArgs, args...)
+	result, err := client.executeCommand(ctx, C.FCall, cmdArgs)
+	if err != nil {
+		return nil, err
+	}
+	return handleAnyResponse(result)
+}
+
+// Invokes a previously loaded read-only function.
+// This command is routed depending on the client's {@link ReadFrom} strategy.
+//
+// Note: When in cluster mode, all `keys` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//	keys - An `array` of keys accessed by the function. To ensure the correct
+//	   execution of functions, both in standalone and clustered deployments, all names of keys
+//	   that a function accesses must be explicitly provided as `keys`.
+//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//
+// Return value:
+//
+//	The invoked function's return value.
 //
 // [valkey.io]: https://valkey.io/commands/fcall_ro/
 func (client *baseClient) FCallReadOnlyWithKeysAndArgs(
+	ctx context.Context,
 	function string,
 	keys []string,
 	args []string,
@@ -7605,9 +8678,575 @@ func (client *baseClient) FCallReadOnlyWithKeysAndArgs(
 	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
 	cmdArgs = append(cmdArgs, keys...)
 	cmdArgs = append(cmdArgs, args...)
-	result, err := client.executeCommand(C.FCallReadOnly, cmdArgs)
+	result, err := client.executeCommand(ctx, C.FCallReadOnly, cmdArgs)
+	if err != nil {
+		return nil, err
+	}
+	return handleAnyResponse(result)
+}
+
+// Invokes a Lua script without keys and arguments. The script is invoked by its SHA1 hash with EVALSHA, and loaded
+// transparently if it is not cached by the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The script to invoke, created with [NewScript].
+//
+// Return value:
+//
+//	The value returned by the script.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *baseClient) InvokeScript(ctx context.Context, script *Script) (any, error) {
+	return client.InvokeScriptWithOptions(ctx, script, options.ScriptOptions{})
+}
+
+// Invokes a Lua script with the given keys and arguments. The script is invoked by its SHA1 hash with EVALSHA, and loaded
+// transparently if it is not cached by the server.
+//
+// Note: When in cluster mode, all `keys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The script to invoke, created with [NewScript].
+//	scriptOptions - The keys and arguments of the script. See [options.ScriptOptions].
+//
+// Return value:
+//
+//	The value returned by the script.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *baseClient) InvokeScriptWithOptions(
+	ctx context.Context,
+	script *Script,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	result, err := client.executeScript(ctx, script.GetHash(), scriptOptions.Keys, scriptOptions.Args, nil)
 	if err != nil {
 		return nil, err
 	}
 	return handleAnyResponse(result)
 }
+
+// Checks the existence of scripts in the script cache by their SHA1 digest.
+// In cluster mode, the command is routed to all primary nodes, and a script is reported as existing only if it exists on
+// all of them.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1s - The SHA1 digests of the scripts to check.
+//
+// Return value:
+//
+//	A slice of boolean values indicating the existence of each script.
+//
+// [valkey.io]: https://valkey.io/commands/script-exists/
+func (client *baseClient) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
+	result, err := client.executeCommand(ctx, C.ScriptExists, sha1s)
+	if err != nil {
+		return nil, err
+	}
+	return handleBoolArrayResponse(result)
+}
+
+// Flushes the script cache.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *baseClient) ScriptFlush(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptFlush, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Flushes the script cache with the given flush mode.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	mode - The flushing mode, could be either [options.SYNC] or [options.ASYNC].
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *baseClient) ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptFlush, []string{string(mode)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Kills the currently executing Lua script, assuming no write operation was yet performed by the script.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-kill/
+func (client *baseClient) ScriptKill(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptKill, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the source code of a script in the script cache.
+//
+// Since:
+//
+//	Valkey 8.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1 - The SHA1 digest of the script.
+//
+// Return value:
+//
+//	The source code of the script.
+//
+// [valkey.io]: https://valkey.io/commands/script-show/
+func (client *baseClient) ScriptShow(ctx context.Context, sha1 string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptShow, []string{sha1})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Loads a script into the script cache, without executing it.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The source code of the script.
+//
+// Return value:
+//
+//	The SHA1 digest of the loaded script.
+//
+// [valkey.io]: https://valkey.io/commands/script-load/
+func (client *baseClient) ScriptLoad(ctx context.Context, script string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptLoad, []string{script})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Creates a user, or modifies the rules of an existing user.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	rules - The rules to apply to the user, see [options.AclRuleBuilder]. A new user without rules is disabled and has no
+//	permissions.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-setuser/
+func (client *baseClient) AclSetUser(
+	ctx context.Context,
+	username string,
+	rules *options.AclRuleBuilder,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclSetSser, append([]string{username}, rules.ToArgs()...))
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the rules of a user.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//
+// Return value:
+//
+//	An [AclUserInfo] describing the rules of the user, or a nil result if the user does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/acl-getuser/
+func (client *baseClient) AclGetUser(ctx context.Context, username string) (Result[AclUserInfo], error) {
+	result, err := client.executeCommand(ctx, C.AclGetUser, []string{username})
+	if err != nil {
+		return Result[AclUserInfo]{isNil: true}, err
+	}
+	return handleAclGetUserResponse(result)
+}
+
+// Deletes users, and terminates the connections authenticated as them.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	usernames - The names of the users to delete.
+//
+// Return value:
+//
+//	The number of deleted users.
+//
+// [valkey.io]: https://valkey.io/commands/acl-deluser/
+func (client *baseClient) AclDelUser(ctx context.Context, usernames []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.AclDelUser, usernames)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the rules of all the users, in the format of the ACL configuration file.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice with the rules of each user.
+//
+// [valkey.io]: https://valkey.io/commands/acl-list/
+func (client *baseClient) AclList(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the names of all the users.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice with the names of the users.
+//
+// [valkey.io]: https://valkey.io/commands/acl-users/
+func (client *baseClient) AclUsers(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclUsers, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the names of the command categories.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice with the names of the categories.
+//
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *baseClient) AclCat(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclCat, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the commands of a category.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	category - The name of the category.
+//
+// Return value:
+//
+//	A slice with the names of the commands and subcommands of the category.
+//
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *baseClient) AclCatWithCategory(ctx context.Context, category string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclCat, []string{category})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Simulates the execution of a command by a user, without executing it, to check whether the user is allowed to execute it.
+// In cluster mode, the command is routed to a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	command - The name of the command.
+//	args - The arguments of the command.
+//
+// Return value:
+//
+//	`"OK"` if the user is allowed to execute the command, or a message describing why the user is not allowed to.
+//
+// [valkey.io]: https://valkey.io/commands/acl-dryrun/
+func (client *baseClient) AclDryRun(
+	ctx context.Context,
+	username string,
+	command string,
+	args []string,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclDryRun, append([]string{username, command}, args...))
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Generates a random password of 256 bits, encoded as 64 hexadecimal characters.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The generated password.
+//
+// [valkey.io]: https://valkey.io/commands/acl-genpass/
+func (client *baseClient) AclGenPass(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclGenPass, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Generates a random password of the given number of bits, encoded in hexadecimal.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	bits - The number of bits of the password, which is rounded up to a multiple of 4.
+//
+// Return value:
+//
+//	The generated password.
+//
+// [valkey.io]: https://valkey.io/commands/acl-genpass/
+func (client *baseClient) AclGenPassWithBits(ctx context.Context, bits int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclGenPass, []string{utils.IntToString(bits)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the 10 most recent security events, such as commands or keys denied by the ACL rules and failed
+// authentications.
+// In cluster mode, the command is routed to a random node, use `AclLogWithRoute` to read the log of the other nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice of [AclLogEntry], from the most recent to the oldest.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *baseClient) AclLog(ctx context.Context) ([]AclLogEntry, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleAclLogResponse(result)
+}
+
+// Returns the most recent security events, such as commands or keys denied by the ACL rules and failed authentications.
+// In cluster mode, the command is routed to a random node, use `AclLogWithCountWithRoute` to read the log of the other
+// nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	count - The maximum number of events to return.
+//
+// Return value:
+//
+//	A slice of [AclLogEntry], from the most recent to the oldest.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *baseClient) AclLogWithCount(ctx context.Context, count int64) ([]AclLogEntry, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{utils.IntToString(count)})
+	if err != nil {
+		return nil, err
+	}
+	return handleAclLogResponse(result)
+}
+
+// Clears the log of security events.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *baseClient) AclLogReset(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{"RESET"})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Reloads the users from the ACL file configured on the server. If the file is invalid, the existing users are kept.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-load/
+func (client *baseClient) AclLoad(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclLoad, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Saves the users to the ACL file configured on the server.
+// In cluster mode, the command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-save/
+func (client *baseClient) AclSave(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclSave, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the name of the user the connection is authenticated as.
+// In cluster mode, the command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The name of the user.
+//
+// [valkey.io]: https://valkey.io/commands/acl-whoami/
+func (client *baseClient) AclWhoami(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclWhoami, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
@@ -0,0 +1,192 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// Batch is a group of commands which are sent to the server in a single request.
+//
+// An atomic batch is executed as a transaction: the commands are wrapped with MULTI and EXEC, so they are executed
+// sequentially and no other client request is served in the middle of the batch. In cluster mode, all keys of an atomic
+// batch must map to the same hash slot.
+//
+// A non-atomic batch is executed as a pipeline: the commands are sent together to reduce network round trips, but other
+// client requests may be served in between. In cluster mode, the commands of a non-atomic batch may span multiple slots and
+// are routed to their nodes independently.
+//
+// The batch commands mirror the commands of [BaseClient] and take the same arguments, except for the context. Commands are
+// queued in the batch and sent once the batch is executed with [GlideClient.Exec] or [GlideClusterClient.Exec]. The result
+// of each command in the returned response has the same type as the first value returned by the matching [BaseClient]
+// command, for example `string` for `Set` and `Result[string]` for `Get`. Commands which return two values, such as scan
+// commands and `ZRankWithScore`, produce a `[]any` holding both values. If raiseOnError is false, a command which failed
+// produces an error in place of its result.
+//
+// Batches are not thread safe and should not be modified while they are executed.
+//
+// For example:
+//
+//	batch := api.NewBatch(true).
+//	    Set("key", "value").
+//	    Get("key")
+//	result, err := client.Exec(context.Background(), batch, true)
+//	fmt.Println(result) // Output: [OK {value false}]
+type Batch struct {
+	isAtomic bool
+	commands []batchCommand
+	// The first error encountered while queueing commands, it is returned when the batch is executed.
+	err error
+}
+
+type batchCommand struct {
+	requestType C.RequestType
+	args        []string
+	converter   func(response *C.struct_CommandResponse) (any, error)
+}
+
+// NewBatch creates an empty [Batch].
+//
+// Parameters:
+//
+//	isAtomic - Determines whether the batch is atomic. An atomic batch is executed as a transaction, and a non-atomic batch is
+//	executed as a pipeline.
+//
+// Return value:
+//
+//	A new empty [Batch].
+func NewBatch(isAtomic bool) *Batch {
+	return &Batch{isAtomic: isAtomic}
+}
+
+// CustomCommand queues [GlideClient.CustomCommand] in the batch.
+//
+// The result of the command in the batch response is not converted, similarly to the result of
+// [GlideClient.CustomCommand].
+func (b *Batch) CustomCommand(args []string) *Batch {
+	return b.addCmd(C.CustomCommand, args, convertAnyResponse)
+}
+
+// batchConverter adapts a typed response converter so that its result can be stored in the batch response.
+func batchConverter[T any](
+	convert func(response *C.struct_CommandResponse) (T, error),
+) func(response *C.struct_CommandResponse) (any, error) {
+	return func(response *C.struct_CommandResponse) (any, error) {
+		return convert(response)
+	}
+}
+
+// convertScanBatchResponse converts the response of a scan command into a slice holding the cursor and the scanned
+// elements, as each command of a batch has a single result.
+func convertScanBatchResponse(response *C.struct_CommandResponse) (any, error) {
+	cursor, elements, err := convertScanResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return []any{cursor, elements}, nil
+}
+
+// convertRankWithScoreBatchResponse converts the response of a rank command with score into a slice holding the rank and
+// the score, as each command of a batch has a single result.
+func convertRankWithScoreBatchResponse(response *C.struct_CommandResponse) (any, error) {
+	rank, score, err := convertLongAndDoubleOrNullResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return []any{rank, score}, nil
+}
+
+func (b *Batch) addCmd(
+	requestType C.RequestType,
+	args []string,
+	converter func(response *C.struct_CommandResponse) (any, error),
+) *Batch {
+	b.commands = append(b.commands, batchCommand{requestType: requestType, args: args, converter: converter})
+	return b
+}
+
+func (b *Batch) addError(err error) *Batch {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+func (b *Batch) toProtobuf(raiseOnError bool, timeout uint32) (*protobuf.Batch, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.commands) == 0 {
+		return nil, &errors.RequestError{Msg: "Batch failed. The batch is empty."}
+	}
+
+	commands := make([]*protobuf.Command, 0, len(b.commands))
+	for _, command := range b.commands {
+		args := make([][]byte, 0, len(command.args))
+		for _, arg := range command.args {
+			args = append(args, utils.StringToBytes(arg))
+		}
+		commands = append(commands, &protobuf.Command{
+			RequestType: protobuf.RequestType(command.requestType),
+			Args:        &protobuf.Command_ArgsArray_{ArgsArray: &protobuf.Command_ArgsArray{Args: args}},
+		})
+	}
+
+	batch := &protobuf.Batch{
+		IsAtomic:     b.isAtomic,
+		Commands:     commands,
+		RaiseOnError: &raiseOnError,
+	}
+	if timeout != 0 {
+		batch.Timeout = &timeout
+	}
+	return batch, nil
+}
+
+// convertResponse converts the response of the batch into the results of its commands.
+// The server responds with null when an atomic batch is aborted because a watched key was modified.
+func (b *Batch) convertResponse(response *C.struct_CommandResponse) ([]any, error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.Array, b.isAtomic)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+	if response == nil || response.response_type == uint32(C.Null) {
+		return nil, &errors.TransactionAbortedError{
+			Msg: "Transaction aborted: a watched key was modified before the transaction was executed",
+		}
+	}
+
+	responses := unsafe.Slice(response.array_value, response.array_value_len)
+	if len(responses) != len(b.commands) {
+		return nil, &errors.RequestError{
+			Msg: fmt.Sprintf("Unexpected batch response length: got %d, expected %d", len(responses), len(b.commands)),
+		}
+	}
+
+	results := make([]any, 0, len(responses))
+	for i := range responses {
+		commandResponse := &responses[i]
+		// Errors are only returned in place of the command results when raiseOnError is false.
+		if commandResponse.response_type == uint32(C.Error) {
+			message := C.GoStringN(commandResponse.string_value, C.int(commandResponse.string_value_len))
+			results = append(results, &errors.RequestError{Msg: message})
+			continue
+		}
+		result, err := b.commands[i].converter(commandResponse)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,1953 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// Set queues [GlideClient.Set] in the batch.
+func (b *Batch) Set(key string, value string) *Batch {
+	return b.addCmd(C.Set, []string{key, value}, batchConverter(convertStringResponse))
+}
+
+// SetWithOptions queues [GlideClient.SetWithOptions] in the batch.
+func (b *Batch) SetWithOptions(key string, value string, options options.SetOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.Set, append([]string{key, value}, optionArgs...), batchConverter(convertStringOrNilResponse))
+}
+
+// Get queues [GlideClient.Get] in the batch.
+func (b *Batch) Get(key string) *Batch {
+	return b.addCmd(C.Get, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// GetEx queues [GlideClient.GetEx] in the batch.
+func (b *Batch) GetEx(key string) *Batch {
+	return b.addCmd(C.GetEx, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// GetExWithOptions queues [GlideClient.GetExWithOptions] in the batch.
+func (b *Batch) GetExWithOptions(key string, options options.GetExOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.GetEx, append([]string{key}, optionArgs...), batchConverter(convertStringOrNilResponse))
+}
+
+// MSet queues [GlideClient.MSet] in the batch.
+func (b *Batch) MSet(keyValueMap map[string]string) *Batch {
+	return b.addCmd(C.MSet, utils.MapToString(keyValueMap), batchConverter(convertStringResponse))
+}
+
+// MSetNX queues [GlideClient.MSetNX] in the batch.
+func (b *Batch) MSetNX(keyValueMap map[string]string) *Batch {
+	return b.addCmd(C.MSetNX, utils.MapToString(keyValueMap), batchConverter(convertBoolResponse))
+}
+
+// MGet queues [GlideClient.MGet] in the batch.
+func (b *Batch) MGet(keys []string) *Batch {
+	return b.addCmd(C.MGet, keys, batchConverter(convertStringOrNilArray))
+}
+
+// Incr queues [GlideClient.Incr] in the batch.
+func (b *Batch) Incr(key string) *Batch {
+	return b.addCmd(C.Incr, []string{key}, batchConverter(convertIntResponse))
+}
+
+// IncrBy queues [GlideClient.IncrBy] in the batch.
+func (b *Batch) IncrBy(key string, amount int64) *Batch {
+	return b.addCmd(C.IncrBy, []string{key, utils.IntToString(amount)}, batchConverter(convertIntResponse))
+}
+
+// IncrByFloat queues [GlideClient.IncrByFloat] in the batch.
+func (b *Batch) IncrByFloat(key string, amount float64) *Batch {
+	return b.addCmd(C.IncrByFloat, []string{key, utils.FloatToString(amount)}, batchConverter(convertFloatResponse))
+}
+
+// Decr queues [GlideClient.Decr] in the batch.
+func (b *Batch) Decr(key string) *Batch {
+	return b.addCmd(C.Decr, []string{key}, batchConverter(convertIntResponse))
+}
+
+// DecrBy queues [GlideClient.DecrBy] in the batch.
+func (b *Batch) DecrBy(key string, amount int64) *Batch {
+	return b.addCmd(C.DecrBy, []string{key, utils.IntToString(amount)}, batchConverter(convertIntResponse))
+}
+
+// Strlen queues [GlideClient.Strlen] in the batch.
+func (b *Batch) Strlen(key string) *Batch {
+	return b.addCmd(C.Strlen, []string{key}, batchConverter(convertIntResponse))
+}
+
+// SetRange queues [GlideClient.SetRange] in the batch.
+func (b *Batch) SetRange(key string, offset int, value string) *Batch {
+	return b.addCmd(C.SetRange, []string{key, strconv.Itoa(offset), value}, batchConverter(convertIntResponse))
+}
+
+// GetRange queues [GlideClient.GetRange] in the batch.
+func (b *Batch) GetRange(key string, start int, end int) *Batch {
+	return b.addCmd(C.GetRange, []string{key, strconv.Itoa(start), strconv.Itoa(end)}, batchConverter(convertStringResponse))
+}
+
+// Append queues [GlideClient.Append] in the batch.
+func (b *Batch) Append(key string, value string) *Batch {
+	return b.addCmd(C.Append, []string{key, value}, batchConverter(convertIntResponse))
+}
+
+// LCS queues [GlideClient.LCS] in the batch.
+func (b *Batch) LCS(key1 string, key2 string) *Batch {
+	return b.addCmd(C.LCS, []string{key1, key2}, batchConverter(convertStringResponse))
+}
+
+// LCSLen queues [GlideClient.LCSLen] in the batch.
+func (b *Batch) LCSLen(key1, key2 string) *Batch {
+	return b.addCmd(C.LCS, []string{key1, key2, options.LCSLenCommand}, batchConverter(convertIntResponse))
+}
+
+// LCSWithOptions queues [GlideClient.LCSWithOptions] in the batch.
+func (b *Batch) LCSWithOptions(key1, key2 string, opts options.LCSIdxOptions) *Batch {
+	optArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.LCS, append([]string{key1, key2}, optArgs...), batchConverter(convertStringToAnyMapResponse))
+}
+
+// GetDel queues [GlideClient.GetDel] in the batch.
+func (b *Batch) GetDel(key string) *Batch {
+	if key == "" {
+		return b.addError(&errors.RequestError{Msg: "key is required"})
+	}
+	return b.addCmd(C.GetDel, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// HGet queues [GlideClient.HGet] in the batch.
+func (b *Batch) HGet(key string, field string) *Batch {
+	return b.addCmd(C.HGet, []string{key, field}, batchConverter(convertStringOrNilResponse))
+}
+
+// HGetAll queues [GlideClient.HGetAll] in the batch.
+func (b *Batch) HGetAll(key string) *Batch {
+	return b.addCmd(C.HGetAll, []string{key}, batchConverter(convertStringToStringMapResponse))
+}
+
+// HMGet queues [GlideClient.HMGet] in the batch.
+func (b *Batch) HMGet(key string, fields []string) *Batch {
+	return b.addCmd(C.HMGet, append([]string{key}, fields...), batchConverter(convertStringOrNilArray))
+}
+
+// HSet queues [GlideClient.HSet] in the batch.
+func (b *Batch) HSet(key string, values map[string]string) *Batch {
+	return b.addCmd(C.HSet, utils.ConvertMapToKeyValueStringArray(key, values), batchConverter(convertIntResponse))
+}
+
+// HSetNX queues [GlideClient.HSetNX] in the batch.
+func (b *Batch) HSetNX(key string, field string, value string) *Batch {
+	return b.addCmd(C.HSetNX, []string{key, field, value}, batchConverter(convertBoolResponse))
+}
+
+// HDel queues [GlideClient.HDel] in the batch.
+func (b *Batch) HDel(key string, fields []string) *Batch {
+	return b.addCmd(C.HDel, append([]string{key}, fields...), batchConverter(convertIntResponse))
+}
+
+// HLen queues [GlideClient.HLen] in the batch.
+func (b *Batch) HLen(key string) *Batch {
+	return b.addCmd(C.HLen, []string{key}, batchConverter(convertIntResponse))
+}
+
+// HVals queues [GlideClient.HVals] in the batch.
+func (b *Batch) HVals(key string) *Batch {
+	return b.addCmd(C.HVals, []string{key}, batchConverter(convertStringArrayResponse))
+}
+
+// HExists queues [GlideClient.HExists] in the batch.
+func (b *Batch) HExists(key string, field string) *Batch {
+	return b.addCmd(C.HExists, []string{key, field}, batchConverter(convertBoolResponse))
+}
+
+// HKeys queues [GlideClient.HKeys] in the batch.
+func (b *Batch) HKeys(key string) *Batch {
+	return b.addCmd(C.HKeys, []string{key}, batchConverter(convertStringArrayResponse))
+}
+
+// HStrLen queues [GlideClient.HStrLen] in the batch.
+func (b *Batch) HStrLen(key string, field string) *Batch {
+	return b.addCmd(C.HStrlen, []string{key, field}, batchConverter(convertIntResponse))
+}
+
+// HIncrBy queues [GlideClient.HIncrBy] in the batch.
+func (b *Batch) HIncrBy(key string, field string, increment int64) *Batch {
+	return b.addCmd(C.HIncrBy, []string{key, field, utils.IntToString(increment)}, batchConverter(convertIntResponse))
+}
+
+// HIncrByFloat queues [GlideClient.HIncrByFloat] in the batch.
+func (b *Batch) HIncrByFloat(key string, field string, increment float64) *Batch {
+	return b.addCmd(C.HIncrByFloat, []string{key, field, utils.FloatToString(increment)}, batchConverter(convertFloatResponse))
+}
+
+// HScan queues [GlideClient.HScan] in the batch.
+func (b *Batch) HScan(key string, cursor string) *Batch {
+	return b.addCmd(C.HScan, []string{key, cursor}, convertScanBatchResponse)
+}
+
+// HScanWithOptions queues [GlideClient.HScanWithOptions] in the batch.
+func (b *Batch) HScanWithOptions(key string, cursor string, options options.HashScanOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.HScan, append([]string{key, cursor}, optionArgs...), convertScanBatchResponse)
+}
+
+// HRandField queues [GlideClient.HRandField] in the batch.
+func (b *Batch) HRandField(key string) *Batch {
+	return b.addCmd(C.HRandField, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// HRandFieldWithCount queues [GlideClient.HRandFieldWithCount] in the batch.
+func (b *Batch) HRandFieldWithCount(key string, count int64) *Batch {
+	return b.addCmd(C.HRandField, []string{key, utils.IntToString(count)}, batchConverter(convertStringArrayResponse))
+}
+
+// HRandFieldWithCountWithValues queues [GlideClient.HRandFieldWithCountWithValues] in the batch.
+func (b *Batch) HRandFieldWithCountWithValues(key string, count int64) *Batch {
+	return b.addCmd(
+		C.HRandField,
+		[]string{key, utils.IntToString(count), options.WithValuesKeyword},
+		batchConverter(convert2DStringArrayResponse),
+	)
+}
+
+// LPush queues [GlideClient.LPush] in the batch.
+func (b *Batch) LPush(key string, elements []string) *Batch {
+	return b.addCmd(C.LPush, append([]string{key}, elements...), batchConverter(convertIntResponse))
+}
+
+// LPop queues [GlideClient.LPop] in the batch.
+func (b *Batch) LPop(key string) *Batch {
+	return b.addCmd(C.LPop, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// LPopCount queues [GlideClient.LPopCount] in the batch.
+func (b *Batch) LPopCount(key string, count int64) *Batch {
+	return b.addCmd(C.LPop, []string{key, utils.IntToString(count)}, batchConverter(convertStringArrayOrNilResponse))
+}
+
+// LPos queues [GlideClient.LPos] in the batch.
+func (b *Batch) LPos(key string, element string) *Batch {
+	return b.addCmd(C.LPos, []string{key, element}, batchConverter(convertIntOrNilResponse))
+}
+
+// LPosWithOptions queues [GlideClient.LPosWithOptions] in the batch.
+func (b *Batch) LPosWithOptions(key string, element string, options options.LPosOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.LPos, append([]string{key, element}, optionArgs...), batchConverter(convertIntOrNilResponse))
+}
+
+// LPosCount queues [GlideClient.LPosCount] in the batch.
+func (b *Batch) LPosCount(key string, element string, count int64) *Batch {
+	return b.addCmd(
+		C.LPos,
+		[]string{key, element, options.CountKeyword, utils.IntToString(count)},
+		batchConverter(convertIntArrayResponse),
+	)
+}
+
+// LPosCountWithOptions queues [GlideClient.LPosCountWithOptions] in the batch.
+func (b *Batch) LPosCountWithOptions(key string, element string, count int64, opts options.LPosOptions) *Batch {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.LPos,
+		append([]string{key, element, options.CountKeyword, utils.IntToString(count)}, optionArgs...),
+		batchConverter(convertIntArrayResponse),
+	)
+}
+
+// RPush queues [GlideClient.RPush] in the batch.
+func (b *Batch) RPush(key string, elements []string) *Batch {
+	return b.addCmd(C.RPush, append([]string{key}, elements...), batchConverter(convertIntResponse))
+}
+
+// SAdd queues [GlideClient.SAdd] in the batch.
+func (b *Batch) SAdd(key string, members []string) *Batch {
+	return b.addCmd(C.SAdd, append([]string{key}, members...), batchConverter(convertIntResponse))
+}
+
+// SRem queues [GlideClient.SRem] in the batch.
+func (b *Batch) SRem(key string, members []string) *Batch {
+	return b.addCmd(C.SRem, append([]string{key}, members...), batchConverter(convertIntResponse))
+}
+
+// SUnionStore queues [GlideClient.SUnionStore] in the batch.
+func (b *Batch) SUnionStore(destination string, keys []string) *Batch {
+	return b.addCmd(C.SUnionStore, append([]string{destination}, keys...), batchConverter(convertIntResponse))
+}
+
+// SMembers queues [GlideClient.SMembers] in the batch.
+func (b *Batch) SMembers(key string) *Batch {
+	return b.addCmd(C.SMembers, []string{key}, batchConverter(convertStringSetResponse))
+}
+
+// SCard queues [GlideClient.SCard] in the batch.
+func (b *Batch) SCard(key string) *Batch {
+	return b.addCmd(C.SCard, []string{key}, batchConverter(convertIntResponse))
+}
+
+// SIsMember queues [GlideClient.SIsMember] in the batch.
+func (b *Batch) SIsMember(key string, member string) *Batch {
+	return b.addCmd(C.SIsMember, []string{key, member}, batchConverter(convertBoolResponse))
+}
+
+// SDiff queues [GlideClient.SDiff] in the batch.
+func (b *Batch) SDiff(keys []string) *Batch {
+	return b.addCmd(C.SDiff, keys, batchConverter(convertStringSetResponse))
+}
+
+// SDiffStore queues [GlideClient.SDiffStore] in the batch.
+func (b *Batch) SDiffStore(destination string, keys []string) *Batch {
+	return b.addCmd(C.SDiffStore, append([]string{destination}, keys...), batchConverter(convertIntResponse))
+}
+
+// SInter queues [GlideClient.SInter] in the batch.
+func (b *Batch) SInter(keys []string) *Batch {
+	return b.addCmd(C.SInter, keys, batchConverter(convertStringSetResponse))
+}
+
+// SInterStore queues [GlideClient.SInterStore] in the batch.
+func (b *Batch) SInterStore(destination string, keys []string) *Batch {
+	return b.addCmd(C.SInterStore, append([]string{destination}, keys...), batchConverter(convertIntResponse))
+}
+
+// SInterCard queues [GlideClient.SInterCard] in the batch.
+func (b *Batch) SInterCard(keys []string) *Batch {
+	return b.addCmd(C.SInterCard, append([]string{strconv.Itoa(len(keys))}, keys...), batchConverter(convertIntResponse))
+}
+
+// SInterCardLimit queues [GlideClient.SInterCardLimit] in the batch.
+func (b *Batch) SInterCardLimit(keys []string, limit int64) *Batch {
+	args := utils.Concat(
+		[]string{utils.IntToString(int64(len(keys)))},
+		keys,
+		[]string{options.LimitKeyword, utils.IntToString(limit)},
+	)
+	return b.addCmd(C.SInterCard, args, batchConverter(convertIntResponse))
+}
+
+// SRandMember queues [GlideClient.SRandMember] in the batch.
+func (b *Batch) SRandMember(key string) *Batch {
+	return b.addCmd(C.SRandMember, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// SPop queues [GlideClient.SPop] in the batch.
+func (b *Batch) SPop(key string) *Batch {
+	return b.addCmd(C.SPop, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// SMIsMember queues [GlideClient.SMIsMember] in the batch.
+func (b *Batch) SMIsMember(key string, members []string) *Batch {
+	return b.addCmd(C.SMIsMember, append([]string{key}, members...), batchConverter(convertBoolArrayResponse))
+}
+
+// SUnion queues [GlideClient.SUnion] in the batch.
+func (b *Batch) SUnion(keys []string) *Batch {
+	return b.addCmd(C.SUnion, keys, batchConverter(convertStringSetResponse))
+}
+
+// SScan queues [GlideClient.SScan] in the batch.
+func (b *Batch) SScan(key string, cursor string) *Batch {
+	return b.addCmd(C.SScan, []string{key, cursor}, convertScanBatchResponse)
+}
+
+// SScanWithOptions queues [GlideClient.SScanWithOptions] in the batch.
+func (b *Batch) SScanWithOptions(key string, cursor string, options options.BaseScanOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.SScan, append([]string{key, cursor}, optionArgs...), convertScanBatchResponse)
+}
+
+// SMove queues [GlideClient.SMove] in the batch.
+func (b *Batch) SMove(source string, destination string, member string) *Batch {
+	return b.addCmd(C.SMove, []string{source, destination, member}, batchConverter(convertBoolResponse))
+}
+
+// LRange queues [GlideClient.LRange] in the batch.
+func (b *Batch) LRange(key string, start int64, end int64) *Batch {
+	return b.addCmd(
+		C.LRange,
+		[]string{key, utils.IntToString(start), utils.IntToString(end)},
+		batchConverter(convertStringArrayResponse),
+	)
+}
+
+// LIndex queues [GlideClient.LIndex] in the batch.
+func (b *Batch) LIndex(key string, index int64) *Batch {
+	return b.addCmd(C.LIndex, []string{key, utils.IntToString(index)}, batchConverter(convertStringOrNilResponse))
+}
+
+// LTrim queues [GlideClient.LTrim] in the batch.
+func (b *Batch) LTrim(key string, start int64, end int64) *Batch {
+	return b.addCmd(
+		C.LTrim,
+		[]string{key, utils.IntToString(start), utils.IntToString(end)},
+		batchConverter(convertStringResponse),
+	)
+}
+
+// LLen queues [GlideClient.LLen] in the batch.
+func (b *Batch) LLen(key string) *Batch {
+	return b.addCmd(C.LLen, []string{key}, batchConverter(convertIntResponse))
+}
+
+// LRem queues [GlideClient.LRem] in the batch.
+func (b *Batch) LRem(key string, count int64, element string) *Batch {
+	return b.addCmd(C.LRem, []string{key, utils.IntToString(count), element}, batchConverter(convertIntResponse))
+}
+
+// RPop queues [GlideClient.RPop] in the batch.
+func (b *Batch) RPop(key string) *Batch {
+	return b.addCmd(C.RPop, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// RPopCount queues [GlideClient.RPopCount] in the batch.
+func (b *Batch) RPopCount(key string, count int64) *Batch {
+	return b.addCmd(C.RPop, []string{key, utils.IntToString(count)}, batchConverter(convertStringArrayOrNilResponse))
+}
+
+// LInsert queues [GlideClient.LInsert] in the batch.
+func (b *Batch) LInsert(key string, insertPosition options.InsertPosition, pivot string, element string) *Batch {
+	insertPositionStr, err := insertPosition.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.LInsert, []string{key, insertPositionStr, pivot, element}, batchConverter(convertIntResponse))
+}
+
+// BLPop queues [GlideClient.BLPop] in the batch.
+func (b *Batch) BLPop(keys []string, timeoutSecs float64) *Batch {
+	return b.addCmd(C.BLPop, append(keys, utils.FloatToString(timeoutSecs)), batchConverter(convertStringArrayOrNilResponse))
+}
+
+// BRPop queues [GlideClient.BRPop] in the batch.
+func (b *Batch) BRPop(keys []string, timeoutSecs float64) *Batch {
+	return b.addCmd(C.BRPop, append(keys, utils.FloatToString(timeoutSecs)), batchConverter(convertStringArrayOrNilResponse))
+}
+
+// RPushX queues [GlideClient.RPushX] in the batch.
+func (b *Batch) RPushX(key string, elements []string) *Batch {
+	return b.addCmd(C.RPushX, append([]string{key}, elements...), batchConverter(convertIntResponse))
+}
+
+// LPushX queues [GlideClient.LPushX] in the batch.
+func (b *Batch) LPushX(key string, elements []string) *Batch {
+	return b.addCmd(C.LPushX, append([]string{key}, elements...), batchConverter(convertIntResponse))
+}
+
+// LMPop queues [GlideClient.LMPop] in the batch.
+func (b *Batch) LMPop(keys []string, listDirection options.ListDirection) *Batch {
+	listDirectionStr, err := listDirection.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	if len(keys) > math.MaxInt-2 {
+		return b.addError(&errors.RequestError{Msg: "Length overflow for the provided keys"})
+	}
+	args := make([]string, 0, len(keys)+2)
+	args = append(args, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr)
+	return b.addCmd(C.LMPop, args, batchConverter(convertStringToStringArrayMapOrNilResponse))
+}
+
+// LMPopCount queues [GlideClient.LMPopCount] in the batch.
+func (b *Batch) LMPopCount(keys []string, listDirection options.ListDirection, count int64) *Batch {
+	listDirectionStr, err := listDirection.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	if len(keys) > math.MaxInt-4 {
+		return b.addError(&errors.RequestError{Msg: "Length overflow for the provided keys"})
+	}
+	args := make([]string, 0, len(keys)+4)
+	args = append(args, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr, options.CountKeyword, utils.IntToString(count))
+	return b.addCmd(C.LMPop, args, batchConverter(convertStringToStringArrayMapOrNilResponse))
+}
+
+// BLMPop queues [GlideClient.BLMPop] in the batch.
+func (b *Batch) BLMPop(keys []string, listDirection options.ListDirection, timeoutSecs float64) *Batch {
+	listDirectionStr, err := listDirection.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	if len(keys) > math.MaxInt-3 {
+		return b.addError(&errors.RequestError{Msg: "Length overflow for the provided keys"})
+	}
+	args := make([]string, 0, len(keys)+3)
+	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr)
+	return b.addCmd(C.BLMPop, args, batchConverter(convertStringToStringArrayMapOrNilResponse))
+}
+
+// BLMPopCount queues [GlideClient.BLMPopCount] in the batch.
+func (b *Batch) BLMPopCount(keys []string, listDirection options.ListDirection, count int64, timeoutSecs float64) *Batch {
+	listDirectionStr, err := listDirection.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	if len(keys) > math.MaxInt-5 {
+		return b.addError(&errors.RequestError{Msg: "Length overflow for the provided keys"})
+	}
+	args := make([]string, 0, len(keys)+5)
+	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr, options.CountKeyword, utils.IntToString(count))
+	return b.addCmd(C.BLMPop, args, batchConverter(convertStringToStringArrayMapOrNilResponse))
+}
+
+// LSet queues [GlideClient.LSet] in the batch.
+func (b *Batch) LSet(key string, index int64, element string) *Batch {
+	return b.addCmd(C.LSet, []string{key, utils.IntToString(index), element}, batchConverter(convertStringResponse))
+}
+
+// LMove queues [GlideClient.LMove] in the batch.
+func (b *Batch) LMove(
+	source string,
+	destination string,
+	whereFrom options.ListDirection,
+	whereTo options.ListDirection,
+) *Batch {
+	whereFromStr, err := whereFrom.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	whereToStr, err := whereTo.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.LMove,
+		[]string{source, destination, whereFromStr, whereToStr},
+		batchConverter(convertStringOrNilResponse),
+	)
+}
+
+// BLMove queues [GlideClient.BLMove] in the batch.
+func (b *Batch) BLMove(
+	source string,
+	destination string,
+	whereFrom options.ListDirection,
+	whereTo options.ListDirection,
+	timeoutSecs float64,
+) *Batch {
+	whereFromStr, err := whereFrom.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	whereToStr, err := whereTo.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.BLMove,
+		[]string{source, destination, whereFromStr, whereToStr, utils.FloatToString(timeoutSecs)},
+		batchConverter(convertStringOrNilResponse),
+	)
+}
+
+// Del queues [GlideClient.Del] in the batch.
+func (b *Batch) Del(keys []string) *Batch {
+	return b.addCmd(C.Del, keys, batchConverter(convertIntResponse))
+}
+
+// Exists queues [GlideClient.Exists] in the batch.
+func (b *Batch) Exists(keys []string) *Batch {
+	return b.addCmd(C.Exists, keys, batchConverter(convertIntResponse))
+}
+
+// Expire queues [GlideClient.Expire] in the batch.
+func (b *Batch) Expire(key string, seconds int64) *Batch {
+	return b.addCmd(C.Expire, []string{key, utils.IntToString(seconds)}, batchConverter(convertBoolResponse))
+}
+
+// ExpireWithOptions queues [GlideClient.ExpireWithOptions] in the batch.
+func (b *Batch) ExpireWithOptions(key string, seconds int64, expireCondition options.ExpireCondition) *Batch {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.Expire,
+		[]string{key, utils.IntToString(seconds), expireConditionStr},
+		batchConverter(convertBoolResponse),
+	)
+}
+
+// ExpireAt queues [GlideClient.ExpireAt] in the batch.
+func (b *Batch) ExpireAt(key string, unixTimestampInSeconds int64) *Batch {
+	return b.addCmd(C.ExpireAt, []string{key, utils.IntToString(unixTimestampInSeconds)}, batchConverter(convertBoolResponse))
+}
+
+// ExpireAtWithOptions queues [GlideClient.ExpireAtWithOptions] in the batch.
+func (b *Batch) ExpireAtWithOptions(key string, unixTimestampInSeconds int64, expireCondition options.ExpireCondition) *Batch {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.ExpireAt,
+		[]string{key, utils.IntToString(unixTimestampInSeconds), expireConditionStr},
+		batchConverter(convertBoolResponse),
+	)
+}
+
+// PExpire queues [GlideClient.PExpire] in the batch.
+func (b *Batch) PExpire(key string, milliseconds int64) *Batch {
+	return b.addCmd(C.PExpire, []string{key, utils.IntToString(milliseconds)}, batchConverter(convertBoolResponse))
+}
+
+// PExpireWithOptions queues [GlideClient.PExpireWithOptions] in the batch.
+func (b *Batch) PExpireWithOptions(key string, milliseconds int64, expireCondition options.ExpireCondition) *Batch {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.PExpire,
+		[]string{key, utils.IntToString(milliseconds), expireConditionStr},
+		batchConverter(convertBoolResponse),
+	)
+}
+
+// PExpireAt queues [GlideClient.PExpireAt] in the batch.
+func (b *Batch) PExpireAt(key string, unixTimestampInMilliSeconds int64) *Batch {
+	return b.addCmd(
+		C.PExpireAt,
+		[]string{key, utils.IntToString(unixTimestampInMilliSeconds)},
+		batchConverter(convertBoolResponse),
+	)
+}
+
+// PExpireAtWithOptions queues [GlideClient.PExpireAtWithOptions] in the batch.
+func (b *Batch) PExpireAtWithOptions(
+	key string,
+	unixTimestampInMilliSeconds int64,
+	expireCondition options.ExpireCondition,
+) *Batch {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.PExpireAt,
+		[]string{key, utils.IntToString(unixTimestampInMilliSeconds), expireConditionStr},
+		batchConverter(convertBoolResponse),
+	)
+}
+
+// ExpireTime queues [GlideClient.ExpireTime] in the batch.
+func (b *Batch) ExpireTime(key string) *Batch {
+	return b.addCmd(C.ExpireTime, []string{key}, batchConverter(convertIntResponse))
+}
+
+// PExpireTime queues [GlideClient.PExpireTime] in the batch.
+func (b *Batch) PExpireTime(key string) *Batch {
+	return b.addCmd(C.PExpireTime, []string{key}, batchConverter(convertIntResponse))
+}
+
+// TTL queues [GlideClient.TTL] in the batch.
+func (b *Batch) TTL(key string) *Batch {
+	return b.addCmd(C.TTL, []string{key}, batchConverter(convertIntResponse))
+}
+
+// PTTL queues [GlideClient.PTTL] in the batch.
+func (b *Batch) PTTL(key string) *Batch {
+	return b.addCmd(C.PTTL, []string{key}, batchConverter(convertIntResponse))
+}
+
+// PfAdd queues [GlideClient.PfAdd] in the batch.
+func (b *Batch) PfAdd(key string, elements []string) *Batch {
+	return b.addCmd(C.PfAdd, append([]string{key}, elements...), batchConverter(convertIntResponse))
+}
+
+// PfCount queues [GlideClient.PfCount] in the batch.
+func (b *Batch) PfCount(keys []string) *Batch {
+	return b.addCmd(C.PfCount, keys, batchConverter(convertIntResponse))
+}
+
+// PfMerge queues [GlideClient.PfMerge] in the batch.
+func (b *Batch) PfMerge(destination string, sourceKeys []string) *Batch {
+	return b.addCmd(C.PfMerge, append([]string{destination}, sourceKeys...), batchConverter(convertStringResponse))
+}
+
+// Unlink queues [GlideClient.Unlink] in the batch.
+func (b *Batch) Unlink(keys []string) *Batch {
+	return b.addCmd(C.Unlink, keys, batchConverter(convertIntResponse))
+}
+
+// Type queues [GlideClient.Type] in the batch.
+func (b *Batch) Type(key string) *Batch {
+	return b.addCmd(C.Type, []string{key}, batchConverter(convertStringResponse))
+}
+
+// Touch queues [GlideClient.Touch] in the batch.
+func (b *Batch) Touch(keys []string) *Batch {
+	return b.addCmd(C.Touch, keys, batchConverter(convertIntResponse))
+}
+
+// Publish queues [GlideClient.Publish] in the batch.
+func (b *Batch) Publish(channel string, message string) *Batch {
+	return b.addCmd(C.Publish, []string{channel, message}, batchConverter(convertIntResponse))
+}
+
+// PubSubChannels queues [GlideClient.PubSubChannels] in the batch.
+func (b *Batch) PubSubChannels() *Batch {
+	return b.addCmd(C.PubSubChannels, []string{}, batchConverter(convertStringArrayResponse))
+}
+
+// PubSubChannelsWithPattern queues [GlideClient.PubSubChannelsWithPattern] in the batch.
+func (b *Batch) PubSubChannelsWithPattern(pattern string) *Batch {
+	return b.addCmd(C.PubSubChannels, []string{pattern}, batchConverter(convertStringArrayResponse))
+}
+
+// PubSubNumPat queues [GlideClient.PubSubNumPat] in the batch.
+func (b *Batch) PubSubNumPat() *Batch {
+	return b.addCmd(C.PubSubNumPat, []string{}, batchConverter(convertIntResponse))
+}
+
+// PubSubNumSub queues [GlideClient.PubSubNumSub] in the batch.
+func (b *Batch) PubSubNumSub(channels []string) *Batch {
+	return b.addCmd(C.PubSubNumSub, channels, batchConverter(convertStringIntMapResponse))
+}
+
+// Rename queues [GlideClient.Rename] in the batch.
+func (b *Batch) Rename(key string, newKey string) *Batch {
+	return b.addCmd(C.Rename, []string{key, newKey}, batchConverter(convertStringResponse))
+}
+
+// RenameNX queues [GlideClient.RenameNX] in the batch.
+func (b *Batch) RenameNX(key string, newKey string) *Batch {
+	return b.addCmd(C.RenameNX, []string{key, newKey}, batchConverter(convertBoolResponse))
+}
+
+// XAdd queues [GlideClient.XAdd] in the batch.
+func (b *Batch) XAdd(key string, values [][]string) *Batch {
+	return b.XAddWithOptions(key, values, *options.NewXAddOptions())
+}
+
+// XAddWithOptions queues [GlideClient.XAddWithOptions] in the batch.
+func (b *Batch) XAddWithOptions(key string, values [][]string, options options.XAddOptions) *Batch {
+	args := []string{}
+	args = append(args, key)
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionArgs...)
+	for _, pair := range values {
+		if len(pair) != 2 {
+			return b.addError(fmt.Errorf(
+				"array entry had the wrong length. Expected length 2 but got length %d",
+				len(pair),
+			))
+		}
+		args = append(args, pair...)
+	}
+	return b.addCmd(C.XAdd, args, batchConverter(convertStringOrNilResponse))
+}
+
+// XRead queues [GlideClient.XRead] in the batch.
+func (b *Batch) XRead(keysAndIds map[string]string) *Batch {
+	return b.XReadWithOptions(keysAndIds, *options.NewXReadOptions())
+}
+
+// XReadWithOptions queues [GlideClient.XReadWithOptions] in the batch.
+func (b *Batch) XReadWithOptions(keysAndIds map[string]string, opts options.XReadOptions) *Batch {
+	args, err := createStreamCommandArgs(make([]string, 0, 5+2*len(keysAndIds)), keysAndIds, &opts)
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.XRead, args, batchConverter(convertXReadResponse))
+}
+
+// XReadGroup queues [GlideClient.XReadGroup] in the batch.
+func (b *Batch) XReadGroup(group string, consumer string, keysAndIds map[string]string) *Batch {
+	return b.XReadGroupWithOptions(group, consumer, keysAndIds, *options.NewXReadGroupOptions())
+}
+
+// XReadGroupWithOptions queues [GlideClient.XReadGroupWithOptions] in the batch.
+func (b *Batch) XReadGroupWithOptions(
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+	opts options.XReadGroupOptions,
+) *Batch {
+	args, err := createStreamCommandArgs([]string{options.GroupKeyword, group, consumer}, keysAndIds, &opts)
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.XReadGroup, args, batchConverter(convertXReadGroupResponse))
+}
+
+// ZAdd queues [GlideClient.ZAdd] in the batch.
+func (b *Batch) ZAdd(key string, membersScoreMap map[string]float64) *Batch {
+	return b.addCmd(
+		C.ZAdd,
+		append([]string{key}, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
+		batchConverter(convertIntResponse),
+	)
+}
+
+// ZAddWithOptions queues [GlideClient.ZAddWithOptions] in the batch.
+func (b *Batch) ZAddWithOptions(key string, membersScoreMap map[string]float64, opts options.ZAddOptions) *Batch {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	commandArgs := append([]string{key}, optionArgs...)
+	return b.addCmd(
+		C.ZAdd,
+		append(commandArgs, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
+		batchConverter(convertIntResponse),
+	)
+}
+
+// ZAddIncr queues [GlideClient.ZAddIncr] in the batch.
+func (b *Batch) ZAddIncr(key string, member string, increment float64) *Batch {
+	options, err := options.NewZAddOptions().SetIncr(true, increment, member)
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.zAddIncrBase(key, options)
+}
+
+// ZAddIncrWithOptions queues [GlideClient.ZAddIncrWithOptions] in the batch.
+func (b *Batch) ZAddIncrWithOptions(key string, member string, increment float64, opts options.ZAddOptions) *Batch {
+	incrOpts, err := opts.SetIncr(true, increment, member)
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.zAddIncrBase(key, incrOpts)
+}
+
+// ZIncrBy queues [GlideClient.ZIncrBy] in the batch.
+func (b *Batch) ZIncrBy(key string, increment float64, member string) *Batch {
+	return b.addCmd(C.ZIncrBy, []string{key, utils.FloatToString(increment), member}, batchConverter(convertFloatResponse))
+}
+
+// ZPopMin queues [GlideClient.ZPopMin] in the batch.
+func (b *Batch) ZPopMin(key string) *Batch {
+	return b.addCmd(C.ZPopMin, []string{key}, batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZPopMinWithOptions queues [GlideClient.ZPopMinWithOptions] in the batch.
+func (b *Batch) ZPopMinWithOptions(key string, options options.ZPopOptions) *Batch {
+	optArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZPopMin, append([]string{key}, optArgs...), batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZPopMax queues [GlideClient.ZPopMax] in the batch.
+func (b *Batch) ZPopMax(key string) *Batch {
+	return b.addCmd(C.ZPopMax, []string{key}, batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZPopMaxWithOptions queues [GlideClient.ZPopMaxWithOptions] in the batch.
+func (b *Batch) ZPopMaxWithOptions(key string, options options.ZPopOptions) *Batch {
+	optArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZPopMax, append([]string{key}, optArgs...), batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZRem queues [GlideClient.ZRem] in the batch.
+func (b *Batch) ZRem(key string, members []string) *Batch {
+	return b.addCmd(C.ZRem, append([]string{key}, members...), batchConverter(convertIntResponse))
+}
+
+// ZCard queues [GlideClient.ZCard] in the batch.
+func (b *Batch) ZCard(key string) *Batch {
+	return b.addCmd(C.ZCard, []string{key}, batchConverter(convertIntResponse))
+}
+
+// BZPopMin queues [GlideClient.BZPopMin] in the batch.
+func (b *Batch) BZPopMin(keys []string, timeoutSecs float64) *Batch {
+	return b.addCmd(
+		C.BZPopMin,
+		append(keys, utils.FloatToString(timeoutSecs)),
+		batchConverter(convertKeyWithMemberAndScoreResponse),
+	)
+}
+
+// BZMPop queues [GlideClient.BZMPop] in the batch.
+func (b *Batch) BZMPop(keys []string, scoreFilter options.ScoreFilter, timeoutSecs float64) *Batch {
+	scoreFilterStr, err := scoreFilter.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	if len(keys) > math.MaxInt-3 {
+		return b.addError(&errors.RequestError{
+			Msg: "Length overflow for the provided keys",
+		})
+	}
+	args := make([]string, 0, len(keys)+3)
+	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, scoreFilterStr)
+	return b.addCmd(C.BZMPop, args, batchConverter(convertKeyWithArrayOfMembersAndScoresResponse))
+}
+
+// BZMPopWithOptions queues [GlideClient.BZMPopWithOptions] in the batch.
+func (b *Batch) BZMPopWithOptions(
+	keys []string,
+	scoreFilter options.ScoreFilter,
+	timeoutSecs float64,
+	opts options.ZMPopOptions,
+) *Batch {
+	scoreFilterStr, err := scoreFilter.ToString()
+	if err != nil {
+		return b.addError(err)
+	}
+	if len(keys) > math.MaxInt-5 {
+		return b.addError(&errors.RequestError{
+			Msg: "Length overflow for the provided keys",
+		})
+	}
+	args := make([]string, 0, len(keys)+5)
+	args = append(args, utils.FloatToString(timeoutSecs), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, scoreFilterStr)
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionArgs...)
+	return b.addCmd(C.BZMPop, args, batchConverter(convertKeyWithArrayOfMembersAndScoresResponse))
+}
+
+// ZRange queues [GlideClient.ZRange] in the batch.
+func (b *Batch) ZRange(key string, rangeQuery options.ZRangeQuery) *Batch {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+	queryArgs, err := rangeQuery.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, queryArgs...)
+	return b.addCmd(C.ZRange, args, batchConverter(convertStringArrayResponse))
+}
+
+// ZRangeWithScores queues [GlideClient.ZRangeWithScores] in the batch.
+func (b *Batch) ZRangeWithScores(key string, rangeQuery options.ZRangeQueryWithScores) *Batch {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+	queryArgs, err := rangeQuery.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, queryArgs...)
+	args = append(args, options.WithScoresKeyword)
+	return b.addCmd(C.ZRange, args, batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZRangeStore queues [GlideClient.ZRangeStore] in the batch.
+func (b *Batch) ZRangeStore(destination string, key string, rangeQuery options.ZRangeQuery) *Batch {
+	args := make([]string, 0, 10)
+	args = append(args, destination)
+	args = append(args, key)
+	rqArgs, err := rangeQuery.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, rqArgs...)
+	return b.addCmd(C.ZRangeStore, args, batchConverter(convertIntResponse))
+}
+
+// Persist queues [GlideClient.Persist] in the batch.
+func (b *Batch) Persist(key string) *Batch {
+	return b.addCmd(C.Persist, []string{key}, batchConverter(convertBoolResponse))
+}
+
+// ZCount queues [GlideClient.ZCount] in the batch.
+func (b *Batch) ZCount(key string, rangeOptions options.ZCountRange) *Batch {
+	zCountRangeArgs, err := rangeOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZCount, append([]string{key}, zCountRangeArgs...), batchConverter(convertIntResponse))
+}
+
+// ZRank queues [GlideClient.ZRank] in the batch.
+func (b *Batch) ZRank(key string, member string) *Batch {
+	return b.addCmd(C.ZRank, []string{key, member}, batchConverter(convertIntOrNilResponse))
+}
+
+// ZRankWithScore queues [GlideClient.ZRankWithScore] in the batch.
+func (b *Batch) ZRankWithScore(key string, member string) *Batch {
+	return b.addCmd(C.ZRank, []string{key, member, options.WithScoreKeyword}, convertRankWithScoreBatchResponse)
+}
+
+// ZRevRank queues [GlideClient.ZRevRank] in the batch.
+func (b *Batch) ZRevRank(key string, member string) *Batch {
+	return b.addCmd(C.ZRevRank, []string{key, member}, batchConverter(convertIntOrNilResponse))
+}
+
+// ZRevRankWithScore queues [GlideClient.ZRevRankWithScore] in the batch.
+func (b *Batch) ZRevRankWithScore(key string, member string) *Batch {
+	return b.addCmd(C.ZRevRank, []string{key, member, options.WithScoreKeyword}, convertRankWithScoreBatchResponse)
+}
+
+// XTrim queues [GlideClient.XTrim] in the batch.
+func (b *Batch) XTrim(key string, options options.XTrimOptions) *Batch {
+	xTrimArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.XTrim, append([]string{key}, xTrimArgs...), batchConverter(convertIntResponse))
+}
+
+// XLen queues [GlideClient.XLen] in the batch.
+func (b *Batch) XLen(key string) *Batch {
+	return b.addCmd(C.XLen, []string{key}, batchConverter(convertIntResponse))
+}
+
+// XAutoClaim queues [GlideClient.XAutoClaim] in the batch.
+func (b *Batch) XAutoClaim(key string, group string, consumer string, minIdleTime int64, start string) *Batch {
+	return b.XAutoClaimWithOptions(key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
+}
+
+// XAutoClaimWithOptions queues [GlideClient.XAutoClaimWithOptions] in the batch.
+func (b *Batch) XAutoClaimWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	options options.XAutoClaimOptions,
+) *Batch {
+	args := []string{key, group, consumer, utils.IntToString(minIdleTime), start}
+	optArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optArgs...)
+	return b.addCmd(C.XAutoClaim, args, batchConverter(convertXAutoClaimResponse))
+}
+
+// XAutoClaimJustId queues [GlideClient.XAutoClaimJustId] in the batch.
+func (b *Batch) XAutoClaimJustId(key string, group string, consumer string, minIdleTime int64, start string) *Batch {
+	return b.XAutoClaimJustIdWithOptions(key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
+}
+
+// XAutoClaimJustIdWithOptions queues [GlideClient.XAutoClaimJustIdWithOptions] in the batch.
+func (b *Batch) XAutoClaimJustIdWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	opts options.XAutoClaimOptions,
+) *Batch {
+	args := []string{key, group, consumer, utils.IntToString(minIdleTime), start}
+	optArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optArgs...)
+	args = append(args, options.JustIdKeyword)
+	return b.addCmd(C.XAutoClaim, args, batchConverter(convertXAutoClaimJustIdResponse))
+}
+
+// XDel queues [GlideClient.XDel] in the batch.
+func (b *Batch) XDel(key string, ids []string) *Batch {
+	return b.addCmd(C.XDel, append([]string{key}, ids...), batchConverter(convertIntResponse))
+}
+
+// ZScore queues [GlideClient.ZScore] in the batch.
+func (b *Batch) ZScore(key string, member string) *Batch {
+	return b.addCmd(C.ZScore, []string{key, member}, batchConverter(convertFloatOrNilResponse))
+}
+
+// ZScan queues [GlideClient.ZScan] in the batch.
+func (b *Batch) ZScan(key string, cursor string) *Batch {
+	return b.addCmd(C.ZScan, []string{key, cursor}, convertScanBatchResponse)
+}
+
+// ZScanWithOptions queues [GlideClient.ZScanWithOptions] in the batch.
+func (b *Batch) ZScanWithOptions(key string, cursor string, options options.ZScanOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZScan, append([]string{key, cursor}, optionArgs...), convertScanBatchResponse)
+}
+
+// XPending queues [GlideClient.XPending] in the batch.
+func (b *Batch) XPending(key string, group string) *Batch {
+	return b.addCmd(C.XPending, []string{key, group}, batchConverter(convertXPendingSummaryResponse))
+}
+
+// XPendingWithOptions queues [GlideClient.XPendingWithOptions] in the batch.
+func (b *Batch) XPendingWithOptions(key string, group string, opts options.XPendingOptions) *Batch {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, group}, optionArgs...)
+	return b.addCmd(C.XPending, args, batchConverter(convertXPendingDetailResponse))
+}
+
+// XGroupCreate queues [GlideClient.XGroupCreate] in the batch.
+func (b *Batch) XGroupCreate(key string, group string, id string) *Batch {
+	return b.XGroupCreateWithOptions(key, group, id, *options.NewXGroupCreateOptions())
+}
+
+// XGroupCreateWithOptions queues [GlideClient.XGroupCreateWithOptions] in the batch.
+func (b *Batch) XGroupCreateWithOptions(key string, group string, id string, opts options.XGroupCreateOptions) *Batch {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, group, id}, optionArgs...)
+	return b.addCmd(C.XGroupCreate, args, batchConverter(convertStringResponse))
+}
+
+// Restore queues [GlideClient.Restore] in the batch.
+func (b *Batch) Restore(key string, ttl int64, value string) *Batch {
+	return b.RestoreWithOptions(key, ttl, value, *options.NewRestoreOptions())
+}
+
+// RestoreWithOptions queues [GlideClient.RestoreWithOptions] in the batch.
+func (b *Batch) RestoreWithOptions(key string, ttl int64, value string, options options.RestoreOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.Restore, append([]string{
+		key,
+		utils.IntToString(ttl), value,
+	}, optionArgs...), batchConverter(convertStringOrNilResponse))
+}
+
+// Dump queues [GlideClient.Dump] in the batch.
+func (b *Batch) Dump(key string) *Batch {
+	return b.addCmd(C.Dump, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// ObjectEncoding queues [GlideClient.ObjectEncoding] in the batch.
+func (b *Batch) ObjectEncoding(key string) *Batch {
+	return b.addCmd(C.ObjectEncoding, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// Echo queues [GlideClient.Echo] in the batch.
+func (b *Batch) Echo(message string) *Batch {
+	return b.addCmd(C.Echo, []string{message}, batchConverter(convertStringOrNilResponse))
+}
+
+// XGroupDestroy queues [GlideClient.XGroupDestroy] in the batch.
+func (b *Batch) XGroupDestroy(key string, group string) *Batch {
+	return b.addCmd(C.XGroupDestroy, []string{key, group}, batchConverter(convertBoolResponse))
+}
+
+// XGroupSetId queues [GlideClient.XGroupSetId] in the batch.
+func (b *Batch) XGroupSetId(key string, group string, id string) *Batch {
+	return b.XGroupSetIdWithOptions(key, group, id, *options.NewXGroupSetIdOptionsOptions())
+}
+
+// XGroupSetIdWithOptions queues [GlideClient.XGroupSetIdWithOptions] in the batch.
+func (b *Batch) XGroupSetIdWithOptions(key string, group string, id string, opts options.XGroupSetIdOptions) *Batch {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, group, id}, optionArgs...)
+	return b.addCmd(C.XGroupSetId, args, batchConverter(convertStringResponse))
+}
+
+// ZRemRangeByLex queues [GlideClient.ZRemRangeByLex] in the batch.
+func (b *Batch) ZRemRangeByLex(key string, rangeQuery options.RangeByLex) *Batch {
+	queryArgs, err := rangeQuery.ToArgsRemRange()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZRemRangeByLex, append([]string{key}, queryArgs...), batchConverter(convertIntResponse))
+}
+
+// ZRemRangeByRank queues [GlideClient.ZRemRangeByRank] in the batch.
+func (b *Batch) ZRemRangeByRank(key string, start int64, stop int64) *Batch {
+	return b.addCmd(
+		C.ZRemRangeByRank,
+		[]string{key, utils.IntToString(start), utils.IntToString(stop)},
+		batchConverter(convertIntResponse),
+	)
+}
+
+// ZRemRangeByScore queues [GlideClient.ZRemRangeByScore] in the batch.
+func (b *Batch) ZRemRangeByScore(key string, rangeQuery options.RangeByScore) *Batch {
+	queryArgs, err := rangeQuery.ToArgsRemRange()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZRemRangeByScore, append([]string{key}, queryArgs...), batchConverter(convertIntResponse))
+}
+
+// ZRandMember queues [GlideClient.ZRandMember] in the batch.
+func (b *Batch) ZRandMember(key string) *Batch {
+	return b.addCmd(C.ZRandMember, []string{key}, batchConverter(convertStringOrNilResponse))
+}
+
+// ZRandMemberWithCount queues [GlideClient.ZRandMemberWithCount] in the batch.
+func (b *Batch) ZRandMemberWithCount(key string, count int64) *Batch {
+	return b.addCmd(C.ZRandMember, []string{key, utils.IntToString(count)}, batchConverter(convertStringArrayResponse))
+}
+
+// ZRandMemberWithCountWithScores queues [GlideClient.ZRandMemberWithCountWithScores] in the batch.
+func (b *Batch) ZRandMemberWithCountWithScores(key string, count int64) *Batch {
+	return b.addCmd(
+		C.ZRandMember,
+		[]string{key, utils.IntToString(count), options.WithScoresKeyword},
+		batchConverter(convertMemberAndScoreArrayResponse),
+	)
+}
+
+// ZMScore queues [GlideClient.ZMScore] in the batch.
+func (b *Batch) ZMScore(key string, members []string) *Batch {
+	return b.addCmd(C.ZMScore, append([]string{key}, members...), batchConverter(convertFloatOrNilArrayResponse))
+}
+
+// ObjectFreq queues [GlideClient.ObjectFreq] in the batch.
+func (b *Batch) ObjectFreq(key string) *Batch {
+	return b.addCmd(C.ObjectFreq, []string{key}, batchConverter(convertIntOrNilResponse))
+}
+
+// ObjectIdleTime queues [GlideClient.ObjectIdleTime] in the batch.
+func (b *Batch) ObjectIdleTime(key string) *Batch {
+	return b.addCmd(C.ObjectIdleTime, []string{key}, batchConverter(convertIntOrNilResponse))
+}
+
+// ObjectRefCount queues [GlideClient.ObjectRefCount] in the batch.
+func (b *Batch) ObjectRefCount(key string) *Batch {
+	return b.addCmd(C.ObjectRefCount, []string{key}, batchConverter(convertIntOrNilResponse))
+}
+
+// Sort queues [GlideClient.Sort] in the batch.
+func (b *Batch) Sort(key string) *Batch {
+	return b.addCmd(C.Sort, []string{key}, batchConverter(convertStringOrNilArray))
+}
+
+// SortWithOptions queues [GlideClient.SortWithOptions] in the batch.
+func (b *Batch) SortWithOptions(key string, options options.SortOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.Sort, append([]string{key}, optionArgs...), batchConverter(convertStringOrNilArray))
+}
+
+// SortReadOnly queues [GlideClient.SortReadOnly] in the batch.
+func (b *Batch) SortReadOnly(key string) *Batch {
+	return b.addCmd(C.SortReadOnly, []string{key}, batchConverter(convertStringOrNilArray))
+}
+
+// SortReadOnlyWithOptions queues [GlideClient.SortReadOnlyWithOptions] in the batch.
+func (b *Batch) SortReadOnlyWithOptions(key string, options options.SortOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.SortReadOnly, append([]string{key}, optionArgs...), batchConverter(convertStringOrNilArray))
+}
+
+// SortStore queues [GlideClient.SortStore] in the batch.
+func (b *Batch) SortStore(key string, destination string) *Batch {
+	return b.addCmd(C.Sort, []string{key, options.StoreKeyword, destination}, batchConverter(convertIntResponse))
+}
+
+// SortStoreWithOptions queues [GlideClient.SortStoreWithOptions] in the batch.
+func (b *Batch) SortStoreWithOptions(key string, destination string, opts options.SortOptions) *Batch {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(
+		C.Sort,
+		append([]string{key, options.StoreKeyword, destination}, optionArgs...),
+		batchConverter(convertIntResponse),
+	)
+}
+
+// XGroupCreateConsumer queues [GlideClient.XGroupCreateConsumer] in the batch.
+func (b *Batch) XGroupCreateConsumer(key string, group string, consumer string) *Batch {
+	return b.addCmd(C.XGroupCreateConsumer, []string{key, group, consumer}, batchConverter(convertBoolResponse))
+}
+
+// XGroupDelConsumer queues [GlideClient.XGroupDelConsumer] in the batch.
+func (b *Batch) XGroupDelConsumer(key string, group string, consumer string) *Batch {
+	return b.addCmd(C.XGroupDelConsumer, []string{key, group, consumer}, batchConverter(convertIntResponse))
+}
+
+// XAck queues [GlideClient.XAck] in the batch.
+func (b *Batch) XAck(key string, group string, ids []string) *Batch {
+	return b.addCmd(C.XAck, append([]string{key, group}, ids...), batchConverter(convertIntResponse))
+}
+
+// SetBit queues [GlideClient.SetBit] in the batch.
+func (b *Batch) SetBit(key string, offset int64, value int64) *Batch {
+	return b.addCmd(
+		C.SetBit,
+		[]string{key, utils.IntToString(offset), utils.IntToString(value)},
+		batchConverter(convertIntResponse),
+	)
+}
+
+// GetBit queues [GlideClient.GetBit] in the batch.
+func (b *Batch) GetBit(key string, offset int64) *Batch {
+	return b.addCmd(C.GetBit, []string{key, utils.IntToString(offset)}, batchConverter(convertIntResponse))
+}
+
+// Wait queues [GlideClient.Wait] in the batch.
+func (b *Batch) Wait(numberOfReplicas int64, timeout int64) *Batch {
+	return b.addCmd(
+		C.Wait,
+		[]string{utils.IntToString(numberOfReplicas), utils.IntToString(timeout)},
+		batchConverter(convertIntResponse),
+	)
+}
+
+// BitCount queues [GlideClient.BitCount] in the batch.
+func (b *Batch) BitCount(key string) *Batch {
+	return b.addCmd(C.BitCount, []string{key}, batchConverter(convertIntResponse))
+}
+
+// BitOp queues [GlideClient.BitOp] in the batch.
+func (b *Batch) BitOp(bitwiseOperation options.BitOpType, destination string, keys []string) *Batch {
+	bitOp, err := options.NewBitOp(bitwiseOperation, destination, keys)
+	if err != nil {
+		return b.addError(err)
+	}
+	args, err := bitOp.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.BitOp, args, batchConverter(convertIntResponse))
+}
+
+// BitCountWithOptions queues [GlideClient.BitCountWithOptions] in the batch.
+func (b *Batch) BitCountWithOptions(key string, opts options.BitCountOptions) *Batch {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	commandArgs := append([]string{key}, optionArgs...)
+	return b.addCmd(C.BitCount, commandArgs, batchConverter(convertIntResponse))
+}
+
+// XClaim queues [GlideClient.XClaim] in the batch.
+func (b *Batch) XClaim(key string, group string, consumer string, minIdleTime int64, ids []string) *Batch {
+	return b.XClaimWithOptions(key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+}
+
+// XClaimWithOptions queues [GlideClient.XClaimWithOptions] in the batch.
+func (b *Batch) XClaimWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	opts options.XClaimOptions,
+) *Batch {
+	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime)}, ids...)
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionArgs...)
+	return b.addCmd(C.XClaim, args, batchConverter(convertMapOfArrayOfStringArrayResponse))
+}
+
+// XClaimJustId queues [GlideClient.XClaimJustId] in the batch.
+func (b *Batch) XClaimJustId(key string, group string, consumer string, minIdleTime int64, ids []string) *Batch {
+	return b.XClaimJustIdWithOptions(key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+}
+
+// XClaimJustIdWithOptions queues [GlideClient.XClaimJustIdWithOptions] in the batch.
+func (b *Batch) XClaimJustIdWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	opts options.XClaimOptions,
+) *Batch {
+	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime)}, ids...)
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionArgs...)
+	args = append(args, options.JustIdKeyword)
+	return b.addCmd(C.XClaim, args, batchConverter(convertStringArrayResponse))
+}
+
+// BitPos queues [GlideClient.BitPos] in the batch.
+func (b *Batch) BitPos(key string, bit int64) *Batch {
+	return b.addCmd(C.BitPos, []string{key, utils.IntToString(bit)}, batchConverter(convertIntResponse))
+}
+
+// BitPosWithOptions queues [GlideClient.BitPosWithOptions] in the batch.
+func (b *Batch) BitPosWithOptions(key string, bit int64, bitposOptions options.BitPosOptions) *Batch {
+	optionArgs, err := bitposOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	commandArgs := append([]string{key, utils.IntToString(bit)}, optionArgs...)
+	return b.addCmd(C.BitPos, commandArgs, batchConverter(convertIntResponse))
+}
+
+// Copy queues [GlideClient.Copy] in the batch.
+func (b *Batch) Copy(source string, destination string) *Batch {
+	return b.addCmd(C.Copy, []string{source, destination}, batchConverter(convertBoolResponse))
+}
+
+// CopyWithOptions queues [GlideClient.CopyWithOptions] in the batch.
+func (b *Batch) CopyWithOptions(source string, destination string, options options.CopyOptions) *Batch {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.Copy, append([]string{
+		source, destination,
+	}, optionArgs...), batchConverter(convertBoolResponse))
+}
+
+// XRange queues [GlideClient.XRange] in the batch.
+func (b *Batch) XRange(key string, start options.StreamBoundary, end options.StreamBoundary) *Batch {
+	return b.XRangeWithOptions(key, start, end, *options.NewXRangeOptions())
+}
+
+// XRangeWithOptions queues [GlideClient.XRangeWithOptions] in the batch.
+func (b *Batch) XRangeWithOptions(
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) *Batch {
+	args := []string{key, string(start), string(end)}
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionArgs...)
+	return b.addCmd(C.XRange, args, batchConverter(convertXRangeResponse))
+}
+
+// XRevRange queues [GlideClient.XRevRange] in the batch.
+func (b *Batch) XRevRange(key string, start options.StreamBoundary, end options.StreamBoundary) *Batch {
+	return b.XRevRangeWithOptions(key, start, end, *options.NewXRangeOptions())
+}
+
+// XRevRangeWithOptions queues [GlideClient.XRevRangeWithOptions] in the batch.
+func (b *Batch) XRevRangeWithOptions(
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) *Batch {
+	args := []string{key, string(start), string(end)}
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionArgs...)
+	return b.addCmd(C.XRevRange, args, batchConverter(convertXRevRangeResponse))
+}
+
+// XInfoStream queues [GlideClient.XInfoStream] in the batch.
+func (b *Batch) XInfoStream(key string) *Batch {
+	return b.addCmd(C.XInfoStream, []string{key}, batchConverter(convertStringToAnyMapResponse))
+}
+
+// XInfoStreamFullWithOptions queues [GlideClient.XInfoStreamFullWithOptions] in the batch.
+func (b *Batch) XInfoStreamFullWithOptions(key string, opts *options.XInfoStreamOptions) *Batch {
+	args := []string{key, options.FullKeyword}
+	if opts != nil {
+		optionArgs, err := opts.ToArgs()
+		if err != nil {
+			return b.addError(err)
+		}
+		args = append(args, optionArgs...)
+	}
+	return b.addCmd(C.XInfoStream, args, batchConverter(convertStringToAnyMapResponse))
+}
+
+// XInfoConsumers queues [GlideClient.XInfoConsumers] in the batch.
+func (b *Batch) XInfoConsumers(key string, group string) *Batch {
+	return b.addCmd(C.XInfoConsumers, []string{key, group}, batchConverter(convertXInfoConsumersResponse))
+}
+
+// XInfoGroups queues [GlideClient.XInfoGroups] in the batch.
+func (b *Batch) XInfoGroups(key string) *Batch {
+	return b.addCmd(C.XInfoGroups, []string{key}, batchConverter(convertXInfoGroupsResponse))
+}
+
+// BitField queues [GlideClient.BitField] in the batch.
+func (b *Batch) BitField(key string, subCommands []options.BitFieldSubCommands) *Batch {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+	for _, cmd := range subCommands {
+		cmdArgs, err := cmd.ToArgs()
+		if err != nil {
+			return b.addError(err)
+		}
+		args = append(args, cmdArgs...)
+	}
+	return b.addCmd(C.BitField, args, batchConverter(convertIntOrNilArrayResponse))
+}
+
+// BitFieldRO queues [GlideClient.BitFieldRO] in the batch.
+func (b *Batch) BitFieldRO(key string, commands []options.BitFieldROCommands) *Batch {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+	for _, cmd := range commands {
+		cmdArgs, err := cmd.ToArgs()
+		if err != nil {
+			return b.addError(err)
+		}
+		args = append(args, cmdArgs...)
+	}
+	return b.addCmd(C.BitFieldReadOnly, args, batchConverter(convertIntOrNilArrayResponse))
+}
+
+// Time queues [GlideClient.Time] in the batch.
+func (b *Batch) Time() *Batch {
+	return b.addCmd(C.Time, []string{}, batchConverter(convertStringArrayResponse))
+}
+
+// ZInter queues [GlideClient.ZInter] in the batch.
+func (b *Batch) ZInter(keys options.KeyArray) *Batch {
+	args, err := keys.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZInter, args, batchConverter(convertStringArrayResponse))
+}
+
+// ZInterWithScores queues [GlideClient.ZInterWithScores] in the batch.
+func (b *Batch) ZInterWithScores(keysOrWeightedKeys options.KeysOrWeightedKeys, zInterOptions options.ZInterOptions) *Batch {
+	args, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	optionsArgs, err := zInterOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, options.WithScoresKeyword)
+	return b.addCmd(C.ZInter, args, batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZInterStore queues [GlideClient.ZInterStore] in the batch.
+func (b *Batch) ZInterStore(destination string, keysOrWeightedKeys options.KeysOrWeightedKeys) *Batch {
+	return b.ZInterStoreWithOptions(destination, keysOrWeightedKeys, *options.NewZInterOptions())
+}
+
+// ZInterStoreWithOptions queues [GlideClient.ZInterStoreWithOptions] in the batch.
+func (b *Batch) ZInterStoreWithOptions(
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zInterOptions options.ZInterOptions,
+) *Batch {
+	args, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append([]string{destination}, args...)
+	optionsArgs, err := zInterOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionsArgs...)
+	return b.addCmd(C.ZInterStore, args, batchConverter(convertIntResponse))
+}
+
+// ZDiff queues [GlideClient.ZDiff] in the batch.
+func (b *Batch) ZDiff(keys []string) *Batch {
+	args := append([]string{}, strconv.Itoa(len(keys)))
+	return b.addCmd(C.ZDiff, append(args, keys...), batchConverter(convertStringArrayResponse))
+}
+
+// ZDiffWithScores queues [GlideClient.ZDiffWithScores] in the batch.
+func (b *Batch) ZDiffWithScores(keys []string) *Batch {
+	args := append([]string{}, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	return b.addCmd(C.ZDiff, append(args, options.WithScoresKeyword), batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZDiffStore queues [GlideClient.ZDiffStore] in the batch.
+func (b *Batch) ZDiffStore(destination string, keys []string) *Batch {
+	return b.addCmd(
+		C.ZDiffStore,
+		append([]string{destination, strconv.Itoa(len(keys))}, keys...),
+		batchConverter(convertIntResponse),
+	)
+}
+
+// ZUnion queues [GlideClient.ZUnion] in the batch.
+func (b *Batch) ZUnion(keys options.KeyArray) *Batch {
+	args, err := keys.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	return b.addCmd(C.ZUnion, args, batchConverter(convertStringArrayResponse))
+}
+
+// ZUnionWithScores queues [GlideClient.ZUnionWithScores] in the batch.
+func (b *Batch) ZUnionWithScores(keysOrWeightedKeys options.KeysOrWeightedKeys, zUnionOptions *options.ZUnionOptions) *Batch {
+	args, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	optionsArgs, err := zUnionOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, options.WithScoresKeyword)
+	return b.addCmd(C.ZUnion, args, batchConverter(convertStringDoubleMapResponse))
+}
+
+// ZUnionStore queues [GlideClient.ZUnionStore] in the batch.
+func (b *Batch) ZUnionStore(destination string, keysOrWeightedKeys options.KeysOrWeightedKeys) *Batch {
+	return b.ZUnionStoreWithOptions(destination, keysOrWeightedKeys, nil)
+}
+
+// ZUnionStoreWithOptions queues [GlideClient.ZUnionStoreWithOptions] in the batch.
+func (b *Batch) ZUnionStoreWithOptions(
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zUnionOptions *options.ZUnionOptions,
+) *Batch {
+	keysArgs, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args := append([]string{destination}, keysArgs...)
+	if zUnionOptions != nil {
+		optionsArgs, err := zUnionOptions.ToArgs()
+		if err != nil {
+			return b.addError(err)
+		}
+		args = append(args, optionsArgs...)
+	}
+	return b.addCmd(C.ZUnionStore, args, batchConverter(convertIntResponse))
+}
+
+// ZInterCard queues [GlideClient.ZInterCard] in the batch.
+func (b *Batch) ZInterCard(keys []string) *Batch {
+	return b.ZInterCardWithOptions(keys, nil)
+}
+
+// ZInterCardWithOptions queues [GlideClient.ZInterCardWithOptions] in the batch.
+func (b *Batch) ZInterCardWithOptions(keys []string, options *options.ZInterCardOptions) *Batch {
+	args := append([]string{strconv.Itoa(len(keys))}, keys...)
+	if options != nil {
+		optionsArgs, err := options.ToArgs()
+		if err != nil {
+			return b.addError(err)
+		}
+		args = append(args, optionsArgs...)
+	}
+	return b.addCmd(C.ZInterCard, args, batchConverter(convertIntResponse))
+}
+
+// ZLexCount queues [GlideClient.ZLexCount] in the batch.
+func (b *Batch) ZLexCount(key string, rangeQuery *options.RangeByLex) *Batch {
+	args := []string{key}
+	args = append(args, rangeQuery.ToArgsLexCount()...)
+	return b.addCmd(C.ZLexCount, args, batchConverter(convertIntResponse))
+}
+
+// GeoAdd queues [GlideClient.GeoAdd] in the batch.
+func (b *Batch) GeoAdd(key string, membersToGeospatialData map[string]options.GeospatialData) *Batch {
+	return b.addCmd(
+		C.GeoAdd,
+		append([]string{key}, options.MapGeoDataToArray(membersToGeospatialData)...),
+		batchConverter(convertIntResponse),
+	)
+}
+
+// GeoAddWithOptions queues [GlideClient.GeoAddWithOptions] in the batch.
+func (b *Batch) GeoAddWithOptions(
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+	geoAddOptions options.GeoAddOptions,
+) *Batch {
+	args := []string{key}
+	optionsArgs, err := geoAddOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, options.MapGeoDataToArray(membersToGeospatialData)...)
+	return b.addCmd(C.GeoAdd, args, batchConverter(convertIntResponse))
+}
+
+// GeoHash queues [GlideClient.GeoHash] in the batch.
+func (b *Batch) GeoHash(key string, members []string) *Batch {
+	return b.addCmd(C.GeoHash, append([]string{key}, members...), batchConverter(convertStringArrayResponse))
+}
+
+// GeoPos queues [GlideClient.GeoPos] in the batch.
+func (b *Batch) GeoPos(key string, members []string) *Batch {
+	args := []string{key}
+	args = append(args, members...)
+	return b.addCmd(C.GeoPos, args, batchConverter(convert2DFloat64OrNullArrayResponse))
+}
+
+// GeoDist queues [GlideClient.GeoDist] in the batch.
+func (b *Batch) GeoDist(key string, member1 string, member2 string) *Batch {
+	return b.addCmd(C.GeoDist, []string{key, member1, member2}, batchConverter(convertFloatOrNilResponse))
+}
+
+// GeoDistWithUnit queues [GlideClient.GeoDistWithUnit] in the batch.
+func (b *Batch) GeoDistWithUnit(key string, member1 string, member2 string, unit options.GeoUnit) *Batch {
+	return b.addCmd(C.GeoDist, []string{key, member1, member2, string(unit)}, batchConverter(convertFloatOrNilResponse))
+}
+
+// GeoSearchWithFullOptions queues [GlideClient.GeoSearchWithFullOptions] in the batch.
+func (b *Batch) GeoSearchWithFullOptions(
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchInfoOptions,
+) *Batch {
+	args := []string{key}
+	searchFromArgs, err := searchFrom.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, searchFromArgs...)
+	searchByShapeArgs, err := searchByShape.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, searchByShapeArgs...)
+	infoOptionsArgs, err := infoOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, infoOptionsArgs...)
+	resultOptionsArgs, err := resultOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, resultOptionsArgs...)
+	return b.addCmd(C.GeoSearch, args, batchConverter(convertLocationArray))
+}
+
+// GeoSearchWithResultOptions queues [GlideClient.GeoSearchWithResultOptions] in the batch.
+func (b *Batch) GeoSearchWithResultOptions(
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) *Batch {
+	args := []string{key}
+	searchFromArgs, err := searchFrom.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, searchFromArgs...)
+	searchByShapeArgs, err := searchByShape.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, searchByShapeArgs...)
+	resultOptionsArgs, err := resultOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, resultOptionsArgs...)
+	return b.addCmd(C.GeoSearch, args, batchConverter(convertStringArrayResponse))
+}
+
+// GeoSearchWithInfoOptions queues [GlideClient.GeoSearchWithInfoOptions] in the batch.
+func (b *Batch) GeoSearchWithInfoOptions(
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchInfoOptions,
+) *Batch {
+	return b.GeoSearchWithFullOptions(key, searchFrom, searchByShape, *options.NewGeoSearchResultOptions(), infoOptions)
+}
+
+// GeoSearch queues [GlideClient.GeoSearch] in the batch.
+func (b *Batch) GeoSearch(key string, searchFrom options.GeoSearchOrigin, searchByShape options.GeoSearchShape) *Batch {
+	return b.GeoSearchWithResultOptions(key, searchFrom, searchByShape, *options.NewGeoSearchResultOptions())
+}
+
+// GeoSearchStoreWithFullOptions queues [GlideClient.GeoSearchStoreWithFullOptions] in the batch.
+func (b *Batch) GeoSearchStoreWithFullOptions(
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchStoreInfoOptions,
+) *Batch {
+	args := []string{destinationKey, sourceKey}
+	searchFromArgs, err := searchFrom.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, searchFromArgs...)
+	searchByShapeArgs, err := searchByShape.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, searchByShapeArgs...)
+	resultOptionsArgs, err := resultOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, resultOptionsArgs...)
+	infoOptionsArgs, err := infoOptions.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+	args = append(args, infoOptionsArgs...)
+	return b.addCmd(C.GeoSearchStore, args, batchConverter(convertIntResponse))
+}
+
+// GeoSearchStore queues [GlideClient.GeoSearchStore] in the batch.
+func (b *Batch) GeoSearchStore(
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) *Batch {
+	return b.GeoSearchStoreWithFullOptions(
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		*options.NewGeoSearchResultOptions(),
+		*options.NewGeoSearchStoreInfoOptions(),
+	)
+}
+
+// GeoSearchStoreWithResultOptions queues [GlideClient.GeoSearchStoreWithResultOptions] in the batch.
+func (b *Batch) GeoSearchStoreWithResultOptions(
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) *Batch {
+	return b.GeoSearchStoreWithFullOptions(
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		resultOptions,
+		*options.NewGeoSearchStoreInfoOptions(),
+	)
+}
+
+// GeoSearchStoreWithInfoOptions queues [GlideClient.GeoSearchStoreWithInfoOptions] in the batch.
+func (b *Batch) GeoSearchStoreWithInfoOptions(
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchStoreInfoOptions,
+) *Batch {
+	return b.GeoSearchStoreWithFullOptions(
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		*options.NewGeoSearchResultOptions(),
+		infoOptions,
+	)
+}
+
+// FunctionLoad queues [GlideClient.FunctionLoad] in the batch.
+func (b *Batch) FunctionLoad(libraryCode string, replace bool) *Batch {
+	args := []string{}
+	if replace {
+		args = append(args, options.ReplaceKeyword)
+	}
+	args = append(args, libraryCode)
+	return b.addCmd(C.FunctionLoad, args, batchConverter(convertStringResponse))
+}
+
+// FunctionFlush queues [GlideClient.FunctionFlush] in the batch.
+func (b *Batch) FunctionFlush() *Batch {
+	return b.addCmd(C.FunctionFlush, []string{}, batchConverter(convertStringResponse))
+}
+
+// FunctionFlushSync queues [GlideClient.FunctionFlushSync] in the batch.
+func (b *Batch) FunctionFlushSync() *Batch {
+	return b.addCmd(C.FunctionFlush, []string{string(options.SYNC)}, batchConverter(convertStringResponse))
+}
+
+// FunctionFlushAsync queues [GlideClient.FunctionFlushAsync] in the batch.
+func (b *Batch) FunctionFlushAsync() *Batch {
+	return b.addCmd(C.FunctionFlush, []string{string(options.ASYNC)}, batchConverter(convertStringResponse))
+}
+
+// FCall queues [GlideClient.FCall] in the batch.
+func (b *Batch) FCall(function string) *Batch {
+	return b.addCmd(C.FCall, []string{function, utils.IntToString(0)}, batchConverter(convertAnyResponse))
+}
+
+// FCallReadOnly queues [GlideClient.FCallReadOnly] in the batch.
+func (b *Batch) FCallReadOnly(function string) *Batch {
+	return b.addCmd(C.FCallReadOnly, []string{function, utils.IntToString(0)}, batchConverter(convertAnyResponse))
+}
+
+// FCallWithKeysAndArgs queues [GlideClient.FCallWithKeysAndArgs] in the batch.
+func (b *Batch) FCallWithKeysAndArgs(function string, keys []string, args []string) *Batch {
+	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
+	cmdArgs = append(cmdArgs, keys...)
+	cmdArgs = append(cmdArgs, args...)
+	return b.addCmd(C.FCall, cmdArgs, batchConverter(convertAnyResponse))
+}
+
+// FCallReadOnlyWithKeysAndArgs queues [GlideClient.FCallReadOnlyWithKeysAndArgs] in the batch.
+func (b *Batch) FCallReadOnlyWithKeysAndArgs(function string, keys []string, args []string) *Batch {
+	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
+	cmdArgs = append(cmdArgs, keys...)
+	cmdArgs = append(cmdArgs, args...)
+	return b.addCmd(C.FCallReadOnly, cmdArgs, batchConverter(convertAnyResponse))
+}
+
+func (b *Batch) zAddIncrBase(key string, opts *options.ZAddOptions) *Batch {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError(err)
+	}
+
+	return b.addCmd(C.ZAdd, append([]string{key}, optionArgs...), batchConverter(convertFloatOrNilResponse))
+}
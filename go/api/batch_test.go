@@ -0,0 +1,160 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func ExampleGlideClient_Exec() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	key := uuid.New().String()
+	batch := NewBatch(true).
+		Set(key, "1").
+		Incr(key).
+		Get(key)
+	result, err := client.Exec(context.Background(), batch, true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [OK 2 {2 false}]
+}
+
+func ExampleGlideClient_ExecWithOptions() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	key := uuid.New().String()
+	batch := NewBatch(false).
+		LPush(key, []string{"a", "b"}).
+		LRange(key, 0, -1)
+	result, err := client.ExecWithOptions(context.Background(), batch, true, options.BatchOptions{Timeout: 1000})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [2 [b a]]
+}
+
+func ExampleGlideClusterClient_Exec() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	key := "{key}-" + uuid.New().String()
+	batch := NewBatch(true).
+		Set(key, "hello").
+		Append(key, " world").
+		Get(key)
+	result, err := client.Exec(context.Background(), batch, true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [OK 11 {hello world false}]
+}
+
+func ExampleGlideClusterClient_ExecWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	key1 := uuid.New().String()
+	key2 := uuid.New().String()
+	batch := NewBatch(false).
+		Set(key1, "value1").
+		Set(key2, "value2").
+		Get(key1).
+		Get(key2)
+	opts := options.ClusterBatchOptions{
+		Timeout:       1000,
+		RetryStrategy: &options.ClusterBatchRetryStrategy{RetryServerError: true},
+	}
+	result, err := client.ExecWithOptions(context.Background(), batch, true, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [OK OK {value1 false} {value2 false}]
+}
+
+func ExampleGlideClient_Watch() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	key := uuid.New().String()
+	client.Set(context.Background(), key, "1")
+
+	// a check-and-set loop, the transaction is retried until the watched key is not modified by another client
+	for {
+		_, err := client.Watch(context.Background(), []string{key})
+		if err != nil {
+			fmt.Println("Glide example failed with an error: ", err)
+			return
+		}
+		value, _ := client.Get(context.Background(), key)
+		counter, _ := strconv.ParseInt(value.Value(), 10, 64)
+		result, err := client.Exec(context.Background(), NewBatch(true).Set(key, strconv.FormatInt(counter+1, 10)), true)
+		if _, ok := err.(*errors.TransactionAbortedError); ok {
+			continue
+		}
+		if err != nil {
+			fmt.Println("Glide example failed with an error: ", err)
+			return
+		}
+		fmt.Println(result)
+		break
+	}
+
+	// Output: [OK]
+}
+
+func ExampleGlideClient_Unwatch() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	key := uuid.New().String()
+	client.Watch(context.Background(), []string{key})
+	result, err := client.Unwatch(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_Watch() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	key := "{key}-" + uuid.New().String()
+	result, err := client.Watch(context.Background(), []string{key})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_Unwatch() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.Unwatch(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_UnwatchWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.UnwatchWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
@@ -0,0 +1,92 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands of the "Cluster" group for cluster client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#cluster
+type ClusterManagementCommands interface {
+	ClusterShards(ctx context.Context) ([]ShardInfo, error)
+
+	ClusterShardsWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[[]ShardInfo], error)
+
+	ClusterNodes(ctx context.Context) ([]ClusterNode, error)
+
+	ClusterNodesWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[[]ClusterNode], error)
+
+	ClusterSlots(ctx context.Context) ([]SlotInfo, error)
+
+	ClusterSlotsWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[[]SlotInfo], error)
+
+	ClusterInfo(ctx context.Context) (map[string]string, error)
+
+	ClusterInfoWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[map[string]string], error)
+
+	ClusterMyId(ctx context.Context) (string, error)
+
+	ClusterMyIdWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[string], error)
+
+	ClusterMyShardId(ctx context.Context) (string, error)
+
+	ClusterMyShardIdWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[string], error)
+
+	ClusterKeySlot(ctx context.Context, key string) (int64, error)
+
+	ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error)
+
+	ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error)
+
+	ClusterAddSlots(ctx context.Context, slots []int64, route *config.ByAddressRoute) (string, error)
+
+	ClusterAddSlotsRange(ctx context.Context, slotRanges []SlotRange, route *config.ByAddressRoute) (string, error)
+
+	ClusterDelSlots(ctx context.Context, slots []int64, route *config.ByAddressRoute) (string, error)
+
+	ClusterDelSlotsRange(ctx context.Context, slotRanges []SlotRange, route *config.ByAddressRoute) (string, error)
+
+	ClusterSetSlot(
+		ctx context.Context,
+		slot int64,
+		action options.ClusterSetSlotAction,
+		route *config.ByAddressRoute,
+	) (string, error)
+
+	ClusterMeet(ctx context.Context, ip string, port int64, route *config.ByAddressRoute) (string, error)
+
+	ClusterForget(ctx context.Context, nodeId string, route *config.ByAddressRoute) (string, error)
+
+	ClusterReplicate(ctx context.Context, nodeId string, route *config.ByAddressRoute) (string, error)
+
+	ClusterFailover(ctx context.Context, route *config.ByAddressRoute) (string, error)
+
+	ClusterFailoverWithMode(
+		ctx context.Context,
+		mode options.ClusterFailoverMode,
+		route *config.ByAddressRoute,
+	) (string, error)
+
+	ClusterReset(ctx context.Context, mode options.ClusterResetMode, route *config.ByAddressRoute) (string, error)
+
+	ClusterSaveConfig(ctx context.Context, route *config.ByAddressRoute) (string, error)
+
+	ClusterBumpEpoch(ctx context.Context, route *config.ByAddressRoute) (string, error)
+
+	Migrate(
+		ctx context.Context,
+		host string,
+		port int64,
+		key string,
+		timeout int64,
+		migrateOptions *options.MigrateOptions,
+		route *config.ByAddressRoute,
+	) (string, error)
+}
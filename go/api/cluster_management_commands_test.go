@@ -0,0 +1,276 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func ExampleGlideClusterClient_ClusterShards() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	shards, err := client.ClusterShards(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	slots := int64(0)
+	for _, shard := range shards {
+		for _, slotRange := range shard.Slots {
+			slots += slotRange.End - slotRange.Start + 1
+		}
+	}
+	fmt.Println(slots)
+
+	// Output: 16384
+}
+
+func ExampleGlideClusterClient_ClusterNodes() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	nodes, err := client.ClusterNodes(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	primaries := 0
+	for _, node := range nodes {
+		if node.PrimaryId == "" {
+			primaries++
+		}
+	}
+	fmt.Println(primaries)
+
+	// Output: 3
+}
+
+func ExampleGlideClusterClient_ClusterSlots() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	slots, err := client.ClusterSlots(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(slots[0].Range.Start)
+
+	// Output: 0
+}
+
+func ExampleGlideClusterClient_ClusterInfo() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	info, err := client.ClusterInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(info["cluster_state"])
+
+	// Output: ok
+}
+
+func ExampleGlideClusterClient_ClusterMyIdWithRoute() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	ids, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(ids.MultiValue()))
+
+	// Output: 3
+}
+
+func ExampleGlideClusterClient_ClusterKeySlot() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	slot, err := client.ClusterKeySlot(context.Background(), "{user1000}.following")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(slot)
+
+	// Output: 3443
+}
+
+func ExampleGlideClusterClient_ClusterCountKeysInSlot() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	client.Set(context.Background(), "{user1000}.following", "value")
+	count, err := client.ClusterCountKeysInSlot(context.Background(), 3443)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	keys, err := client.ClusterGetKeysInSlot(context.Background(), 3443, 10)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(count)
+	fmt.Println(keys)
+
+	// Output:
+	// 1
+	// [{user1000}.following]
+}
+
+func TestParseClusterNodes(t *testing.T) {
+	nodes, err := parseClusterNodes(
+		"07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004,host4 slave " +
+			"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 1426238317239 4 connected\n" +
+			"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected " +
+			"0-5460 5500 [5461->-67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1]\n",
+	)
+	assert.NoError(t, err)
+	expected := []ClusterNode{
+		{
+			Id:             "07c37dfeb235213a872192d90877d0cd55635b91",
+			Ip:             "127.0.0.1",
+			Port:           30004,
+			ClusterBusPort: 31004,
+			Hostname:       "host4",
+			Flags:          []string{"slave"},
+			PrimaryId:      "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca",
+			PongReceived:   1426238317239,
+			ConfigEpoch:    4,
+			LinkState:      "connected",
+			Slots:          []SlotRange{},
+		},
+		{
+			Id:             "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca",
+			Ip:             "127.0.0.1",
+			Port:           30001,
+			ClusterBusPort: 31001,
+			Flags:          []string{"myself", "master"},
+			ConfigEpoch:    1,
+			LinkState:      "connected",
+			Slots:          []SlotRange{{Start: 0, End: 5460}, {Start: 5500, End: 5500}},
+		},
+	}
+	assert.Equal(t, expected, nodes)
+
+	_, err = parseClusterNodes("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 master")
+	assert.Error(t, err)
+}
+
+func TestParseClusterInfo(t *testing.T) {
+	info, err := parseClusterInfo("cluster_state:ok\r\ncluster_slots_assigned:16384\r\ncluster_known_nodes:6\r\n")
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string]string{"cluster_state": "ok", "cluster_slots_assigned": "16384", "cluster_known_nodes": "6"},
+		info,
+	)
+}
+
+func TestParseShardInfos(t *testing.T) {
+	shards, err := parseShardInfos([]any{
+		map[string]any{
+			"slots": []any{int64(0), int64(5460), int64(5500), int64(5500)},
+			"nodes": []any{
+				// with RESP2, the maps are received as flat arrays
+				[]any{
+					"id", "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", "port", int64(30001), "ip", "127.0.0.1",
+					"endpoint", "127.0.0.1", "role", "master", "replication-offset", int64(72156), "health", "online",
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := []ShardInfo{{
+		Slots: []SlotRange{{Start: 0, End: 5460}, {Start: 5500, End: 5500}},
+		Nodes: []ShardNode{{
+			Id:                "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca",
+			Endpoint:          "127.0.0.1",
+			Ip:                "127.0.0.1",
+			Port:              30001,
+			Role:              "master",
+			ReplicationOffset: 72156,
+			Health:            "online",
+		}},
+	}}
+	assert.Equal(t, expected, shards)
+
+	_, err = parseShardInfos([]any{map[string]any{"slots": "0-5460", "nodes": []any{}}})
+	assert.Error(t, err)
+}
+
+func TestParseSlotInfos(t *testing.T) {
+	slots, err := parseSlotInfos([]any{
+		[]any{
+			int64(0),
+			int64(5460),
+			[]any{"127.0.0.1", int64(30001), "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", map[string]any{}},
+			[]any{"127.0.0.1", int64(30004), "07c37dfeb235213a872192d90877d0cd55635b91", map[string]any{"hostname": "host4"}},
+		},
+	})
+	assert.NoError(t, err)
+	expected := []SlotInfo{{
+		Range:   SlotRange{Start: 0, End: 5460},
+		Primary: SlotNode{Endpoint: "127.0.0.1", Port: 30001, Id: "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca"},
+		Replicas: []SlotNode{
+			{Endpoint: "127.0.0.1", Port: 30004, Id: "07c37dfeb235213a872192d90877d0cd55635b91", Hostname: "host4"},
+		},
+	}}
+	assert.Equal(t, expected, slots)
+}
+
+func ExampleGlideClusterClient_ClusterSetSlot() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	slots, err := client.ClusterSlots(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	primary := slots[0].Primary
+	route := config.NewByAddressRoute(primary.Endpoint, int32(primary.Port))
+	// clears the importing and migrating states of the slot, if any
+	result, err := client.ClusterSetSlot(context.Background(), slots[0].Range.Start, options.NewClusterSetSlotStable(), route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_ClusterSaveConfig() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+
+	slots, err := client.ClusterSlots(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	route := config.NewByAddressRoute(slots[0].Primary.Endpoint, int32(slots[0].Primary.Port))
+	result, err := client.ClusterSaveConfig(context.Background(), route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func TestClusterSetSlotAction_ToArgs(t *testing.T) {
+	assert.Equal(t, []string{"IMPORTING", "id1"}, options.NewClusterSetSlotImporting("id1").ToArgs())
+	assert.Equal(t, []string{"MIGRATING", "id2"}, options.NewClusterSetSlotMigrating("id2").ToArgs())
+	assert.Equal(t, []string{"NODE", "id3"}, options.NewClusterSetSlotNode("id3").ToArgs())
+	assert.Equal(t, []string{"STABLE"}, options.NewClusterSetSlotStable().ToArgs())
+}
+
+func TestMigrateOptions_ToArgs(t *testing.T) {
+	var nilOptions *options.MigrateOptions
+	assert.Equal(t, []string{}, nilOptions.ToArgs())
+	assert.Equal(
+		t,
+		[]string{"COPY", "REPLACE", "AUTH", "secret", "KEYS", "key1", "key2"},
+		options.NewMigrateOptions().SetCopy().SetReplace().SetAuth("secret").SetKeys([]string{"key1", "key2"}).ToArgs(),
+	)
+	assert.Equal(
+		t,
+		[]string{"AUTH2", "user", "secret"},
+		options.NewMigrateOptions().SetAuth2("user", "secret").ToArgs(),
+	)
+}
@@ -89,6 +89,24 @@ func mapReadFrom(readFrom ReadFrom) protobuf.ReadFrom {
 	return protobuf.ReadFrom_Primary
 }
 
+// ProtocolVersion represents the serialization protocol used to communicate with the server.
+type ProtocolVersion int
+
+const (
+	// RESP3 - Use RESP3 to communicate with the server nodes.
+	RESP3 ProtocolVersion = iota
+	// RESP2 - Use RESP2 to communicate with the server nodes. Pub/sub subscriptions are not supported with RESP2.
+	RESP2
+)
+
+func mapProtocol(protocol ProtocolVersion) protobuf.ProtocolVersion {
+	if protocol == RESP2 {
+		return protobuf.ProtocolVersion_RESP2
+	}
+
+	return protobuf.ProtocolVersion_RESP3
+}
+
 type baseClientConfiguration struct {
 	addresses      []NodeAddress
 	useTLS         bool
@@ -97,6 +115,11 @@ type baseClientConfiguration struct {
 	requestTimeout int
 	clientName     string
 	clientAZ       string
+	subscriptions  *baseSubscriptionConfig
+	protocol       ProtocolVersion
+	// the connection timeout of a connection URL, which is kept apart from the advanced configuration so that it is not
+	// dropped by WithAdvancedConfiguration, and is overridden by the connection timeout of the advanced configuration
+	urlConnectionTimeout int
 }
 
 func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest, error) {
@@ -128,6 +151,19 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.ClientAz = config.clientAZ
 	}
 
+	request.Protocol = mapProtocol(config.protocol)
+
+	if config.urlConnectionTimeout != 0 {
+		request.ConnectionTimeout = uint32(config.urlConnectionTimeout)
+	}
+
+	if subscriptions := config.subscriptionConfig(); subscriptions != nil {
+		if config.protocol == RESP2 {
+			return nil, errors.New("pub/sub subscriptions require RESP3 protocol, but RESP2 was configured")
+		}
+		request.PubsubSubscriptions = subscriptions.toProtobuf()
+	}
+
 	if request.ReadFrom == protobuf.ReadFrom_AZAffinity ||
 		request.ReadFrom == protobuf.ReadFrom_AZAffinityReplicasAndPrimary {
 		if config.clientAZ == "" {
@@ -138,6 +174,15 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 	return &request, nil
 }
 
+// subscriptionConfig returns the pub/sub subscriptions of the client, or nil if there are none, in which case the client
+// has no message handler.
+func (config *baseClientConfiguration) subscriptionConfig() *baseSubscriptionConfig {
+	if config.subscriptions == nil || len(config.subscriptions.subscriptions) == 0 {
+		return nil
+	}
+	return config.subscriptions
+}
+
 // BackoffStrategy represents the strategy used to determine how and when to reconnect, in case of connection failures. The
 // time between attempts grows exponentially, to the formula:
 //
@@ -199,8 +244,8 @@ func (config *GlideClientConfiguration) toProtobuf() (*protobuf.ConnectionReques
 		request.DatabaseId = uint32(config.databaseId)
 	}
 
-	if config.AdvancedGlideClientConfiguration.connectionTimeout != 0 {
-		request.ConnectionTimeout = uint32(config.AdvancedGlideClientConfiguration.connectionTimeout)
+	if err := config.AdvancedGlideClientConfiguration.toProtobuf(request); err != nil {
+		return nil, err
 	}
 
 	return request, nil
@@ -260,6 +305,13 @@ func (config *GlideClientConfiguration) WithClientName(clientName string) *Glide
 	return config
 }
 
+// WithProtocol sets the serialization protocol used to communicate with the server. If not set, [RESP3] will be used.
+// The responses are converted to the same Go types with both protocols.
+func (config *GlideClientConfiguration) WithProtocol(protocol ProtocolVersion) *GlideClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithClientAZ sets the client's Availability Zone (AZ) to be used for the client.
 func (config *GlideClientConfiguration) WithClientAZ(clientAZ string) *GlideClientConfiguration {
 	config.clientAZ = clientAZ
@@ -279,6 +331,19 @@ func (config *GlideClientConfiguration) WithDatabaseId(id int) *GlideClientConfi
 	return config
 }
 
+// WithSubscriptionConfig sets the pub/sub subscriptions of the client. See [StandaloneSubscriptionConfig] for details. A nil
+// configuration, or a configuration without channels and patterns, removes the subscriptions.
+func (config *GlideClientConfiguration) WithSubscriptionConfig(
+	subscriptionConfig *StandaloneSubscriptionConfig,
+) *GlideClientConfiguration {
+	if subscriptionConfig == nil {
+		config.subscriptions = nil
+		return config
+	}
+	config.subscriptions = &subscriptionConfig.baseSubscriptionConfig
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *GlideClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedGlideClientConfiguration,
@@ -287,12 +352,57 @@ func (config *GlideClientConfiguration) WithAdvancedConfiguration(
 	return config
 }
 
+// PeriodicChecks represents the periodic checks of the cluster topology, which detect changes of the topology and refresh
+// the slots map of the client when needed.
+type PeriodicChecks struct {
+	disabled       bool
+	manualInterval bool
+	durationInSec  int
+}
+
+// NewPeriodicChecksEnabledDefaultConfigs returns [PeriodicChecks] which are enabled with the default interval.
+func NewPeriodicChecksEnabledDefaultConfigs() *PeriodicChecks {
+	return &PeriodicChecks{}
+}
+
+// NewPeriodicChecksManualInterval returns [PeriodicChecks] which are enabled with the given interval in seconds. The
+// interval must be positive.
+func NewPeriodicChecksManualInterval(durationInSec int) *PeriodicChecks {
+	return &PeriodicChecks{manualInterval: true, durationInSec: durationInSec}
+}
+
+// NewPeriodicChecksDisabled returns [PeriodicChecks] which are disabled. The topology is still refreshed when the client
+// detects a topology change, for example when a MOVED error is received.
+func NewPeriodicChecksDisabled() *PeriodicChecks {
+	return &PeriodicChecks{disabled: true}
+}
+
+func (checks *PeriodicChecks) toProtobuf(request *protobuf.ConnectionRequest) error {
+	if checks.disabled {
+		request.PeriodicChecks = &protobuf.ConnectionRequest_PeriodicChecksDisabled{
+			PeriodicChecksDisabled: &protobuf.PeriodicChecksDisabled{},
+		}
+		return nil
+	}
+
+	if checks.manualInterval {
+		if checks.durationInSec <= 0 {
+			return errors.New("periodic checks interval must be positive")
+		}
+		request.PeriodicChecks = &protobuf.ConnectionRequest_PeriodicChecksManualInterval{
+			PeriodicChecksManualInterval: &protobuf.PeriodicChecksManualInterval{DurationInSec: uint32(checks.durationInSec)},
+		}
+	}
+	return nil
+}
+
 // GlideClusterClientConfiguration represents the configuration settings for a Cluster Glide client.
 // Note: Currently, the reconnection strategy in cluster mode is not configurable, and exponential backoff with fixed values is
 // used.
 type GlideClusterClientConfiguration struct {
 	baseClientConfiguration
 	AdvancedGlideClusterClientConfiguration
+	periodicChecks *PeriodicChecks
 }
 
 // NewGlideClusterClientConfiguration returns a [GlideClusterClientConfiguration] with default configuration settings. For
@@ -310,8 +420,13 @@ func (config *GlideClusterClientConfiguration) toProtobuf() (*protobuf.Connectio
 		return nil, err
 	}
 	request.ClusterModeEnabled = true
-	if (config.AdvancedGlideClusterClientConfiguration.connectionTimeout) != 0 {
-		request.ConnectionTimeout = uint32(config.AdvancedGlideClusterClientConfiguration.connectionTimeout)
+	if err := config.AdvancedGlideClusterClientConfiguration.toProtobuf(request); err != nil {
+		return nil, err
+	}
+	if config.periodicChecks != nil {
+		if err := config.periodicChecks.toProtobuf(request); err != nil {
+			return nil, err
+		}
 	}
 	return request, nil
 }
@@ -372,12 +487,47 @@ func (config *GlideClusterClientConfiguration) WithClientName(clientName string)
 	return config
 }
 
+// WithProtocol sets the serialization protocol used to communicate with the server. If not set, [RESP3] will be used.
+// The responses are converted to the same Go types with both protocols.
+func (config *GlideClusterClientConfiguration) WithProtocol(protocol ProtocolVersion) *GlideClusterClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithClientAZ sets the client's Availability Zone (AZ) to be used for the client.
 func (config *GlideClusterClientConfiguration) WithClientAZ(clientAZ string) *GlideClusterClientConfiguration {
 	config.clientAZ = clientAZ
 	return config
 }
 
+// WithSubscriptionConfig sets the pub/sub subscriptions of the client. See [ClusterSubscriptionConfig] for details. A nil
+// configuration, or a configuration without channels, patterns and shard channels, removes the subscriptions.
+func (config *GlideClusterClientConfiguration) WithSubscriptionConfig(
+	subscriptionConfig *ClusterSubscriptionConfig,
+) *GlideClusterClientConfiguration {
+	if subscriptionConfig == nil {
+		config.subscriptions = nil
+		return config
+	}
+	config.subscriptions = &subscriptionConfig.baseSubscriptionConfig
+	return config
+}
+
+// WithPeriodicChecks sets the [PeriodicChecks] of the cluster topology. If not set, the periodic checks are enabled with
+// the default interval.
+//
+// For example:
+//
+//	config := NewGlideClusterClientConfiguration().
+//	    WithAddress(&NodeAddress{Host: "localhost", Port: 7001}).
+//	    WithPeriodicChecks(NewPeriodicChecksManualInterval(30))
+func (config *GlideClusterClientConfiguration) WithPeriodicChecks(
+	periodicChecks *PeriodicChecks,
+) *GlideClusterClientConfiguration {
+	config.periodicChecks = periodicChecks
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *GlideClusterClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedGlideClusterClientConfiguration,
@@ -389,7 +539,102 @@ func (config *GlideClusterClientConfiguration) WithAdvancedConfiguration(
 // Advanced configuration settings class for creating a client. Shared settings for standalone and
 // cluster clients.
 type AdvancedBaseClientConfiguration struct {
-	connectionTimeout int
+	connectionTimeout     int
+	inflightRequestsLimit int
+	tlsConfig             *TlsConfiguration
+	statsExporter         StatisticsExporter
+	commandInterceptors   []Interceptor
+	eventListener         ConnectionEventListener
+}
+
+func (config *AdvancedBaseClientConfiguration) statisticsExporter() StatisticsExporter {
+	return config.statsExporter
+}
+
+func (config *AdvancedBaseClientConfiguration) interceptors() []Interceptor {
+	return config.commandInterceptors
+}
+
+func (config *AdvancedBaseClientConfiguration) connectionEventListener() ConnectionEventListener {
+	return config.eventListener
+}
+
+func (config *AdvancedBaseClientConfiguration) toProtobuf(request *protobuf.ConnectionRequest) error {
+	if config.connectionTimeout != 0 {
+		request.ConnectionTimeout = uint32(config.connectionTimeout)
+	}
+
+	if config.inflightRequestsLimit < 0 {
+		return errors.New("inflight requests limit must be positive")
+	}
+	if config.inflightRequestsLimit != 0 {
+		request.InflightRequestsLimit = uint32(config.inflightRequestsLimit)
+	}
+
+	if config.tlsConfig != nil {
+		return config.tlsConfig.toProtobuf(request)
+	}
+	return nil
+}
+
+// TlsConfiguration represents the advanced TLS settings of the client, used in [AdvancedGlideClientConfiguration] and
+// [AdvancedGlideClusterClientConfiguration]. The settings apply only when TLS is enabled with WithUseTLS.
+//
+// For example:
+//
+//	caCert, err := os.ReadFile("/path/to/ca.crt")
+//	tlsConfig := api.NewTlsConfiguration().WithRootCertificates(caCert)
+type TlsConfiguration struct {
+	useInsecureTLS    bool
+	rootCertificates  []byte
+	clientCertificate []byte
+	clientKey         []byte
+}
+
+// NewTlsConfiguration returns a [TlsConfiguration] which verifies the server certificates with the system root
+// certificates.
+func NewTlsConfiguration() *TlsConfiguration {
+	return &TlsConfiguration{}
+}
+
+// WithInsecureTLS sets whether the certificates of the server are verified. When set to true, the certificates and the
+// hostnames of the server are not verified, which makes the connection vulnerable to man-in-the-middle attacks. It should
+// only be used for development, for example with self-signed certificates.
+func (config *TlsConfiguration) WithInsecureTLS(useInsecureTLS bool) *TlsConfiguration {
+	config.useInsecureTLS = useInsecureTLS
+	return config
+}
+
+// WithRootCertificates sets the root certificates used to verify the certificates of the server, instead of the system
+// root certificates. The certificates are given in PEM format, and may contain multiple certificates.
+func (config *TlsConfiguration) WithRootCertificates(rootCertificates []byte) *TlsConfiguration {
+	config.rootCertificates = rootCertificates
+	return config
+}
+
+// WithClientCertificate sets the certificate and the private key the client authenticates with to the server, for mutual
+// TLS. Both are given in PEM format.
+func (config *TlsConfiguration) WithClientCertificate(certificate []byte, key []byte) *TlsConfiguration {
+	config.clientCertificate = certificate
+	config.clientKey = key
+	return config
+}
+
+func (config *TlsConfiguration) toProtobuf(request *protobuf.ConnectionRequest) error {
+	if request.TlsMode == protobuf.TlsMode_NoTls {
+		return errors.New("TLS configuration requires TLS to be enabled with WithUseTLS")
+	}
+	if (len(config.clientCertificate) == 0) != (len(config.clientKey) == 0) {
+		return errors.New("client certificate and client key must be set together")
+	}
+
+	if config.useInsecureTLS {
+		request.TlsMode = protobuf.TlsMode_InsecureTls
+	}
+	request.RootCerts = config.rootCertificates
+	request.ClientCert = config.clientCertificate
+	request.ClientKey = config.clientKey
+	return nil
 }
 
 // Represents advanced configuration settings for a Standalone [GlideClient] used in [GlideClientConfiguration].
@@ -414,6 +659,51 @@ func (config *AdvancedGlideClientConfiguration) WithConnectionTimeout(
 	return config
 }
 
+// WithInflightRequestsLimit sets the maximum number of concurrent requests of the client which are waiting for a response.
+// Requests exceeding the limit are rejected with an `errors.InflightRequestsLimitError` without being sent to the server.
+// If not set, the number of inflight requests is not limited.
+func (config *AdvancedGlideClientConfiguration) WithInflightRequestsLimit(
+	inflightRequestsLimit int,
+) *AdvancedGlideClientConfiguration {
+	config.inflightRequestsLimit = inflightRequestsLimit
+	return config
+}
+
+// WithTlsConfiguration sets the advanced TLS settings of the client. See [TlsConfiguration] for details.
+func (config *AdvancedGlideClientConfiguration) WithTlsConfiguration(
+	tlsConfig *TlsConfiguration,
+) *AdvancedGlideClientConfiguration {
+	config.tlsConfig = tlsConfig
+	return config
+}
+
+// WithStatisticsExporter sets an exporter receiving each completed request of the client, in addition to the statistics
+// returned by the Statistics method of the client. See [StatisticsExporter] for details.
+func (config *AdvancedGlideClientConfiguration) WithStatisticsExporter(
+	exporter StatisticsExporter,
+) *AdvancedGlideClientConfiguration {
+	config.statsExporter = exporter
+	return config
+}
+
+// WithInterceptors sets the interceptors called around each command sent by the client. The first interceptor is the
+// outermost one, which is called first and returns last. See [Interceptor] for details.
+func (config *AdvancedGlideClientConfiguration) WithInterceptors(
+	interceptors ...Interceptor,
+) *AdvancedGlideClientConfiguration {
+	config.commandInterceptors = interceptors
+	return config
+}
+
+// WithConnectionEventListener sets the listener notified when the connections of the client to the server are established,
+// lost and reestablished. See [ConnectionEventListener] for details.
+func (config *AdvancedGlideClientConfiguration) WithConnectionEventListener(
+	listener ConnectionEventListener,
+) *AdvancedGlideClientConfiguration {
+	config.eventListener = listener
+	return config
+}
+
 // Represents advanced configuration settings for a Standalone [GlideClusterClient] used in
 // [GlideClusterClientConfiguration].
 type AdvancedGlideClusterClientConfiguration struct {
@@ -432,3 +722,198 @@ func (config *AdvancedGlideClusterClientConfiguration) WithConnectionTimeout(
 	config.connectionTimeout = connectionTimeout
 	return config
 }
+
+// WithInflightRequestsLimit sets the maximum number of concurrent requests of the client which are waiting for a response.
+// Requests exceeding the limit are rejected with an `errors.InflightRequestsLimitError` without being sent to the server.
+// If not set, the number of inflight requests is not limited.
+func (config *AdvancedGlideClusterClientConfiguration) WithInflightRequestsLimit(
+	inflightRequestsLimit int,
+) *AdvancedGlideClusterClientConfiguration {
+	config.inflightRequestsLimit = inflightRequestsLimit
+	return config
+}
+
+// WithTlsConfiguration sets the advanced TLS settings of the client. See [TlsConfiguration] for details.
+func (config *AdvancedGlideClusterClientConfiguration) WithTlsConfiguration(
+	tlsConfig *TlsConfiguration,
+) *AdvancedGlideClusterClientConfiguration {
+	config.tlsConfig = tlsConfig
+	return config
+}
+
+// WithStatisticsExporter sets an exporter receiving each completed request of the client, in addition to the statistics
+// returned by the Statistics method of the client. See [StatisticsExporter] for details.
+func (config *AdvancedGlideClusterClientConfiguration) WithStatisticsExporter(
+	exporter StatisticsExporter,
+) *AdvancedGlideClusterClientConfiguration {
+	config.statsExporter = exporter
+	return config
+}
+
+// WithInterceptors sets the interceptors called around each command sent by the client. The first interceptor is the
+// outermost one, which is called first and returns last. See [Interceptor] for details.
+func (config *AdvancedGlideClusterClientConfiguration) WithInterceptors(
+	interceptors ...Interceptor,
+) *AdvancedGlideClusterClientConfiguration {
+	config.commandInterceptors = interceptors
+	return config
+}
+
+// WithConnectionEventListener sets the listener notified when the connections of the client to the cluster nodes are
+// established, lost and reestablished, and when the topology of the cluster changes. See [ConnectionEventListener] for
+// details.
+func (config *AdvancedGlideClusterClientConfiguration) WithConnectionEventListener(
+	listener ConnectionEventListener,
+) *AdvancedGlideClusterClientConfiguration {
+	config.eventListener = listener
+	return config
+}
+
+// PubSubChannelMode is the subscription mode of a channel or pattern of a [StandaloneSubscriptionConfig].
+type PubSubChannelMode int
+
+const (
+	// ExactChannelMode subscribes to a channel by its exact name.
+	ExactChannelMode PubSubChannelMode = iota
+	// PatternChannelMode subscribes to all channels matching a glob-style pattern.
+	PatternChannelMode
+)
+
+// PubSubClusterChannelMode is the subscription mode of a channel or pattern of a [ClusterSubscriptionConfig].
+type PubSubClusterChannelMode int
+
+const (
+	// ExactClusterChannelMode subscribes to a channel by its exact name.
+	ExactClusterChannelMode PubSubClusterChannelMode = iota
+	// PatternClusterChannelMode subscribes to all channels matching a glob-style pattern.
+	PatternClusterChannelMode
+	// ShardedClusterChannelMode subscribes to a shard channel by its exact name. Requires Valkey 7.0 or above.
+	ShardedClusterChannelMode
+)
+
+// baseSubscriptionConfig holds the subscriptions and the message delivery settings shared by the standalone and cluster
+// subscription configurations.
+type baseSubscriptionConfig struct {
+	subscriptions map[protobuf.PubSubChannelType][]string
+	callback      MessageCallback
+	userContext   any
+}
+
+func (config *baseSubscriptionConfig) addSubscription(channelType protobuf.PubSubChannelType, channelOrPattern string) {
+	if config.subscriptions == nil {
+		config.subscriptions = make(map[protobuf.PubSubChannelType][]string)
+	}
+	config.subscriptions[channelType] = append(config.subscriptions[channelType], channelOrPattern)
+}
+
+func (config *baseSubscriptionConfig) toProtobuf() *protobuf.PubSubSubscriptions {
+	request := &protobuf.PubSubSubscriptions{
+		ChannelsOrPatternsByType: make(map[uint32]*protobuf.PubSubChannelsOrPatterns),
+	}
+	for channelType, channelsOrPatterns := range config.subscriptions {
+		channels := &protobuf.PubSubChannelsOrPatterns{}
+		for _, channelOrPattern := range channelsOrPatterns {
+			channels.ChannelsOrPatterns = append(channels.ChannelsOrPatterns, []byte(channelOrPattern))
+		}
+		request.ChannelsOrPatternsByType[uint32(channelType)] = channels
+	}
+	return request
+}
+
+// StandaloneSubscriptionConfig represents the pub/sub subscriptions of a [GlideClient], used in [GlideClientConfiguration].
+// The client subscribes to the configured channels and patterns when it connects, and resubscribes on reconnection.
+//
+// Received messages are delivered to the callback set with [StandaloneSubscriptionConfig.WithCallback] if one is set.
+// Otherwise, they are queued in the client and can be retrieved with [GlideClient.GetPubSubMessage] and
+// [GlideClient.TryGetPubSubMessage].
+//
+// For example:
+//
+//	subscriptionConfig := api.NewStandaloneSubscriptionConfig().
+//	    WithSubscription(api.ExactChannelMode, "news").
+//	    WithSubscription(api.PatternChannelMode, "events.*")
+type StandaloneSubscriptionConfig struct {
+	baseSubscriptionConfig
+}
+
+// NewStandaloneSubscriptionConfig returns a [StandaloneSubscriptionConfig] without subscriptions.
+func NewStandaloneSubscriptionConfig() *StandaloneSubscriptionConfig {
+	return &StandaloneSubscriptionConfig{}
+}
+
+// WithSubscription adds a channel or a pattern to subscribe to, according to the given mode. WithSubscription can be called
+// multiple times to add multiple subscriptions.
+func (config *StandaloneSubscriptionConfig) WithSubscription(
+	mode PubSubChannelMode,
+	channelOrPattern string,
+) *StandaloneSubscriptionConfig {
+	channelType := protobuf.PubSubChannelType_Exact
+	if mode == PatternChannelMode {
+		channelType = protobuf.PubSubChannelType_Pattern
+	}
+	config.addSubscription(channelType, channelOrPattern)
+	return config
+}
+
+// WithCallback sets the callback invoked for each received message. The given userContext is passed to each invocation of
+// the callback. The callback is invoked sequentially from a dedicated goroutine, in the order in which the messages were
+// received. If no callback is set, received messages are queued in the client.
+func (config *StandaloneSubscriptionConfig) WithCallback(
+	callback MessageCallback,
+	userContext any,
+) *StandaloneSubscriptionConfig {
+	config.callback = callback
+	config.userContext = userContext
+	return config
+}
+
+// ClusterSubscriptionConfig represents the pub/sub subscriptions of a [GlideClusterClient], used in
+// [GlideClusterClientConfiguration]. The client subscribes to the configured channels, patterns and shard channels when it
+// connects, and resubscribes on reconnection and on topology changes.
+//
+// Received messages are delivered to the callback set with [ClusterSubscriptionConfig.WithCallback] if one is set.
+// Otherwise, they are queued in the client and can be retrieved with [GlideClusterClient.GetPubSubMessage] and
+// [GlideClusterClient.TryGetPubSubMessage].
+//
+// For example:
+//
+//	subscriptionConfig := api.NewClusterSubscriptionConfig().
+//	    WithSubscription(api.ExactClusterChannelMode, "news").
+//	    WithSubscription(api.ShardedClusterChannelMode, "orders")
+type ClusterSubscriptionConfig struct {
+	baseSubscriptionConfig
+}
+
+// NewClusterSubscriptionConfig returns a [ClusterSubscriptionConfig] without subscriptions.
+func NewClusterSubscriptionConfig() *ClusterSubscriptionConfig {
+	return &ClusterSubscriptionConfig{}
+}
+
+// WithSubscription adds a channel, a pattern or a shard channel to subscribe to, according to the given mode.
+// WithSubscription can be called multiple times to add multiple subscriptions.
+func (config *ClusterSubscriptionConfig) WithSubscription(
+	mode PubSubClusterChannelMode,
+	channelOrPattern string,
+) *ClusterSubscriptionConfig {
+	channelType := protobuf.PubSubChannelType_Exact
+	switch mode {
+	case PatternClusterChannelMode:
+		channelType = protobuf.PubSubChannelType_Pattern
+	case ShardedClusterChannelMode:
+		channelType = protobuf.PubSubChannelType_Sharded
+	}
+	config.addSubscription(channelType, channelOrPattern)
+	return config
+}
+
+// WithCallback sets the callback invoked for each received message. The given userContext is passed to each invocation of
+// the callback. The callback is invoked sequentially from a dedicated goroutine, in the order in which the messages were
+// received. If no callback is set, received messages are queued in the client.
+func (config *ClusterSubscriptionConfig) WithCallback(
+	callback MessageCallback,
+	userContext any,
+) *ClusterSubscriptionConfig {
+	config.callback = callback
+	config.userContext = userContext
+	return config
+}
@@ -59,7 +59,8 @@ func TestConfig_allFieldsSet(t *testing.T) {
 		WithRequestTimeout(timeout).
 		WithClientName(clientName).
 		WithReconnectStrategy(NewBackoffStrategy(retries, factor, base)).
-		WithDatabaseId(databaseId)
+		WithDatabaseId(databaseId).
+		WithProtocol(RESP2)
 
 	expected := &protobuf.ConnectionRequest{
 		TlsMode:            protobuf.TlsMode_SecureTls,
@@ -74,6 +75,7 @@ func TestConfig_allFieldsSet(t *testing.T) {
 			ExponentBase:    uint32(base),
 		},
 		DatabaseId: uint32(databaseId),
+		Protocol:   protobuf.ProtocolVersion_RESP2,
 	}
 
 	assert.Equal(t, len(hosts), len(ports))
@@ -173,3 +175,200 @@ func TestConfig_AzAffinity(t *testing.T) {
 
 	assert.Equal(t, expected, result)
 }
+
+func TestStandaloneConfig_subscriptions(t *testing.T) {
+	subscriptionConfig := NewStandaloneSubscriptionConfig().
+		WithSubscription(ExactChannelMode, "channel1").
+		WithSubscription(ExactChannelMode, "channel2").
+		WithSubscription(PatternChannelMode, "pattern*")
+	config := NewGlideClientConfiguration().WithSubscriptionConfig(subscriptionConfig)
+
+	expected := &protobuf.PubSubSubscriptions{
+		ChannelsOrPatternsByType: map[uint32]*protobuf.PubSubChannelsOrPatterns{
+			uint32(protobuf.PubSubChannelType_Exact): {
+				ChannelsOrPatterns: [][]byte{[]byte("channel1"), []byte("channel2")},
+			},
+			uint32(protobuf.PubSubChannelType_Pattern): {
+				ChannelsOrPatterns: [][]byte{[]byte("pattern*")},
+			},
+		},
+	}
+
+	result, err := config.toProtobuf()
+	if err != nil {
+		t.Fatalf("Failed to convert config to protobuf: %v", err)
+	}
+
+	assert.Equal(t, expected, result.PubsubSubscriptions)
+}
+
+func TestClusterConfig_subscriptions(t *testing.T) {
+	subscriptionConfig := NewClusterSubscriptionConfig().
+		WithSubscription(ExactClusterChannelMode, "channel").
+		WithSubscription(PatternClusterChannelMode, "pattern*").
+		WithSubscription(ShardedClusterChannelMode, "shard")
+	config := NewGlideClusterClientConfiguration().WithSubscriptionConfig(subscriptionConfig)
+
+	expected := &protobuf.PubSubSubscriptions{
+		ChannelsOrPatternsByType: map[uint32]*protobuf.PubSubChannelsOrPatterns{
+			uint32(protobuf.PubSubChannelType_Exact): {
+				ChannelsOrPatterns: [][]byte{[]byte("channel")},
+			},
+			uint32(protobuf.PubSubChannelType_Pattern): {
+				ChannelsOrPatterns: [][]byte{[]byte("pattern*")},
+			},
+			uint32(protobuf.PubSubChannelType_Sharded): {
+				ChannelsOrPatterns: [][]byte{[]byte("shard")},
+			},
+		},
+	}
+
+	result, err := config.toProtobuf()
+	if err != nil {
+		t.Fatalf("Failed to convert config to protobuf: %v", err)
+	}
+
+	assert.Equal(t, expected, result.PubsubSubscriptions)
+}
+
+func TestConfig_emptySubscriptions(t *testing.T) {
+	standaloneConfig := NewGlideClientConfiguration().WithSubscriptionConfig(nil)
+	assert.Nil(t, standaloneConfig.subscriptionConfig())
+	result, err := standaloneConfig.toProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, result.PubsubSubscriptions)
+
+	// the subscriptions are removed by a nil configuration
+	clusterConfig := NewGlideClusterClientConfiguration().
+		WithSubscriptionConfig(NewClusterSubscriptionConfig().WithSubscription(ExactClusterChannelMode, "channel")).
+		WithSubscriptionConfig(nil)
+	assert.Nil(t, clusterConfig.subscriptionConfig())
+
+	// a configuration without channels does not subscribe, even with RESP2
+	standaloneConfig = NewGlideClientConfiguration().
+		WithProtocol(RESP2).
+		WithSubscriptionConfig(NewStandaloneSubscriptionConfig().WithCallback(func(*PubSubMessage, any) {}, nil))
+	assert.Nil(t, standaloneConfig.subscriptionConfig())
+	result, err = standaloneConfig.toProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, result.PubsubSubscriptions)
+}
+
+func TestConfig_protocol(t *testing.T) {
+	result, err := NewGlideClusterClientConfiguration().WithProtocol(RESP2).toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ProtocolVersion_RESP2, result.Protocol)
+
+	result, err = NewGlideClientConfiguration().WithProtocol(RESP3).toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ProtocolVersion_RESP3, result.Protocol)
+
+	// pub/sub subscriptions require RESP3
+	_, err = NewGlideClientConfiguration().
+		WithProtocol(RESP2).
+		WithSubscriptionConfig(NewStandaloneSubscriptionConfig().WithSubscription(ExactChannelMode, "channel")).
+		toProtobuf()
+	assert.Error(t, err)
+
+	_, err = NewGlideClusterClientConfiguration().
+		WithProtocol(RESP2).
+		WithSubscriptionConfig(NewClusterSubscriptionConfig().WithSubscription(ExactClusterChannelMode, "channel")).
+		toProtobuf()
+	assert.Error(t, err)
+}
+
+func TestClusterConfig_periodicChecks(t *testing.T) {
+	result, err := NewGlideClusterClientConfiguration().
+		WithPeriodicChecks(NewPeriodicChecksEnabledDefaultConfigs()).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.Nil(t, result.PeriodicChecks)
+
+	result, err = NewGlideClusterClientConfiguration().
+		WithPeriodicChecks(NewPeriodicChecksManualInterval(30)).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, &protobuf.PeriodicChecksManualInterval{DurationInSec: 30}, result.GetPeriodicChecksManualInterval())
+
+	result, err = NewGlideClusterClientConfiguration().
+		WithPeriodicChecks(NewPeriodicChecksDisabled()).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.NotNil(t, result.GetPeriodicChecksDisabled())
+
+	for _, interval := range []int{0, -1} {
+		_, err = NewGlideClusterClientConfiguration().
+			WithPeriodicChecks(NewPeriodicChecksManualInterval(interval)).
+			toProtobuf()
+		assert.Error(t, err)
+	}
+}
+
+func TestConfig_inflightRequestsLimit(t *testing.T) {
+	result, err := NewGlideClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedGlideClientConfiguration().WithInflightRequestsLimit(10)).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), result.InflightRequestsLimit)
+
+	result, err = NewGlideClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedGlideClusterClientConfiguration().WithInflightRequestsLimit(20)).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(20), result.InflightRequestsLimit)
+
+	_, err = NewGlideClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedGlideClientConfiguration().WithInflightRequestsLimit(-1)).
+		toProtobuf()
+	assert.Error(t, err)
+
+	_, err = NewGlideClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedGlideClusterClientConfiguration().WithInflightRequestsLimit(-1)).
+		toProtobuf()
+	assert.Error(t, err)
+}
+
+func TestConfig_tlsConfiguration(t *testing.T) {
+	rootCerts := []byte("root certificates")
+	clientCert := []byte("client certificate")
+	clientKey := []byte("client key")
+
+	result, err := NewGlideClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedGlideClientConfiguration().WithTlsConfiguration(
+			NewTlsConfiguration().WithRootCertificates(rootCerts).WithClientCertificate(clientCert, clientKey),
+		)).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.TlsMode_SecureTls, result.TlsMode)
+	assert.Equal(t, rootCerts, result.RootCerts)
+	assert.Equal(t, clientCert, result.ClientCert)
+	assert.Equal(t, clientKey, result.ClientKey)
+
+	result, err = NewGlideClusterClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedGlideClusterClientConfiguration().WithTlsConfiguration(
+			NewTlsConfiguration().WithInsecureTLS(true),
+		)).
+		toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.TlsMode_InsecureTls, result.TlsMode)
+	assert.Nil(t, result.RootCerts)
+
+	// TLS must be enabled
+	_, err = NewGlideClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedGlideClientConfiguration().WithTlsConfiguration(
+			NewTlsConfiguration().WithInsecureTLS(true),
+		)).
+		toProtobuf()
+	assert.Error(t, err)
+
+	// the client certificate requires a key
+	_, err = NewGlideClusterClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedGlideClusterClientConfiguration().WithTlsConfiguration(
+			NewTlsConfiguration().WithClientCertificate(clientCert, nil),
+		)).
+		toProtobuf()
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+	"unsafe"
+)
+
+// ConnectionEventType is the type of a [ConnectionEvent].
+type ConnectionEventType int
+
+const (
+	// ConnectionEventConnected - A connection to a node was established for the first time, such as when the client is
+	// created or when a node joins the cluster.
+	ConnectionEventConnected ConnectionEventType = iota
+	// ConnectionEventDisconnected - The connection to a node was lost. The client tries to reconnect in the background, and
+	// the requests sent to the node fail until the connection is reestablished.
+	ConnectionEventDisconnected
+	// ConnectionEventReconnected - The connection to a node was reestablished after it was lost.
+	ConnectionEventReconnected
+	// ConnectionEventTopologyChanged - The topology of the cluster changed, such as after a failover, a slot migration, or
+	// a node joining or leaving the cluster. It is only reported by cluster clients.
+	ConnectionEventTopologyChanged
+)
+
+// String returns the name of the event type.
+func (eventType ConnectionEventType) String() string {
+	switch eventType {
+	case ConnectionEventConnected:
+		return "Connected"
+	case ConnectionEventDisconnected:
+		return "Disconnected"
+	case ConnectionEventReconnected:
+		return "Reconnected"
+	case ConnectionEventTopologyChanged:
+		return "TopologyChanged"
+	default:
+		return "Unknown"
+	}
+}
+
+// ConnectionEvent is a change in the state of the connections of a client, reported to its [ConnectionEventListener].
+type ConnectionEvent struct {
+	// The type of the event.
+	Type ConnectionEventType
+	// The address of the node, in the "host:port" format. It is empty for [ConnectionEventTopologyChanged].
+	Address string
+}
+
+// ConnectionEventListener is notified of the changes in the state of the connections of a client, such as the loss of the
+// connection to a node, before it is noticed by the requests sent to the node. It is set with WithConnectionEventListener in
+// the advanced configuration of the client.
+//
+// The events are delivered in order, one at a time, from a dedicated goroutine. The events received before the client is
+// closed are still delivered after it is closed.
+type ConnectionEventListener func(event ConnectionEvent)
+
+// connectionEventHandler receives the connection events of a client and delivers them to its listener.
+type connectionEventHandler struct {
+	id       uintptr
+	listener ConnectionEventListener
+	queue    *unboundedQueue[ConnectionEvent]
+}
+
+var (
+	connectionEventHandlersMu sync.RWMutex
+	connectionEventHandlers   = make(map[uintptr]*connectionEventHandler)
+)
+
+// newConnectionEventHandler creates and registers a handler delivering the connection events of the client with the given
+// id to listener.
+func newConnectionEventHandler(clientId uintptr, listener ConnectionEventListener) *connectionEventHandler {
+	handler := &connectionEventHandler{
+		id:       clientId,
+		listener: listener,
+		queue:    newUnboundedQueue[ConnectionEvent]("The connection event handler is closed."),
+	}
+
+	connectionEventHandlersMu.Lock()
+	connectionEventHandlers[handler.id] = handler
+	connectionEventHandlersMu.Unlock()
+
+	go handler.run()
+	return handler
+}
+
+// run delivers the events to the listener until the handler is closed and all the events are delivered.
+func (handler *connectionEventHandler) run() {
+	for {
+		event, err := handler.queue.pop(context.Background())
+		if err != nil {
+			return
+		}
+		handler.listener(event)
+	}
+}
+
+// close unregisters the handler. Events received before the handler was closed are still delivered to the listener.
+func (handler *connectionEventHandler) close() {
+	connectionEventHandlersMu.Lock()
+	delete(connectionEventHandlers, handler.id)
+	connectionEventHandlersMu.Unlock()
+
+	handler.queue.close()
+}
+
+//export connectionEventCallback
+func connectionEventCallback(clientId C.uintptr_t, kind C.ConnectionEventKind, cAddress *C.uint8_t, addressLen C.int64_t) {
+	connectionEventHandlersMu.RLock()
+	handler := connectionEventHandlers[uintptr(clientId)]
+	connectionEventHandlersMu.RUnlock()
+	if handler == nil {
+		// the client was closed
+		return
+	}
+
+	event := ConnectionEvent{Type: ConnectionEventType(kind)}
+	if cAddress != nil {
+		event.Address = C.GoStringN((*C.char)(unsafe.Pointer(cAddress)), C.int(addressLen))
+	}
+	handler.queue.push(event)
+}
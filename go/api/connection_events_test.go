@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionEventHandler_deliversEventsInOrder(t *testing.T) {
+	received := make(chan ConnectionEvent, 3)
+	handler := newConnectionEventHandler(newClientId(), func(event ConnectionEvent) {
+		received <- event
+	})
+	defer handler.close()
+
+	expected := []ConnectionEvent{
+		{Type: ConnectionEventDisconnected, Address: "localhost:6379"},
+		{Type: ConnectionEventReconnected, Address: "localhost:6379"},
+		{Type: ConnectionEventTopologyChanged},
+	}
+	for _, event := range expected {
+		handler.queue.push(event)
+	}
+	for _, event := range expected {
+		select {
+		case actual := <-received:
+			assert.Equal(t, event, actual)
+		case <-time.After(time.Second):
+			t.Fatal("The listener was not invoked")
+		}
+	}
+}
+
+func TestConnectionEventHandler_close(t *testing.T) {
+	received := make(chan ConnectionEvent, 2)
+	handler := newConnectionEventHandler(newClientId(), func(event ConnectionEvent) {
+		received <- event
+	})
+
+	connectionEventHandlersMu.RLock()
+	assert.Same(t, handler, connectionEventHandlers[handler.id])
+	connectionEventHandlersMu.RUnlock()
+
+	event := ConnectionEvent{Type: ConnectionEventConnected, Address: "localhost:6379"}
+	handler.queue.push(event)
+	handler.close()
+	// the events received after the handler was closed are dropped
+	handler.queue.push(ConnectionEvent{Type: ConnectionEventDisconnected, Address: "localhost:6379"})
+
+	connectionEventHandlersMu.RLock()
+	assert.NotContains(t, connectionEventHandlers, handler.id)
+	connectionEventHandlersMu.RUnlock()
+
+	select {
+	case actual := <-received:
+		assert.Equal(t, event, actual)
+	case <-time.After(time.Second):
+		t.Fatal("The event received before the handler was closed was not delivered")
+	}
+	select {
+	case actual := <-received:
+		t.Fatalf("Unexpected event %v", actual)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestConnectionEventType_String(t *testing.T) {
+	assert.Equal(t, "Connected", ConnectionEventConnected.String())
+	assert.Equal(t, "Disconnected", ConnectionEventDisconnected.String())
+	assert.Equal(t, "Reconnected", ConnectionEventReconnected.String())
+	assert.Equal(t, "TopologyChanged", ConnectionEventTopologyChanged.String())
+	assert.Equal(t, "Unknown", ConnectionEventType(-1).String())
+}
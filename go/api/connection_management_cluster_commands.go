@@ -2,7 +2,11 @@
 
 package api
 
-import "github.com/valkey-io/valkey-glide/go/api/options"
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
 
 // Supports commands and transactions for the "Connection Management" group of commands for cluster client.
 //
@@ -10,23 +14,81 @@ import "github.com/valkey-io/valkey-glide/go/api/options"
 //
 // [valkey.io]: https://valkey.io/commands/#connection
 type ConnectionManagementClusterCommands interface {
-	Ping() (string, error)
+	Ping(ctx context.Context) (string, error)
+
+	PingWithOptions(ctx context.Context, pingOptions options.ClusterPingOptions) (string, error)
+
+	Echo(ctx context.Context, message string) (Result[string], error)
+
+	EchoWithOptions(ctx context.Context, echoOptions options.ClusterEchoOptions) (ClusterValue[string], error)
+
+	ClientId(ctx context.Context) (ClusterValue[int64], error)
+
+	ClientIdWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[int64], error)
+
+	ClientSetName(ctx context.Context, connectionName string) (ClusterValue[string], error)
+
+	ClientSetNameWithOptions(
+		ctx context.Context,
+		connectionName string,
+		routeOptions options.RouteOption,
+	) (ClusterValue[string], error)
+
+	ClientGetName(ctx context.Context) (ClusterValue[string], error)
+
+	ClientGetNameWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[string], error)
+
+	ClientList(ctx context.Context) (ClusterValue[[]ClientInfo], error)
+
+	ClientListWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[[]ClientInfo], error)
+
+	ClientInfo(ctx context.Context) (ClusterValue[ClientInfo], error)
+
+	ClientInfoWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[ClientInfo], error)
+
+	ClientKill(ctx context.Context, filter *options.ClientKillFilter) (ClusterValue[int64], error)
+
+	ClientKillWithOptions(
+		ctx context.Context,
+		filter *options.ClientKillFilter,
+		routeOptions options.RouteOption,
+	) (ClusterValue[int64], error)
+
+	ClientPause(ctx context.Context, timeout int64, mode options.ClientPauseMode) (ClusterValue[string], error)
 
-	PingWithOptions(pingOptions options.ClusterPingOptions) (string, error)
+	ClientPauseWithOptions(
+		ctx context.Context,
+		timeout int64,
+		mode options.ClientPauseMode,
+		routeOptions options.RouteOption,
+	) (ClusterValue[string], error)
 
-	Echo(message string) (Result[string], error)
+	ClientUnpause(ctx context.Context) (ClusterValue[string], error)
 
-	EchoWithOptions(echoOptions options.ClusterEchoOptions) (ClusterValue[string], error)
+	ClientUnpauseWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[string], error)
 
-	ClientId() (ClusterValue[int64], error)
+	ClientNoEvict(ctx context.Context, enabled bool) (ClusterValue[string], error)
 
-	ClientIdWithOptions(routeOptions options.RouteOption) (ClusterValue[int64], error)
+	ClientNoEvictWithOptions(
+		ctx context.Context,
+		enabled bool,
+		routeOptions options.RouteOption,
+	) (ClusterValue[string], error)
 
-	ClientSetName(connectionName string) (ClusterValue[string], error)
+	ClientNoTouch(ctx context.Context, enabled bool) (ClusterValue[string], error)
 
-	ClientSetNameWithOptions(connectionName string, routeOptions options.RouteOption) (ClusterValue[string], error)
+	ClientNoTouchWithOptions(
+		ctx context.Context,
+		enabled bool,
+		routeOptions options.RouteOption,
+	) (ClusterValue[string], error)
 
-	ClientGetName() (ClusterValue[string], error)
+	ClientUnblock(ctx context.Context, clientId int64, mode options.ClientUnblockMode) (ClusterValue[bool], error)
 
-	ClientGetNameWithOptions(routeOptions options.RouteOption) (ClusterValue[string], error)
+	ClientUnblockWithOptions(
+		ctx context.Context,
+		clientId int64,
+		mode options.ClientUnblockMode,
+		routeOptions options.RouteOption,
+	) (ClusterValue[bool], error)
 }
@@ -3,15 +3,17 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/valkey-io/valkey-glide/go/api/config"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
 func ExampleGlideClusterClient_Ping() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Ping()
+	result, err := client.Ping(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -28,7 +30,7 @@ func ExampleGlideClusterClient_PingWithOptions() {
 		},
 		RouteOption: nil,
 	}
-	result, err := client.PingWithOptions(options)
+	result, err := client.PingWithOptions(context.Background(), options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -39,7 +41,7 @@ func ExampleGlideClusterClient_PingWithOptions() {
 
 func ExampleGlideClusterClient_Echo() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Echo("Hello")
+	result, err := client.Echo(context.Background(), "Hello")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -56,7 +58,7 @@ func ExampleGlideClusterClient_EchoWithOptions() {
 		},
 		RouteOption: &options.RouteOption{Route: nil},
 	}
-	result, err := client.EchoWithOptions(opts)
+	result, err := client.EchoWithOptions(context.Background(), opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -67,7 +69,7 @@ func ExampleGlideClusterClient_EchoWithOptions() {
 
 func ExampleGlideClusterClient_ClientId() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.ClientId()
+	result, err := client.ClientId(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -80,7 +82,7 @@ func ExampleGlideClusterClient_ClientId() {
 func ExampleGlideClusterClient_ClientIdWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	opts := options.RouteOption{Route: nil}
-	result, err := client.ClientIdWithOptions(opts)
+	result, err := client.ClientIdWithOptions(context.Background(), opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -93,7 +95,7 @@ func ExampleGlideClusterClient_ClientIdWithOptions() {
 func ExampleGlideClusterClient_ClientSetName() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	connectionName := "ConnectionName-" + uuid.NewString()
-	result, err := client.ClientSetName(connectionName)
+	result, err := client.ClientSetName(context.Background(), connectionName)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -105,8 +107,8 @@ func ExampleGlideClusterClient_ClientSetName() {
 func ExampleGlideClusterClient_ClientGetName() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	connectionName := "ConnectionName-" + uuid.NewString()
-	client.ClientSetName(connectionName)
-	result, err := client.ClientGetName()
+	client.ClientSetName(context.Background(), connectionName)
+	result, err := client.ClientGetName(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -119,7 +121,7 @@ func ExampleGlideClusterClient_ClientSetNameWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	connectionName := "ConnectionName-" + uuid.NewString()
 	opts := options.RouteOption{Route: nil}
-	result, err := client.ClientSetNameWithOptions(connectionName, opts)
+	result, err := client.ClientSetNameWithOptions(context.Background(), connectionName, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -132,8 +134,8 @@ func ExampleGlideClusterClient_ClientGetNameWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	connectionName := "ConnectionName-" + uuid.NewString()
 	opts := options.RouteOption{Route: nil}
-	client.ClientSetNameWithOptions(connectionName, opts)
-	result, err := client.ClientGetNameWithOptions(opts)
+	client.ClientSetNameWithOptions(context.Background(), connectionName, opts)
+	result, err := client.ClientGetNameWithOptions(context.Background(), opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -141,3 +143,210 @@ func ExampleGlideClusterClient_ClientGetNameWithOptions() {
 
 	// Output: true
 }
+
+func ExampleGlideClusterClient_ClientList() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientList(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result.SingleValue()) > 0)
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientListWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientListWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, clients := range result.MultiValue() {
+		if len(clients) == 0 {
+			fmt.Println("no connection")
+		}
+	}
+	fmt.Println(result.IsMultiValue())
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientInfo() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue().Cmd)
+
+	// Output: client|info
+}
+
+func ExampleGlideClusterClient_ClientInfoWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllNodes}
+	result, err := client.ClientInfoWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, info := range result.MultiValue() {
+		if info.Id <= 0 {
+			fmt.Println("invalid connection identifier")
+		}
+	}
+	fmt.Println(result.IsMultiValue())
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientKill() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	filter := options.NewClientKillFilter().SetAddr("127.0.0.1:1")
+	result, err := client.ClientKill(context.Background(), filter)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: 0
+}
+
+func ExampleGlideClusterClient_ClientKillWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	filter := options.NewClientKillFilter().SetUser("default").SetMaxAge(86400)
+	opts := options.RouteOption{Route: config.AllNodes}
+	result, err := client.ClientKillWithOptions(context.Background(), filter, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsMultiValue())
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientPause() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientPause(context.Background(), 100, options.PauseWrite)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_ClientPauseWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientPauseWithOptions(context.Background(), 100, options.PauseWrite, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, response := range result.MultiValue() {
+		fmt.Println(response)
+		break
+	}
+	client.ClientUnpauseWithOptions(context.Background(), opts)
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_ClientUnpause() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientUnpause(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_ClientUnpauseWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientUnpauseWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsMultiValue())
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientNoEvict() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientNoEvict(context.Background(), true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsMultiValue())
+	client.ClientNoEvict(context.Background(), false)
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientNoEvictWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.RandomRoute}
+	result, err := client.ClientNoEvictWithOptions(context.Background(), false, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_ClientNoTouch() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientNoTouch(context.Background(), true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsMultiValue())
+	client.ClientNoTouch(context.Background(), false)
+
+	// Output: true
+}
+
+func ExampleGlideClusterClient_ClientNoTouchWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.RandomRoute}
+	result, err := client.ClientNoTouchWithOptions(context.Background(), false, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: OK
+}
+
+func ExampleGlideClusterClient_ClientUnblock() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClientUnblock(context.Background(), 1_000_000, options.UnblockTimeout)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.SingleValue()) // no such blocked connection
+
+	// Output: false
+}
+
+func ExampleGlideClusterClient_ClientUnblockWithOptions() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientUnblockWithOptions(context.Background(), 1_000_000, options.UnblockError, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, unblocked := range result.MultiValue() {
+		if unblocked {
+			fmt.Println("unexpected blocked connection")
+		}
+	}
+	fmt.Println(result.IsMultiValue())
+
+	// Output: true
+}
@@ -2,7 +2,11 @@
 
 package api
 
-import "github.com/valkey-io/valkey-glide/go/api/options"
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
 
 // Supports commands and transactions for the "Connection Management" group of commands for standalone client.
 //
@@ -10,15 +14,31 @@ import "github.com/valkey-io/valkey-glide/go/api/options"
 //
 // [valkey.io]: https://valkey.io/commands/#connection
 type ConnectionManagementCommands interface {
-	Ping() (string, error)
+	Ping(ctx context.Context) (string, error)
+
+	PingWithOptions(ctx context.Context, pingOptions options.PingOptions) (string, error)
+
+	Echo(ctx context.Context, message string) (Result[string], error)
+
+	ClientId(ctx context.Context) (int64, error)
+
+	ClientGetName(ctx context.Context) (string, error)
+
+	ClientSetName(ctx context.Context, connectionName string) (string, error)
+
+	ClientList(ctx context.Context) ([]ClientInfo, error)
+
+	ClientInfo(ctx context.Context) (ClientInfo, error)
+
+	ClientKill(ctx context.Context, filter *options.ClientKillFilter) (int64, error)
 
-	PingWithOptions(pingOptions options.PingOptions) (string, error)
+	ClientPause(ctx context.Context, timeout int64, mode options.ClientPauseMode) (string, error)
 
-	Echo(message string) (Result[string], error)
+	ClientUnpause(ctx context.Context) (string, error)
 
-	ClientId() (int64, error)
+	ClientNoEvict(ctx context.Context, enabled bool) (string, error)
 
-	ClientGetName() (string, error)
+	ClientNoTouch(ctx context.Context, enabled bool) (string, error)
 
-	ClientSetName(connectionName string) (string, error)
+	ClientUnblock(ctx context.Context, clientId int64, mode options.ClientUnblockMode) (bool, error)
 }
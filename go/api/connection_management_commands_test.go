@@ -3,15 +3,18 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
 func ExampleGlideClient_Ping() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Ping()
+	result, err := client.Ping(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -23,7 +26,7 @@ func ExampleGlideClient_Ping() {
 func ExampleGlideClient_PingWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	options := options.PingOptions{Message: "hello"}
-	result, err := client.PingWithOptions(options)
+	result, err := client.PingWithOptions(context.Background(), options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -34,7 +37,7 @@ func ExampleGlideClient_PingWithOptions() {
 
 func ExampleGlideClient_Echo() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Echo("Hello World")
+	result, err := client.Echo(context.Background(), "Hello World")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -45,7 +48,7 @@ func ExampleGlideClient_Echo() {
 
 func ExampleGlideClient_ClientId() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.ClientId()
+	result, err := client.ClientId(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -57,7 +60,7 @@ func ExampleGlideClient_ClientId() {
 
 func ExampleGlideClient_ClientSetName() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.ClientSetName("ConnectionName")
+	result, err := client.ClientSetName(context.Background(), "ConnectionName")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -69,8 +72,8 @@ func ExampleGlideClient_ClientSetName() {
 func ExampleGlideClient_ClientGetName() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	connectionName := "ConnectionName-" + uuid.NewString()
-	client.ClientSetName(connectionName)
-	result, err := client.ClientGetName()
+	client.ClientSetName(context.Background(), connectionName)
+	result, err := client.ClientGetName(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -78,3 +81,158 @@ func ExampleGlideClient_ClientGetName() {
 
 	// Output: true
 }
+
+func ExampleGlideClient_ClientList() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	id, _ := client.ClientId(context.Background())
+	clients, err := client.ClientList(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	found := false
+	for _, info := range clients {
+		found = found || info.Id == id
+	}
+	fmt.Println(found)
+
+	// Output: true
+}
+
+func ExampleGlideClient_ClientInfo() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	id, _ := client.ClientId(context.Background())
+	info, err := client.ClientInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(info.Id == id)
+	fmt.Println(info.Cmd)
+
+	// Output:
+	// true
+	// client|info
+}
+
+func ExampleGlideClient_ClientKill() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	filter := options.NewClientKillFilter().SetAddr("127.0.0.1:1")
+	result, err := client.ClientKill(context.Background(), filter)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 0
+}
+
+func ExampleGlideClient_ClientPause() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	result, err := client.ClientPause(context.Background(), 100, options.PauseWrite)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	result, err = client.ClientUnpause(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output:
+	// OK
+	// OK
+}
+
+func ExampleGlideClient_ClientUnpause() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	result, err := client.ClientUnpause(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleGlideClient_ClientNoEvict() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	result, err := client.ClientNoEvict(context.Background(), true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.ClientNoEvict(context.Background(), false)
+
+	// Output: OK
+}
+
+func ExampleGlideClient_ClientNoTouch() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	result, err := client.ClientNoTouch(context.Background(), true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.ClientNoTouch(context.Background(), false)
+
+	// Output: OK
+}
+
+func ExampleGlideClient_ClientUnblock() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	id, _ := client.ClientId(context.Background())
+	result, err := client.ClientUnblock(context.Background(), id, options.UnblockTimeout)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result) // the connection is not blocked
+
+	// Output: false
+}
+
+func TestParseClientInfos(t *testing.T) {
+	text := "id=3 addr=127.0.0.1:50010 laddr=127.0.0.1:6379 fd=8 name=worker age=12 idle=1 flags=N db=2 " +
+		"sub=0 psub=0 multi=-1 user=default lib-name=GlideGo cmd=client|list\n" +
+		"id=4 addr=127.0.0.1:50012 laddr=127.0.0.1:6379 fd=9 name= age=3 idle=3 flags=P db=0 user=admin cmd=blpop\n"
+	infos, err := parseClientInfos(text)
+	assert.NoError(t, err)
+	assert.Len(t, infos, 2)
+
+	info := infos[0]
+	assert.Equal(t, int64(3), info.Id)
+	assert.Equal(t, "127.0.0.1:50010", info.Addr)
+	assert.Equal(t, "127.0.0.1:6379", info.LAddr)
+	assert.Equal(t, "worker", info.Name)
+	assert.Equal(t, int64(12), info.Age)
+	assert.Equal(t, int64(1), info.Idle)
+	assert.Equal(t, "N", info.Flags)
+	assert.Equal(t, int64(2), info.Db)
+	assert.Equal(t, "default", info.User)
+	assert.Equal(t, "client|list", info.Cmd)
+	assert.Equal(t, "GlideGo", info.Fields["lib-name"])
+
+	assert.Equal(t, int64(4), infos[1].Id)
+	assert.Equal(t, "", infos[1].Name)
+	assert.Equal(t, "blpop", infos[1].Cmd)
+
+	_, err = parseClientInfos(int64(1))
+	assert.Error(t, err)
+}
+
+func TestClientKillFilter_ToArgs(t *testing.T) {
+	var nilFilter *options.ClientKillFilter
+	assert.Equal(t, []string{}, nilFilter.ToArgs())
+	assert.Equal(
+		t,
+		[]string{"ID", "7", "ADDR", "10.0.0.1:6000", "LADDR", "10.0.0.2:6379", "USER", "app", "SKIPME", "no", "MAXAGE", "60"},
+		options.NewClientKillFilter().
+			SetId(7).
+			SetAddr("10.0.0.1:6000").
+			SetLAddr("10.0.0.2:6379").
+			SetUser("app").
+			SetSkipMe(false).
+			SetMaxAge(60).
+			ToArgs(),
+	)
+	assert.Equal(t, []string{"SKIPME", "yes"}, options.NewClientKillFilter().SetSkipMe(true).ToArgs())
+}
@@ -27,6 +27,14 @@ type ExecAbortError struct {
 
 func (e *ExecAbortError) Error() string { return e.msg }
 
+// TransactionAbortedError is a client error that occurs when a transaction is not executed because a key watched with
+// WATCH was modified after it was watched.
+type TransactionAbortedError struct {
+	Msg string
+}
+
+func (e *TransactionAbortedError) Error() string { return e.Msg }
+
 // TimeoutError is a client error that occurs when a request times out.
 type TimeoutError struct {
 	msg string
@@ -41,6 +49,25 @@ type DisconnectError struct {
 
 func (e *DisconnectError) Error() string { return e.msg }
 
+// InflightRequestsLimitError is a client error that occurs when a request is rejected because the maximum number of
+// inflight requests of the client, configured with `WithInflightRequestsLimit`, was reached. The rejected request is not
+// sent to the server.
+type InflightRequestsLimitError struct {
+	msg string
+}
+
+func (e *InflightRequestsLimitError) Error() string { return e.msg }
+
+// CrossSlotError is a client error that occurs when the keys of a multi-key command are in more than one hash slot, which
+// the server rejects with a CROSSSLOT error in cluster mode.
+type CrossSlotError struct {
+	Msg string
+	// The distinct hash slots of the keys, in the order of the keys.
+	Slots []uint16
+}
+
+func (e *CrossSlotError) Error() string { return e.Msg }
+
 // ClosingError is a client error that indicates that the client has closed and is no longer usable.
 type ClosingError struct {
 	Msg string
@@ -56,6 +83,8 @@ func GoError(cErrorType uint32, errorMessage string) error {
 		return &TimeoutError{errorMessage}
 	case C.Disconnect:
 		return &DisconnectError{errorMessage}
+	case C.InflightRequestsLimit:
+		return &InflightRequestsLimitError{errorMessage}
 	default:
 		return &RequestError{errorMessage}
 	}
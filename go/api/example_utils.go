@@ -3,11 +3,13 @@
 package api
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/api/config"
 )
@@ -55,7 +57,10 @@ func getExampleGlideClient() *GlideClient {
 	})
 
 	// Flush the database before each test to ensure a clean state.
-	_, err := standaloneClient.CustomCommand([]string{"FLUSHALL"}) // todo: replace with client.FlushAll() when implemented
+	_, err := standaloneClient.CustomCommand(
+		context.Background(),
+		[]string{"FLUSHALL"},
+	) // todo: replace with client.FlushAll() when implemented
 	if err != nil {
 		fmt.Println("error flushing database: ", err)
 	}
@@ -81,6 +86,7 @@ func getExampleGlideClusterClient() *GlideClusterClient {
 
 	// Flush the database before each test to ensure a clean state.
 	_, err := clusterClient.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"FLUSHALL"},
 		config.AllPrimaries,
 	) // todo: replace with client.FlushAll() when implemented
@@ -91,6 +97,47 @@ func getExampleGlideClusterClient() *GlideClusterClient {
 	return clusterClient
 }
 
+// getExampleSubscriberClient returns a new GlideClient instance subscribed with the given subscription configuration.
+// This function is used in the examples of the pub/sub methods.
+func getExampleSubscriberClient(subscriptionConfig *StandaloneSubscriptionConfig) *GlideClient {
+	initFlags()
+	addresses := parseHosts(*standaloneNode)
+	config := NewGlideClientConfiguration().
+		WithAddress(&addresses[0]).
+		WithSubscriptionConfig(subscriptionConfig)
+
+	client, err := NewGlideClient(config)
+	if err != nil {
+		fmt.Println("error connecting to database: ", err)
+		return nil
+	}
+	// give the client time to complete its subscriptions
+	time.Sleep(100 * time.Millisecond)
+
+	return client.(*GlideClient)
+}
+
+// getExampleClusterSubscriberClient returns a new GlideClusterClient instance subscribed with the given subscription
+// configuration. This function is used in the examples of the pub/sub methods.
+func getExampleClusterSubscriberClient(subscriptionConfig *ClusterSubscriptionConfig) *GlideClusterClient {
+	initFlags()
+	addresses := parseHosts(*clusterNodes)
+	config := NewGlideClusterClientConfiguration().
+		WithAddress(&addresses[0]).
+		WithRequestTimeout(5000).
+		WithSubscriptionConfig(subscriptionConfig)
+
+	client, err := NewGlideClusterClient(config)
+	if err != nil {
+		fmt.Println("error connecting to database: ", err)
+		return nil
+	}
+	// give the client time to complete its subscriptions
+	time.Sleep(100 * time.Millisecond)
+
+	return client.(*GlideClusterClient)
+}
+
 func parseHosts(addresses string) []NodeAddress {
 	var result []NodeAddress
 
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,9 +12,9 @@ import (
 
 func ExampleGlideClient_Del() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Del([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Del(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -29,9 +30,9 @@ func ExampleGlideClient_Del() {
 
 func ExampleGlideClusterClient_Del() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Del([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Del(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -47,9 +48,9 @@ func ExampleGlideClusterClient_Del() {
 
 func ExampleGlideClient_Exists() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Exists([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Exists(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -65,9 +66,9 @@ func ExampleGlideClient_Exists() {
 
 func ExampleGlideClusterClient_Exists() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Exists([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Exists(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -83,9 +84,9 @@ func ExampleGlideClusterClient_Exists() {
 
 func ExampleGlideClient_Expire() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireAt("key", time.Now().Unix()+1)
-	result2, err := client.Expire("key", 1)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireAt(context.Background(), "key", time.Now().Unix()+1)
+	result2, err := client.Expire(context.Background(), "key", 1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -101,9 +102,9 @@ func ExampleGlideClient_Expire() {
 
 func ExampleGlideClusterClient_Expire() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireAt("key", time.Now().Unix()+1)
-	result2, err := client.Expire("key", 1)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireAt(context.Background(), "key", time.Now().Unix()+1)
+	result2, err := client.Expire(context.Background(), "key", 1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -119,8 +120,8 @@ func ExampleGlideClusterClient_Expire() {
 
 func ExampleGlideClient_ExpireWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireWithOptions("key", 1, options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireWithOptions(context.Background(), "key", 1, options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -134,8 +135,8 @@ func ExampleGlideClient_ExpireWithOptions() {
 
 func ExampleGlideClusterClient_ExpireWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireWithOptions("key", 1, options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireWithOptions(context.Background(), "key", 1, options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -149,8 +150,8 @@ func ExampleGlideClusterClient_ExpireWithOptions() {
 
 func ExampleGlideClient_ExpireAt() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireAt("key", time.Now().Unix()+1)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireAt(context.Background(), "key", time.Now().Unix()+1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -164,8 +165,8 @@ func ExampleGlideClient_ExpireAt() {
 
 func ExampleGlideClusterClient_ExpireAt() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireAt("key", time.Now().Unix()+1)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireAt(context.Background(), "key", time.Now().Unix()+1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -179,8 +180,8 @@ func ExampleGlideClusterClient_ExpireAt() {
 
 func ExampleGlideClient_ExpireAtWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireAtWithOptions("key", time.Now().Unix()+1, options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireAtWithOptions(context.Background(), "key", time.Now().Unix()+1, options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -194,8 +195,8 @@ func ExampleGlideClient_ExpireAtWithOptions() {
 
 func ExampleGlideClusterClient_ExpireAtWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireAtWithOptions("key", time.Now().Unix()+1, options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireAtWithOptions(context.Background(), "key", time.Now().Unix()+1, options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -209,8 +210,8 @@ func ExampleGlideClusterClient_ExpireAtWithOptions() {
 
 func ExampleGlideClient_PExpire() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpire("key", int64(5*1000))
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpire(context.Background(), "key", int64(5*1000))
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -224,8 +225,8 @@ func ExampleGlideClient_PExpire() {
 
 func ExampleGlideClusterClient_PExpire() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpire("key", int64(5*1000))
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpire(context.Background(), "key", int64(5*1000))
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -239,8 +240,8 @@ func ExampleGlideClusterClient_PExpire() {
 
 func ExampleGlideClient_PExpireWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireWithOptions("key", int64(5*1000), options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireWithOptions(context.Background(), "key", int64(5*1000), options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -254,8 +255,8 @@ func ExampleGlideClient_PExpireWithOptions() {
 
 func ExampleGlideClusterClient_PExpireWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireWithOptions("key", int64(5*1000), options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireWithOptions(context.Background(), "key", int64(5*1000), options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -269,8 +270,8 @@ func ExampleGlideClusterClient_PExpireWithOptions() {
 
 func ExampleGlideClient_PExpireAt() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireAt("key", time.Now().Unix()*1000)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireAt(context.Background(), "key", time.Now().Unix()*1000)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -284,8 +285,8 @@ func ExampleGlideClient_PExpireAt() {
 
 func ExampleGlideClusterClient_PExpireAt() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireAt("key", time.Now().Unix()*1000)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireAt(context.Background(), "key", time.Now().Unix()*1000)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -299,8 +300,8 @@ func ExampleGlideClusterClient_PExpireAt() {
 
 func ExampleGlideClient_PExpireAtWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireAtWithOptions("key", time.Now().Unix()*1000, options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireAtWithOptions(context.Background(), "key", time.Now().Unix()*1000, options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -314,8 +315,8 @@ func ExampleGlideClient_PExpireAtWithOptions() {
 
 func ExampleGlideClusterClient_PExpireAtWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireAtWithOptions("key", time.Now().Unix()*1000, options.HasNoExpiry)
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireAtWithOptions(context.Background(), "key", time.Now().Unix()*1000, options.HasNoExpiry)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -329,9 +330,13 @@ func ExampleGlideClusterClient_PExpireAtWithOptions() {
 
 func ExampleGlideClient_ExpireTime() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireTime("key")
-	_, err = client.ExpireAt("key", time.Now().Unix()*1000) // ExpireTime("key") returns proper unix timestamp in seconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireTime(context.Background(), "key")
+	_, err = client.ExpireAt(
+		context.Background(),
+		"key",
+		time.Now().Unix()*1000,
+	) // ExpireTime("key") returns proper unix timestamp in seconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -345,9 +350,13 @@ func ExampleGlideClient_ExpireTime() {
 
 func ExampleGlideClusterClient_ExpireTime() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.ExpireTime("key")
-	_, err = client.ExpireAt("key", time.Now().Unix()*1000) // ExpireTime("key") returns proper unix timestamp in seconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.ExpireTime(context.Background(), "key")
+	_, err = client.ExpireAt(
+		context.Background(),
+		"key",
+		time.Now().Unix()*1000,
+	) // ExpireTime("key") returns proper unix timestamp in seconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -361,9 +370,13 @@ func ExampleGlideClusterClient_ExpireTime() {
 
 func ExampleGlideClient_PExpireTime() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireTime("key")
-	_, err = client.PExpireAt("key", time.Now().Unix()*1000) // PExpireTime("key") returns proper unix time in milliseconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireTime(context.Background(), "key")
+	_, err = client.PExpireAt(
+		context.Background(),
+		"key",
+		time.Now().Unix()*1000,
+	) // PExpireTime("key") returns proper unix time in milliseconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -377,9 +390,13 @@ func ExampleGlideClient_PExpireTime() {
 
 func ExampleGlideClusterClient_PExpireTime() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PExpireTime("key")
-	_, err = client.PExpireAt("key", time.Now().Unix()*1000) // PExpireTime("key") returns proper unix time in milliseconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PExpireTime(context.Background(), "key")
+	_, err = client.PExpireAt(
+		context.Background(),
+		"key",
+		time.Now().Unix()*1000,
+	) // PExpireTime("key") returns proper unix time in milliseconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -393,9 +410,9 @@ func ExampleGlideClusterClient_PExpireTime() {
 
 func ExampleGlideClient_TTL() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.TTL("key")
-	_, err = client.ExpireAt("key", time.Now().Unix()*1000) // TTL("key") returns proper TTL in seconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.TTL(context.Background(), "key")
+	_, err = client.ExpireAt(context.Background(), "key", time.Now().Unix()*1000) // TTL("key") returns proper TTL in seconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -409,9 +426,9 @@ func ExampleGlideClient_TTL() {
 
 func ExampleGlideClusterClient_TTL() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.TTL("key")
-	_, err = client.ExpireAt("key", time.Now().Unix()*1000) // TTL("key") returns proper TTL in seconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.TTL(context.Background(), "key")
+	_, err = client.ExpireAt(context.Background(), "key", time.Now().Unix()*1000) // TTL("key") returns proper TTL in seconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -425,9 +442,13 @@ func ExampleGlideClusterClient_TTL() {
 
 func ExampleGlideClient_PTTL() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PTTL("key")
-	_, err = client.PExpireAt("key", time.Now().Unix()*100000) // PTTL("key") returns proper TTL in milliseconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PTTL(context.Background(), "key")
+	_, err = client.PExpireAt(
+		context.Background(),
+		"key",
+		time.Now().Unix()*100000,
+	) // PTTL("key") returns proper TTL in milliseconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -441,9 +462,13 @@ func ExampleGlideClient_PTTL() {
 
 func ExampleGlideClusterClient_PTTL() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key", "someValue")
-	result1, err := client.PTTL("key")
-	_, err = client.PExpireAt("key", time.Now().Unix()*100000) // PTTL("key") returns proper TTL in milliseconds
+	result, err := client.Set(context.Background(), "key", "someValue")
+	result1, err := client.PTTL(context.Background(), "key")
+	_, err = client.PExpireAt(
+		context.Background(),
+		"key",
+		time.Now().Unix()*100000,
+	) // PTTL("key") returns proper TTL in milliseconds
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -457,9 +482,9 @@ func ExampleGlideClusterClient_PTTL() {
 
 func ExampleGlideClient_Unlink() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Unlink([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Unlink(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -475,9 +500,9 @@ func ExampleGlideClient_Unlink() {
 
 func ExampleGlideClusterClient_Unlink() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Unlink([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Unlink(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -493,9 +518,9 @@ func ExampleGlideClusterClient_Unlink() {
 
 func ExampleGlideClient_Touch() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Touch([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Touch(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -511,9 +536,9 @@ func ExampleGlideClient_Touch() {
 
 func ExampleGlideClusterClient_Touch() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Set("key2", "someValue")
-	result2, err := client.Touch([]string{"key1", "key2", "key3"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Set(context.Background(), "key2", "someValue")
+	result2, err := client.Touch(context.Background(), []string{"key1", "key2", "key3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -529,8 +554,8 @@ func ExampleGlideClusterClient_Touch() {
 
 func ExampleGlideClient_Type() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Type("key1")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Type(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -544,8 +569,8 @@ func ExampleGlideClient_Type() {
 
 func ExampleGlideClusterClient_Type() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Type("key1")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Type(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -559,8 +584,8 @@ func ExampleGlideClusterClient_Type() {
 
 func ExampleGlideClient_Rename() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Rename("key1", "key2")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Rename(context.Background(), "key1", "key2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -574,8 +599,8 @@ func ExampleGlideClient_Rename() {
 
 func ExampleGlideClusterClient_Rename() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("{key}1", "someValue")
-	result1, err := client.Rename("{key}1", "{key}2")
+	result, err := client.Set(context.Background(), "{key}1", "someValue")
+	result1, err := client.Rename(context.Background(), "{key}1", "{key}2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -589,8 +614,8 @@ func ExampleGlideClusterClient_Rename() {
 
 func ExampleGlideClient_RenameNX() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.RenameNX("key1", "key2")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.RenameNX(context.Background(), "key1", "key2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -604,8 +629,8 @@ func ExampleGlideClient_RenameNX() {
 
 func ExampleGlideClusterClient_RenameNX() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("{key}1", "someValue")
-	result1, err := client.RenameNX("{key}1", "{key}2")
+	result, err := client.Set(context.Background(), "{key}1", "someValue")
+	result1, err := client.RenameNX(context.Background(), "{key}1", "{key}2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -619,9 +644,9 @@ func ExampleGlideClusterClient_RenameNX() {
 
 func ExampleGlideClient_Persist() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ExpireAt("key1", time.Now().Unix()*1000)
-	result2, err := client.Persist("key1")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ExpireAt(context.Background(), "key1", time.Now().Unix()*1000)
+	result2, err := client.Persist(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -637,9 +662,9 @@ func ExampleGlideClient_Persist() {
 
 func ExampleGlideClusterClient_Persist() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ExpireAt("key1", time.Now().Unix()*1000)
-	result2, err := client.Persist("key1")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ExpireAt(context.Background(), "key1", time.Now().Unix()*1000)
+	result2, err := client.Persist(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -655,10 +680,10 @@ func ExampleGlideClusterClient_Persist() {
 
 func ExampleGlideClient_Restore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	dump, err := client.Dump("key1")
-	result1, err := client.Del([]string{"key1"})
-	result2, err := client.Restore("key1", 0, dump.Value())
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	dump, err := client.Dump(context.Background(), "key1")
+	result1, err := client.Del(context.Background(), []string{"key1"})
+	result2, err := client.Restore(context.Background(), "key1", 0, dump.Value())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -674,10 +699,10 @@ func ExampleGlideClient_Restore() {
 
 func ExampleGlideClusterClient_Restore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	dump, err := client.Dump("key1")
-	result1, err := client.Del([]string{"key1"})
-	result2, err := client.Restore("key1", 0, dump.Value())
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	dump, err := client.Dump(context.Background(), "key1")
+	result1, err := client.Del(context.Background(), []string{"key1"})
+	result2, err := client.Restore(context.Background(), "key1", 0, dump.Value())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -693,11 +718,11 @@ func ExampleGlideClusterClient_Restore() {
 
 func ExampleGlideClient_RestoreWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	dump, err := client.Dump("key1")
-	result1, err := client.Del([]string{"key1"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	dump, err := client.Dump(context.Background(), "key1")
+	result1, err := client.Del(context.Background(), []string{"key1"})
 	opts := options.NewRestoreOptions().SetReplace().SetABSTTL().SetEviction(options.FREQ, 10)
-	result2, err := client.RestoreWithOptions("key1", 0, dump.Value(), *opts)
+	result2, err := client.RestoreWithOptions(context.Background(), "key1", 0, dump.Value(), *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -713,11 +738,11 @@ func ExampleGlideClient_RestoreWithOptions() {
 
 func ExampleGlideClusterClient_RestoreWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	dump, err := client.Dump("key1")
-	result1, err := client.Del([]string{"key1"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	dump, err := client.Dump(context.Background(), "key1")
+	result1, err := client.Del(context.Background(), []string{"key1"})
 	opts := options.NewRestoreOptions().SetReplace().SetABSTTL().SetEviction(options.FREQ, 10)
-	result2, err := client.RestoreWithOptions("key1", 0, dump.Value(), *opts)
+	result2, err := client.RestoreWithOptions(context.Background(), "key1", 0, dump.Value(), *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -733,8 +758,8 @@ func ExampleGlideClusterClient_RestoreWithOptions() {
 
 func ExampleGlideClient_ObjectEncoding() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ObjectEncoding("key1")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ObjectEncoding(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -748,8 +773,8 @@ func ExampleGlideClient_ObjectEncoding() {
 
 func ExampleGlideClusterClient_ObjectEncoding() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ObjectEncoding("key1")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ObjectEncoding(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -763,8 +788,8 @@ func ExampleGlideClusterClient_ObjectEncoding() {
 
 func ExampleGlideClient_Dump() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Dump("key1") // Contains serialized value of the data stored at key1
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Dump(context.Background(), "key1") // Contains serialized value of the data stored at key1
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -778,8 +803,8 @@ func ExampleGlideClient_Dump() {
 
 func ExampleGlideClusterClient_Dump() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Dump("key1") // Contains serialized value of the data stored at key1
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Dump(context.Background(), "key1") // Contains serialized value of the data stored at key1
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -794,11 +819,11 @@ func ExampleGlideClusterClient_Dump() {
 func ExampleGlideClient_ObjectFreq() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ConfigSet(map[string]string{"maxmemory-policy": "allkeys-lfu"}) // example configuration
-	client.Set("key1", "someValue")
-	client.Set("key1", "someOtherValue")
+	client.ConfigSet(context.Background(), map[string]string{"maxmemory-policy": "allkeys-lfu"}) // example configuration
+	client.Set(context.Background(), "key1", "someValue")
+	client.Set(context.Background(), "key1", "someOtherValue")
 
-	result, err := client.ObjectFreq("key1")
+	result, err := client.ObjectFreq(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -811,10 +836,10 @@ func ExampleGlideClusterClient_ObjectFreq() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
 	// TODO: Once ConfigSet and ConfigGet are implemented, replace CustomCommand
-	client.CustomCommand([]string{"CONFIG", "SET", "maxmemory-policy", "allkeys-lfu"})
-	result, err := client.Set("key1", "someValue")
-	_, err = client.Set("key1", "someOtherValue")
-	result1, err := client.ObjectFreq("key1")
+	client.CustomCommand(context.Background(), []string{"CONFIG", "SET", "maxmemory-policy", "allkeys-lfu"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	_, err = client.Set(context.Background(), "key1", "someOtherValue")
+	result1, err := client.ObjectFreq(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -827,10 +852,10 @@ func ExampleGlideClusterClient_ObjectFreq() {
 }
 
 func ExampleGlideClient_ObjectIdleTime() {
-	var client *GlideClient = getExampleGlideClient()                      // example helper function
-	client.ConfigSet(map[string]string{"maxmemory-policy": "allkeys-lru"}) // example configuration
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ObjectIdleTime("key1")
+	var client *GlideClient = getExampleGlideClient()                                            // example helper function
+	client.ConfigSet(context.Background(), map[string]string{"maxmemory-policy": "allkeys-lru"}) // example configuration
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ObjectIdleTime(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -846,9 +871,9 @@ func ExampleGlideClusterClient_ObjectIdleTime() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
 	// TODO: Once ConfigSet and ConfigGet are implemented, replace CustomCommand
-	client.CustomCommand([]string{"CONFIG", "SET", "maxmemory-policy", "allkeys-lru"})
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ObjectIdleTime("key1")
+	client.CustomCommand(context.Background(), []string{"CONFIG", "SET", "maxmemory-policy", "allkeys-lru"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ObjectIdleTime(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -861,10 +886,13 @@ func ExampleGlideClusterClient_ObjectIdleTime() {
 }
 
 func ExampleGlideClient_ObjectRefCount() {
-	var client *GlideClient = getExampleGlideClient()                                // example helper function
-	_, err := client.ConfigSet(map[string]string{"maxmemory-policy": "allkeys-lru"}) // example configuration
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ObjectRefCount("key1")
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	_, err := client.ConfigSet(
+		context.Background(),
+		map[string]string{"maxmemory-policy": "allkeys-lru"},
+	) // example configuration
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ObjectRefCount(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -878,9 +906,9 @@ func ExampleGlideClient_ObjectRefCount() {
 
 func ExampleGlideClusterClient_ObjectRefCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	client.CustomCommand([]string{"CONFIG", "SET", "maxmemory-policy", "allkeys-lru"})
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.ObjectRefCount("key1")
+	client.CustomCommand(context.Background(), []string{"CONFIG", "SET", "maxmemory-policy", "allkeys-lru"})
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.ObjectRefCount(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -894,8 +922,8 @@ func ExampleGlideClusterClient_ObjectRefCount() {
 
 func ExampleGlideClient_Sort() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("key1", []string{"1", "3", "2", "4"})
-	result1, err := client.Sort("key1")
+	result, err := client.LPush(context.Background(), "key1", []string{"1", "3", "2", "4"})
+	result1, err := client.Sort(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -909,8 +937,8 @@ func ExampleGlideClient_Sort() {
 
 func ExampleGlideClusterClient_Sort() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("key1", []string{"1", "3", "2", "4"})
-	result1, err := client.Sort("key1")
+	result, err := client.LPush(context.Background(), "key1", []string{"1", "3", "2", "4"})
+	result1, err := client.Sort(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -925,11 +953,11 @@ func ExampleGlideClusterClient_Sort() {
 func ExampleGlideClient_SortWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	opts := options.NewSortOptions().SetByPattern("weight_*").SetIsAlpha(false).SetOrderBy(options.ASC)
-	client.Set("weight_item1", "3")
-	client.Set("weight_item2", "1")
-	client.Set("weight_item3", "2")
-	result, err := client.LPush("key1", []string{"item1", "item2", "item3"})
-	result1, err := client.SortWithOptions("key1", *opts)
+	client.Set(context.Background(), "weight_item1", "3")
+	client.Set(context.Background(), "weight_item2", "1")
+	client.Set(context.Background(), "weight_item3", "2")
+	result, err := client.LPush(context.Background(), "key1", []string{"item1", "item2", "item3"})
+	result1, err := client.SortWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -944,8 +972,8 @@ func ExampleGlideClient_SortWithOptions() {
 func ExampleGlideClusterClient_SortWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	opts := options.NewSortOptions().SetIsAlpha(false).SetOrderBy(options.ASC)
-	result, err := client.LPush("key1", []string{"3", "1", "2"})
-	result1, err := client.SortWithOptions("key1", *opts)
+	result, err := client.LPush(context.Background(), "key1", []string{"3", "1", "2"})
+	result1, err := client.SortWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -959,8 +987,8 @@ func ExampleGlideClusterClient_SortWithOptions() {
 
 func ExampleGlideClient_SortStore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("key1", []string{"1", "3", "2", "4"})
-	result1, err := client.SortStore("key1", "key1_store")
+	result, err := client.LPush(context.Background(), "key1", []string{"1", "3", "2", "4"})
+	result1, err := client.SortStore(context.Background(), "key1", "key1_store")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -974,8 +1002,8 @@ func ExampleGlideClient_SortStore() {
 
 func ExampleGlideClusterClient_SortStore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("{key}1", []string{"1", "3", "2", "4"})
-	result1, err := client.SortStore("{key}1", "{key}2")
+	result, err := client.LPush(context.Background(), "{key}1", []string{"1", "3", "2", "4"})
+	result1, err := client.SortStore(context.Background(), "{key}1", "{key}2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -990,11 +1018,11 @@ func ExampleGlideClusterClient_SortStore() {
 func ExampleGlideClient_SortStoreWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	opts := options.NewSortOptions().SetByPattern("weight_*").SetIsAlpha(false).SetOrderBy(options.ASC)
-	client.Set("weight_item1", "3")
-	client.Set("weight_item2", "1")
-	client.Set("weight_item3", "2")
-	result, err := client.LPush("key1", []string{"item1", "item2", "item3"})
-	result1, err := client.SortStoreWithOptions("key1", "key1_store", *opts)
+	client.Set(context.Background(), "weight_item1", "3")
+	client.Set(context.Background(), "weight_item2", "1")
+	client.Set(context.Background(), "weight_item3", "2")
+	result, err := client.LPush(context.Background(), "key1", []string{"item1", "item2", "item3"})
+	result1, err := client.SortStoreWithOptions(context.Background(), "key1", "key1_store", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1009,8 +1037,8 @@ func ExampleGlideClient_SortStoreWithOptions() {
 func ExampleGlideClusterClient_SortStoreWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	opts := options.NewSortOptions().SetIsAlpha(false).SetOrderBy(options.ASC)
-	result, err := client.LPush("{key}1", []string{"3", "1", "2"})
-	result1, err := client.SortStoreWithOptions("{key}1", "{key}2", *opts)
+	result, err := client.LPush(context.Background(), "{key}1", []string{"3", "1", "2"})
+	result1, err := client.SortStoreWithOptions(context.Background(), "{key}1", "{key}2", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1024,8 +1052,8 @@ func ExampleGlideClusterClient_SortStoreWithOptions() {
 
 func ExampleGlideClient_SortReadOnly() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("key1", []string{"1", "3", "2", "4"})
-	result1, err := client.SortReadOnly("key1")
+	result, err := client.LPush(context.Background(), "key1", []string{"1", "3", "2", "4"})
+	result1, err := client.SortReadOnly(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1039,8 +1067,8 @@ func ExampleGlideClient_SortReadOnly() {
 
 func ExampleGlideClusterClient_SortReadOnly() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("key1", []string{"1", "3", "2", "4"})
-	result1, err := client.SortReadOnly("key1")
+	result, err := client.LPush(context.Background(), "key1", []string{"1", "3", "2", "4"})
+	result1, err := client.SortReadOnly(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1055,11 +1083,11 @@ func ExampleGlideClusterClient_SortReadOnly() {
 func ExampleGlideClient_SortReadOnlyWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	opts := options.NewSortOptions().SetByPattern("weight_*").SetIsAlpha(false).SetOrderBy(options.ASC)
-	client.Set("weight_item1", "3")
-	client.Set("weight_item2", "1")
-	client.Set("weight_item3", "2")
-	result, err := client.LPush("key1", []string{"item1", "item2", "item3"})
-	result1, err := client.SortReadOnlyWithOptions("key1", *opts)
+	client.Set(context.Background(), "weight_item1", "3")
+	client.Set(context.Background(), "weight_item2", "1")
+	client.Set(context.Background(), "weight_item3", "2")
+	result, err := client.LPush(context.Background(), "key1", []string{"item1", "item2", "item3"})
+	result1, err := client.SortReadOnlyWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1074,8 +1102,8 @@ func ExampleGlideClient_SortReadOnlyWithOptions() {
 func ExampleGlideClusterClient_SortReadOnlyWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	opts := options.NewSortOptions().SetIsAlpha(false).SetOrderBy(options.ASC)
-	result, err := client.LPush("key1", []string{"3", "1", "2"})
-	result1, err := client.SortReadOnlyWithOptions("key1", *opts)
+	result, err := client.LPush(context.Background(), "key1", []string{"3", "1", "2"})
+	result1, err := client.SortReadOnlyWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1089,8 +1117,8 @@ func ExampleGlideClusterClient_SortReadOnlyWithOptions() {
 
 func ExampleGlideClient_Wait() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	client.Set("key1", "someValue")
-	result, err := client.Wait(2, 1)
+	client.Set(context.Background(), "key1", "someValue")
+	result, err := client.Wait(context.Background(), 2, 1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1104,8 +1132,8 @@ func ExampleGlideClient_Wait() {
 
 func ExampleGlideClusterClient_Wait() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	client.Set("key1", "someValue")
-	result, err := client.Wait(2, 1)
+	client.Set(context.Background(), "key1", "someValue")
+	result, err := client.Wait(context.Background(), 2, 1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1117,9 +1145,9 @@ func ExampleGlideClusterClient_Wait() {
 
 func ExampleGlideClient_Copy() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.Set("key1", "someValue")
-	result1, err := client.Copy("key1", "key2")
-	result2, err := client.Get("key2")
+	result, err := client.Set(context.Background(), "key1", "someValue")
+	result1, err := client.Copy(context.Background(), "key1", "key2")
+	result2, err := client.Get(context.Background(), "key2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1135,9 +1163,9 @@ func ExampleGlideClient_Copy() {
 
 func ExampleGlideClusterClient_Copy() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.Set("{key}1", "someValue")
-	result1, err := client.Copy("{key}1", "{key}2")
-	result2, err := client.Get("{key}2")
+	result, err := client.Set(context.Background(), "{key}1", "someValue")
+	result1, err := client.Copy(context.Background(), "{key}1", "{key}2")
+	result2, err := client.Get(context.Background(), "{key}2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1153,12 +1181,12 @@ func ExampleGlideClusterClient_Copy() {
 
 func ExampleGlideClient_CopyWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	client.Set("key1", "someValue")
+	client.Set(context.Background(), "key1", "someValue")
 
 	opts := options.NewCopyOptions().SetReplace()
-	client.CopyWithOptions("key1", "key2", *opts)
+	client.CopyWithOptions(context.Background(), "key1", "key2", *opts)
 
-	result, err := client.Get("key2")
+	result, err := client.Get(context.Background(), "key2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1170,12 +1198,12 @@ func ExampleGlideClient_CopyWithOptions() {
 func ExampleGlideClusterClient_CopyWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.Set("{key}1", "someValue")
+	client.Set(context.Background(), "{key}1", "someValue")
 
 	opts := options.NewCopyOptions().SetReplace()
-	client.CopyWithOptions("{key}1", "{key}2", *opts)
+	client.CopyWithOptions(context.Background(), "{key}1", "{key}2", *opts)
 
-	result, err := client.Get("{key}2")
+	result, err := client.Get(context.Background(), "{key}2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
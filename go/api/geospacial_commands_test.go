@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -17,7 +18,7 @@ func ExampleGlideClient_GeoAdd() {
 		"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 	}
 
-	result, err := client.GeoAdd(uuid.New().String(), membersToCoordinates)
+	result, err := client.GeoAdd(context.Background(), uuid.New().String(), membersToCoordinates)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -36,7 +37,7 @@ func ExampleGlideClusterClient_GeoAdd() {
 		"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 	}
 
-	result, err := client.GeoAdd(uuid.New().String(), membersToCoordinates)
+	result, err := client.GeoAdd(context.Background(), uuid.New().String(), membersToCoordinates)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -56,13 +57,13 @@ func ExampleGlideClient_GeoHash() {
 	}
 
 	// Add the coordinates
-	_, err := client.GeoAdd(key, membersToCoordinates)
+	_, err := client.GeoAdd(context.Background(), key, membersToCoordinates)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Test getting geohash for multiple members
-	geoHashResults, err := client.GeoHash(key, []string{"Palermo", "Catania"})
+	geoHashResults, err := client.GeoHash(context.Background(), key, []string{"Palermo", "Catania"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -81,13 +82,13 @@ func ExampleGlideClusterClient_GeoHash() {
 	}
 
 	// Add the coordinates
-	_, err := client.GeoAdd(key, membersToCoordinates)
+	_, err := client.GeoAdd(context.Background(), key, membersToCoordinates)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Test getting geohash for multiple members
-	geoHashResults, err := client.GeoHash(key, []string{"Palermo", "Catania"})
+	geoHashResults, err := client.GeoHash(context.Background(), key, []string{"Palermo", "Catania"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -101,7 +102,7 @@ func ExampleGlideClient_GeoPos() {
 	client := getExampleGlideClient()
 
 	key := uuid.New().String()
-	_, err := client.GeoAdd(key, map[string]options.GeospatialData{
+	_, err := client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
 		"Palermo": {Longitude: 13.361389, Latitude: 38.115556},
 		"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 	})
@@ -109,7 +110,7 @@ func ExampleGlideClient_GeoPos() {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
-	positions, err := client.GeoPos(key, []string{"Palermo", "Catania"})
+	positions, err := client.GeoPos(context.Background(), key, []string{"Palermo", "Catania"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -124,7 +125,7 @@ func ExampleGlideClusterClient_GeoPos() {
 	client := getExampleGlideClusterClient()
 
 	key := uuid.New().String()
-	_, err := client.GeoAdd(key, map[string]options.GeospatialData{
+	_, err := client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
 		"Palermo": {Longitude: 13.361389, Latitude: 38.115556},
 		"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 	})
@@ -132,7 +133,7 @@ func ExampleGlideClusterClient_GeoPos() {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
-	positions, err := client.GeoPos(key, []string{"Palermo", "Catania"})
+	positions, err := client.GeoPos(context.Background(), key, []string{"Palermo", "Catania"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -154,13 +155,13 @@ func ExampleGlideClient_GeoDist() {
 	}
 
 	// Add the coordinates
-	_, err := client.GeoAdd(key, membersToCoordinates)
+	_, err := client.GeoAdd(context.Background(), key, membersToCoordinates)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Test getting geodist for multiple members
-	result, err := client.GeoDist(key, member1, member2)
+	result, err := client.GeoDist(context.Background(), key, member1, member2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -181,12 +182,12 @@ func ExampleGlideClusterClient_GeoDist() {
 	}
 
 	// Add the coordinates
-	_, err := client.GeoAdd(key, membersToCoordinates)
+	_, err := client.GeoAdd(context.Background(), key, membersToCoordinates)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 	// Test getting geodist for multiple members
-	result, err := client.GeoDist(key, member1, member2)
+	result, err := client.GeoDist(context.Background(), key, member1, member2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -204,6 +205,7 @@ func ExampleGlideClient_GeoSearch() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearch(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -226,6 +228,7 @@ func ExampleGlideClusterClient_GeoSearch() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearch(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -248,6 +251,7 @@ func ExampleGlideClient_GeoSearchWithResultOptions() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearchWithResultOptions(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -271,6 +275,7 @@ func ExampleGlideClusterClient_GeoSearchWithResultOptions() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearchWithResultOptions(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -294,6 +299,7 @@ func ExampleGlideClient_GeoSearchWithFullOptions() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearchWithFullOptions(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -318,6 +324,7 @@ func ExampleGlideClusterClient_GeoSearchWithFullOptions() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearchWithFullOptions(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -342,6 +349,7 @@ func ExampleGlideClient_GeoSearchWithInfoOptions() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearchWithInfoOptions(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -365,6 +373,7 @@ func ExampleGlideClusterClient_GeoSearchWithInfoOptions() {
 	AddInitialGeoData(client, key)
 
 	result, err := client.GeoSearchWithInfoOptions(
+		context.Background(),
 		key,
 		&options.GeoMemberOrigin{Member: "Palermo"},
 		*options.NewCircleSearchShape(200, options.GeoUnitKilometers),
@@ -389,6 +398,7 @@ func ExampleGlideClient_GeoSearchStore() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStore(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -413,6 +423,7 @@ func ExampleGlideClusterClient_GeoSearchStore() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStore(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -437,6 +448,7 @@ func ExampleGlideClient_GeoSearchStoreWithInfoOptions() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStoreWithInfoOptions(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -462,6 +474,7 @@ func ExampleGlideClusterClient_GeoSearchStoreWithInfoOptions() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStoreWithInfoOptions(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -487,6 +500,7 @@ func ExampleGlideClient_GeoSearchStoreWithResultOptions() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStoreWithResultOptions(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -512,6 +526,7 @@ func ExampleGlideClusterClient_GeoSearchStoreWithResultOptions() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStoreWithResultOptions(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -537,6 +552,7 @@ func ExampleGlideClient_GeoSearchStoreWithFullOptions() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStoreWithFullOptions(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -563,6 +579,7 @@ func ExampleGlideClusterClient_GeoSearchStoreWithFullOptions() {
 	AddInitialGeoData(client, source)
 
 	result, err := client.GeoSearchStoreWithFullOptions(
+		context.Background(),
 		destination,
 		source,
 		&options.GeoMemberOrigin{Member: "Palermo"},
@@ -585,7 +602,7 @@ func AddInitialGeoData(client BaseClient, key string) {
 		"Palermo": {Longitude: 13.361389, Latitude: 38.115556},
 	}
 
-	_, err := client.GeoAdd(key, membersToCoordinates)
+	_, err := client.GeoAdd(context.Background(), key, membersToCoordinates)
 	if err != nil {
 		fmt.Println("GeoSearch glide example failed with an error: ", err)
 	}
@@ -6,6 +6,8 @@ package api
 import "C"
 
 import (
+	"context"
+
 	"github.com/valkey-io/valkey-glide/go/api/options"
 	"github.com/valkey-io/valkey-glide/go/utils"
 )
@@ -57,8 +59,8 @@ func NewGlideClient(config *GlideClientConfiguration) (GlideClientCommands, erro
 //	The returned value for the custom command.
 //
 // [Valkey GLIDE Wiki]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#custom-command
-func (client *GlideClient) CustomCommand(args []string) (interface{}, error) {
-	res, err := client.executeCommand(C.CustomCommand, args)
+func (client *GlideClient) CustomCommand(ctx context.Context, args []string) (interface{}, error) {
+	res, err := client.executeCommand(ctx, C.CustomCommand, args)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +82,8 @@ func (client *GlideClient) CustomCommand(args []string) (interface{}, error) {
 //	`"OK"` if all configurations have been successfully set. Otherwise, raises an error.
 //
 // [valkey.io]: https://valkey.io/commands/config-set/
-func (client *GlideClient) ConfigSet(parameters map[string]string) (string, error) {
-	result, err := client.executeCommand(C.ConfigSet, utils.MapToString(parameters))
+func (client *GlideClient) ConfigSet(ctx context.Context, parameters map[string]string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ConfigSet, utils.MapToString(parameters))
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -103,8 +105,8 @@ func (client *GlideClient) ConfigSet(parameters map[string]string) (string, erro
 //	A map of api.Result[string] corresponding to the configuration parameters.
 //
 // [valkey.io]: https://valkey.io/commands/config-get/
-func (client *GlideClient) ConfigGet(args []string) (map[string]string, error) {
-	res, err := client.executeCommand(C.ConfigGet, args)
+func (client *GlideClient) ConfigGet(ctx context.Context, args []string) (map[string]string, error) {
+	res, err := client.executeCommand(ctx, C.ConfigGet, args)
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +124,8 @@ func (client *GlideClient) ConfigGet(args []string) (map[string]string, error) {
 //	A simple `"OK"` response.
 //
 // [valkey.io]: https://valkey.io/commands/select/
-func (client *GlideClient) Select(index int64) (string, error) {
-	result, err := client.executeCommand(C.Select, []string{utils.IntToString(index)})
+func (client *GlideClient) Select(ctx context.Context, index int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.Select, []string{utils.IntToString(index)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -140,8 +142,8 @@ func (client *GlideClient) Select(index int64) (string, error) {
 //	A string with the information for the default sections.
 //
 // [valkey.io]: https://valkey.io/commands/info/
-func (client *GlideClient) Info() (string, error) {
-	return client.InfoWithOptions(options.InfoOptions{Sections: []options.Section{}})
+func (client *GlideClient) Info(ctx context.Context) (string, error) {
+	return client.InfoWithOptions(ctx, options.InfoOptions{Sections: []options.Section{}})
 }
 
 // Gets information and statistics about the server.
@@ -157,12 +159,12 @@ func (client *GlideClient) Info() (string, error) {
 //	A string containing the information for the sections requested.
 //
 // [valkey.io]: https://valkey.io/commands/info/
-func (client *GlideClient) InfoWithOptions(options options.InfoOptions) (string, error) {
+func (client *GlideClient) InfoWithOptions(ctx context.Context, options options.InfoOptions) (string, error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return DefaultStringResponse, err
 	}
-	result, err := client.executeCommand(C.Info, optionArgs)
+	result, err := client.executeCommand(ctx, C.Info, optionArgs)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -177,8 +179,8 @@ func (client *GlideClient) InfoWithOptions(options options.InfoOptions) (string,
 //	The number of keys in the currently selected database.
 //
 // [valkey.io]: https://valkey.io/commands/dbsize/
-func (client *GlideClient) DBSize() (int64, error) {
-	result, err := client.executeCommand(C.DBSize, []string{})
+func (client *GlideClient) DBSize(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.DBSize, []string{})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -197,8 +199,8 @@ func (client *GlideClient) DBSize() (int64, error) {
 //	The provided message
 //
 // [valkey.io]: https://valkey.io/commands/echo/
-func (client *GlideClient) Echo(message string) (Result[string], error) {
-	result, err := client.executeCommand(C.Echo, []string{message})
+func (client *GlideClient) Echo(ctx context.Context, message string) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Echo, []string{message})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -212,8 +214,8 @@ func (client *GlideClient) Echo(message string) (Result[string], error) {
 //	Returns "PONG".
 //
 // [valkey.io]: https://valkey.io/commands/ping/
-func (client *GlideClient) Ping() (string, error) {
-	return client.PingWithOptions(options.PingOptions{})
+func (client *GlideClient) Ping(ctx context.Context) (string, error) {
+	return client.PingWithOptions(ctx, options.PingOptions{})
 }
 
 // Pings the server.
@@ -227,12 +229,12 @@ func (client *GlideClient) Ping() (string, error) {
 //	Returns the copy of message.
 //
 // [valkey.io]: https://valkey.io/commands/ping/
-func (client *GlideClient) PingWithOptions(pingOptions options.PingOptions) (string, error) {
+func (client *GlideClient) PingWithOptions(ctx context.Context, pingOptions options.PingOptions) (string, error) {
 	optionArgs, err := pingOptions.ToArgs()
 	if err != nil {
 		return DefaultStringResponse, err
 	}
-	result, err := client.executeCommand(C.Ping, optionArgs)
+	result, err := client.executeCommand(ctx, C.Ping, optionArgs)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -248,8 +250,8 @@ func (client *GlideClient) PingWithOptions(pingOptions options.PingOptions) (str
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushall/
-func (client *GlideClient) FlushAll() (string, error) {
-	result, err := client.executeCommand(C.FlushAll, []string{})
+func (client *GlideClient) FlushAll(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FlushAll, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -269,8 +271,8 @@ func (client *GlideClient) FlushAll() (string, error) {
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushall/
-func (client *GlideClient) FlushAllWithOptions(mode options.FlushMode) (string, error) {
-	result, err := client.executeCommand(C.FlushAll, []string{string(mode)})
+func (client *GlideClient) FlushAllWithOptions(ctx context.Context, mode options.FlushMode) (string, error) {
+	result, err := client.executeCommand(ctx, C.FlushAll, []string{string(mode)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -286,8 +288,8 @@ func (client *GlideClient) FlushAllWithOptions(mode options.FlushMode) (string,
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushdb/
-func (client *GlideClient) FlushDB() (string, error) {
-	result, err := client.executeCommand(C.FlushDB, []string{})
+func (client *GlideClient) FlushDB(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FlushDB, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -307,8 +309,8 @@ func (client *GlideClient) FlushDB() (string, error) {
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushdb/
-func (client *GlideClient) FlushDBWithOptions(mode options.FlushMode) (string, error) {
-	result, err := client.executeCommand(C.FlushDB, []string{string(mode)})
+func (client *GlideClient) FlushDBWithOptions(ctx context.Context, mode options.FlushMode) (string, error) {
+	result, err := client.executeCommand(ctx, C.FlushDB, []string{string(mode)})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -322,8 +324,8 @@ func (client *GlideClient) FlushDBWithOptions(mode options.FlushMode) (string, e
 // A piece of generative computer art of that specific valkey version along with the Valkey version.
 //
 // [valkey.io]: https://valkey.io/commands/lolwut/
-func (client *GlideClient) Lolwut() (string, error) {
-	result, err := client.executeCommand(C.Lolwut, []string{})
+func (client *GlideClient) Lolwut(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.Lolwut, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -341,12 +343,12 @@ func (client *GlideClient) Lolwut() (string, error) {
 // A piece of generative computer art of that specific valkey version along with the Valkey version.
 //
 // [valkey.io]: https://valkey.io/commands/lolwut/
-func (client *baseClient) LolwutWithOptions(opts options.LolwutOptions) (string, error) {
+func (client *baseClient) LolwutWithOptions(ctx context.Context, opts options.LolwutOptions) (string, error) {
 	commandArgs, err := opts.ToArgs()
 	if err != nil {
 		return DefaultStringResponse, err
 	}
-	result, err := client.executeCommand(C.Lolwut, commandArgs)
+	result, err := client.executeCommand(ctx, C.Lolwut, commandArgs)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -360,8 +362,8 @@ func (client *baseClient) LolwutWithOptions(opts options.LolwutOptions) (string,
 //	The id of the client.
 //
 // [valkey.io]: https://valkey.io/commands/client-id/
-func (client *GlideClient) ClientId() (int64, error) {
-	result, err := client.executeCommand(C.ClientId, []string{})
+func (client *GlideClient) ClientId(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ClientId, []string{})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -375,8 +377,8 @@ func (client *GlideClient) ClientId() (int64, error) {
 //	UNIX TIME of the last DB save executed with success.
 //
 // [valkey.io]: https://valkey.io/commands/lastsave/
-func (client *GlideClient) LastSave() (int64, error) {
-	response, err := client.executeCommand(C.LastSave, []string{})
+func (client *GlideClient) LastSave(ctx context.Context) (int64, error) {
+	response, err := client.executeCommand(ctx, C.LastSave, []string{})
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -390,8 +392,8 @@ func (client *GlideClient) LastSave() (int64, error) {
 //	OK to confirm that the statistics were successfully reset.
 //
 // [valkey.io]: https://valkey.io/commands/config-resetstat/
-func (client *GlideClient) ConfigResetStat() (string, error) {
-	response, err := client.executeCommand(C.ConfigResetStat, []string{})
+func (client *GlideClient) ConfigResetStat(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ConfigResetStat, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -405,8 +407,8 @@ func (client *GlideClient) ConfigResetStat() (string, error) {
 //	The name of the client connection as a string if a name is set, or nil if  no name is assigned.
 //
 // [valkey.io]: https://valkey.io/commands/client-getname/
-func (client *GlideClient) ClientGetName() (string, error) {
-	result, err := client.executeCommand(C.ClientGetName, []string{})
+func (client *GlideClient) ClientGetName(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientGetName, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -424,8 +426,77 @@ func (client *GlideClient) ClientGetName() (string, error) {
 //	OK - when connection name is set
 //
 // [valkey.io]: https://valkey.io/commands/client-setname/
-func (client *GlideClient) ClientSetName(connectionName string) (string, error) {
-	result, err := client.executeCommand(C.ClientSetName, []string{connectionName})
+func (client *GlideClient) ClientSetName(ctx context.Context, connectionName string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientSetName, []string{connectionName})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Executes a batch by processing the queued commands.
+//
+// An atomic batch is executed as a transaction using MULTI and EXEC. A non-atomic batch is executed as a pipeline.
+// See [Batch] and [valkey.io] for details.
+//
+// Parameters:
+//
+//	batch        - A [Batch] containing the commands to execute.
+//	raiseOnError - Determines how errors are handled within the batch response. When true, the first encountered error is
+//	               returned and the other results are discarded. When false, errors are included in the response in place
+//	               of the results of the failed commands.
+//
+// Return value:
+//
+//	A slice of results corresponding to the commands of the batch. The type of each result is the same as the type
+//	returned by the matching client command. If the batch is atomic and a key watched with WATCH was modified before
+//	the execution, the batch is not executed and an [errors.TransactionAbortedError] is returned.
+//
+// [valkey.io]: https://valkey.io/topics/transactions/
+func (client *GlideClient) Exec(ctx context.Context, batch *Batch, raiseOnError bool) ([]any, error) {
+	return client.executeBatch(ctx, batch, raiseOnError, 0, nil, nil)
+}
+
+// Executes a batch by processing the queued commands with the given options.
+//
+// See [GlideClient.Exec] and [valkey.io] for details.
+//
+// Parameters:
+//
+//	batch        - A [Batch] containing the commands to execute.
+//	raiseOnError - Determines how errors are handled within the batch response. When true, the first encountered error is
+//	               returned and the other results are discarded. When false, errors are included in the response in place
+//	               of the results of the failed commands.
+//	opts         - The [options.BatchOptions] of the execution.
+//
+// Return value:
+//
+//	A slice of results corresponding to the commands of the batch. The type of each result is the same as the type
+//	returned by the matching client command. If the batch is atomic and a key watched with WATCH was modified before
+//	the execution, the batch is not executed and an [errors.TransactionAbortedError] is returned.
+//
+// [valkey.io]: https://valkey.io/topics/transactions/
+func (client *GlideClient) ExecWithOptions(
+	ctx context.Context,
+	batch *Batch,
+	raiseOnError bool,
+	opts options.BatchOptions,
+) ([]any, error) {
+	return client.executeBatch(ctx, batch, raiseOnError, opts.Timeout, nil, nil)
+}
+
+// Flushes all the previously watched keys for a transaction. Executing a transaction will automatically flush all
+// previously watched keys.
+//
+// See [valkey.io] for details.
+//
+// Return value:
+//
+//	A simple `"OK"` response.
+//
+// [valkey.io]: https://valkey.io/commands/unwatch/
+func (client *GlideClient) Unwatch(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.UnWatch, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -444,8 +515,8 @@ func (client *GlideClient) ClientSetName(connectionName string) (string, error)
 //	Returns "OK".
 //
 // [valkey.io]: https://valkey.io/commands/move/
-func (client *GlideClient) Move(key string, dbIndex int64) (bool, error) {
-	result, err := client.executeCommand(C.Move, []string{key, utils.IntToString(dbIndex)})
+func (client *GlideClient) Move(ctx context.Context, key string, dbIndex int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Move, []string{key, utils.IntToString(dbIndex)})
 	if err != nil {
 		return defaultBoolResponse, err
 	}
@@ -467,8 +538,8 @@ func (client *GlideClient) Move(key string, dbIndex int64) (bool, error) {
 //	of the scan. The second element is always an Array of matched keys from the database.
 //
 // [valkey.io]: https://valkey.io/commands/scan/
-func (client *GlideClient) Scan(cursor int64) (string, []string, error) {
-	res, err := client.executeCommand(C.Scan, []string{utils.IntToString(cursor)})
+func (client *GlideClient) Scan(ctx context.Context, cursor int64) (string, []string, error) {
+	res, err := client.executeCommand(ctx, C.Scan, []string{utils.IntToString(cursor)})
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -490,12 +561,16 @@ func (client *GlideClient) Scan(cursor int64) (string, []string, error) {
 //	of the scan. The second element is always an Array of matched keys from the database.
 //
 // [valkey.io]: https://valkey.io/commands/scan/
-func (client *GlideClient) ScanWithOptions(cursor int64, scanOptions options.ScanOptions) (string, []string, error) {
+func (client *GlideClient) ScanWithOptions(
+	ctx context.Context,
+	cursor int64,
+	scanOptions options.ScanOptions,
+) (string, []string, error) {
 	optionArgs, err := scanOptions.ToArgs()
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
-	res, err := client.executeCommand(C.Scan, append([]string{utils.IntToString(cursor)}, optionArgs...))
+	res, err := client.executeCommand(ctx, C.Scan, append([]string{utils.IntToString(cursor)}, optionArgs...))
 	if err != nil {
 		return DefaultStringResponse, nil, err
 	}
@@ -509,8 +584,8 @@ func (client *GlideClient) ScanWithOptions(cursor int64, scanOptions options.Sca
 //	"OK" when the configuration was rewritten properly, otherwise an error is thrown.
 //
 // [valkey.io]: https://valkey.io/commands/config-rewrite/
-func (client *GlideClient) ConfigRewrite() (string, error) {
-	response, err := client.executeCommand(C.ConfigRewrite, []string{})
+func (client *GlideClient) ConfigRewrite(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ConfigRewrite, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -524,10 +599,219 @@ func (client *GlideClient) ConfigRewrite() (string, error) {
 //	A random existing key name from the currently selected database.
 //
 // [valkey.io]: https://valkey.io/commands/randomkey/
-func (client *GlideClient) RandomKey() (Result[string], error) {
-	result, err := client.executeCommand(C.RandomKey, []string{})
+func (client *GlideClient) RandomKey(ctx context.Context) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.RandomKey, []string{})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
 	return handleStringOrNilResponse(result)
 }
+
+// onOff returns the ON or OFF argument of the commands which enable or disable a setting.
+func onOff(enabled bool) string {
+	if enabled {
+		return "ON"
+	}
+	return "OFF"
+}
+
+// Returns information about the client connections of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice of [ClientInfo] describing the connections.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *GlideClient) ClientList(ctx context.Context) ([]ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	clients, err := handleClusterResponse(result, false, parseClientInfos)
+	return clients.SingleValue(), err
+}
+
+// Returns information about the current connection.
+//
+// Since:
+//
+//	Valkey 6.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClientInfo] describing the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *GlideClient) ClientInfo(ctx context.Context) (ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientInfo, []string{})
+	if err != nil {
+		return ClientInfo{}, err
+	}
+	info, err := handleClusterResponse(result, false, parseClientInfo)
+	return info.SingleValue(), err
+}
+
+// Closes the client connections matching a filter.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	filter - The filter of the connections to close, see [options.ClientKillFilter].
+//
+// Return value:
+//
+//	The number of closed connections.
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *GlideClient) ClientKill(ctx context.Context, filter *options.ClientKillFilter) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ClientKill, filter.ToArgs())
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Suspends the commands of all the clients of the server, for example to stop the writes during a failover. The commands
+// of the clients are processed once the timeout elapses or [GlideClient.ClientUnpause] is called.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	timeout - The duration of the pause in milliseconds.
+//	mode - The commands to suspend, see [options.ClientPauseMode].
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *GlideClient) ClientPause(
+	ctx context.Context,
+	timeout int64,
+	mode options.ClientPauseMode,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientPause, []string{utils.IntToString(timeout), string(mode)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Resumes the commands of the clients suspended by [GlideClient.ClientPause].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *GlideClient) ClientUnpause(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnpause, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Sets whether the keys of the client connection are excluded from the eviction of keys when the memory limit is
+// reached. The setting is lost if the client reconnects.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - True to exclude the connection from the eviction.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *GlideClient) ClientNoEvict(ctx context.Context, enabled bool) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientNoEvict, []string{onOff(enabled)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Sets whether the commands of the client connection leave the last access time of the keys unchanged, which is used by
+// the LRU and LFU eviction policies. The setting is lost if the client reconnects.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - True to leave the last access time of the keys unchanged.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *GlideClient) ClientNoTouch(ctx context.Context, enabled bool) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientNoTouch, []string{onOff(enabled)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Unblocks a client connection blocked by a blocking command, such as BLPOP or XREAD.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	clientId - The identifier of the blocked connection, as returned by `ClientId`.
+//	mode - How the connection is unblocked, see [options.ClientUnblockMode].
+//
+// Return value:
+//
+//	`true` if the connection was blocked and is unblocked, `false` otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *GlideClient) ClientUnblock(
+	ctx context.Context,
+	clientId int64,
+	mode options.ClientUnblockMode,
+) (bool, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnblock, []string{utils.IntToString(clientId), string(mode)})
+	if err != nil {
+		return defaultBoolResponse, err
+	}
+	unblocked, err := handleClusterResponse(result, false, asBool)
+	return unblocked.SingleValue(), err
+}
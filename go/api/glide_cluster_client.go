@@ -6,6 +6,8 @@ package api
 import "C"
 
 import (
+	"context"
+	"time"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/api/config"
@@ -24,6 +26,9 @@ type GlideClusterClientCommands interface {
 	ServerManagementClusterCommands
 	ConnectionManagementClusterCommands
 	ScriptingAndFunctionClusterCommands
+	PubSubClusterCommands
+	ClusterManagementCommands
+	AclClusterCommands
 }
 
 // GlideClusterClient implements cluster mode operations by extending baseClient functionality.
@@ -64,8 +69,8 @@ func NewGlideClusterClient(config *GlideClusterClientConfiguration) (GlideCluste
 //	The returned value for the custom command.
 //
 // [Valkey GLIDE Wiki]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#custom-command
-func (client *GlideClusterClient) CustomCommand(args []string) (ClusterValue[interface{}], error) {
-	res, err := client.executeCommand(C.CustomCommand, args)
+func (client *GlideClusterClient) CustomCommand(ctx context.Context, args []string) (ClusterValue[interface{}], error) {
+	res, err := client.executeCommand(ctx, C.CustomCommand, args)
 	if err != nil {
 		return createEmptyClusterValue[interface{}](), err
 	}
@@ -87,8 +92,8 @@ func (client *GlideClusterClient) CustomCommand(args []string) (ClusterValue[int
 //	A map where each address is the key and its corresponding node response is the information for the default sections.
 //
 // [valkey.io]: https://valkey.io/commands/info/
-func (client *GlideClusterClient) Info() (map[string]string, error) {
-	result, err := client.executeCommand(C.Info, []string{})
+func (client *GlideClusterClient) Info(ctx context.Context) (map[string]string, error) {
+	result, err := client.executeCommand(ctx, C.Info, []string{})
 	if err != nil {
 		return nil, err
 	}
@@ -113,13 +118,16 @@ func (client *GlideClusterClient) Info() (map[string]string, error) {
 //	When a single node route is given, command returns a string containing the information for the sections requested.
 //
 // [valkey.io]: https://valkey.io/commands/info/
-func (client *GlideClusterClient) InfoWithOptions(options options.ClusterInfoOptions) (ClusterValue[string], error) {
+func (client *GlideClusterClient) InfoWithOptions(
+	ctx context.Context,
+	options options.ClusterInfoOptions,
+) (ClusterValue[string], error) {
 	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
 	if options.RouteOption == nil || options.RouteOption.Route == nil {
-		response, err := client.executeCommand(C.Info, optionArgs)
+		response, err := client.executeCommand(ctx, C.Info, optionArgs)
 		if err != nil {
 			return createEmptyClusterValue[string](), err
 		}
@@ -129,7 +137,7 @@ func (client *GlideClusterClient) InfoWithOptions(options options.ClusterInfoOpt
 		}
 		return createClusterMultiValue[string](data), nil
 	}
-	response, err := client.executeCommandWithRoute(C.Info, optionArgs, options.Route)
+	response, err := client.executeCommandWithRoute(ctx, C.Info, optionArgs, options.Route)
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -165,10 +173,11 @@ func (client *GlideClusterClient) InfoWithOptions(options options.ClusterInfoOpt
 //
 // [Valkey GLIDE Wiki]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#custom-command
 func (client *GlideClusterClient) CustomCommandWithRoute(
+	ctx context.Context,
 	args []string,
 	route config.Route,
 ) (ClusterValue[interface{}], error) {
-	res, err := client.executeCommandWithRoute(C.CustomCommand, args, route)
+	res, err := client.executeCommandWithRoute(ctx, C.CustomCommand, args, route)
 	if err != nil {
 		return createEmptyClusterValue[interface{}](), err
 	}
@@ -187,8 +196,8 @@ func (client *GlideClusterClient) CustomCommandWithRoute(
 //	Returns "PONG".
 //
 // [valkey.io]: https://valkey.io/commands/ping/
-func (client *GlideClusterClient) Ping() (string, error) {
-	result, err := client.executeCommand(C.Ping, []string{})
+func (client *GlideClusterClient) Ping(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.Ping, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -210,24 +219,27 @@ func (client *GlideClusterClient) Ping() (string, error) {
 //
 //	route := options.RouteOption{config.RandomRoute}
 //	opts  := options.ClusterPingOptions{ &options.PingOptions{ "Hello" }, &route }
-//	result, err := clusterClient.PingWithOptions(opts)
+//	result, err := clusterClient.PingWithOptions(context.Background(), opts)
 //	fmt.Println(result) // Output: Hello
 //
 // [valkey.io]: https://valkey.io/commands/ping/
-func (client *GlideClusterClient) PingWithOptions(pingOptions options.ClusterPingOptions) (string, error) {
+func (client *GlideClusterClient) PingWithOptions(
+	ctx context.Context,
+	pingOptions options.ClusterPingOptions,
+) (string, error) {
 	args, err := pingOptions.ToArgs()
 	if err != nil {
 		return DefaultStringResponse, err
 	}
 	if pingOptions.RouteOption == nil || pingOptions.RouteOption.Route == nil {
-		response, err := client.executeCommand(C.Ping, args)
+		response, err := client.executeCommand(ctx, C.Ping, args)
 		if err != nil {
 			return DefaultStringResponse, err
 		}
 		return handleStringResponse(response)
 	}
 
-	response, err := client.executeCommandWithRoute(C.Ping, args, pingOptions.Route)
+	response, err := client.executeCommandWithRoute(ctx, C.Ping, args, pingOptions.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -250,8 +262,11 @@ func (client *GlideClusterClient) PingWithOptions(pingOptions options.ClusterPin
 // of microseconds already elapsed in the current second.
 // The returned array is in a [UNIX TIME, Microseconds already elapsed] format.
 // [valkey.io]: https://valkey.io/commands/time/
-func (client *GlideClusterClient) TimeWithOptions(opts options.RouteOption) (ClusterValue[[]string], error) {
-	result, err := client.executeCommandWithRoute(C.Time, []string{}, opts.Route)
+func (client *GlideClusterClient) TimeWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[[]string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.Time, []string{}, opts.Route)
 	if err != nil {
 		return createEmptyClusterValue[[]string](), err
 	}
@@ -269,8 +284,8 @@ func (client *GlideClusterClient) TimeWithOptions(opts options.RouteOption) (Clu
 //	The number of keys in the database.
 //
 // [valkey.io]: https://valkey.io/commands/dbsize/
-func (client *GlideClusterClient) DBSizeWithOptions(opts options.RouteOption) (int64, error) {
-	result, err := client.executeCommandWithRoute(C.DBSize, []string{}, opts.Route)
+func (client *GlideClusterClient) DBSizeWithOptions(ctx context.Context, opts options.RouteOption) (int64, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.DBSize, []string{}, opts.Route)
 	if err != nil {
 		return defaultIntResponse, err
 	}
@@ -287,8 +302,8 @@ func (client *GlideClusterClient) DBSizeWithOptions(opts options.RouteOption) (i
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushall/
-func (client *GlideClusterClient) FlushAll() (string, error) {
-	result, err := client.executeCommand(C.FlushAll, []string{})
+func (client *GlideClusterClient) FlushAll(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FlushAll, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -308,15 +323,18 @@ func (client *GlideClusterClient) FlushAll() (string, error) {
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushall/
-func (client *GlideClusterClient) FlushAllWithOptions(flushOptions options.FlushClusterOptions) (string, error) {
+func (client *GlideClusterClient) FlushAllWithOptions(
+	ctx context.Context,
+	flushOptions options.FlushClusterOptions,
+) (string, error) {
 	if flushOptions.RouteOption == nil || flushOptions.RouteOption.Route == nil {
-		result, err := client.executeCommand(C.FlushAll, flushOptions.ToArgs())
+		result, err := client.executeCommand(ctx, C.FlushAll, flushOptions.ToArgs())
 		if err != nil {
 			return DefaultStringResponse, err
 		}
 		return handleStringResponse(result)
 	}
-	result, err := client.executeCommandWithRoute(C.FlushAll, flushOptions.ToArgs(), flushOptions.RouteOption.Route)
+	result, err := client.executeCommandWithRoute(ctx, C.FlushAll, flushOptions.ToArgs(), flushOptions.RouteOption.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -333,8 +351,8 @@ func (client *GlideClusterClient) FlushAllWithOptions(flushOptions options.Flush
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushdb/
-func (client *GlideClusterClient) FlushDB() (string, error) {
-	result, err := client.executeCommand(C.FlushDB, []string{})
+func (client *GlideClusterClient) FlushDB(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FlushDB, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -354,15 +372,18 @@ func (client *GlideClusterClient) FlushDB() (string, error) {
 //	`"OK"` response on success.
 //
 // [valkey.io]: https://valkey.io/commands/flushdb/
-func (client *GlideClusterClient) FlushDBWithOptions(flushOptions options.FlushClusterOptions) (string, error) {
+func (client *GlideClusterClient) FlushDBWithOptions(
+	ctx context.Context,
+	flushOptions options.FlushClusterOptions,
+) (string, error) {
 	if flushOptions.RouteOption == nil || flushOptions.RouteOption.Route == nil {
-		result, err := client.executeCommand(C.FlushDB, flushOptions.ToArgs())
+		result, err := client.executeCommand(ctx, C.FlushDB, flushOptions.ToArgs())
 		if err != nil {
 			return DefaultStringResponse, err
 		}
 		return handleStringResponse(result)
 	}
-	result, err := client.executeCommandWithRoute(C.FlushDB, flushOptions.ToArgs(), flushOptions.RouteOption.Route)
+	result, err := client.executeCommandWithRoute(ctx, C.FlushDB, flushOptions.ToArgs(), flushOptions.RouteOption.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -381,7 +402,10 @@ func (client *GlideClusterClient) FlushDBWithOptions(flushOptions options.FlushC
 //	A map where each address is the key and its corresponding node response is the information for the default sections.
 //
 // [valkey.io]: https://valkey.io/commands/echo/
-func (client *GlideClusterClient) EchoWithOptions(echoOptions options.ClusterEchoOptions) (ClusterValue[string], error) {
+func (client *GlideClusterClient) EchoWithOptions(
+	ctx context.Context,
+	echoOptions options.ClusterEchoOptions,
+) (ClusterValue[string], error) {
 	args, err := echoOptions.ToArgs()
 	if err != nil {
 		return createEmptyClusterValue[string](), err
@@ -390,7 +414,7 @@ func (client *GlideClusterClient) EchoWithOptions(echoOptions options.ClusterEch
 	if echoOptions.RouteOption != nil && echoOptions.RouteOption.Route != nil {
 		route = echoOptions.RouteOption.Route
 	}
-	response, err := client.executeCommandWithRoute(C.Echo, args, route)
+	response, err := client.executeCommandWithRoute(ctx, C.Echo, args, route)
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -411,21 +435,40 @@ func (client *GlideClusterClient) EchoWithOptions(echoOptions options.ClusterEch
 
 // Helper function to perform the cluster scan.
 func (client *GlideClusterClient) clusterScan(
+	ctx context.Context,
 	cursor *options.ClusterScanCursor,
 	opts options.ClusterScanOptions,
 ) (*C.struct_CommandResponse, error) {
+	start := time.Now()
+	if err := ctx.Err(); err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+
+	args, err := opts.ToArgs()
+	if err != nil {
+		return nil, client.rejectRequest(start, err)
+	}
+
+	var cArgsPtr *C.uintptr_t = nil
+	var argLengthsPtr *C.ulong = nil
+	if len(args) > 0 {
+		cArgs, argLengths := toCStrings(args)
+		cArgsPtr = &cArgs[0]
+		argLengthsPtr = &argLengths[0]
+	}
+
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
 	resultChannelPtr := unsafe.Pointer(&resultChannel)
 
 	pinner := pinner{}
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
 
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
-		return nil, &errors.ClosingError{Msg: "Cluster Scan failed. The client is closed."}
+		pinner.Unpin()
+		return nil, client.rejectRequest(start, &errors.ClosingError{Msg: "Cluster Scan failed. The client is closed."})
 	}
 	client.pending[resultChannelPtr] = struct{}{}
 
@@ -433,19 +476,6 @@ func (client *GlideClusterClient) clusterScan(
 	c_cursor := C.new_cluster_cursor(cStr)
 	defer C.free(unsafe.Pointer(cStr))
 
-	args, err := opts.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-
-	var cArgsPtr *C.uintptr_t = nil
-	var argLengthsPtr *C.ulong = nil
-	if len(args) > 0 {
-		cArgs, argLengths := toCStrings(args)
-		cArgsPtr = &cArgs[0]
-		argLengthsPtr = &argLengths[0]
-	}
-
 	C.request_cluster_scan(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
@@ -456,19 +486,7 @@ func (client *GlideClusterClient) clusterScan(
 	)
 	client.mu.Unlock()
 
-	payload := <-resultChannel
-
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
-
-	if payload.error != nil {
-		return nil, payload.error
-	}
-
-	return payload.value, nil
+	return client.waitForResponse(ctx, start, resultChannel, resultChannelPtr, &pinner)
 }
 
 // Incrementally iterates over the keys in the cluster.
@@ -497,9 +515,10 @@ func (client *GlideClusterClient) clusterScan(
 //
 // [valkey.io]: https://valkey.io/commands/scan/
 func (client *GlideClusterClient) Scan(
+	ctx context.Context,
 	cursor options.ClusterScanCursor,
 ) (options.ClusterScanCursor, []string, error) {
-	response, err := client.clusterScan(&cursor, *options.NewClusterScanOptions())
+	response, err := client.clusterScan(ctx, &cursor, *options.NewClusterScanOptions())
 	if err != nil {
 		return *options.NewClusterScanCursorWithId("finished"), []string{}, err
 	}
@@ -535,10 +554,11 @@ func (client *GlideClusterClient) Scan(
 //
 // [valkey.io]: https://valkey.io/commands/scan/
 func (client *GlideClusterClient) ScanWithOptions(
+	ctx context.Context,
 	cursor options.ClusterScanCursor,
 	opts options.ClusterScanOptions,
 ) (options.ClusterScanCursor, []string, error) {
-	response, err := client.clusterScan(&cursor, opts)
+	response, err := client.clusterScan(ctx, &cursor, opts)
 	if err != nil {
 		return *options.NewClusterScanCursorWithId("finished"), []string{}, err
 	}
@@ -554,8 +574,8 @@ func (client *GlideClusterClient) ScanWithOptions(
 // A piece of generative computer art of that specific valkey version along with the Valkey version.
 //
 // [valkey.io]: https://valkey.io/commands/lolwut/
-func (client *GlideClusterClient) Lolwut() (string, error) {
-	result, err := client.executeCommand(C.Lolwut, []string{})
+func (client *GlideClusterClient) Lolwut(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.Lolwut, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -573,14 +593,17 @@ func (client *GlideClusterClient) Lolwut() (string, error) {
 // A piece of generative computer art of that specific valkey version along with the Valkey version.
 //
 // [valkey.io]: https://valkey.io/commands/lolwut/
-func (client *GlideClusterClient) LolwutWithOptions(lolwutOptions options.ClusterLolwutOptions) (ClusterValue[string], error) {
+func (client *GlideClusterClient) LolwutWithOptions(
+	ctx context.Context,
+	lolwutOptions options.ClusterLolwutOptions,
+) (ClusterValue[string], error) {
 	args, err := lolwutOptions.ToArgs()
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
 
 	if lolwutOptions.RouteOption == nil || lolwutOptions.RouteOption.Route == nil {
-		response, err := client.executeCommand(C.Lolwut, args)
+		response, err := client.executeCommand(ctx, C.Lolwut, args)
 		if err != nil {
 			return createEmptyClusterValue[string](), err
 		}
@@ -592,7 +615,7 @@ func (client *GlideClusterClient) LolwutWithOptions(lolwutOptions options.Cluste
 	}
 
 	route := lolwutOptions.RouteOption.Route
-	response, err := client.executeCommandWithRoute(C.Lolwut, args, route)
+	response, err := client.executeCommandWithRoute(ctx, C.Lolwut, args, route)
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -619,8 +642,8 @@ func (client *GlideClusterClient) LolwutWithOptions(lolwutOptions options.Cluste
 //	The id of the client.
 //
 // [valkey.io]: https://valkey.io/commands/client-id/
-func (client *GlideClusterClient) ClientId() (ClusterValue[int64], error) {
-	response, err := client.executeCommand(C.ClientId, []string{})
+func (client *GlideClusterClient) ClientId(ctx context.Context) (ClusterValue[int64], error) {
+	response, err := client.executeCommand(ctx, C.ClientId, []string{})
 	if err != nil {
 		return createEmptyClusterValue[int64](), err
 	}
@@ -643,8 +666,11 @@ func (client *GlideClusterClient) ClientId() (ClusterValue[int64], error) {
 //	The id of the client.
 //
 // [valkey.io]: https://valkey.io/commands/client-id/
-func (client *GlideClusterClient) ClientIdWithOptions(opts options.RouteOption) (ClusterValue[int64], error) {
-	response, err := client.executeCommandWithRoute(C.ClientId, []string{}, opts.Route)
+func (client *GlideClusterClient) ClientIdWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[int64], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientId, []string{}, opts.Route)
 	if err != nil {
 		return createEmptyClusterValue[int64](), err
 	}
@@ -671,8 +697,8 @@ func (client *GlideClusterClient) ClientIdWithOptions(opts options.RouteOption)
 //	UNIX TIME of the last DB save executed with success.
 //
 // [valkey.io]: https://valkey.io/commands/lastsave/
-func (client *GlideClusterClient) LastSave() (ClusterValue[int64], error) {
-	response, err := client.executeCommand(C.LastSave, []string{})
+func (client *GlideClusterClient) LastSave(ctx context.Context) (ClusterValue[int64], error) {
+	response, err := client.executeCommand(ctx, C.LastSave, []string{})
 	if err != nil {
 		return createEmptyClusterValue[int64](), err
 	}
@@ -695,8 +721,11 @@ func (client *GlideClusterClient) LastSave() (ClusterValue[int64], error) {
 //	UNIX TIME of the last DB save executed with success.
 //
 // [valkey.io]: https://valkey.io/commands/lastsave/
-func (client *GlideClusterClient) LastSaveWithOptions(opts options.RouteOption) (ClusterValue[int64], error) {
-	response, err := client.executeCommandWithRoute(C.LastSave, []string{}, opts.Route)
+func (client *GlideClusterClient) LastSaveWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[int64], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LastSave, []string{}, opts.Route)
 	if err != nil {
 		return createEmptyClusterValue[int64](), err
 	}
@@ -722,8 +751,8 @@ func (client *GlideClusterClient) LastSaveWithOptions(opts options.RouteOption)
 //	OK to confirm that the statistics were successfully reset.
 //
 // [valkey.io]: https://valkey.io/commands/config-resetstat/
-func (client *GlideClusterClient) ConfigResetStat() (string, error) {
-	response, err := client.executeCommand(C.ConfigResetStat, []string{})
+func (client *GlideClusterClient) ConfigResetStat(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ConfigResetStat, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -742,8 +771,8 @@ func (client *GlideClusterClient) ConfigResetStat() (string, error) {
 //	OK to confirm that the statistics were successfully reset.
 //
 // [valkey.io]: https://valkey.io/commands/config-resetstat/
-func (client *GlideClusterClient) ConfigResetStatWithOptions(opts options.RouteOption) (string, error) {
-	response, err := client.executeCommandWithRoute(C.ConfigResetStat, []string{}, opts.Route)
+func (client *GlideClusterClient) ConfigResetStatWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ConfigResetStat, []string{}, opts.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -764,9 +793,10 @@ func (client *GlideClusterClient) ConfigResetStatWithOptions(opts options.RouteO
 //
 // [valkey.io]: https://valkey.io/commands/config-set/
 func (client *GlideClusterClient) ConfigSet(
+	ctx context.Context,
 	parameters map[string]string,
 ) (string, error) {
-	result, err := client.executeCommand(C.ConfigSet, utils.MapToString(parameters))
+	result, err := client.executeCommand(ctx, C.ConfigSet, utils.MapToString(parameters))
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -788,9 +818,10 @@ func (client *GlideClusterClient) ConfigSet(
 //
 // [valkey.io]: https://valkey.io/commands/config-set/
 func (client *GlideClusterClient) ConfigSetWithOptions(
+	ctx context.Context,
 	parameters map[string]string, opts options.RouteOption,
 ) (string, error) {
-	result, err := client.executeCommandWithRoute(C.ConfigSet, utils.MapToString(parameters), opts.Route)
+	result, err := client.executeCommandWithRoute(ctx, C.ConfigSet, utils.MapToString(parameters), opts.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -811,9 +842,10 @@ func (client *GlideClusterClient) ConfigSetWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/config-get/
 func (client *GlideClusterClient) ConfigGet(
+	ctx context.Context,
 	parameters []string,
 ) (ClusterValue[interface{}], error) {
-	res, err := client.executeCommand(C.ConfigGet, parameters)
+	res, err := client.executeCommand(ctx, C.ConfigGet, parameters)
 	if err != nil {
 		return createEmptyClusterValue[interface{}](), err
 	}
@@ -839,9 +871,10 @@ func (client *GlideClusterClient) ConfigGet(
 //
 // [valkey.io]: https://valkey.io/commands/config-get/
 func (client *GlideClusterClient) ConfigGetWithOptions(
+	ctx context.Context,
 	parameters []string, opts options.RouteOption,
 ) (ClusterValue[interface{}], error) {
-	res, err := client.executeCommandWithRoute(C.ConfigGet, parameters, opts.Route)
+	res, err := client.executeCommandWithRoute(ctx, C.ConfigGet, parameters, opts.Route)
 	if err != nil {
 		return createEmptyClusterValue[interface{}](), err
 	}
@@ -863,8 +896,8 @@ func (client *GlideClusterClient) ConfigGetWithOptions(
 //	OK - when connection name is set
 //
 // [valkey.io]: https://valkey.io/commands/client-setname/
-func (client *GlideClusterClient) ClientSetName(connectionName string) (ClusterValue[string], error) {
-	response, err := client.executeCommand(C.ClientSetName, []string{connectionName})
+func (client *GlideClusterClient) ClientSetName(ctx context.Context, connectionName string) (ClusterValue[string], error) {
+	response, err := client.executeCommand(ctx, C.ClientSetName, []string{connectionName})
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -889,10 +922,11 @@ func (client *GlideClusterClient) ClientSetName(connectionName string) (ClusterV
 //
 // [valkey.io]: https://valkey.io/commands/client-setname/
 func (client *GlideClusterClient) ClientSetNameWithOptions(
+	ctx context.Context,
 	connectionName string,
 	opts options.RouteOption,
 ) (ClusterValue[string], error) {
-	response, err := client.executeCommandWithRoute(C.ClientSetName, []string{connectionName}, opts.Route)
+	response, err := client.executeCommandWithRoute(ctx, C.ClientSetName, []string{connectionName}, opts.Route)
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -918,8 +952,8 @@ func (client *GlideClusterClient) ClientSetNameWithOptions(
 //	The name of the client connection as a string if a name is set, or nil if  no name is assigned.
 //
 // [valkey.io]: https://valkey.io/commands/client-getname/
-func (client *GlideClusterClient) ClientGetName() (ClusterValue[string], error) {
-	response, err := client.executeCommand(C.ClientGetName, []string{})
+func (client *GlideClusterClient) ClientGetName(ctx context.Context) (ClusterValue[string], error) {
+	response, err := client.executeCommand(ctx, C.ClientGetName, []string{})
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -942,8 +976,11 @@ func (client *GlideClusterClient) ClientGetName() (ClusterValue[string], error)
 //	The name of the client connection as a string if a name is set, or nil if  no name is assigned.
 //
 // [valkey.io]: https://valkey.io/commands/client-getname/
-func (client *GlideClusterClient) ClientGetNameWithOptions(opts options.RouteOption) (ClusterValue[string], error) {
-	response, err := client.executeCommandWithRoute(C.ClientGetName, []string{}, opts.Route)
+func (client *GlideClusterClient) ClientGetNameWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientGetName, []string{}, opts.Route)
 	if err != nil {
 		return createEmptyClusterValue[string](), err
 	}
@@ -970,8 +1007,8 @@ func (client *GlideClusterClient) ClientGetNameWithOptions(opts options.RouteOpt
 //	"OK" when the configuration was rewritten properly, otherwise an error is thrown.
 //
 // [valkey.io]: https://valkey.io/commands/config-rewrite/
-func (client *GlideClusterClient) ConfigRewrite() (string, error) {
-	response, err := client.executeCommand(C.ConfigRewrite, []string{})
+func (client *GlideClusterClient) ConfigRewrite(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ConfigRewrite, []string{})
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -990,8 +1027,8 @@ func (client *GlideClusterClient) ConfigRewrite() (string, error) {
 //	"OK" when the configuration was rewritten properly, otherwise an error is thrown.
 //
 // [valkey.io]: https://valkey.io/commands/config-rewrite/
-func (client *GlideClusterClient) ConfigRewriteWithOptions(opts options.RouteOption) (string, error) {
-	response, err := client.executeCommandWithRoute(C.ConfigRewrite, []string{}, opts.Route)
+func (client *GlideClusterClient) ConfigRewriteWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ConfigRewrite, []string{}, opts.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -1005,8 +1042,8 @@ func (client *GlideClusterClient) ConfigRewriteWithOptions(opts options.RouteOpt
 //	A random key from the database.
 //
 // [valkey.io]: https://valkey.io/commands/randomkey/
-func (client *GlideClusterClient) RandomKey() (Result[string], error) {
-	result, err := client.executeCommand(C.RandomKey, []string{})
+func (client *GlideClusterClient) RandomKey(ctx context.Context) (Result[string], error) {
+	result, err := client.executeCommand(ctx, C.RandomKey, []string{})
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
@@ -1027,14 +1064,226 @@ func (client *GlideClusterClient) RandomKey() (Result[string], error) {
 //	A random key from the database.
 //
 // [valkey.io]: https://valkey.io/commands/randomkey/
-func (client *GlideClusterClient) RandomKeyWithRoute(opts options.RouteOption) (Result[string], error) {
-	result, err := client.executeCommandWithRoute(C.RandomKey, []string{}, opts.Route)
+func (client *GlideClusterClient) RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (Result[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.RandomKey, []string{}, opts.Route)
 	if err != nil {
 		return CreateNilStringResult(), err
 	}
 	return handleStringOrNilResponse(result)
 }
 
+// Posts a message to the given shard channel. The command is routed to the shard owning the slot of the channel, and the
+// message is only propagated to the nodes of that shard.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	channel - The shard channel to publish the message to.
+//	message - The message to publish.
+//
+// Return value:
+//
+//	The number of clients that received the message.
+//
+// [valkey.io]: https://valkey.io/commands/spublish/
+func (client *GlideClusterClient) SPublish(ctx context.Context, channel string, message string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SPublish, []string{channel, message})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Lists the currently active shard channels.
+//
+// The command is routed to all nodes, and their results are combined.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Return value:
+//
+//	An array of the active shard channels. A shard channel is active if it has one or more subscribers.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-shardchannels/
+func (client *GlideClusterClient) PubSubShardChannels(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubShardChannels, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Lists the currently active shard channels matching the given pattern.
+//
+// The command is routed to all nodes, and their results are combined.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	pattern - A glob-style pattern to match the active shard channels.
+//
+// Return value:
+//
+//	An array of the active shard channels matching the pattern.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-shardchannels/
+func (client *GlideClusterClient) PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubShardChannels, []string{pattern})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the number of subscribers for the given shard channels.
+//
+// The command is routed to all nodes, and the numbers of subscribers of each shard channel are summed.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	channels - The shard channels to count the subscribers of.
+//
+// Return value:
+//
+//	A map where the keys are the shard channels and the values are their numbers of subscribers.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-shardnumsub/
+func (client *GlideClusterClient) PubSubShardNumSub(ctx context.Context, channels []string) (map[string]int64, error) {
+	result, err := client.executeCommand(ctx, C.PubSubShardNumSub, channels)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringIntMapResponse(result)
+}
+
+// Executes a batch by processing the queued commands.
+//
+// An atomic batch is executed as a transaction using MULTI and EXEC, and all of its keys must map to the same hash slot.
+// It is routed to the slot owner of its first key, or to a random node if it contains no keys. A non-atomic batch is
+// executed as a pipeline, and each of its commands is routed to the node owning its keys.
+// See [Batch] and [valkey.io] for details.
+//
+// Parameters:
+//
+//	batch        - A [Batch] containing the commands to execute.
+//	raiseOnError - Determines how errors are handled within the batch response. When true, the first encountered error is
+//	               returned and the other results are discarded. When false, errors are included in the response in place
+//	               of the results of the failed commands.
+//
+// Return value:
+//
+//	A slice of results corresponding to the commands of the batch. The type of each result is the same as the type
+//	returned by the matching client command. If the batch is atomic and a key watched with WATCH was modified before
+//	the execution, the batch is not executed and an [errors.TransactionAbortedError] is returned.
+//
+// [valkey.io]: https://valkey.io/topics/transactions/
+func (client *GlideClusterClient) Exec(ctx context.Context, batch *Batch, raiseOnError bool) ([]any, error) {
+	return client.executeBatch(ctx, batch, raiseOnError, 0, nil, nil)
+}
+
+// Flushes all the previously watched keys for a transaction. Executing a transaction will automatically flush all
+// previously watched keys.
+//
+// The command will be routed to all primary nodes.
+//
+// See [valkey.io] for details.
+//
+// Return value:
+//
+//	A simple `"OK"` response.
+//
+// [valkey.io]: https://valkey.io/commands/unwatch/
+func (client *GlideClusterClient) Unwatch(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.UnWatch, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Flushes all the previously watched keys for a transaction. Executing a transaction will automatically flush all
+// previously watched keys.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	opts - specifies the routing configuration for the command.
+//
+//	The client will route the command to the nodes defined by route.
+//
+// Return value:
+//
+//	A simple `"OK"` response.
+//
+// [valkey.io]: https://valkey.io/commands/unwatch/
+func (client *GlideClusterClient) UnwatchWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.UnWatch, []string{}, opts.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Executes a batch by processing the queued commands with the given options.
+//
+// See [GlideClusterClient.Exec] and [valkey.io] for details.
+//
+// Parameters:
+//
+//	batch        - A [Batch] containing the commands to execute.
+//	raiseOnError - Determines how errors are handled within the batch response. When true, the first encountered error is
+//	               returned and the other results are discarded. When false, errors are included in the response in place
+//	               of the results of the failed commands.
+//	opts         - The [options.ClusterBatchOptions] of the execution. The batch can be routed to a single node only, and
+//	               a retry strategy can be set for non-atomic batches only.
+//
+// Return value:
+//
+//	A slice of results corresponding to the commands of the batch. The type of each result is the same as the type
+//	returned by the matching client command. If the batch is atomic and a key watched with WATCH was modified before
+//	the execution, the batch is not executed and an [errors.TransactionAbortedError] is returned.
+//
+// [valkey.io]: https://valkey.io/topics/transactions/
+func (client *GlideClusterClient) ExecWithOptions(
+	ctx context.Context,
+	batch *Batch,
+	raiseOnError bool,
+	opts options.ClusterBatchOptions,
+) ([]any, error) {
+	if batch.isAtomic && opts.RetryStrategy != nil {
+		return nil, &errors.RequestError{Msg: "Retry strategies are not supported for atomic batches"}
+	}
+	var route config.Route
+	if opts.RouteOption != nil && opts.Route != nil {
+		if opts.Route.IsMultiNode() {
+			return nil, &errors.RequestError{Msg: "Batches can only be routed to a single node"}
+		}
+		route = opts.Route
+	}
+	return client.executeBatch(ctx, batch, raiseOnError, opts.Timeout, opts.RetryStrategy, route)
+}
+
 // Loads a library to Valkey.
 //
 // Since:
@@ -1057,6 +1306,7 @@ func (client *GlideClusterClient) RandomKeyWithRoute(opts options.RouteOption) (
 //
 // [valkey.io]: https://valkey.io/commands/function-load/
 func (client *GlideClusterClient) FunctionLoadWithRoute(
+	ctx context.Context,
 	libraryCode string,
 	replace bool,
 	route options.RouteOption,
@@ -1066,7 +1316,7 @@ func (client *GlideClusterClient) FunctionLoadWithRoute(
 		args = append(args, options.ReplaceKeyword)
 	}
 	args = append(args, libraryCode)
-	result, err := client.executeCommandWithRoute(C.FunctionLoad, args, route.Route)
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionLoad, args, route.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -1091,8 +1341,8 @@ func (client *GlideClusterClient) FunctionLoadWithRoute(
 //	`OK`
 //
 // [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *GlideClusterClient) FunctionFlushWithRoute(route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(C.FunctionFlush, []string{}, route.Route)
+func (client *GlideClusterClient) FunctionFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{}, route.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -1117,8 +1367,8 @@ func (client *GlideClusterClient) FunctionFlushWithRoute(route options.RouteOpti
 //	`OK`
 //
 // [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *GlideClusterClient) FunctionFlushSyncWithRoute(route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(C.FunctionFlush, []string{string(options.SYNC)}, route.Route)
+func (client *GlideClusterClient) FunctionFlushSyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{string(options.SYNC)}, route.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
@@ -1143,154 +1393,405 @@ func (client *GlideClusterClient) FunctionFlushSyncWithRoute(route options.Route
 //	`OK`
 //
 // [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *GlideClusterClient) FunctionFlushAsyncWithRoute(route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(C.FunctionFlush, []string{string(options.ASYNC)}, route.Route)
+func (client *GlideClusterClient) FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{string(options.ASYNC)}, route.Route)
 	if err != nil {
 		return DefaultStringResponse, err
 	}
 	return handleStringResponse(result)
 }
 
-// Invokes a previously loaded function.
-// To route to a replica please refer to [FCallReadOnly].
+// Returns information about the function libraries on the nodes defined by the route.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
+//	ctx - The context for controlling the command execution.
+//	query - Filters the listed libraries and sets whether their code is included. See [options.FunctionListQuery].
 //	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	    command to the nodes defined by `route`.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	A slice of [LibraryInfo] describing the libraries and their functions, wrapped by a [ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *GlideClusterClient) FCallWithRoute(function string, route options.RouteOption) (ClusterValue[any], error) {
-	result, err := client.executeCommandWithRoute(
-		C.FCall,
-		[]string{function, utils.IntToString(0)},
-		route.Route,
-	)
-	if err != nil {
-		return createEmptyClusterValue[any](), err
-	}
-	if route.Route != nil &&
-		(route.Route).IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(result)
-		if err != nil {
-			return createEmptyClusterValue[any](), err
-		}
-		return createClusterMultiValue[any](data), nil
-	}
-	data, err := handleAnyResponse(result)
+// [valkey.io]: https://valkey.io/commands/function-list/
+func (client *GlideClusterClient) FunctionListWithRoute(
+	ctx context.Context,
+	query options.FunctionListQuery,
+	route options.RouteOption,
+) (ClusterValue[[]LibraryInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionList, query.ToArgs(), route.Route)
 	if err != nil {
-		return createEmptyClusterValue[any](), err
+		return createEmptyClusterValue[[]LibraryInfo](), err
 	}
-	return createClusterSingleValue[any](data), nil
+	return handleFunctionListClusterResponse(result, isMultiNodeRoute(route))
 }
 
-// Invokes a previously loaded read-only function.
+// Returns information about the function currently running and the available execution engines on the nodes defined by
+// the route.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
+//	ctx - The context for controlling the command execution.
 //	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	    command to the nodes defined by `route`. The command is routed to all nodes if no route is provided.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	The [FunctionStatsResult] of the nodes wrapped by a [ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *GlideClusterClient) FCallReadOnlyWithRoute(
-	function string,
+// [valkey.io]: https://valkey.io/commands/function-stats/
+func (client *GlideClusterClient) FunctionStatsWithRoute(
+	ctx context.Context,
 	route options.RouteOption,
-) (ClusterValue[any], error) {
-	result, err := client.executeCommandWithRoute(
-		C.FCallReadOnly,
-		[]string{function, utils.IntToString(0)},
-		route.Route,
-	)
-	if err != nil {
-		return createEmptyClusterValue[any](), err
-	}
-	if route.Route != nil &&
-		(route.Route).IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(result)
-		if err != nil {
-			return createEmptyClusterValue[any](), err
-		}
-		return createClusterMultiValue[any](data), nil
-	}
-	data, err := handleAnyResponse(result)
+) (ClusterValue[FunctionStatsResult], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionStats, []string{}, route.Route)
 	if err != nil {
-		return createEmptyClusterValue[any](), err
+		return createEmptyClusterValue[FunctionStatsResult](), err
 	}
-	return createClusterSingleValue[any](data), nil
+	return handleFunctionStatsClusterResponse(result, route.Route == nil || route.Route.IsMultiNode())
 }
 
-// Invokes a previously loaded function.
-// The command will be routed to a random primary node.
+// Deletes a library and all its functions on the nodes defined by the route.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
-//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//	ctx - The context for controlling the command execution.
+//	libName - The name of the library to delete.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
 //
 // Return value:
 //
-//	The invoked function's return value wrapped by a [ClusterValue].
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *GlideClusterClient) FCallWithArgs(function string, args []string) (ClusterValue[any], error) {
-	return client.FCallWithArgsWithRoute(function, args, options.RouteOption{})
+// [valkey.io]: https://valkey.io/commands/function-delete/
+func (client *GlideClusterClient) FunctionDeleteWithRoute(
+	ctx context.Context,
+	libName string,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionDelete, []string{libName}, route.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
 }
 
-// Invokes a previously loaded function.
+// Kills the function currently running on the nodes defined by the route, assuming no write operation was yet performed
+// by the function.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	function - The function name.
-//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	ctx - The context for controlling the command execution.
 //	route - Specifies the routing configuration for the command. The client will route the
 //	    command to the nodes defined by `route`.
 //
 // Return value:
 //
-//	The invoked function's return value wrapped by a [ClusterValue].
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *GlideClusterClient) FCallWithArgsWithRoute(
+// [valkey.io]: https://valkey.io/commands/function-kill/
+func (client *GlideClusterClient) FunctionKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionKill, []string{}, route.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the serialized payload of all the libraries loaded on the nodes defined by the route.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The serialized payload of the libraries wrapped by a [ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/function-dump/
+func (client *GlideClusterClient) FunctionDumpWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionDump, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return createEmptyClusterValue[string](), err
+		}
+		payloads := make(map[string]string, len(data))
+		for node, payload := range data {
+			payloads[node], _ = payload.(string)
+		}
+		return createClusterMultiValue[string](payloads), nil
+	}
+	payload, err := handleStringResponse(result)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return createClusterSingleValue[string](payload), nil
+}
+
+// Restores the libraries from a payload returned by [GlideClusterClient.FunctionDumpWithRoute] on the nodes defined by
+// the route. The restore fails if one of the libraries already exists.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized payload of the libraries.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *GlideClusterClient) FunctionRestoreWithRoute(
+	ctx context.Context,
+	payload string,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionRestore, []string{payload}, route.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Restores the libraries from a payload returned by [GlideClusterClient.FunctionDumpWithRoute] on the nodes defined by
+// the route, handling the existing libraries with the given policy.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized payload of the libraries.
+//	policy - The policy for the existing libraries, could be [options.AppendPolicy], [options.FlushPolicy] or
+//	    [options.ReplacePolicy].
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *GlideClusterClient) FunctionRestoreWithPolicyWithRoute(
+	ctx context.Context,
+	payload string,
+	policy options.FunctionRestorePolicy,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionRestore, []string{payload, string(policy)}, route.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Invokes a previously loaded function.
+// To route to a replica please refer to [FCallReadOnly].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *GlideClusterClient) FCallWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (ClusterValue[any], error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FCall,
+		[]string{function, utils.IntToString(0)},
+		route.Route,
+	)
+	if err != nil {
+		return createEmptyClusterValue[any](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return createEmptyClusterValue[any](), err
+		}
+		return createClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return createEmptyClusterValue[any](), err
+	}
+	return createClusterSingleValue[any](data), nil
+}
+
+// Invokes a previously loaded read-only function.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *GlideClusterClient) FCallReadOnlyWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (ClusterValue[any], error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FCallReadOnly,
+		[]string{function, utils.IntToString(0)},
+		route.Route,
+	)
+	if err != nil {
+		return createEmptyClusterValue[any](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return createEmptyClusterValue[any](), err
+		}
+		return createClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return createEmptyClusterValue[any](), err
+	}
+	return createClusterSingleValue[any](data), nil
+}
+
+// Invokes a previously loaded function.
+// The command will be routed to a random primary node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//
+// Return value:
+//
+//	The invoked function's return value wrapped by a [ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *GlideClusterClient) FCallWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (ClusterValue[any], error) {
+	return client.FCallWithArgsWithRoute(ctx, function, args, options.RouteOption{})
+}
+
+// Invokes a previously loaded function.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	function - The function name.
+//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The invoked function's return value wrapped by a [ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *GlideClusterClient) FCallWithArgsWithRoute(
+	ctx context.Context,
 	function string,
 	args []string,
 	route options.RouteOption,
 ) (ClusterValue[any], error) {
 	cmdArgs := append([]string{function, utils.IntToString(0)}, args...)
 	result, err := client.executeCommandWithRoute(
+		ctx,
 		C.FCall,
 		cmdArgs,
 		route.Route,
@@ -1334,12 +1835,14 @@ func (client *GlideClusterClient) FCallWithArgsWithRoute(
 //
 // [valkey.io]: https://valkey.io/commands/fcall_ro/
 func (client *GlideClusterClient) FCallReadOnlyWithArgsWithRoute(
+	ctx context.Context,
 	function string,
 	args []string,
 	route options.RouteOption,
 ) (ClusterValue[any], error) {
 	cmdArgs := append([]string{function, utils.IntToString(0)}, args...)
 	result, err := client.executeCommandWithRoute(
+		ctx,
 		C.FCallReadOnly,
 		cmdArgs,
 		route.Route,
@@ -1381,6 +1884,1590 @@ func (client *GlideClusterClient) FCallReadOnlyWithArgsWithRoute(
 //	The invoked function's return value wrapped by a [ClusterValue].
 //
 // [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *GlideClusterClient) FCallReadOnlyWithArgs(function string, args []string) (ClusterValue[any], error) {
-	return client.FCallReadOnlyWithArgsWithRoute(function, args, options.RouteOption{})
+func (client *GlideClusterClient) FCallReadOnlyWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (ClusterValue[any], error) {
+	return client.FCallReadOnlyWithArgsWithRoute(ctx, function, args, options.RouteOption{})
+}
+
+// Invokes a Lua script without arguments on the nodes defined by the route. The script is invoked by its SHA1 hash with
+// EVALSHA, and loaded transparently on the nodes where it is not cached.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The script to invoke, created with [NewScript].
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The value returned by the script wrapped by a [ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *GlideClusterClient) InvokeScriptWithRoute(
+	ctx context.Context,
+	script *Script,
+	route options.RouteOption,
+) (ClusterValue[any], error) {
+	return client.InvokeScriptWithClusterOptions(ctx, script, options.ClusterScriptOptions{RouteOption: &route})
+}
+
+// Invokes a Lua script with the given arguments. The script is invoked by its SHA1 hash with EVALSHA, and loaded
+// transparently on the nodes where it is not cached.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The script to invoke, created with [NewScript].
+//	clusterScriptOptions - The arguments of the script and the route of the command. See [options.ClusterScriptOptions].
+//	    The command is routed to a random node if no route is provided.
+//
+// Return value:
+//
+//	The value returned by the script wrapped by a [ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *GlideClusterClient) InvokeScriptWithClusterOptions(
+	ctx context.Context,
+	script *Script,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (ClusterValue[any], error) {
+	var route config.Route
+	if clusterScriptOptions.RouteOption != nil {
+		route = clusterScriptOptions.Route
+	}
+	result, err := client.executeScript(ctx, script.GetHash(), nil, clusterScriptOptions.Args, route)
+	if err != nil {
+		return createEmptyClusterValue[any](), err
+	}
+	if route != nil && route.IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return createEmptyClusterValue[any](), err
+		}
+		return createClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return createEmptyClusterValue[any](), err
+	}
+	return createClusterSingleValue[any](data), nil
+}
+
+// Checks the existence of scripts in the script cache by their SHA1 digest on the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1s - The SHA1 digests of the scripts to check.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`. For multi-node routes, a script is reported as existing only if it
+//	    exists on all the nodes.
+//
+// Return value:
+//
+//	A slice of boolean values indicating the existence of each script.
+//
+// [valkey.io]: https://valkey.io/commands/script-exists/
+func (client *GlideClusterClient) ScriptExistsWithRoute(
+	ctx context.Context,
+	sha1s []string,
+	route options.RouteOption,
+) ([]bool, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptExists, sha1s, route.Route)
+	if err != nil {
+		return nil, err
+	}
+	return handleBoolArrayResponse(result)
+}
+
+// Flushes the script cache on the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *GlideClusterClient) ScriptFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptFlush, []string{}, route.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Flushes the script cache with the given flush mode on the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	flushOptions - The flushing mode and the route of the command. See [options.FlushClusterOptions].
+//	    The command is routed to all nodes if no route is provided.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *GlideClusterClient) ScriptFlushWithOptions(
+	ctx context.Context,
+	flushOptions options.FlushClusterOptions,
+) (string, error) {
+	var route config.Route
+	if flushOptions.RouteOption != nil {
+		route = flushOptions.Route
+	}
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptFlush, flushOptions.ToArgs(), route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Kills the currently executing Lua script, assuming no write operation was yet performed by the script.
+// The command is routed to all nodes, and succeeds if the script is killed on one of them.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-kill/
+func (client *GlideClusterClient) ScriptKill(ctx context.Context) (string, error) {
+	return client.baseClient.ScriptKill(ctx)
+}
+
+// Kills the currently executing Lua script on the nodes defined by the route, assuming no write operation was yet
+// performed by the script.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-kill/
+func (client *GlideClusterClient) ScriptKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptKill, []string{}, route.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the source code of a script in the script cache of the nodes defined by the route.
+//
+// Since:
+//
+//	Valkey 8.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1 - The SHA1 digest of the script.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route.Route`.
+//
+// Return value:
+//
+//	The source code of the script. When specifying a route other than a single node, it returns a map of the node
+//	addresses to the source code of the script.
+//
+// [valkey.io]: https://valkey.io/commands/script-show/
+func (client *GlideClusterClient) ScriptShowWithRoute(
+	ctx context.Context,
+	sha1 string,
+	route options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptShow, []string{sha1}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), asString)
+}
+
+// Reloads the users from the ACL file configured on each node. If the file of a node is invalid, the existing users of
+// the node are kept.
+// The command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-load/
+func (client *GlideClusterClient) AclLoad(ctx context.Context) (string, error) {
+	// the command is not routed to all nodes by default, and its responses are not aggregated
+	result, err := client.executeCommandWithRoute(ctx, C.AclLoad, []string{}, config.AllNodes)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	if _, err := handleStringToStringMapResponse(result); err != nil {
+		return DefaultStringResponse, err
+	}
+	return OK, nil
+}
+
+// Returns the 10 most recent security events of the nodes defined by the route, such as commands or keys denied by the ACL
+// rules and failed authentications. Each node has its own log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route.Route`.
+//
+// Return value:
+//
+//	A slice of [AclLogEntry], from the most recent to the oldest. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their entries.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClusterClient) AclLogWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[[]AclLogEntry], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclLog, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]AclLogEntry](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), parseAclLogEntries)
+}
+
+// Returns the most recent security events of the nodes defined by the route, such as commands or keys denied by the ACL
+// rules and failed authentications. Each node has its own log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	count - The maximum number of events to return for each node.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route.Route`.
+//
+// Return value:
+//
+//	A slice of [AclLogEntry], from the most recent to the oldest. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their entries.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClusterClient) AclLogWithCountWithRoute(
+	ctx context.Context,
+	count int64,
+	route options.RouteOption,
+) (ClusterValue[[]AclLogEntry], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclLog, []string{utils.IntToString(count)}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]AclLogEntry](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), parseAclLogEntries)
+}
+
+// Clears the log of security events of all the nodes.
+// The command is routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClusterClient) AclLogReset(ctx context.Context) (string, error) {
+	// the command is routed to a random node by default, which would only clear the log of that node
+	result, err := client.executeCommandWithRoute(ctx, C.AclLog, []string{"RESET"}, config.AllNodes)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	if _, err := handleStringToStringMapResponse(result); err != nil {
+		return DefaultStringResponse, err
+	}
+	return OK, nil
+}
+
+// isMultiNodeRoute returns whether the route sends the command to multiple nodes.
+func isMultiNodeRoute(routeOptions options.RouteOption) bool {
+	return routeOptions.Route != nil && routeOptions.Route.IsMultiNode()
+}
+
+// Returns the shards of the cluster, with their slot ranges and their nodes.
+// The command is routed to a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice of [ShardInfo] describing the shards of the cluster.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *GlideClusterClient) ClusterShards(ctx context.Context) ([]ShardInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClusterShards, []string{})
+	if err != nil {
+		return nil, err
+	}
+	shards, err := handleClusterResponse(result, false, parseShardInfos)
+	return shards.SingleValue(), err
+}
+
+// Returns the shards of the cluster, with their slot ranges and their nodes, as seen by the nodes defined by the route.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	A slice of [ShardInfo] describing the shards of the cluster. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their slices of [ShardInfo].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *GlideClusterClient) ClusterShardsWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[[]ShardInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterShards, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]ShardInfo](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), parseShardInfos)
+}
+
+// Returns the nodes of the cluster, with their roles, their states and their slot ranges.
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice of [ClusterNode] describing the nodes of the cluster.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-nodes/
+func (client *GlideClusterClient) ClusterNodes(ctx context.Context) ([]ClusterNode, error) {
+	result, err := client.executeCommand(ctx, C.ClusterNodes, []string{})
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := handleClusterResponse(result, false, parseClusterNodes)
+	return nodes.SingleValue(), err
+}
+
+// Returns the nodes of the cluster, with their roles, their states and their slot ranges, as seen by the nodes defined by
+// the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	A slice of [ClusterNode] describing the nodes of the cluster. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their slices of [ClusterNode].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-nodes/
+func (client *GlideClusterClient) ClusterNodesWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[[]ClusterNode], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterNodes, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClusterNode](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), parseClusterNodes)
+}
+
+// Returns the slot ranges of the cluster, with the nodes serving them.
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice of [SlotInfo] describing the slot ranges of the cluster.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slots/
+func (client *GlideClusterClient) ClusterSlots(ctx context.Context) ([]SlotInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClusterSlots, []string{})
+	if err != nil {
+		return nil, err
+	}
+	slots, err := handleClusterResponse(result, false, parseSlotInfos)
+	return slots.SingleValue(), err
+}
+
+// Returns the slot ranges of the cluster, with the nodes serving them, as seen by the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	A slice of [SlotInfo] describing the slot ranges of the cluster. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their slices of [SlotInfo].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slots/
+func (client *GlideClusterClient) ClusterSlotsWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[[]SlotInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterSlots, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]SlotInfo](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), parseSlotInfos)
+}
+
+// Returns the state of the cluster and its statistics, such as "cluster_state" and "cluster_known_nodes".
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the fields of the cluster information to their values.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-info/
+func (client *GlideClusterClient) ClusterInfo(ctx context.Context) (map[string]string, error) {
+	result, err := client.executeCommand(ctx, C.ClusterInfo, []string{})
+	if err != nil {
+		return nil, err
+	}
+	info, err := handleClusterResponse(result, false, parseClusterInfo)
+	return info.SingleValue(), err
+}
+
+// Returns the state of the cluster and its statistics, as seen by the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	A map of the fields of the cluster information to their values. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their cluster information.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-info/
+func (client *GlideClusterClient) ClusterInfoWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[map[string]string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterInfo, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[map[string]string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), parseClusterInfo)
+}
+
+// Returns the unique identifier of a node.
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The identifier of the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myid/
+func (client *GlideClusterClient) ClusterMyId(ctx context.Context) (string, error) {
+	// without a route, the core routes the command by the slot of "MYID", as if it was a key
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterMyId, []string{}, config.RandomRoute)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the unique identifiers of the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The identifier of the node. When specifying a route other than a single node, it returns a map of the node addresses
+//	to their identifiers.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myid/
+func (client *GlideClusterClient) ClusterMyIdWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterMyId, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), asString)
+}
+
+// Returns the unique identifier of the shard of a node.
+// The command is routed to a random node.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The identifier of the shard of the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myshardid/
+func (client *GlideClusterClient) ClusterMyShardId(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClusterMyShardId, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the unique identifiers of the shards of the nodes defined by the route.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The identifier of the shard of the node. When specifying a route other than a single node, it returns a map of the
+//	node addresses to the identifiers of their shards.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myshardid/
+func (client *GlideClusterClient) ClusterMyShardIdWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterMyShardId, []string{}, route.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(route), asString)
+}
+
+// Returns the hash slot of a key, which is computed by the server.
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key.
+//
+// Return value:
+//
+//	The hash slot of the key.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-keyslot/
+func (client *GlideClusterClient) ClusterKeySlot(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ClusterKeySlot, []string{key})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the number of keys in a hash slot.
+// The command is routed to the primary serving the slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot, between 0 and 16383.
+//
+// Return value:
+//
+//	The number of keys in the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-countkeysinslot/
+func (client *GlideClusterClient) ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ClusterCountKeysInSlot, []string{utils.IntToString(slot)})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the keys in a hash slot.
+// The command is routed to the primary serving the slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot, between 0 and 16383.
+//	count - The maximum number of keys to return.
+//
+// Return value:
+//
+//	A slice with the keys in the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-getkeysinslot/
+func (client *GlideClusterClient) ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.ClusterGetKeysInSlot,
+		[]string{utils.IntToString(slot), utils.IntToString(count)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// executeCommandWithAddressRoute sends a command to the node of the route, which is required since the default routing of
+// the cluster administration commands does not target a specific node.
+func (client *GlideClusterClient) executeCommandWithAddressRoute(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route *config.ByAddressRoute,
+) (*C.struct_CommandResponse, error) {
+	if route == nil {
+		return nil, &errors.RequestError{Msg: "A route to the node is required"}
+	}
+	return client.executeCommandWithRoute(ctx, requestType, args, route)
+}
+
+// slotRangesToArgs returns the first and the last slot of each range.
+func slotRangesToArgs(slotRanges []SlotRange) []string {
+	args := make([]string, 0, 2*len(slotRanges))
+	for _, slotRange := range slotRanges {
+		args = append(args, utils.IntToString(slotRange.Start), utils.IntToString(slotRange.End))
+	}
+	return args
+}
+
+// Assigns slots to the node defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slots - The slots to assign, which must not be assigned to another node.
+//	route - The node to which the slots are assigned.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-addslots/
+func (client *GlideClusterClient) ClusterAddSlots(
+	ctx context.Context,
+	slots []int64,
+	route *config.ByAddressRoute,
+) (string, error) {
+	args := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		args = append(args, utils.IntToString(slot))
+	}
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterAddSlots, args, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Assigns slot ranges to the node defined by the route.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slotRanges - The slot ranges to assign, which must not be assigned to another node.
+//	route - The node to which the slot ranges are assigned.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-addslotsrange/
+func (client *GlideClusterClient) ClusterAddSlotsRange(
+	ctx context.Context,
+	slotRanges []SlotRange,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterAddSlotsRange, slotRangesToArgs(slotRanges), route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Unassigns slots from the node defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slots - The slots to unassign.
+//	route - The node from which the slots are unassigned.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-delslots/
+func (client *GlideClusterClient) ClusterDelSlots(
+	ctx context.Context,
+	slots []int64,
+	route *config.ByAddressRoute,
+) (string, error) {
+	args := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		args = append(args, utils.IntToString(slot))
+	}
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterDelSlots, args, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Unassigns slot ranges from the node defined by the route.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slotRanges - The slot ranges to unassign.
+//	route - The node from which the slot ranges are unassigned.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-delslotsrange/
+func (client *GlideClusterClient) ClusterDelSlotsRange(
+	ctx context.Context,
+	slotRanges []SlotRange,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterDelSlotsRange, slotRangesToArgs(slotRanges), route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Changes the state of a slot on the node defined by the route, to migrate the slot between nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The slot.
+//	action - The change of the state of the slot, see [options.NewClusterSetSlotImporting],
+//	    [options.NewClusterSetSlotMigrating], [options.NewClusterSetSlotNode] and [options.NewClusterSetSlotStable].
+//	route - The node on which the state of the slot is changed.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+func (client *GlideClusterClient) ClusterSetSlot(
+	ctx context.Context,
+	slot int64,
+	action options.ClusterSetSlotAction,
+	route *config.ByAddressRoute,
+) (string, error) {
+	args := append([]string{utils.IntToString(slot)}, action.ToArgs()...)
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterSetslot, args, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Connects the node defined by the route to another node, to add the node to the cluster of the other node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	ip - The IP address of the other node.
+//	port - The port of the other node.
+//	route - The node which is connected to the other node.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-meet/
+func (client *GlideClusterClient) ClusterMeet(
+	ctx context.Context,
+	ip string,
+	port int64,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterMeet, []string{ip, utils.IntToString(port)}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Removes a node from the known nodes of the node defined by the route. The node is banned for 60 seconds, so it should be
+// removed from all the nodes of the cluster within this delay.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	nodeId - The identifier of the node to remove.
+//	route - The node from which the other node is removed.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-forget/
+func (client *GlideClusterClient) ClusterForget(
+	ctx context.Context,
+	nodeId string,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterForget, []string{nodeId}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Makes the node defined by the route a replica of a primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	nodeId - The identifier of the primary.
+//	route - The node which becomes a replica.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-replicate/
+func (client *GlideClusterClient) ClusterReplicate(
+	ctx context.Context,
+	nodeId string,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterReplicate, []string{nodeId}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Starts a manual failover of the primary of the replica defined by the route, with the agreement of the primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - The replica which takes over its primary.
+//
+// Return value:
+//
+//	`"OK"` response when the failover is started. The failover completes asynchronously.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-failover/
+func (client *GlideClusterClient) ClusterFailover(ctx context.Context, route *config.ByAddressRoute) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterFailover, []string{}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Starts a manual failover of the primary of the replica defined by the route, without the agreement of the primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	mode - The failover mode, see [options.ClusterFailoverMode].
+//	route - The replica which takes over its primary.
+//
+// Return value:
+//
+//	`"OK"` response when the failover is started. The failover completes asynchronously.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-failover/
+func (client *GlideClusterClient) ClusterFailoverWithMode(
+	ctx context.Context,
+	mode options.ClusterFailoverMode,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterFailover, []string{string(mode)}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Resets the node defined by the route, which forgets the cluster. The node must not hold any key if it is a primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	mode - The reset mode, see [options.ClusterResetMode].
+//	route - The node to reset.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-reset/
+func (client *GlideClusterClient) ClusterReset(
+	ctx context.Context,
+	mode options.ClusterResetMode,
+	route *config.ByAddressRoute,
+) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterReset, []string{string(mode)}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Saves the cluster configuration of the node defined by the route to its nodes.conf file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - The node which saves its configuration.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-saveconfig/
+func (client *GlideClusterClient) ClusterSaveConfig(ctx context.Context, route *config.ByAddressRoute) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterSaveConfig, []string{}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Advances the configuration epoch of the node defined by the route, unless it is already the greatest epoch of the
+// cluster.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - The node whose epoch is advanced.
+//
+// Return value:
+//
+//	`"BUMPED <epoch>"` if the epoch was advanced, or `"STILL <epoch>"` if it was already the greatest epoch.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-bumpepoch/
+func (client *GlideClusterClient) ClusterBumpEpoch(ctx context.Context, route *config.ByAddressRoute) (string, error) {
+	result, err := client.executeCommandWithAddressRoute(ctx, C.ClusterBumpEpoch, []string{}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Moves keys atomically from the node defined by the route to another node. The keys are deleted from the source node,
+// unless [options.MigrateOptions.SetCopy] is set.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	host - The host of the destination node.
+//	port - The port of the destination node.
+//	key - The key to move, or an empty string to move the keys set with [options.MigrateOptions.SetKeys].
+//	timeout - The maximum idle time of the communication with the destination node, in milliseconds.
+//	migrateOptions - The optional arguments, see [options.MigrateOptions].
+//	route - The source node of the keys.
+//
+// Return value:
+//
+//	`"OK"` response on success, or `"NOKEY"` if none of the keys exists on the source node.
+//
+// [valkey.io]: https://valkey.io/commands/migrate/
+func (client *GlideClusterClient) Migrate(
+	ctx context.Context,
+	host string,
+	port int64,
+	key string,
+	timeout int64,
+	migrateOptions *options.MigrateOptions,
+	route *config.ByAddressRoute,
+) (string, error) {
+	// the destination database is always 0 in cluster mode
+	args := []string{host, utils.IntToString(port), key, "0", utils.IntToString(timeout)}
+	result, err := client.executeCommandWithAddressRoute(ctx, C.Migrate, append(args, migrateOptions.ToArgs()...), route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns information about the client connections of a node.
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A slice of [ClientInfo] describing the connections of the node.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *GlideClusterClient) ClientList(ctx context.Context) (ClusterValue[[]ClientInfo], error) {
+	// without a route, the command would be routed by hashing the "LIST" subcommand
+	return client.ClientListWithOptions(ctx, options.RouteOption{Route: config.RandomRoute})
+}
+
+// Returns information about the client connections of the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	A slice of [ClientInfo] describing the connections of the node. When specifying a route other than a single node, it
+//	returns a map of the node addresses to their connections.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *GlideClusterClient) ClientListWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (ClusterValue[[]ClientInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientList, []string{}, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClientInfo](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), parseClientInfos)
+}
+
+// Returns information about the current connection to a node.
+// The command is routed to a random node.
+//
+// Since:
+//
+//	Valkey 6.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClientInfo] describing the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *GlideClusterClient) ClientInfo(ctx context.Context) (ClusterValue[ClientInfo], error) {
+	return client.ClientInfoWithOptions(ctx, options.RouteOption{})
+}
+
+// Returns information about the current connections to the nodes defined by the route.
+//
+// Since:
+//
+//	Valkey 6.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	A [ClientInfo] describing the connection. When specifying a route other than a single node, it returns a map of the
+//	node addresses to their [ClientInfo].
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *GlideClusterClient) ClientInfoWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (ClusterValue[ClientInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientInfo, []string{}, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[ClientInfo](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), parseClientInfo)
+}
+
+// Closes the client connections of a node matching a filter.
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	filter - The filter of the connections to close, see [options.ClientKillFilter].
+//
+// Return value:
+//
+//	The number of closed connections.
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *GlideClusterClient) ClientKill(
+	ctx context.Context,
+	filter *options.ClientKillFilter,
+) (ClusterValue[int64], error) {
+	return client.ClientKillWithOptions(ctx, filter, options.RouteOption{})
+}
+
+// Closes the client connections of the nodes defined by the route matching a filter.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	filter - The filter of the connections to close, see [options.ClientKillFilter].
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	The number of closed connections. When specifying a route other than a single node, it returns a map of the node
+//	addresses to their numbers of closed connections.
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *GlideClusterClient) ClientKillWithOptions(
+	ctx context.Context,
+	filter *options.ClientKillFilter,
+	routeOptions options.RouteOption,
+) (ClusterValue[int64], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientKill, filter.ToArgs(), routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[int64](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), asInt64)
+}
+
+// Suspends the commands of all the clients of a node. The commands of the clients are processed once the timeout
+// elapses or [GlideClusterClient.ClientUnpause] is called.
+// The command is routed to a random node, use [GlideClusterClient.ClientPauseWithOptions] to pause all the nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	timeout - The duration of the pause in milliseconds.
+//	mode - The commands to suspend, see [options.ClientPauseMode].
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *GlideClusterClient) ClientPause(
+	ctx context.Context,
+	timeout int64,
+	mode options.ClientPauseMode,
+) (ClusterValue[string], error) {
+	return client.ClientPauseWithOptions(ctx, timeout, mode, options.RouteOption{})
+}
+
+// Suspends the commands of all the clients of the nodes defined by the route, for example to stop the writes to all the
+// primaries during a failover.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	timeout - The duration of the pause in milliseconds.
+//	mode - The commands to suspend, see [options.ClientPauseMode].
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	`"OK"` response on success. When specifying a route other than a single node, it returns a map of the node addresses
+//	to their responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *GlideClusterClient) ClientPauseWithOptions(
+	ctx context.Context,
+	timeout int64,
+	mode options.ClientPauseMode,
+	routeOptions options.RouteOption,
+) (ClusterValue[string], error) {
+	args := []string{utils.IntToString(timeout), string(mode)}
+	result, err := client.executeCommandWithRoute(ctx, C.ClientPause, args, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), asString)
+}
+
+// Resumes the commands of the clients of a node suspended by [GlideClusterClient.ClientPause].
+// The command is routed to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *GlideClusterClient) ClientUnpause(ctx context.Context) (ClusterValue[string], error) {
+	return client.ClientUnpauseWithOptions(ctx, options.RouteOption{})
+}
+
+// Resumes the commands of the clients of the nodes defined by the route suspended by
+// [GlideClusterClient.ClientPauseWithOptions].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	`"OK"` response on success. When specifying a route other than a single node, it returns a map of the node addresses
+//	to their responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *GlideClusterClient) ClientUnpauseWithOptions(
+	ctx context.Context,
+	routeOptions options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientUnpause, []string{}, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), asString)
+}
+
+// Sets whether the keys of the client connections are excluded from the eviction of keys when the memory limit is
+// reached. The setting is lost if the client reconnects.
+// The command is routed to all nodes, so that the setting applies to all the connections of the client.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - True to exclude the connections from the eviction.
+//
+// Return value:
+//
+//	A map of the node addresses to their `"OK"` responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *GlideClusterClient) ClientNoEvict(ctx context.Context, enabled bool) (ClusterValue[string], error) {
+	return client.ClientNoEvictWithOptions(ctx, enabled, options.RouteOption{Route: config.AllNodes})
+}
+
+// Sets whether the keys of the connections to the nodes defined by the route are excluded from the eviction of keys when
+// the memory limit is reached. The setting is lost if the client reconnects.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - True to exclude the connections from the eviction.
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	`"OK"` response on success. When specifying a route other than a single node, it returns a map of the node addresses
+//	to their responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *GlideClusterClient) ClientNoEvictWithOptions(
+	ctx context.Context,
+	enabled bool,
+	routeOptions options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientNoEvict, []string{onOff(enabled)}, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), asString)
+}
+
+// Sets whether the commands of the client connections leave the last access time of the keys unchanged, which is used
+// by the LRU and LFU eviction policies. The setting is lost if the client reconnects.
+// The command is routed to all nodes, so that the setting applies to all the connections of the client.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - True to leave the last access time of the keys unchanged.
+//
+// Return value:
+//
+//	A map of the node addresses to their `"OK"` responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *GlideClusterClient) ClientNoTouch(ctx context.Context, enabled bool) (ClusterValue[string], error) {
+	return client.ClientNoTouchWithOptions(ctx, enabled, options.RouteOption{Route: config.AllNodes})
+}
+
+// Sets whether the commands sent to the nodes defined by the route leave the last access time of the keys unchanged,
+// which is used by the LRU and LFU eviction policies. The setting is lost if the client reconnects.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - True to leave the last access time of the keys unchanged.
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	`"OK"` response on success. When specifying a route other than a single node, it returns a map of the node addresses
+//	to their responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *GlideClusterClient) ClientNoTouchWithOptions(
+	ctx context.Context,
+	enabled bool,
+	routeOptions options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientNoTouch, []string{onOff(enabled)}, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), asString)
+}
+
+// Unblocks a client connection of a node blocked by a blocking command, such as BLPOP or XREAD.
+// The command is routed to a random node, use [GlideClusterClient.ClientUnblockWithOptions] to target the node of the
+// connection.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	clientId - The identifier of the blocked connection, as returned by `ClientId`.
+//	mode - How the connection is unblocked, see [options.ClientUnblockMode].
+//
+// Return value:
+//
+//	`true` if the connection was blocked and is unblocked, `false` otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *GlideClusterClient) ClientUnblock(
+	ctx context.Context,
+	clientId int64,
+	mode options.ClientUnblockMode,
+) (ClusterValue[bool], error) {
+	return client.ClientUnblockWithOptions(ctx, clientId, mode, options.RouteOption{})
+}
+
+// Unblocks a client connection of the nodes defined by the route blocked by a blocking command, such as BLPOP or XREAD.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	clientId - The identifier of the blocked connection, as returned by `ClientId`.
+//	mode - How the connection is unblocked, see [options.ClientUnblockMode].
+//	routeOptions - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `routeOptions.Route`.
+//
+// Return value:
+//
+//	`true` if the connection was blocked and is unblocked, `false` otherwise. When specifying a route other than a single
+//	node, it returns a map of the node addresses to their responses.
+//
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *GlideClusterClient) ClientUnblockWithOptions(
+	ctx context.Context,
+	clientId int64,
+	mode options.ClientUnblockMode,
+	routeOptions options.RouteOption,
+) (ClusterValue[bool], error) {
+	args := []string{utils.IntToString(clientId), string(mode)}
+	result, err := client.executeCommandWithRoute(ctx, C.ClientUnblock, args, routeOptions.Route)
+	if err != nil {
+		return createEmptyClusterValue[bool](), err
+	}
+	return handleClusterResponse(result, isMultiNodeRoute(routeOptions), asBool)
 }
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/valkey-io/valkey-glide/go/api/options"
@@ -16,9 +17,9 @@ func ExampleGlideClient_HGet() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	payload, err := client.HGet("my_hash", "field1")
-	payload2, err := client.HGet("my_hash", "nonexistent_field")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	payload, err := client.HGet(context.Background(), "my_hash", "field1")
+	payload2, err := client.HGet(context.Background(), "my_hash", "nonexistent_field")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -40,9 +41,9 @@ func ExampleGlideClusterClient_HGet() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	payload, err := client.HGet("my_hash", "field1")
-	payload2, err := client.HGet("my_hash", "nonexistent_field")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	payload, err := client.HGet(context.Background(), "my_hash", "field1")
+	payload2, err := client.HGet(context.Background(), "my_hash", "nonexistent_field")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -64,8 +65,8 @@ func ExampleGlideClient_HGetAll() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	payload, err := client.HGetAll("my_hash")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	payload, err := client.HGetAll(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -88,8 +89,8 @@ func ExampleGlideClusterClient_HGetAll() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	payload, err := client.HGetAll("my_hash")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	payload, err := client.HGetAll(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -112,8 +113,8 @@ func ExampleGlideClient_HMGet() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	values, err := client.HMGet("my_hash", []string{"field1", "field2"})
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	values, err := client.HMGet(context.Background(), "my_hash", []string{"field1", "field2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -135,8 +136,8 @@ func ExampleGlideClusterClient_HMGet() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	values, err := client.HMGet("my_hash", []string{"field1", "field2"})
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	values, err := client.HMGet(context.Background(), "my_hash", []string{"field1", "field2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -158,8 +159,8 @@ func ExampleGlideClient_HSet() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HGet("my_hash", "field1")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HGet(context.Background(), "my_hash", "field1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -179,8 +180,8 @@ func ExampleGlideClusterClient_HSet() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HGet("my_hash", "field1")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HGet(context.Background(), "my_hash", "field1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -200,9 +201,9 @@ func ExampleGlideClient_HSetNX() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HSetNX("my_hash", "field3", "value")
-	payload, err := client.HGet("my_hash", "field3")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HSetNX(context.Background(), "my_hash", "field3", "value")
+	payload, err := client.HGet(context.Background(), "my_hash", "field3")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -224,9 +225,9 @@ func ExampleGlideClusterClient_HSetNX() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HSetNX("my_hash", "field3", "value")
-	payload, err := client.HGet("my_hash", "field3")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HSetNX(context.Background(), "my_hash", "field3", "value")
+	payload, err := client.HGet(context.Background(), "my_hash", "field3")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -248,8 +249,8 @@ func ExampleGlideClient_HDel() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HDel("my_hash", []string{"field1", "field2"})
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HDel(context.Background(), "my_hash", []string{"field1", "field2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -269,8 +270,8 @@ func ExampleGlideClusterClient_HDel() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HDel("my_hash", []string{"field1", "field2"})
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HDel(context.Background(), "my_hash", []string{"field1", "field2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -290,8 +291,8 @@ func ExampleGlideClient_HLen() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HLen("my_hash")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HLen(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -311,8 +312,8 @@ func ExampleGlideClusterClient_HLen() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HLen("my_hash")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HLen(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -333,8 +334,8 @@ func ExampleGlideClient_HVals() {
 		// other fields here
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HVals("my_hash")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HVals(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -355,8 +356,8 @@ func ExampleGlideClusterClient_HVals() {
 		// other fields here
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HVals("my_hash")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HVals(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -376,8 +377,8 @@ func ExampleGlideClient_HExists() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HExists("my_hash", "field1")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HExists(context.Background(), "my_hash", "field1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -397,8 +398,8 @@ func ExampleGlideClusterClient_HExists() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HExists("my_hash", "field1")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HExists(context.Background(), "my_hash", "field1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -417,8 +418,8 @@ func ExampleGlideClient_HKeys() {
 		"field1": "someValue",
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HKeys("my_hash")
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HKeys(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -434,8 +435,8 @@ func ExampleGlideClusterClient_HKeys() {
 		"field1": "someValue",
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HKeys("my_hash")
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HKeys(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -452,8 +453,8 @@ func ExampleGlideClient_HStrLen() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HStrLen("my_hash", "field1")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HStrLen(context.Background(), "my_hash", "field1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -473,8 +474,8 @@ func ExampleGlideClusterClient_HStrLen() {
 		"field2": "someOtherValue",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HStrLen("my_hash", "field1")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HStrLen(context.Background(), "my_hash", "field1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -494,8 +495,8 @@ func ExampleGlideClient_HIncrBy() {
 		"field2": "14",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HIncrBy("my_hash", "field1", 1)
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HIncrBy(context.Background(), "my_hash", "field1", 1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -515,8 +516,8 @@ func ExampleGlideClusterClient_HIncrBy() {
 		"field2": "14",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HIncrBy("my_hash", "field1", 1)
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HIncrBy(context.Background(), "my_hash", "field1", 1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -536,8 +537,8 @@ func ExampleGlideClient_HIncrByFloat() {
 		"field2": "14",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HIncrByFloat("my_hash", "field1", 1.5)
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HIncrByFloat(context.Background(), "my_hash", "field1", 1.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -557,8 +558,8 @@ func ExampleGlideClusterClient_HIncrByFloat() {
 		"field2": "14",
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	result1, err := client.HIncrByFloat("my_hash", "field1", 1.5)
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	result1, err := client.HIncrByFloat(context.Background(), "my_hash", "field1", 1.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -579,8 +580,8 @@ func ExampleGlideClient_HScan() {
 		// other fields here
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	resCursor, resCollection, err := client.HScan("my_hash", "0")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	resCursor, resCollection, err := client.HScan(context.Background(), "my_hash", "0")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -603,8 +604,8 @@ func ExampleGlideClusterClient_HScan() {
 		// other fields here
 	}
 
-	result, err := client.HSet("my_hash", fields)
-	resCursor, resCollection, err := client.HScan("my_hash", "0")
+	result, err := client.HSet(context.Background(), "my_hash", fields)
+	resCursor, resCollection, err := client.HScan(context.Background(), "my_hash", "0")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -627,8 +628,8 @@ func ExampleGlideClient_HRandField() {
 		// other fields here...
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HRandField("my_hash")
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HRandField(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -646,8 +647,8 @@ func ExampleGlideClusterClient_HRandField() {
 		// other fields here...
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HRandField("my_hash")
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HRandField(context.Background(), "my_hash")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -664,8 +665,8 @@ func ExampleGlideClient_HRandFieldWithCount() {
 		"field2": "someOtherValue",
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HRandFieldWithCount("my_hash", 2)
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HRandFieldWithCount(context.Background(), "my_hash", 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -682,8 +683,8 @@ func ExampleGlideClusterClient_HRandFieldWithCount() {
 		"field2": "someOtherValue",
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HRandFieldWithCount("my_hash", 2)
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HRandFieldWithCount(context.Background(), "my_hash", 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -699,8 +700,8 @@ func ExampleGlideClient_HRandFieldWithCountWithValues() {
 		"field1": "someValue",
 		"field2": "someOtherValue",
 	}
-	client.HSet("my_hash", fields)
-	result, err := client.HRandFieldWithCountWithValues("my_hash", 2)
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HRandFieldWithCountWithValues(context.Background(), "my_hash", 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -717,8 +718,8 @@ func ExampleGlideClusterClient_HRandFieldWithCountWithValues() {
 		"field2": "someOtherValue",
 	}
 
-	client.HSet("my_hash", fields)
-	result, err := client.HRandFieldWithCountWithValues("my_hash", 2)
+	client.HSet(context.Background(), "my_hash", fields)
+	result, err := client.HRandFieldWithCountWithValues(context.Background(), "my_hash", 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -735,9 +736,9 @@ func ExampleGlideClient_HScanWithOptions() {
 		"b": "2",
 	}
 
-	result, err := client.HSet("my_hash", fields)
+	result, err := client.HSet(context.Background(), "my_hash", fields)
 	opts := options.NewHashScanOptions().SetMatch("a")
-	resCursor, resCollection, err := client.HScanWithOptions("my_hash", "0", *opts)
+	resCursor, resCollection, err := client.HScanWithOptions(context.Background(), "my_hash", "0", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -761,9 +762,9 @@ func ExampleGlideClusterClient_HScanWithOptions() {
 		"b": "2",
 	}
 
-	result, err := client.HSet("my_hash", fields)
+	result, err := client.HSet(context.Background(), "my_hash", fields)
 	opts := options.NewHashScanOptions().SetMatch("a")
-	resCursor, resCollection, err := client.HScanWithOptions("my_hash", "0", *opts)
+	resCursor, resCollection, err := client.HScanWithOptions(context.Background(), "my_hash", "0", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -778,3 +779,141 @@ func ExampleGlideClusterClient_HScanWithOptions() {
 	// 0
 	// [a 1]
 }
+
+func ExampleGlideClient_HSetBytes() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	result, err := client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}, {Field: []byte("field2"), Value: []byte{0x80}}},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 2
+}
+
+func ExampleGlideClusterClient_HSetBytes() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}, {Field: []byte("field2"), Value: []byte{0x80}}},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 2
+}
+
+func ExampleGlideClient_HGetBytes() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}},
+	)
+	result, err := client.HGetBytes(context.Background(), []byte("my_hash"), []byte("field1"))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// Output: [0 255]
+}
+
+func ExampleGlideClusterClient_HGetBytes() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}},
+	)
+	result, err := client.HGetBytes(context.Background(), []byte("my_hash"), []byte("field1"))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// Output: [0 255]
+}
+
+func ExampleGlideClient_HGetAllBytes() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}},
+	)
+	result, err := client.HGetAllBytes(context.Background(), []byte("my_hash"))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, entry := range result {
+		fmt.Println(string(entry.Field), entry.Value)
+	}
+
+	// Output: field1 [0 255]
+}
+
+func ExampleGlideClusterClient_HGetAllBytes() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}},
+	)
+	result, err := client.HGetAllBytes(context.Background(), []byte("my_hash"))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, entry := range result {
+		fmt.Println(string(entry.Field), entry.Value)
+	}
+
+	// Output: field1 [0 255]
+}
+
+func ExampleGlideClient_HMGetBytes() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}},
+	)
+	result, err := client.HMGetBytes(context.Background(), []byte("my_hash"), [][]byte{[]byte("field1"), []byte("field2")})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, value := range result {
+		fmt.Println(value.Value(), value.IsNil())
+	}
+
+	// Output:
+	// [0 255] false
+	// [] true
+}
+
+func ExampleGlideClusterClient_HMGetBytes() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	client.HSetBytes(
+		context.Background(),
+		[]byte("my_hash"),
+		[]FieldAndValue{{Field: []byte("field1"), Value: []byte{0x00, 0xff}}},
+	)
+	result, err := client.HMGetBytes(context.Background(), []byte("my_hash"), [][]byte{[]byte("field1"), []byte("field2")})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, value := range result {
+		fmt.Println(value.Value(), value.IsNil())
+	}
+
+	// Output:
+	// [0 255] false
+	// [] true
+}
@@ -2,15 +2,17 @@
 
 package api
 
+import "context"
+
 // Supports commands and transactions for the "HyperLogLog" group of commands for standalone and cluster clients.
 //
 // See [valkey.io] for details.
 //
 // [valkey.io]: https://valkey.io/commands/#hyperloglog
 type HyperLogLogCommands interface {
-	PfAdd(key string, elements []string) (int64, error)
+	PfAdd(ctx context.Context, key string, elements []string) (int64, error)
 
-	PfCount(keys []string) (int64, error)
+	PfCount(ctx context.Context, keys []string) (int64, error)
 
-	PfMerge(destination string, sourceKeys []string) (string, error)
+	PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error)
 }
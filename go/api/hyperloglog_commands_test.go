@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -10,7 +11,7 @@ import (
 
 func ExampleGlideClient_PfAdd() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.PfAdd(uuid.New().String(), []string{"value1", "value2", "value3"})
+	result, err := client.PfAdd(context.Background(), uuid.New().String(), []string{"value1", "value2", "value3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -21,7 +22,7 @@ func ExampleGlideClient_PfAdd() {
 
 func ExampleGlideClusterClient_PfAdd() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.PfAdd(uuid.New().String(), []string{"value1", "value2", "value3"})
+	result, err := client.PfAdd(context.Background(), uuid.New().String(), []string{"value1", "value2", "value3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -33,8 +34,8 @@ func ExampleGlideClusterClient_PfAdd() {
 func ExampleGlideClient_PfCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	key := uuid.New().String()
-	result, err := client.PfAdd(key, []string{"value1", "value2", "value3"})
-	result1, err := client.PfCount([]string{key})
+	result, err := client.PfAdd(context.Background(), key, []string{"value1", "value2", "value3"})
+	result1, err := client.PfCount(context.Background(), []string{key})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -49,8 +50,8 @@ func ExampleGlideClient_PfCount() {
 func ExampleGlideClusterClient_PfCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	key := uuid.New().String()
-	result, err := client.PfAdd(key, []string{"value1", "value2", "value3"})
-	result1, err := client.PfCount([]string{key})
+	result, err := client.PfAdd(context.Background(), key, []string{"value1", "value2", "value3"})
+	result1, err := client.PfCount(context.Background(), []string{key})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -70,13 +71,13 @@ func ExampleGlideClient_PfMerge() {
 	sourceKey2 := uuid.New().String() + "{group}"
 
 	// Add values to source keys
-	_, err := client.PfAdd(sourceKey1, []string{"value1", "value2"})
+	_, err := client.PfAdd(context.Background(), sourceKey1, []string{"value1", "value2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 		return
 	}
 
-	_, err = client.PfAdd(sourceKey2, []string{"value2", "value3", "value4"})
+	_, err = client.PfAdd(context.Background(), sourceKey2, []string{"value2", "value3", "value4"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 		return
@@ -84,7 +85,7 @@ func ExampleGlideClient_PfMerge() {
 
 	// Merge the source keys into a destination key
 	destKey := uuid.New().String() + "{group}"
-	result, err := client.PfMerge(destKey, []string{sourceKey1, sourceKey2})
+	result, err := client.PfMerge(context.Background(), destKey, []string{sourceKey1, sourceKey2})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 		return
@@ -102,13 +103,13 @@ func ExampleGlideClusterClient_PfMerge() {
 	sourceKey2 := uuid.New().String() + "{group}"
 
 	// Add values to source keys
-	_, err := client.PfAdd(sourceKey1, []string{"value1", "value2"})
+	_, err := client.PfAdd(context.Background(), sourceKey1, []string{"value1", "value2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 		return
 	}
 
-	_, err = client.PfAdd(sourceKey2, []string{"value2", "value3", "value4"})
+	_, err = client.PfAdd(context.Background(), sourceKey2, []string{"value2", "value3", "value4"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 		return
@@ -116,7 +117,7 @@ func ExampleGlideClusterClient_PfMerge() {
 
 	// Merge the source keys into a destination key
 	destKey := uuid.New().String() + "{group}"
-	result, err := client.PfMerge(destKey, []string{sourceKey1, sourceKey2})
+	result, err := client.PfMerge(context.Background(), destKey, []string{sourceKey1, sourceKey2})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 		return
@@ -0,0 +1,147 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// CommandInfo describes a command sent by a client, as seen by an [Interceptor].
+type CommandInfo struct {
+	// The name of the command, such as "SET" or "CLIENT INFO". It is empty for the commands sent with CustomCommand,
+	// whose name is the first of the Args.
+	//
+	// The name is read-only: the invoker sends the command of the client method, and ignores a modified name. To send
+	// another command, an interceptor can call another method of the client instead of the invoker.
+	Name string
+	// The arguments of the command, excluding its name.
+	Args []string
+	// The route of the command, nil if the command is routed by the client.
+	Route config.Route
+
+	requestType C.RequestType
+}
+
+// CommandResult is the raw response of a command, as seen by an [Interceptor].
+//
+// The response is owned by the client, and must not be used once the interceptor which received it returns.
+type CommandResult struct {
+	response *C.struct_CommandResponse
+}
+
+// Value converts the response into a Go value, in the same way as the response of CustomCommand.
+func (result *CommandResult) Value() (any, error) {
+	return parseInterface(result.response)
+}
+
+// CommandInvoker sends a command to the server and returns its response. It is passed to an [Interceptor], which may call
+// it with a modified copy of the command, for example to change its arguments.
+type CommandInvoker func(ctx context.Context, command *CommandInfo) (*CommandResult, error)
+
+// Interceptor is called around each command sent by a client, with the command and the invoker sending it. It returns
+// the result of the command, which must be a result returned by the invoker, or an error. Any other result is rejected
+// with a [errors.RequestError].
+//
+// An interceptor can inspect or modify the command before calling the invoker, inspect the result or the error of the
+// invoker and measure its duration, or return an error without calling the invoker at all. Typical uses are audit
+// logging, key-prefix enforcement, redaction and fault injection in tests.
+//
+// Interceptors are set with WithInterceptors in the advanced configuration of the client. They intercept the commands
+// sent by the methods of the client, but not the batches, the scripts and the cluster scans. Interceptors are called
+// concurrently by the concurrent commands of the client, so they must be safe for concurrent use.
+type Interceptor func(ctx context.Context, command *CommandInfo, invoker CommandInvoker) (*CommandResult, error)
+
+// chainInterceptors combines interceptors into a single one, where the first interceptor is the outermost. It returns nil
+// if there are no interceptors.
+func chainInterceptors(interceptors []Interceptor) Interceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, command *CommandInfo, invoker CommandInvoker) (*CommandResult, error) {
+		return interceptors[0](ctx, command, chainedInvoker(interceptors[1:], invoker))
+	}
+}
+
+func chainedInvoker(interceptors []Interceptor, invoker CommandInvoker) CommandInvoker {
+	if len(interceptors) == 0 {
+		return invoker
+	}
+	return func(ctx context.Context, command *CommandInfo) (*CommandResult, error) {
+		return interceptors[0](ctx, command, chainedInvoker(interceptors[1:], invoker))
+	}
+}
+
+// commandNames caches the names of the commands by request type.
+var commandNames sync.Map
+
+// commandName returns the name of the command of the given request type, or an empty string for custom commands.
+func commandName(requestType C.RequestType) string {
+	if name, ok := commandNames.Load(requestType); ok {
+		return name.(string)
+	}
+	var name string
+	if cName := C.get_command_name(uint32(requestType)); cName != nil {
+		name = C.GoString(cName)
+		C.free_command_name(cName)
+	}
+	commandNames.Store(requestType, name)
+	return name
+}
+
+// executeInterceptedCommand sends a command through the interceptor of the client.
+func (client *baseClient) executeInterceptedCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	// the responses received by the invoker, which are freed unless returned by the interceptor
+	var mu sync.Mutex
+	var responses []*C.struct_CommandResponse
+	invoker := func(ctx context.Context, command *CommandInfo) (*CommandResult, error) {
+		response, err := client.sendCommand(ctx, command.requestType, command.Args, command.Route)
+		if err != nil {
+			return nil, err
+		}
+		mu.Lock()
+		responses = append(responses, response)
+		mu.Unlock()
+		return &CommandResult{response: response}, nil
+	}
+
+	command := &CommandInfo{Name: commandName(requestType), Args: args, Route: route, requestType: requestType}
+	result, err := client.interceptor(ctx, command, invoker)
+
+	var response *C.struct_CommandResponse
+	if err == nil && result != nil {
+		response = result.response
+	}
+	mu.Lock()
+	received := false
+	for _, invoked := range responses {
+		if invoked == response {
+			received = true
+		} else if invoked != nil {
+			C.free_command_response(invoked)
+		}
+	}
+	mu.Unlock()
+
+	switch {
+	case err != nil:
+		return nil, err
+	case result == nil:
+		return nil, &errors.RequestError{Msg: "The interceptor of the command returned neither a result nor an error"}
+	case !received:
+		// the response handlers expect a response of the server, which is also freed by them
+		return nil, &errors.RequestError{Msg: "The interceptor of the command returned a result not returned by the invoker"}
+	}
+	return response, nil
+}
@@ -0,0 +1,62 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestChainInterceptors(t *testing.T) {
+	assert.Nil(t, chainInterceptors(nil))
+
+	var calls []string
+	interceptor := func(name string) Interceptor {
+		return func(ctx context.Context, command *CommandInfo, invoker CommandInvoker) (*CommandResult, error) {
+			calls = append(calls, name+" before")
+			modified := *command
+			modified.Args = append(append([]string(nil), command.Args...), name)
+			result, err := invoker(ctx, &modified)
+			calls = append(calls, name+" after")
+			return result, err
+		}
+	}
+	expected := &CommandResult{}
+	invoker := func(ctx context.Context, command *CommandInfo) (*CommandResult, error) {
+		calls = append(calls, "invoker")
+		assert.Equal(t, []string{"key", "first", "second"}, command.Args)
+		return expected, nil
+	}
+
+	chain := chainInterceptors([]Interceptor{interceptor("first"), interceptor("second")})
+	result, err := chain(context.Background(), &CommandInfo{Name: "GET", Args: []string{"key"}}, invoker)
+	assert.NoError(t, err)
+	assert.Same(t, expected, result)
+	assert.Equal(t, []string{"first before", "second before", "invoker", "second after", "first after"}, calls)
+}
+
+func TestExecuteInterceptedCommand(t *testing.T) {
+	injected := &errors.TimeoutError{}
+	client := &baseClient{
+		statistics: newClientStatistics(nil),
+		interceptor: func(ctx context.Context, command *CommandInfo, invoker CommandInvoker) (*CommandResult, error) {
+			assert.Equal(t, []string{"key"}, command.Args)
+			assert.Nil(t, command.Route)
+			// the command is not sent to the server
+			return nil, injected
+		},
+	}
+	response, err := client.executeCommand(context.Background(), 0, []string{"key"})
+	assert.Nil(t, response)
+	assert.Same(t, injected, err)
+
+	client.interceptor = func(ctx context.Context, command *CommandInfo, invoker CommandInvoker) (*CommandResult, error) {
+		return nil, nil
+	}
+	response, err = client.executeCommand(context.Background(), 0, []string{"key"})
+	assert.Nil(t, response)
+	assert.IsType(t, &errors.RequestError{}, err)
+}
@@ -0,0 +1,99 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// SlotCount is the number of hash slots of a cluster.
+const SlotCount = 16384
+
+// crc16Table is the lookup table of CRC16-CCITT (XMODEM), with the polynomial 0x1021, which is used for the hash slots.
+var crc16Table = func() [256]uint16 {
+	var table [256]uint16
+	for i := range table {
+		crc := uint16(i) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}()
+
+func crc16(data string) uint16 {
+	var crc uint16
+	for i := 0; i < len(data); i++ {
+		crc = crc<<8 ^ crc16Table[byte(crc>>8)^data[i]]
+	}
+	return crc
+}
+
+// KeySlot returns the hash slot of a key, as computed by the server in cluster mode, without sending any request.
+//
+// If the key contains a hash tag, which is a non-empty substring between the first "{" and the next "}", only the hash
+// tag is hashed. Keys sharing the same hash tag, such as "{user1000}.following" and "{user1000}.followers", are thus in
+// the same slot, and can be used together in multi-key commands.
+//
+// The slot can be used to route a command with config.NewSlotIdRoute.
+//
+// For example:
+//
+//	api.KeySlot("{user1000}.following") // 3443
+func KeySlot(key string) uint16 {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if length := strings.IndexByte(key[start+1:], '}'); length > 0 {
+			key = key[start+1 : start+1+length]
+		}
+	}
+	return crc16(key) % SlotCount
+}
+
+// GroupKeysBySlot groups keys by their hash slot, see [KeySlot]. The keys of each slot keep their order.
+//
+// To only check that the keys of a multi-key command are in the same slot, use [ValidateSameSlot].
+func GroupKeysBySlot(keys []string) map[uint16][]string {
+	groups := make(map[uint16][]string)
+	for _, key := range keys {
+		slot := KeySlot(key)
+		groups[slot] = append(groups[slot], key)
+	}
+	return groups
+}
+
+// ValidateSameSlot checks that all the keys are in the same hash slot, see [KeySlot], as required by multi-key commands in
+// cluster mode. This allows rejecting a command before sending it, instead of receiving a CROSSSLOT error from the server:
+//
+//	if err := api.ValidateSameSlot("{user1000}.following", "{user1000}.followers"); err != nil {
+//	    return err
+//	}
+//
+// Return value:
+//
+//	nil if the keys are in the same slot, or if there are no keys. Otherwise, an [errors.CrossSlotError] with the slots of
+//	the keys.
+func ValidateSameSlot(keys ...string) error {
+	var slots []uint16
+	for _, key := range keys {
+		slot := KeySlot(key)
+		if !slices.Contains(slots, slot) {
+			slots = append(slots, slot)
+		}
+	}
+	if len(slots) <= 1 {
+		return nil
+	}
+	return &errors.CrossSlotError{
+		Msg:   fmt.Sprintf("CROSSSLOT Keys in request don't hash to the same slot: %v", slots),
+		Slots: slots,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func ExampleKeySlot() {
+	fmt.Println(KeySlot("{user1000}.following"))
+	fmt.Println(KeySlot("{user1000}.followers"))
+
+	// Output:
+	// 3443
+	// 3443
+}
+
+func ExampleGroupKeysBySlot() {
+	groups := GroupKeysBySlot([]string{"{user1000}.following", "{user1000}.followers", "user1001"})
+	fmt.Println(len(groups))
+	fmt.Println(groups[3443])
+
+	// Output:
+	// 2
+	// [{user1000}.following {user1000}.followers]
+}
+
+func ExampleValidateSameSlot() {
+	fmt.Println(ValidateSameSlot("{user1000}.following", "{user1000}.followers"))
+	fmt.Println(ValidateSameSlot("{user1000}.following", "user1001"))
+
+	// Output:
+	// <nil>
+	// CROSSSLOT Keys in request don't hash to the same slot: [3443 7506]
+}
+
+func TestKeySlot(t *testing.T) {
+	assert.Equal(t, uint16(0x31C3), crc16("123456789"))
+
+	assert.Equal(t, uint16(12182), KeySlot("foo"))
+	assert.Equal(t, uint16(0), KeySlot(""))
+	assert.Equal(t, KeySlot("bar"), KeySlot("foo{bar}zap"))
+	// only the first hash tag is used
+	assert.Equal(t, KeySlot("bar"), KeySlot("{bar}{zap}"))
+	// an empty hash tag is ignored
+	assert.Equal(t, crc16("{}foo")%SlotCount, KeySlot("{}foo"))
+	assert.Equal(t, crc16("foo{}{bar}")%SlotCount, KeySlot("foo{}{bar}"))
+	// the hash tag ends at the first "}"
+	assert.Equal(t, KeySlot("{bar"), KeySlot("foo{{bar}}zap"))
+	// an unclosed hash tag is ignored
+	assert.Equal(t, crc16("foo{bar")%SlotCount, KeySlot("foo{bar"))
+}
+
+func TestGroupKeysBySlot(t *testing.T) {
+	groups := GroupKeysBySlot([]string{"{a}1", "b", "{a}2", "{b}"})
+	assert.Equal(t, map[uint16][]string{
+		KeySlot("a"): {"{a}1", "{a}2"},
+		KeySlot("b"): {"b", "{b}"},
+	}, groups)
+	assert.Empty(t, GroupKeysBySlot(nil))
+}
+
+func TestValidateSameSlot(t *testing.T) {
+	assert.NoError(t, ValidateSameSlot())
+	assert.NoError(t, ValidateSameSlot("foo"))
+	assert.NoError(t, ValidateSameSlot("foo", "foo"))
+	// keys with the same hash tag
+	assert.NoError(t, ValidateSameSlot("{user}.name", "{user}.age", "user"))
+
+	// keys without hash tags
+	err := ValidateSameSlot("foo", "bar", "foo")
+	assert.IsType(t, &errors.CrossSlotError{}, err)
+	assert.Equal(t, []uint16{KeySlot("foo"), KeySlot("bar")}, err.(*errors.CrossSlotError).Slots)
+	assert.Contains(t, err.Error(), "CROSSSLOT")
+
+	// keys with different hash tags
+	err = ValidateSameSlot("{a}.name", "{a}.age", "{b}.name")
+	assert.IsType(t, &errors.CrossSlotError{}, err)
+	assert.Equal(t, []uint16{KeySlot("a"), KeySlot("b")}, err.(*errors.CrossSlotError).Slots)
+}
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/valkey-io/valkey-glide/go/api/options"
@@ -10,7 +11,7 @@ import (
 
 func ExampleGlideClient_LPush() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"value1", "value2"})
+	result, err := client.LPush(context.Background(), "my_list", []string{"value1", "value2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -21,7 +22,7 @@ func ExampleGlideClient_LPush() {
 
 func ExampleGlideClusterClient_LPush() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"value1", "value2"})
+	result, err := client.LPush(context.Background(), "my_list", []string{"value1", "value2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -32,9 +33,9 @@ func ExampleGlideClusterClient_LPush() {
 
 func ExampleGlideClient_LPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"value1", "value2"})
-	result1, err := client.LPop("my_list")
-	result2, err := client.LPop("non_existent")
+	result, err := client.LPush(context.Background(), "my_list", []string{"value1", "value2"})
+	result1, err := client.LPop(context.Background(), "my_list")
+	result2, err := client.LPop(context.Background(), "non_existent")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -50,9 +51,9 @@ func ExampleGlideClient_LPop() {
 
 func ExampleGlideClusterClient_LPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"value1", "value2"})
-	result1, err := client.LPop("my_list")
-	result2, err := client.LPop("non_existent")
+	result, err := client.LPush(context.Background(), "my_list", []string{"value1", "value2"})
+	result1, err := client.LPop(context.Background(), "my_list")
+	result2, err := client.LPop(context.Background(), "non_existent")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -68,9 +69,9 @@ func ExampleGlideClusterClient_LPop() {
 
 func ExampleGlideClient_LPopCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"value1", "value2"})
-	result1, err := client.LPopCount("my_list", 2)
-	result2, err := client.LPop("non_existent")
+	result, err := client.LPush(context.Background(), "my_list", []string{"value1", "value2"})
+	result1, err := client.LPopCount(context.Background(), "my_list", 2)
+	result2, err := client.LPop(context.Background(), "non_existent")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -86,9 +87,9 @@ func ExampleGlideClient_LPopCount() {
 
 func ExampleGlideClusterClient_LPopCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"value1", "value2"})
-	result1, err := client.LPopCount("my_list", 2)
-	result2, err := client.LPop("non_existent")
+	result, err := client.LPush(context.Background(), "my_list", []string{"value1", "value2"})
+	result1, err := client.LPopCount(context.Background(), "my_list", 2)
+	result2, err := client.LPop(context.Background(), "non_existent")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -104,8 +105,8 @@ func ExampleGlideClusterClient_LPopCount() {
 
 func ExampleGlideClient_LPos() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e"})
-	result1, err := client.LPos("my_list", "e")
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e"})
+	result1, err := client.LPos(context.Background(), "my_list", "e")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -119,8 +120,8 @@ func ExampleGlideClient_LPos() {
 
 func ExampleGlideClusterClient_LPos() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e"})
-	result1, err := client.LPos("my_list", "e")
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e"})
+	result1, err := client.LPos(context.Background(), "my_list", "e")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -134,8 +135,9 @@ func ExampleGlideClusterClient_LPos() {
 
 func ExampleGlideClient_LPosWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e"})
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e"})
 	result1, err := client.LPosWithOptions(
+		context.Background(),
 		"my_list",
 		"e",
 		*options.NewLPosOptions().SetRank(2),
@@ -153,8 +155,9 @@ func ExampleGlideClient_LPosWithOptions() {
 
 func ExampleGlideClusterClient_LPosWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e"})
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e"})
 	result1, err := client.LPosWithOptions(
+		context.Background(),
 		"my_list",
 		"e",
 		*options.NewLPosOptions().SetRank(2),
@@ -172,8 +175,8 @@ func ExampleGlideClusterClient_LPosWithOptions() {
 
 func ExampleGlideClient_LPosCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LPosCount("my_list", "e", 3)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LPosCount(context.Background(), "my_list", "e", 3)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -187,8 +190,8 @@ func ExampleGlideClient_LPosCount() {
 
 func ExampleGlideClusterClient_LPosCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LPosCount("my_list", "e", 3)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LPosCount(context.Background(), "my_list", "e", 3)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -202,9 +205,9 @@ func ExampleGlideClusterClient_LPosCount() {
 
 func ExampleGlideClient_LPosCountWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LPosCountWithOptions("my_list", "e", 1, *options.NewLPosOptions().SetRank(2))
-	result2, err := client.LPosCountWithOptions("my_list", "e", 3,
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LPosCountWithOptions(context.Background(), "my_list", "e", 1, *options.NewLPosOptions().SetRank(2))
+	result2, err := client.LPosCountWithOptions(context.Background(), "my_list", "e", 3,
 		*options.NewLPosOptions().SetRank(2).SetMaxLen(1000))
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
@@ -221,9 +224,9 @@ func ExampleGlideClient_LPosCountWithOptions() {
 
 func ExampleGlideClusterClient_LPosCountWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LPosCountWithOptions("my_list", "e", 1, *options.NewLPosOptions().SetRank(2))
-	result2, err := client.LPosCountWithOptions("my_list", "e", 3,
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LPosCountWithOptions(context.Background(), "my_list", "e", 1, *options.NewLPosOptions().SetRank(2))
+	result2, err := client.LPosCountWithOptions(context.Background(), "my_list", "e", 3,
 		*options.NewLPosOptions().SetRank(2).SetMaxLen(1000))
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
@@ -240,7 +243,7 @@ func ExampleGlideClusterClient_LPosCountWithOptions() {
 
 func ExampleGlideClient_RPush() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -252,7 +255,7 @@ func ExampleGlideClient_RPush() {
 
 func ExampleGlideClusterClient_RPush() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -264,8 +267,8 @@ func ExampleGlideClusterClient_RPush() {
 
 func ExampleGlideClient_LRange() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LRange("my_list", 0, 2)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LRange(context.Background(), "my_list", 0, 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -279,8 +282,8 @@ func ExampleGlideClient_LRange() {
 
 func ExampleGlideClusterClient_LRange() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LRange("my_list", 0, 2)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LRange(context.Background(), "my_list", 0, 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -294,8 +297,8 @@ func ExampleGlideClusterClient_LRange() {
 
 func ExampleGlideClient_LIndex() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LIndex("my_list", 3)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LIndex(context.Background(), "my_list", 3)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -309,8 +312,8 @@ func ExampleGlideClient_LIndex() {
 
 func ExampleGlideClusterClient_LIndex() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LIndex("my_list", 3)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LIndex(context.Background(), "my_list", 3)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -324,9 +327,9 @@ func ExampleGlideClusterClient_LIndex() {
 
 func ExampleGlideClient_LTrim() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LTrim("my_list", 0, 4)
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LTrim(context.Background(), "my_list", 0, 4)
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -342,9 +345,9 @@ func ExampleGlideClient_LTrim() {
 
 func ExampleGlideClusterClient_LTrim() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LTrim("my_list", 0, 4)
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LTrim(context.Background(), "my_list", 0, 4)
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -360,8 +363,8 @@ func ExampleGlideClusterClient_LTrim() {
 
 func ExampleGlideClient_LLen() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LLen("my_list")
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LLen(context.Background(), "my_list")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -375,8 +378,8 @@ func ExampleGlideClient_LLen() {
 
 func ExampleGlideClusterClient_LLen() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LLen("my_list")
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LLen(context.Background(), "my_list")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -390,9 +393,9 @@ func ExampleGlideClusterClient_LLen() {
 
 func ExampleGlideClient_LRem() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LRem("my_list", 2, "e")
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LRem(context.Background(), "my_list", 2, "e")
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -408,9 +411,9 @@ func ExampleGlideClient_LRem() {
 
 func ExampleGlideClusterClient_LRem() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.LRem("my_list", 2, "e")
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.LRem(context.Background(), "my_list", 2, "e")
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -426,9 +429,9 @@ func ExampleGlideClusterClient_LRem() {
 
 func ExampleGlideClient_RPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.RPop("my_list")
-	result2, err := client.RPop("non_existing_key")
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.RPop(context.Background(), "my_list")
+	result2, err := client.RPop(context.Background(), "non_existing_key")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -444,9 +447,9 @@ func ExampleGlideClient_RPop() {
 
 func ExampleGlideClusterClient_RPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.RPop("my_list")
-	result2, err := client.RPop("non_existing_key")
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.RPop(context.Background(), "my_list")
+	result2, err := client.RPop(context.Background(), "non_existing_key")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -462,8 +465,8 @@ func ExampleGlideClusterClient_RPop() {
 
 func ExampleGlideClient_RPopCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.RPopCount("my_list", 4)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.RPopCount(context.Background(), "my_list", 4)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -477,8 +480,8 @@ func ExampleGlideClient_RPopCount() {
 
 func ExampleGlideClusterClient_RPopCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
-	result1, err := client.RPopCount("my_list", 4)
+	result, err := client.RPush(context.Background(), "my_list", []string{"a", "b", "c", "d", "e", "e", "e"})
+	result1, err := client.RPopCount(context.Background(), "my_list", 4)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -492,9 +495,9 @@ func ExampleGlideClusterClient_RPopCount() {
 
 func ExampleGlideClient_LInsert() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	client.Del([]string{"my_list"})
-	result, err := client.RPush("my_list", []string{"hello", "world"})
-	result1, err := client.LInsert("my_list", options.Before, "world", "there")
+	client.Del(context.Background(), []string{"my_list"})
+	result, err := client.RPush(context.Background(), "my_list", []string{"hello", "world"})
+	result1, err := client.LInsert(context.Background(), "my_list", options.Before, "world", "there")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -508,9 +511,9 @@ func ExampleGlideClient_LInsert() {
 
 func ExampleGlideClusterClient_LInsert() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	client.Del([]string{"my_list"})
-	result, err := client.RPush("my_list", []string{"hello", "world"})
-	result1, err := client.LInsert("my_list", options.Before, "world", "there")
+	client.Del(context.Background(), []string{"my_list"})
+	result, err := client.RPush(context.Background(), "my_list", []string{"hello", "world"})
+	result1, err := client.LInsert(context.Background(), "my_list", options.Before, "world", "there")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -524,9 +527,9 @@ func ExampleGlideClusterClient_LInsert() {
 
 func ExampleGlideClient_BLPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("list_a", []string{"a", "b", "c", "d", "e"})
-	result1, err := client.RPush("list_b", []string{"f", "g", "h", "i", "j"})
-	result2, err := client.BLPop([]string{"list_a", "list_b"}, 0.5)
+	result, err := client.RPush(context.Background(), "list_a", []string{"a", "b", "c", "d", "e"})
+	result1, err := client.RPush(context.Background(), "list_b", []string{"f", "g", "h", "i", "j"})
+	result2, err := client.BLPop(context.Background(), []string{"list_a", "list_b"}, 0.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -542,9 +545,9 @@ func ExampleGlideClient_BLPop() {
 
 func ExampleGlideClusterClient_BLPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("{list}-a", []string{"a", "b", "c", "d", "e"})
-	result1, err := client.RPush("{list}-b", []string{"f", "g", "h", "i", "j"})
-	result2, err := client.BLPop([]string{"{list}-a", "{list}-b"}, 0.5)
+	result, err := client.RPush(context.Background(), "{list}-a", []string{"a", "b", "c", "d", "e"})
+	result1, err := client.RPush(context.Background(), "{list}-b", []string{"f", "g", "h", "i", "j"})
+	result2, err := client.BLPop(context.Background(), []string{"{list}-a", "{list}-b"}, 0.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -560,10 +563,10 @@ func ExampleGlideClusterClient_BLPop() {
 
 func ExampleGlideClient_BRPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	client.Del([]string{"my_list", "list_a", "list_b"})
-	result, err := client.RPush("list_a", []string{"a", "b", "c", "d", "e"})
-	result1, err := client.RPush("list_b", []string{"f", "g", "h", "i", "j"})
-	result2, err := client.BRPop([]string{"list_a", "list_b"}, 0.5)
+	client.Del(context.Background(), []string{"my_list", "list_a", "list_b"})
+	result, err := client.RPush(context.Background(), "list_a", []string{"a", "b", "c", "d", "e"})
+	result1, err := client.RPush(context.Background(), "list_b", []string{"f", "g", "h", "i", "j"})
+	result2, err := client.BRPop(context.Background(), []string{"list_a", "list_b"}, 0.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -579,10 +582,10 @@ func ExampleGlideClient_BRPop() {
 
 func ExampleGlideClusterClient_BRPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	client.Del([]string{"my_list", "{list}-a", "{list}-b"})
-	result, err := client.RPush("{list}-a", []string{"a", "b", "c", "d", "e"})
-	result1, err := client.RPush("{list}-b", []string{"f", "g", "h", "i", "j"})
-	result2, err := client.BRPop([]string{"{list}-a", "{list}-b"}, 0.5)
+	client.Del(context.Background(), []string{"my_list", "{list}-a", "{list}-b"})
+	result, err := client.RPush(context.Background(), "{list}-a", []string{"a", "b", "c", "d", "e"})
+	result1, err := client.RPush(context.Background(), "{list}-b", []string{"f", "g", "h", "i", "j"})
+	result2, err := client.BRPop(context.Background(), []string{"{list}-a", "{list}-b"}, 0.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -598,9 +601,9 @@ func ExampleGlideClusterClient_BRPop() {
 
 func ExampleGlideClient_RPushX() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"value1"})
-	result1, err := client.RPushX("my_list", []string{"value2", "value3"})
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"value1"})
+	result1, err := client.RPushX(context.Background(), "my_list", []string{"value2", "value3"})
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -616,9 +619,9 @@ func ExampleGlideClient_RPushX() {
 
 func ExampleGlideClusterClient_RPushX() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"value1"})
-	result1, err := client.RPushX("my_list", []string{"value2", "value3"})
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"value1"})
+	result1, err := client.RPushX(context.Background(), "my_list", []string{"value2", "value3"})
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -634,9 +637,9 @@ func ExampleGlideClusterClient_RPushX() {
 
 func ExampleGlideClient_LPushX() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.RPush("my_list", []string{"value1"})
-	result1, err := client.LPushX("my_list", []string{"value2", "value3"})
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"value1"})
+	result1, err := client.LPushX(context.Background(), "my_list", []string{"value2", "value3"})
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -652,9 +655,9 @@ func ExampleGlideClient_LPushX() {
 
 func ExampleGlideClusterClient_LPushX() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.RPush("my_list", []string{"value1"})
-	result1, err := client.LPushX("my_list", []string{"value2", "value3"})
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.RPush(context.Background(), "my_list", []string{"value1"})
+	result1, err := client.LPushX(context.Background(), "my_list", []string{"value2", "value3"})
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -670,8 +673,8 @@ func ExampleGlideClusterClient_LPushX() {
 
 func ExampleGlideClient_LMPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.LMPop([]string{"my_list"}, options.Left)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.LMPop(context.Background(), []string{"my_list"}, options.Left)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -685,8 +688,8 @@ func ExampleGlideClient_LMPop() {
 
 func ExampleGlideClusterClient_LMPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.LMPop([]string{"my_list"}, options.Left)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.LMPop(context.Background(), []string{"my_list"}, options.Left)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -700,8 +703,8 @@ func ExampleGlideClusterClient_LMPop() {
 
 func ExampleGlideClient_LMPopCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.LMPopCount([]string{"my_list"}, options.Left, 2)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.LMPopCount(context.Background(), []string{"my_list"}, options.Left, 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -715,8 +718,8 @@ func ExampleGlideClient_LMPopCount() {
 
 func ExampleGlideClusterClient_LMPopCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.LMPopCount([]string{"my_list"}, options.Left, 2)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.LMPopCount(context.Background(), []string{"my_list"}, options.Left, 2)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -730,8 +733,8 @@ func ExampleGlideClusterClient_LMPopCount() {
 
 func ExampleGlideClient_BLMPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.BLMPop([]string{"my_list"}, options.Left, 0.1)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.BLMPop(context.Background(), []string{"my_list"}, options.Left, 0.1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -745,8 +748,8 @@ func ExampleGlideClient_BLMPop() {
 
 func ExampleGlideClusterClient_BLMPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.BLMPop([]string{"my_list"}, options.Left, 0.1)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.BLMPop(context.Background(), []string{"my_list"}, options.Left, 0.1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -760,8 +763,8 @@ func ExampleGlideClusterClient_BLMPop() {
 
 func ExampleGlideClient_BLMPopCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.BLMPopCount([]string{"my_list"}, options.Left, 2, 0.1)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.BLMPopCount(context.Background(), []string{"my_list"}, options.Left, 2, 0.1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -775,8 +778,8 @@ func ExampleGlideClient_BLMPopCount() {
 
 func ExampleGlideClusterClient_BLMPopCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.BLMPopCount([]string{"my_list"}, options.Left, 2, 0.1)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.BLMPopCount(context.Background(), []string{"my_list"}, options.Left, 2, 0.1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -791,9 +794,9 @@ func ExampleGlideClusterClient_BLMPopCount() {
 func ExampleGlideClient_LSet() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.LSet("my_list", 1, "someOtherValue")
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.LSet(context.Background(), "my_list", 1, "someOtherValue")
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -810,9 +813,9 @@ func ExampleGlideClient_LSet() {
 func ExampleGlideClusterClient_LSet() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.LPush("my_list", []string{"one", "two", "three"})
-	result1, err := client.LSet("my_list", 1, "someOtherValue")
-	result2, err := client.LRange("my_list", 0, -1)
+	result, err := client.LPush(context.Background(), "my_list", []string{"one", "two", "three"})
+	result1, err := client.LSet(context.Background(), "my_list", 1, "someOtherValue")
+	result2, err := client.LRange(context.Background(), "my_list", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -828,11 +831,11 @@ func ExampleGlideClusterClient_LSet() {
 
 func ExampleGlideClient_LMove() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list1", []string{"two", "one"})
-	result1, err := client.LPush("my_list2", []string{"four", "three"})
-	result2, err := client.LMove("my_list1", "my_list2", options.Left, options.Left)
-	result3, err := client.LRange("my_list1", 0, -1)
-	result4, err := client.LRange("my_list2", 0, -1)
+	result, err := client.LPush(context.Background(), "my_list1", []string{"two", "one"})
+	result1, err := client.LPush(context.Background(), "my_list2", []string{"four", "three"})
+	result2, err := client.LMove(context.Background(), "my_list1", "my_list2", options.Left, options.Left)
+	result3, err := client.LRange(context.Background(), "my_list1", 0, -1)
+	result4, err := client.LRange(context.Background(), "my_list2", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -852,11 +855,11 @@ func ExampleGlideClient_LMove() {
 
 func ExampleGlideClusterClient_LMove() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("{list}-1", []string{"two", "one"})
-	result1, err := client.LPush("{list}-2", []string{"four", "three"})
-	result2, err := client.LMove("{list}-1", "{list}-2", options.Left, options.Left)
-	result3, err := client.LRange("{list}-1", 0, -1)
-	result4, err := client.LRange("{list}-2", 0, -1)
+	result, err := client.LPush(context.Background(), "{list}-1", []string{"two", "one"})
+	result1, err := client.LPush(context.Background(), "{list}-2", []string{"four", "three"})
+	result2, err := client.LMove(context.Background(), "{list}-1", "{list}-2", options.Left, options.Left)
+	result3, err := client.LRange(context.Background(), "{list}-1", 0, -1)
+	result4, err := client.LRange(context.Background(), "{list}-2", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -876,11 +879,11 @@ func ExampleGlideClusterClient_LMove() {
 
 func ExampleGlideClient_BLMove() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	result, err := client.LPush("my_list1", []string{"two", "one"})
-	result1, err := client.LPush("my_list2", []string{"four", "three"})
-	result2, err := client.BLMove("my_list1", "my_list2", options.Left, options.Left, 0.1)
-	result3, err := client.LRange("my_list1", 0, -1)
-	result4, err := client.LRange("my_list2", 0, -1)
+	result, err := client.LPush(context.Background(), "my_list1", []string{"two", "one"})
+	result1, err := client.LPush(context.Background(), "my_list2", []string{"four", "three"})
+	result2, err := client.BLMove(context.Background(), "my_list1", "my_list2", options.Left, options.Left, 0.1)
+	result3, err := client.LRange(context.Background(), "my_list1", 0, -1)
+	result4, err := client.LRange(context.Background(), "my_list2", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -900,11 +903,11 @@ func ExampleGlideClient_BLMove() {
 
 func ExampleGlideClusterClient_BLMove() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	result, err := client.LPush("{list}-1", []string{"two", "one"})
-	result1, err := client.LPush("{list}-2", []string{"four", "three"})
-	result2, err := client.BLMove("{list}-1", "{list}-2", options.Left, options.Left, 0.1)
-	result3, err := client.LRange("{list}-1", 0, -1)
-	result4, err := client.LRange("{list}-2", 0, -1)
+	result, err := client.LPush(context.Background(), "{list}-1", []string{"two", "one"})
+	result1, err := client.LPush(context.Background(), "{list}-2", []string{"four", "three"})
+	result2, err := client.BLMove(context.Background(), "{list}-1", "{list}-2", options.Left, options.Left, 0.1)
+	result3, err := client.LRange(context.Background(), "{list}-1", 0, -1)
+	result4, err := client.LRange(context.Background(), "{list}-2", 0, -1)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -0,0 +1,170 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+//
+// void logCallback(LogLevel level, uint8_t *message, int64_t messageLen);
+import "C"
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// LogLevel represents the level of a log. A logger configured with a level records the logs of that level or above, where
+// [ErrorLevel] is the highest level.
+type LogLevel int
+
+const (
+	// ErrorLevel - Log the errors only.
+	ErrorLevel LogLevel = iota
+	// WarnLevel - Log the warnings and above.
+	WarnLevel
+	// InfoLevel - Log the informational messages and above.
+	InfoLevel
+	// DebugLevel - Log the debugging messages and above.
+	DebugLevel
+	// TraceLevel - Log everything, including the tracing messages.
+	TraceLevel
+	// OffLevel - Turn off logging completely.
+	OffLevel
+)
+
+// DefaultLogLevel is the level of the logger if it is used before being configured.
+const DefaultLogLevel = WarnLevel
+
+// SlogLevelTrace is the [slog.Level] of the [TraceLevel] logs forwarded to a [slog.Handler] by
+// [GlideLogger.SetSlogHandler]. The other levels are mapped to their slog counterpart.
+const SlogLevelTrace = slog.LevelDebug - 4
+
+func (level LogLevel) toSlogLevel() slog.Level {
+	switch level {
+	case ErrorLevel:
+		return slog.LevelError
+	case WarnLevel:
+		return slog.LevelWarn
+	case InfoLevel:
+		return slog.LevelInfo
+	case DebugLevel:
+		return slog.LevelDebug
+	default:
+		return SlogLevelTrace
+	}
+}
+
+// GlideLogger configures the logger of the Rust core of the clients, and logs messages consistently with it. The logger
+// is shared by all the clients of the process, and is accessed through [Logger].
+//
+// The logger can be set up in 3 ways:
+//   - By calling [GlideLogger.Init], which configures the logger only if it wasn't configured before.
+//   - By calling [GlideLogger.SetLoggerConfig], which replaces the existing configuration, so that the new logs are not
+//     saved together with the logs sent before the call.
+//   - By calling [GlideLogger.SetSlogHandler], which forwards the logs to a [slog.Handler] instead of the console or a
+//     file.
+//
+// If the logger isn't set up, the first log configures it with [DefaultLogLevel], writing to the console.
+type GlideLogger struct {
+	mu         sync.Mutex
+	configured bool
+	level      LogLevel
+}
+
+// Logger is the logger shared by all the clients of the process.
+var Logger = &GlideLogger{}
+
+// slogHandler is the handler receiving the logs of the core, if set by [GlideLogger.SetSlogHandler].
+var slogHandler atomic.Pointer[slog.Handler]
+
+// Init configures the logger if it wasn't configured before. It has no effect otherwise, and is meant to be used when
+// there is no intention to replace an existing configuration.
+//
+// The logger records the logs of the given level or above. If fileName is not empty, the logs are written to files
+// postfixed with fileName, in the directory set by the GLIDE_LOG_DIR environment variable or in the glide-logs directory.
+// Otherwise, the logs are written to the console. To turn off logging completely, set the level to [OffLevel].
+func (logger *GlideLogger) Init(level LogLevel, fileName string) {
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+
+	if !logger.configured {
+		logger.configure(level, fileName)
+	}
+}
+
+// SetLoggerConfig replaces the configuration of the logger. The logger records the logs of the given level or above,
+// writing them to files postfixed with fileName if it is not empty, or to the console otherwise. See
+// [GlideLogger.Init] for details.
+func (logger *GlideLogger) SetLoggerConfig(level LogLevel, fileName string) {
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+
+	logger.configure(level, fileName)
+}
+
+func (logger *GlideLogger) configure(level LogLevel, fileName string) {
+	cLevel := uint32(level)
+	var cFileName *C.char
+	if fileName != "" {
+		cFileName = C.CString(fileName)
+		defer C.free(unsafe.Pointer(cFileName))
+	}
+	logger.level = LogLevel(C.init_logger(&cLevel, cFileName))
+	logger.configured = true
+	slogHandler.Store(nil)
+}
+
+// SetSlogHandler forwards the logs of the given level or above to handler, so that the logs of the core and the logs
+// written with [GlideLogger.Log] flow into the structured logging of the application. The logs are no longer written
+// to the console or to a file, until the logger is configured again with [GlideLogger.SetLoggerConfig].
+//
+// The handler is called synchronously on the thread emitting the log, which may be a thread of the core, so it should
+// return quickly. The logs written by the handler itself through [GlideLogger.Log] are ignored.
+func (logger *GlideLogger) SetSlogHandler(level LogLevel, handler slog.Handler) {
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+
+	slogHandler.Store(&handler)
+	cLevel := uint32(level)
+	logger.level = LogLevel(C.set_log_callback(&cLevel, (C.LogCallback)(unsafe.Pointer(C.logCallback))))
+	logger.configured = true
+}
+
+// Log logs the message with the given level, if the level is not below the level of the logger. The identifier gives
+// context to the log, making it easier to connect it to other relevant logs, for example by passing a task identifier.
+func (logger *GlideLogger) Log(level LogLevel, identifier string, message string) {
+	logger.mu.Lock()
+	if !logger.configured {
+		logger.configure(DefaultLogLevel, "")
+	}
+	loggerLevel := logger.level
+	logger.mu.Unlock()
+
+	if level == OffLevel || level > loggerLevel {
+		return
+	}
+	cIdentifier := C.CString(identifier)
+	defer C.free(unsafe.Pointer(cIdentifier))
+	cMessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cMessage))
+	C.log_message(uint32(level), cIdentifier, cMessage)
+}
+
+//export logCallback
+func logCallback(level C.LogLevel, cMessage *C.uint8_t, messageLen C.int64_t) {
+	handler := slogHandler.Load()
+	if handler == nil {
+		return
+	}
+
+	ctx := context.Background()
+	slogLevel := LogLevel(level).toSlogLevel()
+	if !(*handler).Enabled(ctx, slogLevel) {
+		return
+	}
+	message := C.GoStringN((*C.char)(unsafe.Pointer(cMessage)), C.int(messageLen))
+	_ = (*handler).Handle(ctx, slog.NewRecord(time.Now(), slogLevel, message, 0))
+}
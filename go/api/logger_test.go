@@ -0,0 +1,45 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLevel_toSlogLevel(t *testing.T) {
+	assert.Equal(t, slog.LevelError, ErrorLevel.toSlogLevel())
+	assert.Equal(t, slog.LevelWarn, WarnLevel.toSlogLevel())
+	assert.Equal(t, slog.LevelInfo, InfoLevel.toSlogLevel())
+	assert.Equal(t, slog.LevelDebug, DebugLevel.toSlogLevel())
+	assert.Equal(t, SlogLevelTrace, TraceLevel.toSlogLevel())
+	assert.Less(t, SlogLevelTrace, slog.LevelDebug)
+}
+
+func TestGlideLogger(t *testing.T) {
+	logger := &GlideLogger{}
+	defer slogHandler.Store(nil)
+
+	logger.Init(InfoLevel, "")
+	assert.True(t, logger.configured)
+	assert.Equal(t, InfoLevel, logger.level)
+
+	// the logger is already configured
+	logger.Init(TraceLevel, "")
+	assert.Equal(t, InfoLevel, logger.level)
+
+	logger.SetLoggerConfig(DebugLevel, "")
+	assert.Equal(t, DebugLevel, logger.level)
+
+	handler := slog.Default().Handler()
+	logger.SetSlogHandler(ErrorLevel, handler)
+	assert.Equal(t, ErrorLevel, logger.level)
+	assert.Equal(t, handler, *slogHandler.Load())
+
+	// configuring the logger again stops forwarding the logs to the handler
+	logger.SetLoggerConfig(WarnLevel, "")
+	assert.Equal(t, WarnLevel, logger.level)
+	assert.Nil(t, slogHandler.Load())
+}
@@ -0,0 +1,148 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+const (
+	// DefaultOpenTelemetryFlushIntervalMs is the default interval in milliseconds between two consecutive exports of
+	// spans to the collector.
+	DefaultOpenTelemetryFlushIntervalMs = 5000
+	// DefaultOpenTelemetrySamplePercentage is the default percentage of the commands for which a span is created.
+	DefaultOpenTelemetrySamplePercentage = 1
+)
+
+// OpenTelemetryConfig represents the configuration of the OpenTelemetry tracing of the commands sent by the clients.
+//
+// The spans are exported to a collector endpoint, which is a URL with one of the following schemes:
+//   - http:// or https:// - an OTLP collector accepting HTTP requests.
+//   - grpc:// - an OTLP collector accepting gRPC requests.
+//   - file:// - a local directory. The spans are appended as JSON lines to the spans.json file in that directory, which
+//     is useful for tests and offline debugging.
+type OpenTelemetryConfig struct {
+	endpoint         string
+	flushIntervalMs  int64
+	samplePercentage int
+}
+
+// NewOpenTelemetryConfig returns an [OpenTelemetryConfig] exporting the spans to the given collector endpoint, with the
+// default flush interval and sample percentage.
+func NewOpenTelemetryConfig(endpoint string) *OpenTelemetryConfig {
+	return &OpenTelemetryConfig{
+		endpoint:         endpoint,
+		flushIntervalMs:  DefaultOpenTelemetryFlushIntervalMs,
+		samplePercentage: DefaultOpenTelemetrySamplePercentage,
+	}
+}
+
+// WithFlushIntervalMs sets the interval in milliseconds between two consecutive exports of spans to the collector. The
+// value must be positive. If not set, [DefaultOpenTelemetryFlushIntervalMs] is used.
+func (config *OpenTelemetryConfig) WithFlushIntervalMs(flushIntervalMs int64) *OpenTelemetryConfig {
+	config.flushIntervalMs = flushIntervalMs
+	return config
+}
+
+// WithSamplePercentage sets the percentage of the commands for which a span is created, between 0 and 100. If not set,
+// [DefaultOpenTelemetrySamplePercentage] is used. Tracing every command has a performance cost, so a low percentage is
+// recommended in production.
+func (config *OpenTelemetryConfig) WithSamplePercentage(samplePercentage int) *OpenTelemetryConfig {
+	config.samplePercentage = samplePercentage
+	return config
+}
+
+func (config *OpenTelemetryConfig) validate() error {
+	if config.endpoint == "" {
+		return errors.New("the OpenTelemetry collector endpoint must be set")
+	}
+	if config.flushIntervalMs <= 0 {
+		return errors.New("the OpenTelemetry flush interval must be a positive number of milliseconds")
+	}
+	return validateSamplePercentage(config.samplePercentage)
+}
+
+func validateSamplePercentage(samplePercentage int) error {
+	if samplePercentage < 0 || samplePercentage > 100 {
+		return errors.New("the OpenTelemetry sample percentage must be between 0 and 100")
+	}
+	return nil
+}
+
+var (
+	openTelemetryMu sync.Mutex
+	// openTelemetrySamplePercentage is negative until OpenTelemetry is initialized.
+	openTelemetrySamplePercentage atomic.Int32
+)
+
+func init() {
+	openTelemetrySamplePercentage.Store(-1)
+}
+
+// InitOpenTelemetry initializes the OpenTelemetry tracing of the commands sent by all the clients of the process.
+//
+// Once initialized, a span named after the command is created for the sampled commands, a span named "Batch" for the
+// sampled batches and a span named "InvokeScript" for the sampled script invocations. Each of them has a child span
+// covering the time spent sending the request and waiting for the response, whose status reflects whether the request
+// succeeded.
+//
+// OpenTelemetry can only be initialized once per process. Subsequent calls return an error, but the sample percentage
+// can still be changed with [SetOpenTelemetrySamplePercentage].
+func InitOpenTelemetry(config *OpenTelemetryConfig) error {
+	if config == nil {
+		return errors.New("the OpenTelemetry configuration must be set")
+	}
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	openTelemetryMu.Lock()
+	defer openTelemetryMu.Unlock()
+	if IsOpenTelemetryInitialized() {
+		return errors.New("OpenTelemetry is already initialized")
+	}
+
+	cEndpoint := C.CString(config.endpoint)
+	defer C.free(unsafe.Pointer(cEndpoint))
+	cErr := C.init_open_telemetry(cEndpoint, C.uint64_t(config.flushIntervalMs))
+	if cErr != nil {
+		defer C.free_error_message(cErr)
+		return errors.New("failed to initialize OpenTelemetry: " + C.GoString(cErr))
+	}
+	openTelemetrySamplePercentage.Store(int32(config.samplePercentage))
+	return nil
+}
+
+// IsOpenTelemetryInitialized returns whether [InitOpenTelemetry] has successfully been called.
+func IsOpenTelemetryInitialized() bool {
+	return openTelemetrySamplePercentage.Load() >= 0
+}
+
+// SetOpenTelemetrySamplePercentage changes the percentage of the commands for which a span is created, between 0 and 100.
+// OpenTelemetry must have been initialized with [InitOpenTelemetry] beforehand.
+func SetOpenTelemetrySamplePercentage(samplePercentage int) error {
+	if err := validateSamplePercentage(samplePercentage); err != nil {
+		return err
+	}
+
+	openTelemetryMu.Lock()
+	defer openTelemetryMu.Unlock()
+	if !IsOpenTelemetryInitialized() {
+		return errors.New("OpenTelemetry is not initialized")
+	}
+	openTelemetrySamplePercentage.Store(int32(samplePercentage))
+	return nil
+}
+
+// shouldSampleOpenTelemetrySpan returns whether a span should be created for the next command.
+func shouldSampleOpenTelemetrySpan() bool {
+	samplePercentage := openTelemetrySamplePercentage.Load()
+	return samplePercentage > 0 && (samplePercentage == 100 || rand.Int32N(100) < samplePercentage)
+}
@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenTelemetryConfig_validate(t *testing.T) {
+	config := NewOpenTelemetryConfig("file:///tmp/traces")
+	assert.NoError(t, config.validate())
+	assert.Equal(t, int64(DefaultOpenTelemetryFlushIntervalMs), config.flushIntervalMs)
+	assert.Equal(t, DefaultOpenTelemetrySamplePercentage, config.samplePercentage)
+
+	assert.NoError(t, NewOpenTelemetryConfig("http://localhost:4318").WithSamplePercentage(100).validate())
+	assert.NoError(t, NewOpenTelemetryConfig("grpc://localhost:4317").WithSamplePercentage(0).validate())
+
+	assert.Error(t, NewOpenTelemetryConfig("").validate())
+	assert.Error(t, NewOpenTelemetryConfig("file:///tmp/traces").WithFlushIntervalMs(0).validate())
+	assert.Error(t, NewOpenTelemetryConfig("file:///tmp/traces").WithSamplePercentage(-1).validate())
+	assert.Error(t, NewOpenTelemetryConfig("file:///tmp/traces").WithSamplePercentage(101).validate())
+
+	// invalid configurations are rejected before reaching the core
+	assert.Error(t, InitOpenTelemetry(nil))
+	assert.Error(t, InitOpenTelemetry(NewOpenTelemetryConfig("")))
+	assert.False(t, IsOpenTelemetryInitialized())
+}
+
+func TestOpenTelemetry_sampling(t *testing.T) {
+	assert.False(t, IsOpenTelemetryInitialized())
+	assert.False(t, shouldSampleOpenTelemetrySpan())
+	assert.Error(t, SetOpenTelemetrySamplePercentage(50))
+	assert.Error(t, SetOpenTelemetrySamplePercentage(101))
+}
@@ -0,0 +1,181 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "strings"
+
+// AclRuleBuilder composes the rules of a user for `AclSetUser`. The rules are applied by the server in the order in which
+// they are added, on top of the existing rules of the user.
+//
+// For example, the following rules enable a user which can only read the keys of a tenant:
+//
+//	rules := options.NewAclRuleBuilder().
+//	    Reset().
+//	    On().
+//	    AddPassword("secret").
+//	    KeyPattern("tenant1:*").
+//	    AllowCategory("read")
+type AclRuleBuilder struct {
+	rules []string
+}
+
+// NewAclRuleBuilder creates a builder without any rule.
+func NewAclRuleBuilder() *AclRuleBuilder {
+	return &AclRuleBuilder{rules: []string{}}
+}
+
+func (builder *AclRuleBuilder) add(rule string) *AclRuleBuilder {
+	builder.rules = append(builder.rules, rule)
+	return builder
+}
+
+// On enables the user, so that it is possible to authenticate as this user.
+func (builder *AclRuleBuilder) On() *AclRuleBuilder {
+	return builder.add("on")
+}
+
+// Off disables the user, so that it is no longer possible to authenticate as this user. The connections which are already
+// authenticated as this user keep working.
+func (builder *AclRuleBuilder) Off() *AclRuleBuilder {
+	return builder.add("off")
+}
+
+// AddPassword adds a valid password for the user.
+func (builder *AclRuleBuilder) AddPassword(password string) *AclRuleBuilder {
+	return builder.add(">" + password)
+}
+
+// RemovePassword removes a password of the user.
+func (builder *AclRuleBuilder) RemovePassword(password string) *AclRuleBuilder {
+	return builder.add("<" + password)
+}
+
+// AddHashedPassword adds a valid password for the user, given as its SHA-256 hash in hexadecimal.
+func (builder *AclRuleBuilder) AddHashedPassword(hash string) *AclRuleBuilder {
+	return builder.add("#" + hash)
+}
+
+// RemoveHashedPassword removes a password of the user, given as its SHA-256 hash in hexadecimal.
+func (builder *AclRuleBuilder) RemoveHashedPassword(hash string) *AclRuleBuilder {
+	return builder.add("!" + hash)
+}
+
+// NoPass removes all the passwords of the user, and allows to authenticate as this user with any password.
+func (builder *AclRuleBuilder) NoPass() *AclRuleBuilder {
+	return builder.add("nopass")
+}
+
+// ResetPass removes all the passwords of the user, so that it is no longer possible to authenticate as this user until a
+// password is added.
+func (builder *AclRuleBuilder) ResetPass() *AclRuleBuilder {
+	return builder.add("resetpass")
+}
+
+// KeyPattern allows the user to read and write the keys matching the given glob-style pattern.
+func (builder *AclRuleBuilder) KeyPattern(pattern string) *AclRuleBuilder {
+	return builder.add("~" + pattern)
+}
+
+// ReadKeyPattern allows the user to read the keys matching the given glob-style pattern.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+func (builder *AclRuleBuilder) ReadKeyPattern(pattern string) *AclRuleBuilder {
+	return builder.add("%R~" + pattern)
+}
+
+// WriteKeyPattern allows the user to write the keys matching the given glob-style pattern.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+func (builder *AclRuleBuilder) WriteKeyPattern(pattern string) *AclRuleBuilder {
+	return builder.add("%W~" + pattern)
+}
+
+// AllKeys allows the user to read and write all the keys.
+func (builder *AclRuleBuilder) AllKeys() *AclRuleBuilder {
+	return builder.add("allkeys")
+}
+
+// ResetKeys removes all the key patterns of the user.
+func (builder *AclRuleBuilder) ResetKeys() *AclRuleBuilder {
+	return builder.add("resetkeys")
+}
+
+// ChannelPattern allows the user to access the Pub/Sub channels matching the given glob-style pattern.
+func (builder *AclRuleBuilder) ChannelPattern(pattern string) *AclRuleBuilder {
+	return builder.add("&" + pattern)
+}
+
+// AllChannels allows the user to access all the Pub/Sub channels.
+func (builder *AclRuleBuilder) AllChannels() *AclRuleBuilder {
+	return builder.add("allchannels")
+}
+
+// ResetChannels removes all the channel patterns of the user.
+func (builder *AclRuleBuilder) ResetChannels() *AclRuleBuilder {
+	return builder.add("resetchannels")
+}
+
+// AllowCategory allows the user to call the commands of the given category, such as "read" or "string". The categories
+// are listed by `AclCat`.
+func (builder *AclRuleBuilder) AllowCategory(category string) *AclRuleBuilder {
+	return builder.add("+@" + category)
+}
+
+// DenyCategory denies the user to call the commands of the given category.
+func (builder *AclRuleBuilder) DenyCategory(category string) *AclRuleBuilder {
+	return builder.add("-@" + category)
+}
+
+// AllowCommand allows the user to call the given command, or the given subcommand with the "command|subcommand" syntax.
+func (builder *AclRuleBuilder) AllowCommand(command string) *AclRuleBuilder {
+	return builder.add("+" + command)
+}
+
+// DenyCommand denies the user to call the given command, or the given subcommand with the "command|subcommand" syntax.
+func (builder *AclRuleBuilder) DenyCommand(command string) *AclRuleBuilder {
+	return builder.add("-" + command)
+}
+
+// AllCommands allows the user to call all the commands.
+func (builder *AclRuleBuilder) AllCommands() *AclRuleBuilder {
+	return builder.add("allcommands")
+}
+
+// NoCommands denies the user to call any command.
+func (builder *AclRuleBuilder) NoCommands() *AclRuleBuilder {
+	return builder.add("nocommands")
+}
+
+// Selector adds a selector with the given rules, which grants additional permissions to the user.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+func (builder *AclRuleBuilder) Selector(selector *AclRuleBuilder) *AclRuleBuilder {
+	return builder.add("(" + strings.Join(selector.ToArgs(), " ") + ")")
+}
+
+// ClearSelectors removes all the selectors of the user.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+func (builder *AclRuleBuilder) ClearSelectors() *AclRuleBuilder {
+	return builder.add("clearselectors")
+}
+
+// Reset removes all the passwords, keys, channels, commands and selectors of the user, and disables it.
+func (builder *AclRuleBuilder) Reset() *AclRuleBuilder {
+	return builder.add("reset")
+}
+
+func (builder *AclRuleBuilder) ToArgs() []string {
+	if builder == nil {
+		return []string{}
+	}
+	return append([]string{}, builder.rules...)
+}
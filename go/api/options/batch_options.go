@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// Optional arguments for `Exec` for standalone client
+type BatchOptions struct {
+	// The duration in milliseconds that the client should wait for the batch request to complete. This duration
+	// encompasses sending the request, awaiting a response from the server, and any required reconnections or retries.
+	// If not set, the client's request timeout is used.
+	Timeout uint32
+}
+
+// Optional arguments for `Exec` for cluster client
+type ClusterBatchOptions struct {
+	// The duration in milliseconds that the client should wait for the batch request to complete. This duration
+	// encompasses sending the request, awaiting a response from the server, and any required reconnections or retries.
+	// If not set, the client's request timeout is used.
+	Timeout uint32
+	// Configures the retry behavior of a non-atomic batch. Retry strategies are not supported for atomic batches.
+	RetryStrategy *ClusterBatchRetryStrategy
+	// Routes the whole batch to a single node. Multi-node routes are not supported. If not set, atomic batches are routed to
+	// the slot owner of the first key in the batch, and non-atomic batches route each command by its own key.
+	*RouteOption
+}
+
+// Defines the retry behavior of a non-atomic batch executed by a cluster client.
+//
+// Retrying may cause commands to be executed more than once, or out of order, so it should only be enabled for idempotent
+// commands.
+type ClusterBatchRetryStrategy struct {
+	// Retry commands that failed with a retriable server error, such as TRYAGAIN.
+	RetryServerError bool
+	// Retry the batch on connection errors. This may lead to duplicate executions if the server received the commands
+	// before the connection was lost.
+	RetryConnectionError bool
+}
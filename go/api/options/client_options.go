@@ -0,0 +1,99 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "github.com/valkey-io/valkey-glide/go/utils"
+
+// ClientPauseMode defines which commands are paused by `ClientPause`.
+type ClientPauseMode string
+
+const (
+	// PauseAll pauses all the commands of the clients.
+	PauseAll ClientPauseMode = "ALL"
+	// PauseWrite pauses the commands which may modify the dataset, such as SET, EXPIRE or PUBLISH.
+	PauseWrite ClientPauseMode = "WRITE"
+)
+
+// ClientUnblockMode defines how a client blocked by `ClientUnblock` is unblocked.
+type ClientUnblockMode string
+
+const (
+	// UnblockTimeout unblocks the client as if the timeout of the blocking command was reached.
+	UnblockTimeout ClientUnblockMode = "TIMEOUT"
+	// UnblockError unblocks the client with an error.
+	UnblockError ClientUnblockMode = "ERROR"
+)
+
+const (
+	idKeyword     string = "ID"
+	addrKeyword   string = "ADDR"
+	laddrKeyword  string = "LADDR"
+	userKeyword   string = "USER"
+	skipMeKeyword string = "SKIPME"
+	maxAgeKeyword string = "MAXAGE"
+)
+
+// ClientKillFilter selects the connections closed by `ClientKill`. A connection is closed only if it matches all the
+// filters.
+//
+// For example, the following filter matches the connections of a user which are older than an hour:
+//
+//	filter := options.NewClientKillFilter().SetUser("tenant1").SetMaxAge(3600)
+type ClientKillFilter struct {
+	args []string
+}
+
+// NewClientKillFilter creates an empty filter, to which at least one filter must be added. The connection sending the
+// command is skipped, unless [ClientKillFilter.SetSkipMe] is set to false.
+func NewClientKillFilter() *ClientKillFilter {
+	return &ClientKillFilter{args: []string{}}
+}
+
+func (filter *ClientKillFilter) add(keyword string, value string) *ClientKillFilter {
+	filter.args = append(filter.args, keyword, value)
+	return filter
+}
+
+// SetId matches the connection with the given identifier, as returned by `ClientId`.
+func (filter *ClientKillFilter) SetId(id int64) *ClientKillFilter {
+	return filter.add(idKeyword, utils.IntToString(id))
+}
+
+// SetAddr matches the connections from the given remote address, in the "ip:port" format.
+func (filter *ClientKillFilter) SetAddr(address string) *ClientKillFilter {
+	return filter.add(addrKeyword, address)
+}
+
+// SetLAddr matches the connections to the given local address of the server, in the "ip:port" format.
+func (filter *ClientKillFilter) SetLAddr(address string) *ClientKillFilter {
+	return filter.add(laddrKeyword, address)
+}
+
+// SetUser matches the connections authenticated as the given user.
+func (filter *ClientKillFilter) SetUser(username string) *ClientKillFilter {
+	return filter.add(userKeyword, username)
+}
+
+// SetSkipMe sets whether the connection sending the command is skipped, which is the default.
+func (filter *ClientKillFilter) SetSkipMe(skipMe bool) *ClientKillFilter {
+	if skipMe {
+		return filter.add(skipMeKeyword, "yes")
+	}
+	return filter.add(skipMeKeyword, "no")
+}
+
+// SetMaxAge matches the connections which are older than the given number of seconds.
+//
+// Since:
+//
+//	Valkey 8.0 and above.
+func (filter *ClientKillFilter) SetMaxAge(seconds int64) *ClientKillFilter {
+	return filter.add(maxAgeKeyword, utils.IntToString(seconds))
+}
+
+func (filter *ClientKillFilter) ToArgs() []string {
+	if filter == nil {
+		return []string{}
+	}
+	return append([]string{}, filter.args...)
+}
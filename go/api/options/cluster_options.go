@@ -0,0 +1,145 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// ClusterFailoverMode defines how a replica takes over its primary with `ClusterFailoverWithMode`.
+type ClusterFailoverMode string
+
+const (
+	// FailoverForce starts the failover without the agreement of the primary, which may be unreachable. The failover is
+	// still authorized by the majority of the primaries.
+	FailoverForce ClusterFailoverMode = "FORCE"
+	// FailoverTakeover starts the failover without any agreement of the primaries, by generating a new configuration epoch
+	// unilaterally.
+	FailoverTakeover ClusterFailoverMode = "TAKEOVER"
+)
+
+// ClusterResetMode defines how a node is reset with `ClusterReset`.
+type ClusterResetMode string
+
+const (
+	// ResetSoft forgets the other nodes of the cluster and the assigned slots.
+	ResetSoft ClusterResetMode = "SOFT"
+	// ResetHard also generates a new node identifier, and sets the epochs to 0.
+	ResetHard ClusterResetMode = "HARD"
+)
+
+const (
+	importingKeyword string = "IMPORTING"
+	migratingKeyword string = "MIGRATING"
+	nodeKeyword      string = "NODE"
+	stableKeyword    string = "STABLE"
+)
+
+// ClusterSetSlotAction is the change of the state of a slot applied by `ClusterSetSlot`.
+type ClusterSetSlotAction struct {
+	subcommand string
+	nodeId     string
+}
+
+// NewClusterSetSlotImporting sets the slot in the importing state on the destination node of a migration, from the given
+// source node.
+func NewClusterSetSlotImporting(sourceNodeId string) ClusterSetSlotAction {
+	return ClusterSetSlotAction{subcommand: importingKeyword, nodeId: sourceNodeId}
+}
+
+// NewClusterSetSlotMigrating sets the slot in the migrating state on the source node of a migration, to the given
+// destination node.
+func NewClusterSetSlotMigrating(destinationNodeId string) ClusterSetSlotAction {
+	return ClusterSetSlotAction{subcommand: migratingKeyword, nodeId: destinationNodeId}
+}
+
+// NewClusterSetSlotNode assigns the slot to the given node, which ends a migration.
+func NewClusterSetSlotNode(nodeId string) ClusterSetSlotAction {
+	return ClusterSetSlotAction{subcommand: nodeKeyword, nodeId: nodeId}
+}
+
+// NewClusterSetSlotStable clears the importing and migrating states of the slot, which cancels a migration.
+func NewClusterSetSlotStable() ClusterSetSlotAction {
+	return ClusterSetSlotAction{subcommand: stableKeyword}
+}
+
+func (action ClusterSetSlotAction) ToArgs() []string {
+	if action.subcommand == stableKeyword || action.subcommand == "" {
+		return []string{stableKeyword}
+	}
+	return []string{action.subcommand, action.nodeId}
+}
+
+const (
+	copyKeyword  string = "COPY"
+	authKeyword  string = "AUTH"
+	auth2Keyword string = "AUTH2"
+	keysKeyword  string = "KEYS"
+)
+
+// Optional arguments for `Migrate`.
+//
+// [valkey.io]: https://valkey.io/commands/migrate/
+type MigrateOptions struct {
+	copy     bool
+	replace  bool
+	username string
+	password string
+	keys     []string
+}
+
+func NewMigrateOptions() *MigrateOptions {
+	return &MigrateOptions{}
+}
+
+// SetCopy keeps the keys on the source node.
+func (opts *MigrateOptions) SetCopy() *MigrateOptions {
+	opts.copy = true
+	return opts
+}
+
+// SetReplace replaces the existing keys on the destination node.
+func (opts *MigrateOptions) SetReplace() *MigrateOptions {
+	opts.replace = true
+	return opts
+}
+
+// SetAuth authenticates to the destination node with the given password of the default user.
+func (opts *MigrateOptions) SetAuth(password string) *MigrateOptions {
+	opts.username = ""
+	opts.password = password
+	return opts
+}
+
+// SetAuth2 authenticates to the destination node with the given username and password.
+func (opts *MigrateOptions) SetAuth2(username string, password string) *MigrateOptions {
+	opts.username = username
+	opts.password = password
+	return opts
+}
+
+// SetKeys migrates the given keys at once, in which case the key argument of `Migrate` must be empty.
+func (opts *MigrateOptions) SetKeys(keys []string) *MigrateOptions {
+	opts.keys = keys
+	return opts
+}
+
+func (opts *MigrateOptions) ToArgs() []string {
+	args := []string{}
+	if opts == nil {
+		return args
+	}
+	if opts.copy {
+		args = append(args, copyKeyword)
+	}
+	if opts.replace {
+		args = append(args, ReplaceKeyword)
+	}
+	switch {
+	case opts.username != "":
+		args = append(args, auth2Keyword, opts.username, opts.password)
+	case opts.password != "":
+		args = append(args, authKeyword, opts.password)
+	}
+	if len(opts.keys) > 0 {
+		args = append(args, keysKeyword)
+		args = append(args, opts.keys...)
+	}
+	return args
+}
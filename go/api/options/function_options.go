@@ -0,0 +1,59 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// FunctionRestorePolicy defines how the existing libraries are handled when restoring libraries with
+// `FunctionRestoreWithPolicy`.
+type FunctionRestorePolicy string
+
+const (
+	// AppendPolicy appends the restored libraries to the existing libraries, and fails if a library already exists.
+	AppendPolicy FunctionRestorePolicy = "APPEND"
+	// FlushPolicy deletes all the existing libraries before restoring the payload.
+	FlushPolicy FunctionRestorePolicy = "FLUSH"
+	// ReplacePolicy appends the restored libraries to the existing libraries, replacing the existing libraries in case of
+	// name collisions. It does not prevent function name collisions, only library name collisions.
+	ReplacePolicy FunctionRestorePolicy = "REPLACE"
+)
+
+const (
+	libraryNameKeyword string = "LIBRARYNAME"
+	withCodeKeyword    string = "WITHCODE"
+)
+
+// Optional arguments for `FunctionList` for standalone and cluster clients.
+type FunctionListQuery struct {
+	LibraryName string
+	WithCode    bool
+}
+
+// NewFunctionListQuery creates a query which lists all the libraries, without their code.
+func NewFunctionListQuery() *FunctionListQuery {
+	return &FunctionListQuery{}
+}
+
+// SetLibraryName sets a pattern for the names of the libraries to list.
+func (query *FunctionListQuery) SetLibraryName(libraryName string) *FunctionListQuery {
+	query.LibraryName = libraryName
+	return query
+}
+
+// SetWithCode sets whether the code of the libraries is included in the response.
+func (query *FunctionListQuery) SetWithCode(withCode bool) *FunctionListQuery {
+	query.WithCode = withCode
+	return query
+}
+
+func (query *FunctionListQuery) ToArgs() []string {
+	if query == nil {
+		return []string{}
+	}
+	args := []string{}
+	if query.LibraryName != "" {
+		args = append(args, libraryNameKeyword, query.LibraryName)
+	}
+	if query.WithCode {
+		args = append(args, withCodeKeyword)
+	}
+	return args
+}
@@ -0,0 +1,19 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// Optional arguments for `InvokeScriptWithOptions` for standalone and cluster clients.
+type ScriptOptions struct {
+	// The keys accessed by the script, passed to the script as KEYS.
+	Keys []string
+	// The arguments of the script, passed to the script as ARGV.
+	Args []string
+}
+
+// Optional arguments for `InvokeScriptWithClusterOptions` for cluster client. Keys are not supported, since the command is
+// routed by the given route.
+type ClusterScriptOptions struct {
+	// The arguments of the script, passed to the script as ARGV.
+	Args []string
+	*RouteOption
+}
@@ -0,0 +1,158 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// PubSubMessage is a message received by a client subscribed to pub/sub channels.
+type PubSubMessage struct {
+	// The published message.
+	Message string
+	// The channel the message was published to.
+	Channel string
+	// The pattern matched by the channel. It is only set for messages received through a pattern subscription.
+	Pattern Result[string]
+}
+
+// MessageCallback is invoked for each pub/sub message received by a client configured with a callback. userContext is the
+// value set together with the callback in the subscription configuration.
+type MessageCallback func(message *PubSubMessage, userContext any)
+
+// messageHandler receives the pub/sub messages of a client and delivers them to its callback, or queues them until they are
+// retrieved if the client has no callback.
+type messageHandler struct {
+	id          uintptr
+	callback    MessageCallback
+	userContext any
+	queue       *unboundedQueue[*PubSubMessage]
+}
+
+var (
+	messageHandlersMu sync.RWMutex
+	messageHandlers   = make(map[uintptr]*messageHandler)
+)
+
+// newMessageHandler creates and registers a handler for the pub/sub messages of the client with the given id, configured
+// with the given subscriptions. If a callback is configured, the messages are delivered to it from a dedicated goroutine.
+func newMessageHandler(clientId uintptr, config *baseSubscriptionConfig) *messageHandler {
+	handler := &messageHandler{
+		id:          clientId,
+		callback:    config.callback,
+		userContext: config.userContext,
+		queue:       newUnboundedQueue[*PubSubMessage]("GetPubSubMessage failed. The client is closed."),
+	}
+
+	messageHandlersMu.Lock()
+	messageHandlers[handler.id] = handler
+	messageHandlersMu.Unlock()
+
+	if handler.callback != nil {
+		go func() {
+			for {
+				message, err := handler.queue.pop(context.Background())
+				if err != nil {
+					return
+				}
+				handler.callback(message, handler.userContext)
+			}
+		}()
+	}
+	return handler
+}
+
+// close unregisters the handler. Messages received before the handler was closed are still delivered to the callback.
+func (handler *messageHandler) close() {
+	messageHandlersMu.Lock()
+	delete(messageHandlers, handler.id)
+	messageHandlersMu.Unlock()
+
+	handler.queue.close()
+}
+
+//export pubSubCallback
+func pubSubCallback(
+	clientId C.uintptr_t,
+	kind C.PushKind,
+	cMessage *C.uint8_t,
+	messageLen C.int64_t,
+	cChannel *C.uint8_t,
+	channelLen C.int64_t,
+	cPattern *C.uint8_t,
+	patternLen C.int64_t,
+) {
+	messageHandlersMu.RLock()
+	handler := messageHandlers[uintptr(clientId)]
+	messageHandlersMu.RUnlock()
+	if handler == nil {
+		// the client was closed
+		return
+	}
+
+	message := &PubSubMessage{
+		Message: C.GoStringN((*C.char)(unsafe.Pointer(cMessage)), C.int(messageLen)),
+		Channel: C.GoStringN((*C.char)(unsafe.Pointer(cChannel)), C.int(channelLen)),
+		Pattern: CreateNilStringResult(),
+	}
+	if kind == C.PushPMessage {
+		message.Pattern = CreateStringResult(C.GoStringN((*C.char)(unsafe.Pointer(cPattern)), C.int(patternLen)))
+	}
+	handler.queue.push(message)
+}
+
+// getMessageQueue returns the queue of the received pub/sub messages, or an error if the messages of the client are not
+// queued.
+func (client *baseClient) getMessageQueue() (*unboundedQueue[*PubSubMessage], error) {
+	if client.messageHandler == nil {
+		return nil, &errors.RequestError{Msg: "The client has no pub/sub subscriptions configured."}
+	}
+	if client.messageHandler.callback != nil {
+		return nil, &errors.RequestError{
+			Msg: "The client is configured with a pub/sub callback, messages are delivered to the callback.",
+		}
+	}
+	return client.messageHandler.queue, nil
+}
+
+// Returns the next pub/sub message received by the client, waiting until a message is received if none is available.
+//
+// The client must be configured with pub/sub subscriptions and without a callback, see [StandaloneSubscriptionConfig] and
+// [ClusterSubscriptionConfig].
+//
+// Return value:
+//
+//	The next received [PubSubMessage]. If the context is cancelled or its deadline expires before a message is received,
+//	ctx.Err() is returned. Once the client is closed, the messages which were received and not yet retrieved are returned,
+//	and then a [errors.ClosingError].
+func (client *baseClient) GetPubSubMessage(ctx context.Context) (*PubSubMessage, error) {
+	queue, err := client.getMessageQueue()
+	if err != nil {
+		return nil, err
+	}
+	return queue.pop(ctx)
+}
+
+// Returns the next pub/sub message received by the client if one is available, without waiting.
+//
+// The client must be configured with pub/sub subscriptions and without a callback, see [StandaloneSubscriptionConfig] and
+// [ClusterSubscriptionConfig].
+//
+// Return value:
+//
+//	The next received [PubSubMessage], or nil if no message is available.
+func (client *baseClient) TryGetPubSubMessage() (*PubSubMessage, error) {
+	queue, err := client.getMessageQueue()
+	if err != nil {
+		return nil, err
+	}
+	message, _ := queue.tryPop()
+	return message, nil
+}
@@ -0,0 +1,41 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import "context"
+
+// Supports commands for the "Pub/Sub" group for standalone and cluster clients.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#pubsub
+type PubSubBaseCommands interface {
+	Publish(ctx context.Context, channel string, message string) (int64, error)
+
+	PubSubChannels(ctx context.Context) ([]string, error)
+
+	PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error)
+
+	PubSubNumPat(ctx context.Context) (int64, error)
+
+	PubSubNumSub(ctx context.Context, channels []string) (map[string]int64, error)
+
+	GetPubSubMessage(ctx context.Context) (*PubSubMessage, error)
+
+	TryGetPubSubMessage() (*PubSubMessage, error)
+}
+
+// Supports commands for the "Pub/Sub" group for cluster client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#pubsub
+type PubSubClusterCommands interface {
+	SPublish(ctx context.Context, channel string, message string) (int64, error)
+
+	PubSubShardChannels(ctx context.Context) ([]string, error)
+
+	PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error)
+
+	PubSubShardNumSub(ctx context.Context, channels []string) (map[string]int64, error)
+}
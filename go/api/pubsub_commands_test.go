@@ -0,0 +1,241 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func ExampleGlideClient_Publish() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().WithSubscription(ExactChannelMode, "news"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.Publish(context.Background(), "news", "hello")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 1
+}
+
+func ExampleGlideClusterClient_Publish() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.Publish(context.Background(), "news", "hello")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 0
+}
+
+func ExampleGlideClusterClient_SPublish() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	subscriber := getExampleClusterSubscriberClient(
+		NewClusterSubscriptionConfig().WithSubscription(ShardedClusterChannelMode, "orders"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.SPublish(context.Background(), "orders", "hello")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 1
+}
+
+func ExampleGlideClient_GetPubSubMessage() {
+	var publisher *GlideClient = getExampleGlideClient() // example helper function
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().WithSubscription(ExactChannelMode, "news"),
+	) // example helper function
+	defer subscriber.Close()
+
+	publisher.Publish(context.Background(), "news", "hello")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	message, err := subscriber.GetPubSubMessage(ctx)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(message.Channel, message.Message)
+
+	// Output: news hello
+}
+
+func ExampleGlideClient_TryGetPubSubMessage() {
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().WithSubscription(PatternChannelMode, "news.*"),
+	) // example helper function
+	defer subscriber.Close()
+
+	message, err := subscriber.TryGetPubSubMessage()
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(message)
+
+	// Output: <nil>
+}
+
+func ExampleStandaloneSubscriptionConfig_WithCallback() {
+	var publisher *GlideClient = getExampleGlideClient() // example helper function
+	received := make(chan *PubSubMessage)
+	callback := func(message *PubSubMessage, userContext any) {
+		userContext.(chan *PubSubMessage) <- message
+	}
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().
+			WithSubscription(PatternChannelMode, "news.*").
+			WithCallback(callback, received),
+	) // example helper function
+	defer subscriber.Close()
+
+	publisher.Publish(context.Background(), "news.sports", "hello")
+	message := <-received
+	fmt.Println(message.Pattern.Value(), message.Channel, message.Message)
+
+	// Output: news.* news.sports hello
+}
+
+func ExampleGlideClusterClient_GetPubSubMessage() {
+	var publisher *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	subscriber := getExampleClusterSubscriberClient(
+		NewClusterSubscriptionConfig().WithSubscription(ExactClusterChannelMode, "news"),
+	) // example helper function
+	defer subscriber.Close()
+
+	publisher.Publish(context.Background(), "news", "hello")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	message, err := subscriber.GetPubSubMessage(ctx)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(message.Channel, message.Message)
+
+	// Output: news hello
+}
+
+func ExampleGlideClient_PubSubChannels() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().WithSubscription(ExactChannelMode, "news"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubChannels(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [news]
+}
+
+func ExampleGlideClient_PubSubChannelsWithPattern() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().
+			WithSubscription(ExactChannelMode, "news.sports").
+			WithSubscription(ExactChannelMode, "weather"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubChannelsWithPattern(context.Background(), "news.*")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [news.sports]
+}
+
+func ExampleGlideClient_PubSubNumPat() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().WithSubscription(PatternChannelMode, "news.*"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubNumPat(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 1
+}
+
+func ExampleGlideClient_PubSubNumSub() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	subscriber := getExampleSubscriberClient(
+		NewStandaloneSubscriptionConfig().WithSubscription(ExactChannelMode, "news"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubNumSub(context.Background(), []string{"news", "weather"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: map[news:1 weather:0]
+}
+
+func ExampleGlideClusterClient_PubSubShardChannels() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	subscriber := getExampleClusterSubscriberClient(
+		NewClusterSubscriptionConfig().WithSubscription(ShardedClusterChannelMode, "orders"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubShardChannels(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [orders]
+}
+
+func ExampleGlideClusterClient_PubSubShardChannelsWithPattern() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	subscriber := getExampleClusterSubscriberClient(
+		NewClusterSubscriptionConfig().
+			WithSubscription(ShardedClusterChannelMode, "orders.eu").
+			WithSubscription(ShardedClusterChannelMode, "payments"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubShardChannelsWithPattern(context.Background(), "orders.*")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [orders.eu]
+}
+
+func ExampleGlideClusterClient_PubSubShardNumSub() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	subscriber := getExampleClusterSubscriberClient(
+		NewClusterSubscriptionConfig().WithSubscription(ShardedClusterChannelMode, "orders"),
+	) // example helper function
+	defer subscriber.Close()
+
+	result, err := client.PubSubShardNumSub(context.Background(), []string{"orders", "payments"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: map[orders:1 payments:0]
+}
@@ -0,0 +1,31 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageHandler_callback(t *testing.T) {
+	received := make(chan *PubSubMessage)
+	config := NewStandaloneSubscriptionConfig().
+		WithSubscription(ExactChannelMode, "channel").
+		WithCallback(func(message *PubSubMessage, userContext any) {
+			assert.Equal(t, "context", userContext)
+			received <- message
+		}, "context")
+	handler := newMessageHandler(newClientId(), &config.baseSubscriptionConfig)
+	defer handler.close()
+
+	expected := &PubSubMessage{Message: "message", Channel: "channel", Pattern: CreateNilStringResult()}
+	handler.queue.push(expected)
+	select {
+	case message := <-received:
+		assert.Equal(t, expected, message)
+	case <-time.After(time.Second):
+		t.Fatal("The callback was not invoked")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// unboundedQueue is an unbounded FIFO queue of the items received by a client, such as pub/sub messages or connection
+// events, which are delivered to a callback or retrieved by the user. It is safe for concurrent use.
+type unboundedQueue[T any] struct {
+	mu    sync.Mutex
+	items []T
+	// notify wakes up a goroutine waiting for an item. It is closed once the queue is closed.
+	notify chan struct{}
+	closed bool
+	// The message of the error returned by pop once the queue is closed and empty.
+	closingErrorMsg string
+}
+
+func newUnboundedQueue[T any](closingErrorMsg string) *unboundedQueue[T] {
+	return &unboundedQueue[T]{notify: make(chan struct{}, 1), closingErrorMsg: closingErrorMsg}
+}
+
+// push adds an item at the end of the queue. Items pushed after the queue is closed are dropped.
+func (queue *unboundedQueue[T]) push(item T) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return
+	}
+	queue.items = append(queue.items, item)
+	queue.signal()
+}
+
+// signal wakes up a waiting goroutine, if any. It must be called while holding the lock of an open queue.
+func (queue *unboundedQueue[T]) signal() {
+	select {
+	case queue.notify <- struct{}{}:
+	default:
+	}
+}
+
+// tryPop removes and returns the first item of the queue, or false if the queue is empty.
+func (queue *unboundedQueue[T]) tryPop() (T, bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	var zero T
+	if len(queue.items) == 0 {
+		return zero, false
+	}
+	item := queue.items[0]
+	queue.items[0] = zero
+	queue.items = queue.items[1:]
+	if len(queue.items) > 0 && !queue.closed {
+		// let another waiting goroutine pick the next item
+		queue.signal()
+	}
+	return item, true
+}
+
+// pop removes and returns the first item of the queue, waiting for an item if the queue is empty. If ctx is done first,
+// ctx.Err() is returned. The items remaining in the queue once it is closed are still returned before a
+// [errors.ClosingError].
+func (queue *unboundedQueue[T]) pop(ctx context.Context) (T, error) {
+	for {
+		if item, ok := queue.tryPop(); ok {
+			return item, nil
+		}
+
+		queue.mu.Lock()
+		closed := queue.closed
+		queue.mu.Unlock()
+		if closed {
+			// an item may have been queued right before the queue was closed
+			if item, ok := queue.tryPop(); ok {
+				return item, nil
+			}
+			var zero T
+			return zero, &errors.ClosingError{Msg: queue.closingErrorMsg}
+		}
+
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-queue.notify:
+		}
+	}
+}
+
+// close closes the queue, waking up the goroutines waiting for an item. Calling close more than once has no effect.
+func (queue *unboundedQueue[T]) close() {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if !queue.closed {
+		queue.closed = true
+		close(queue.notify)
+	}
+}
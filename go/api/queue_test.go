@@ -0,0 +1,81 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestUnboundedQueue(t *testing.T) {
+	queue := newUnboundedQueue[*PubSubMessage]("closed")
+	message, ok := queue.tryPop()
+	assert.False(t, ok)
+	assert.Nil(t, message)
+
+	first := &PubSubMessage{Message: "first", Channel: "channel", Pattern: CreateNilStringResult()}
+	second := &PubSubMessage{Message: "second", Channel: "channel", Pattern: CreateNilStringResult()}
+	queue.push(first)
+	queue.push(second)
+
+	message, ok = queue.tryPop()
+	assert.True(t, ok)
+	assert.Equal(t, first, message)
+	message, err := queue.pop(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, second, message)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	message, err = queue.pop(ctx)
+	assert.Nil(t, message)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestUnboundedQueue_waitForItem(t *testing.T) {
+	queue := newUnboundedQueue[ConnectionEvent]("closed")
+	expected := ConnectionEvent{Type: ConnectionEventConnected, Address: "localhost:6379"}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.push(expected)
+	}()
+
+	event, err := queue.pop(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, expected, event)
+}
+
+func TestUnboundedQueue_cancelWait(t *testing.T) {
+	queue := newUnboundedQueue[ConnectionEvent]("closed")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := queue.pop(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnboundedQueue_close(t *testing.T) {
+	queue := newUnboundedQueue[*PubSubMessage]("The client is closed.")
+	expected := &PubSubMessage{Message: "message", Channel: "channel", Pattern: CreateNilStringResult()}
+	queue.push(expected)
+	queue.close()
+	queue.push(&PubSubMessage{Message: "dropped"})
+
+	// items received before the queue was closed are still returned
+	message, err := queue.pop(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, expected, message)
+
+	message, err = queue.pop(context.Background())
+	assert.Nil(t, message)
+	assert.Equal(t, &errors.ClosingError{Msg: "The client is closed."}, err)
+}
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/api/errors"
@@ -47,6 +48,30 @@ func checkResponseType(response *C.struct_CommandResponse, expectedType C.Respon
 	}
 }
 
+// asStringToAnyMap converts a parsed map. The core converts the maps of most commands with RESP2, but the maps of the
+// commands which it does not convert, such as ACL GETUSER or CLUSTER SHARDS, are received as flat arrays of alternating
+// keys and values. Only the converters of these commands should accept flat arrays, so that an array of even length is
+// not misread as a map.
+func asStringToAnyMap(data any) (map[string]any, error) {
+	switch data := data.(type) {
+	case map[string]any:
+		return data, nil
+	case []any:
+		if len(data)%2 == 0 {
+			result := make(map[string]any, len(data)/2)
+			for i := 0; i < len(data); i += 2 {
+				key, ok := data[i].(string)
+				if !ok {
+					return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data[i])}
+				}
+				result[key] = data[i+1]
+			}
+			return result, nil
+		}
+	}
+	return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+}
+
 func convertCharArrayToString(response *C.struct_CommandResponse, isNilable bool) (Result[string], error) {
 	typeErr := checkResponseType(response, C.String, isNilable)
 	if typeErr != nil {
@@ -56,10 +81,20 @@ func convertCharArrayToString(response *C.struct_CommandResponse, isNilable bool
 	if response.string_value == nil {
 		return CreateNilStringResult(), nil
 	}
-	byteSlice := C.GoBytes(unsafe.Pointer(response.string_value), C.int(int64(response.string_value_len)))
+	// C.GoStringN copies the value only once and preserves null characters
+	return CreateStringResult(C.GoStringN(response.string_value, C.int(int64(response.string_value_len)))), nil
+}
 
-	// Create Go string from byte slice (preserving null characters)
-	return CreateStringResult(string(byteSlice)), nil
+func convertCharArrayToBytes(response *C.struct_CommandResponse, isNilable bool) (Result[[]byte], error) {
+	typeErr := checkResponseType(response, C.String, isNilable)
+	if typeErr != nil {
+		return CreateNilBytesResult(), typeErr
+	}
+
+	if response.string_value == nil {
+		return CreateNilBytesResult(), nil
+	}
+	return CreateBytesResult(C.GoBytes(unsafe.Pointer(response.string_value), C.int(int64(response.string_value_len)))), nil
 }
 
 func handleInterfaceResponse(response *C.struct_CommandResponse) (interface{}, error) {
@@ -99,10 +134,8 @@ func parseString(response *C.struct_CommandResponse) (interface{}, error) {
 	if response.string_value == nil {
 		return nil, nil
 	}
-	byteSlice := C.GoBytes(unsafe.Pointer(response.string_value), C.int(int64(response.string_value_len)))
-
-	// Create Go string from byte slice (preserving null characters)
-	return string(byteSlice), nil
+	// C.GoStringN copies the value only once and preserves null characters
+	return C.GoStringN(response.string_value, C.int(int64(response.string_value_len))), nil
 }
 
 func parseArray(response *C.struct_CommandResponse) (interface{}, error) {
@@ -161,6 +194,10 @@ func parseSet(response *C.struct_CommandResponse) (interface{}, error) {
 func handleStringResponse(response *C.struct_CommandResponse) (string, error) {
 	defer C.free_command_response(response)
 
+	return convertStringResponse(response)
+}
+
+func convertStringResponse(response *C.struct_CommandResponse) (string, error) {
 	res, err := convertCharArrayToString(response, false)
 	return res.Value(), err
 }
@@ -168,6 +205,10 @@ func handleStringResponse(response *C.struct_CommandResponse) (string, error) {
 func handleStringOrNilResponse(response *C.struct_CommandResponse) (Result[string], error) {
 	defer C.free_command_response(response)
 
+	return convertStringOrNilResponse(response)
+}
+
+func convertStringOrNilResponse(response *C.struct_CommandResponse) (Result[string], error) {
 	return convertCharArrayToString(response, true)
 }
 
@@ -189,8 +230,61 @@ func convertStringOrNilArray(response *C.struct_CommandResponse) ([]Result[strin
 	return slice, nil
 }
 
+func handleBytesOrNilResponse(response *C.struct_CommandResponse) (Result[[]byte], error) {
+	defer C.free_command_response(response)
+
+	return convertCharArrayToBytes(response, true)
+}
+
+func handleBytesOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result[[]byte], error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.Array, false)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	slice := make([]Result[[]byte], 0, response.array_value_len)
+	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
+		res, err := convertCharArrayToBytes(&v, true)
+		if err != nil {
+			return nil, err
+		}
+		slice = append(slice, res)
+	}
+	return slice, nil
+}
+
+func handleFieldAndValueArrayResponse(response *C.struct_CommandResponse) ([]FieldAndValue, error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.Map, false)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	result := make([]FieldAndValue, 0, response.array_value_len)
+	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
+		field, err := convertCharArrayToBytes(v.map_key, false)
+		if err != nil {
+			return nil, err
+		}
+		value, err := convertCharArrayToBytes(v.map_value, false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, FieldAndValue{Field: field.Value(), Value: value.Value()})
+	}
+	return result, nil
+}
+
 func handle2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string, error) {
 	defer C.free_command_response(response)
+
+	return convert2DStringArrayResponse(response)
+}
+
+func convert2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -218,6 +312,11 @@ func handle2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string
 
 func handle2DFloat64OrNullArrayResponse(response *C.struct_CommandResponse) ([][]float64, error) {
 	defer C.free_command_response(response)
+
+	return convert2DFloat64OrNullArrayResponse(response)
+}
+
+func convert2DFloat64OrNullArrayResponse(response *C.struct_CommandResponse) ([][]float64, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -291,6 +390,10 @@ func convertStringArray(response *C.struct_CommandResponse, isNilable bool) ([]s
 func handleAnyResponse(response *C.struct_CommandResponse) (any, error) {
 	defer C.free_command_response(response)
 
+	return convertAnyResponse(response)
+}
+
+func convertAnyResponse(response *C.struct_CommandResponse) (any, error) {
 	return parseInterface(response)
 }
 
@@ -367,12 +470,20 @@ func handleStringOrNilArrayResponse(response *C.struct_CommandResponse) ([]Resul
 func handleStringArrayResponse(response *C.struct_CommandResponse) ([]string, error) {
 	defer C.free_command_response(response)
 
+	return convertStringArrayResponse(response)
+}
+
+func convertStringArrayResponse(response *C.struct_CommandResponse) ([]string, error) {
 	return convertStringArray(response, false)
 }
 
 func handleStringArrayOrNilResponse(response *C.struct_CommandResponse) ([]string, error) {
 	defer C.free_command_response(response)
 
+	return convertStringArrayOrNilResponse(response)
+}
+
+func convertStringArrayOrNilResponse(response *C.struct_CommandResponse) ([]string, error) {
 	return convertStringArray(response, true)
 }
 
@@ -385,6 +496,10 @@ func handleAnyArrayResponse(response *C.struct_CommandResponse) ([]any, error) {
 func handleIntResponse(response *C.struct_CommandResponse) (int64, error) {
 	defer C.free_command_response(response)
 
+	return convertIntResponse(response)
+}
+
+func convertIntResponse(response *C.struct_CommandResponse) (int64, error) {
 	typeErr := checkResponseType(response, C.Int, false)
 	if typeErr != nil {
 		return 0, typeErr
@@ -396,6 +511,10 @@ func handleIntResponse(response *C.struct_CommandResponse) (int64, error) {
 func handleIntOrNilResponse(response *C.struct_CommandResponse) (Result[int64], error) {
 	defer C.free_command_response(response)
 
+	return convertIntOrNilResponse(response)
+}
+
+func convertIntOrNilResponse(response *C.struct_CommandResponse) (Result[int64], error) {
 	typeErr := checkResponseType(response, C.Int, true)
 	if typeErr != nil {
 		return CreateNilInt64Result(), typeErr
@@ -411,6 +530,10 @@ func handleIntOrNilResponse(response *C.struct_CommandResponse) (Result[int64],
 func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error) {
 	defer C.free_command_response(response)
 
+	return convertIntArrayResponse(response)
+}
+
+func convertIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -430,6 +553,10 @@ func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error)
 func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result[int64], error) {
 	defer C.free_command_response(response)
 
+	return convertIntOrNilArrayResponse(response)
+}
+
+func convertIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result[int64], error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -456,6 +583,10 @@ func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result[i
 func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
 	defer C.free_command_response(response)
 
+	return convertFloatResponse(response)
+}
+
+func convertFloatResponse(response *C.struct_CommandResponse) (float64, error) {
 	typeErr := checkResponseType(response, C.Float, false)
 	if typeErr != nil {
 		return float64(0), typeErr
@@ -467,6 +598,10 @@ func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
 func handleFloatOrNilResponse(response *C.struct_CommandResponse) (Result[float64], error) {
 	defer C.free_command_response(response)
 
+	return convertFloatOrNilResponse(response)
+}
+
+func convertFloatOrNilResponse(response *C.struct_CommandResponse) (Result[float64], error) {
 	typeErr := checkResponseType(response, C.Float, true)
 	if typeErr != nil {
 		return CreateNilFloat64Result(), typeErr
@@ -481,6 +616,10 @@ func handleFloatOrNilResponse(response *C.struct_CommandResponse) (Result[float6
 func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result[float64], error) {
 	defer C.free_command_response(response)
 
+	return convertFloatOrNilArrayResponse(response)
+}
+
+func convertFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result[float64], error) {
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
 		return nil, typeErr
@@ -507,6 +646,10 @@ func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]Result
 func handleLongAndDoubleOrNullResponse(response *C.struct_CommandResponse) (Result[int64], Result[float64], error) {
 	defer C.free_command_response(response)
 
+	return convertLongAndDoubleOrNullResponse(response)
+}
+
+func convertLongAndDoubleOrNullResponse(response *C.struct_CommandResponse) (Result[int64], Result[float64], error) {
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
 		return CreateNilInt64Result(), CreateNilFloat64Result(), typeErr
@@ -533,6 +676,10 @@ func handleLongAndDoubleOrNullResponse(response *C.struct_CommandResponse) (Resu
 func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
 	defer C.free_command_response(response)
 
+	return convertBoolResponse(response)
+}
+
+func convertBoolResponse(response *C.struct_CommandResponse) (bool, error) {
 	typeErr := checkResponseType(response, C.Bool, false)
 	if typeErr != nil {
 		return false, typeErr
@@ -544,6 +691,10 @@ func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
 func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error) {
 	defer C.free_command_response(response)
 
+	return convertBoolArrayResponse(response)
+}
+
+func convertBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -563,6 +714,10 @@ func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error)
 func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[string]float64, error) {
 	defer C.free_command_response(response)
 
+	return convertStringDoubleMapResponse(response)
+}
+
+func convertStringDoubleMapResponse(response *C.struct_CommandResponse) (map[string]float64, error) {
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -590,6 +745,10 @@ func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[stri
 func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[string]string, error) {
 	defer C.free_command_response(response)
 
+	return convertStringToStringMapResponse(response)
+}
+
+func convertStringToStringMapResponse(response *C.struct_CommandResponse) (map[string]string, error) {
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -619,6 +778,12 @@ func handleStringToStringArrayMapOrNilResponse(
 ) (map[string][]string, error) {
 	defer C.free_command_response(response)
 
+	return convertStringToStringArrayMapOrNilResponse(response)
+}
+
+func convertStringToStringArrayMapOrNilResponse(
+	response *C.struct_CommandResponse,
+) (map[string][]string, error) {
 	typeErr := checkResponseType(response, C.Map, true)
 	if typeErr != nil {
 		return nil, typeErr
@@ -652,13 +817,27 @@ func handleStringToStringArrayMapOrNilResponse(
 func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]struct{}, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Sets, false)
-	if typeErr != nil {
-		return nil, typeErr
+	return convertStringSetResponse(response)
+}
+
+func convertStringSetResponse(response *C.struct_CommandResponse) (map[string]struct{}, error) {
+	// with RESP2, the sets which are not converted by the core are received as arrays
+	if response == nil || response.response_type != C.Array {
+		typeErr := checkResponseType(response, C.Sets, false)
+		if typeErr != nil {
+			return nil, typeErr
+		}
 	}
 
-	slice := make(map[string]struct{}, response.sets_value_len)
-	for _, v := range unsafe.Slice(response.sets_value, response.sets_value_len) {
+	var members []C.struct_CommandResponse
+	if response.response_type == C.Array {
+		members = unsafe.Slice(response.array_value, response.array_value_len)
+	} else {
+		members = unsafe.Slice(response.sets_value, response.sets_value_len)
+	}
+
+	slice := make(map[string]struct{}, len(members))
+	for _, v := range members {
 		res, err := convertCharArrayToString(&v, true)
 		if err != nil {
 			return nil, err
@@ -672,6 +851,10 @@ func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]str
 func handleKeyWithMemberAndScoreResponse(response *C.struct_CommandResponse) (Result[KeyWithMemberAndScore], error) {
 	defer C.free_command_response(response)
 
+	return convertKeyWithMemberAndScoreResponse(response)
+}
+
+func convertKeyWithMemberAndScoreResponse(response *C.struct_CommandResponse) (Result[KeyWithMemberAndScore], error) {
 	if response == nil || response.response_type == uint32(C.Null) {
 		return CreateNilKeyWithMemberAndScoreResult(), nil
 	}
@@ -698,6 +881,12 @@ func handleKeyWithArrayOfMembersAndScoresResponse(
 ) (Result[KeyWithArrayOfMembersAndScores], error) {
 	defer C.free_command_response(response)
 
+	return convertKeyWithArrayOfMembersAndScoresResponse(response)
+}
+
+func convertKeyWithArrayOfMembersAndScoresResponse(
+	response *C.struct_CommandResponse,
+) (Result[KeyWithArrayOfMembersAndScores], error) {
 	if response.response_type == uint32(C.Null) {
 		return CreateNilKeyWithArrayOfMembersAndScoresResult(), nil
 	}
@@ -740,6 +929,10 @@ func handleKeyWithArrayOfMembersAndScoresResponse(
 func handleMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]MemberAndScore, error) {
 	defer C.free_command_response(response)
 
+	return convertMemberAndScoreArrayResponse(response)
+}
+
+func convertMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]MemberAndScore, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -761,6 +954,10 @@ func handleMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]Me
 func handleScanResponse(response *C.struct_CommandResponse) (string, []string, error) {
 	defer C.free_command_response(response)
 
+	return convertScanResponse(response)
+}
+
+func convertScanResponse(response *C.struct_CommandResponse) (string, []string, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return "", nil, typeErr
@@ -908,6 +1105,10 @@ func (node arrayConverter[T]) convert(data interface{}) (interface{}, error) {
 func handleMapOfArrayOfStringArrayResponse(response *C.struct_CommandResponse) (map[string][][]string, error) {
 	defer C.free_command_response(response)
 
+	return convertMapOfArrayOfStringArrayResponse(response)
+}
+
+func convertMapOfArrayOfStringArrayResponse(response *C.struct_CommandResponse) (map[string][][]string, error) {
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -940,6 +1141,10 @@ func handleMapOfArrayOfStringArrayResponse(response *C.struct_CommandResponse) (
 func handleXRangeResponse(response *C.struct_CommandResponse) ([]XRangeResponse, error) {
 	defer C.free_command_response(response)
 
+	return convertXRangeResponse(response)
+}
+
+func convertXRangeResponse(response *C.struct_CommandResponse) ([]XRangeResponse, error) {
 	if response.response_type == uint32(C.Null) {
 		return nil, nil
 	}
@@ -985,6 +1190,10 @@ func handleXRangeResponse(response *C.struct_CommandResponse) ([]XRangeResponse,
 func handleXRevRangeResponse(response *C.struct_CommandResponse) ([]XRangeResponse, error) {
 	defer C.free_command_response(response)
 
+	return convertXRevRangeResponse(response)
+}
+
+func convertXRevRangeResponse(response *C.struct_CommandResponse) ([]XRangeResponse, error) {
 	if response.response_type == uint32(C.Null) {
 		return nil, nil
 	}
@@ -1029,6 +1238,11 @@ func handleXRevRangeResponse(response *C.struct_CommandResponse) ([]XRangeRespon
 
 func handleXAutoClaimResponse(response *C.struct_CommandResponse) (XAutoClaimResponse, error) {
 	defer C.free_command_response(response)
+
+	return convertXAutoClaimResponse(response)
+}
+
+func convertXAutoClaimResponse(response *C.struct_CommandResponse) (XAutoClaimResponse, error) {
 	var null XAutoClaimResponse // default response
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1080,6 +1294,11 @@ func handleXAutoClaimResponse(response *C.struct_CommandResponse) (XAutoClaimRes
 
 func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (XAutoClaimJustIdResponse, error) {
 	defer C.free_command_response(response)
+
+	return convertXAutoClaimJustIdResponse(response)
+}
+
+func convertXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (XAutoClaimJustIdResponse, error) {
 	var null XAutoClaimJustIdResponse // default response
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1125,6 +1344,11 @@ func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (XAutoCl
 
 func handleXReadResponse(response *C.struct_CommandResponse) (map[string]map[string][][]string, error) {
 	defer C.free_command_response(response)
+
+	return convertXReadResponse(response)
+}
+
+func convertXReadResponse(response *C.struct_CommandResponse) (map[string]map[string][][]string, error) {
 	data, err := parseMap(response)
 	if err != nil {
 		return nil, err
@@ -1159,6 +1383,11 @@ func handleXReadResponse(response *C.struct_CommandResponse) (map[string]map[str
 
 func handleXReadGroupResponse(response *C.struct_CommandResponse) (map[string]map[string][][]string, error) {
 	defer C.free_command_response(response)
+
+	return convertXReadGroupResponse(response)
+}
+
+func convertXReadGroupResponse(response *C.struct_CommandResponse) (map[string]map[string][][]string, error) {
 	data, err := parseMap(response)
 	if err != nil {
 		return nil, err
@@ -1194,6 +1423,10 @@ func handleXReadGroupResponse(response *C.struct_CommandResponse) (map[string]ma
 func handleXPendingSummaryResponse(response *C.struct_CommandResponse) (XPendingSummary, error) {
 	defer C.free_command_response(response)
 
+	return convertXPendingSummaryResponse(response)
+}
+
+func convertXPendingSummaryResponse(response *C.struct_CommandResponse) (XPendingSummary, error) {
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
 		return CreateNilXPendingSummary(), typeErr
@@ -1237,10 +1470,14 @@ func handleXPendingSummaryResponse(response *C.struct_CommandResponse) (XPending
 }
 
 func handleXPendingDetailResponse(response *C.struct_CommandResponse) ([]XPendingDetail, error) {
-	// response should be [][]interface{}
-
 	defer C.free_command_response(response)
 
+	return convertXPendingDetailResponse(response)
+}
+
+func convertXPendingDetailResponse(response *C.struct_CommandResponse) ([]XPendingDetail, error) {
+	// response should be [][]interface{}
+
 	// TODO: Not sure if this is correct for a nill response
 	if response == nil || response.response_type == uint32(C.Null) {
 		return make([]XPendingDetail, 0), nil
@@ -1285,6 +1522,10 @@ func handleXPendingDetailResponse(response *C.struct_CommandResponse) ([]XPendin
 func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]XInfoConsumerInfo, error) {
 	defer C.free_command_response(response)
 
+	return convertXInfoConsumersResponse(response)
+}
+
+func convertXInfoConsumersResponse(response *C.struct_CommandResponse) ([]XInfoConsumerInfo, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1328,6 +1569,10 @@ func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]XInfoCo
 func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]XInfoGroupInfo, error) {
 	defer C.free_command_response(response)
 
+	return convertXInfoGroupsResponse(response)
+}
+
+func convertXInfoGroupsResponse(response *C.struct_CommandResponse) ([]XInfoGroupInfo, error) {
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1378,6 +1623,10 @@ func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]XInfoGroup
 func handleStringToAnyMapResponse(response *C.struct_CommandResponse) (map[string]interface{}, error) {
 	defer C.free_command_response(response)
 
+	return convertStringToAnyMapResponse(response)
+}
+
+func convertStringToAnyMapResponse(response *C.struct_CommandResponse) (map[string]interface{}, error) {
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1442,6 +1691,10 @@ func handleTimeClusterResponse(response *C.struct_CommandResponse) (ClusterValue
 func handleStringIntMapResponse(response *C.struct_CommandResponse) (map[string]int64, error) {
 	defer C.free_command_response(response)
 
+	return convertStringIntMapResponse(response)
+}
+
+func convertStringIntMapResponse(response *C.struct_CommandResponse) (map[string]int64, error) {
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1465,3 +1718,583 @@ func handleStringIntMapResponse(response *C.struct_CommandResponse) (map[string]
 	}
 	return result, nil
 }
+
+func handleFunctionListResponse(response *C.struct_CommandResponse) ([]LibraryInfo, error) {
+	defer C.free_command_response(response)
+
+	return convertFunctionListResponse(response)
+}
+
+func convertFunctionListResponse(response *C.struct_CommandResponse) ([]LibraryInfo, error) {
+	typeErr := checkResponseType(response, C.Array, false)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+	data, err := parseArray(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseLibraryInfos(data)
+}
+
+func handleFunctionListClusterResponse(
+	response *C.struct_CommandResponse,
+	isMultiNode bool,
+) (ClusterValue[[]LibraryInfo], error) {
+	defer C.free_command_response(response)
+
+	if !isMultiNode {
+		libraries, err := convertFunctionListResponse(response)
+		if err != nil {
+			return createEmptyClusterValue[[]LibraryInfo](), err
+		}
+		return createClusterSingleValue[[]LibraryInfo](libraries), nil
+	}
+
+	data, err := convertStringToAnyMapResponse(response)
+	if err != nil {
+		return createEmptyClusterValue[[]LibraryInfo](), err
+	}
+	result := make(map[string][]LibraryInfo, len(data))
+	for node, nodeData := range data {
+		libraries, err := parseLibraryInfos(nodeData)
+		if err != nil {
+			return createEmptyClusterValue[[]LibraryInfo](), err
+		}
+		result[node] = libraries
+	}
+	return createClusterMultiValue[[]LibraryInfo](result), nil
+}
+
+// parseLibraryInfos converts the parsed response of `FUNCTION LIST` to a slice of [LibraryInfo].
+func parseLibraryInfos(data any) ([]LibraryInfo, error) {
+	if data == nil {
+		return []LibraryInfo{}, nil
+	}
+	libraries, ok := data.([]any)
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+
+	result := make([]LibraryInfo, 0, len(libraries))
+	for _, library := range libraries {
+		libraryMap, ok := library.(map[string]any)
+		if !ok {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", library)}
+		}
+		info := LibraryInfo{Functions: []FunctionInfo{}}
+		info.Name, _ = libraryMap["library_name"].(string)
+		info.Engine, _ = libraryMap["engine"].(string)
+		info.Code, _ = libraryMap["library_code"].(string)
+
+		functions, _ := libraryMap["functions"].([]any)
+		for _, function := range functions {
+			functionMap, ok := function.(map[string]any)
+			if !ok {
+				return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", function)}
+			}
+			functionInfo := FunctionInfo{Flags: map[string]struct{}{}}
+			functionInfo.Name, _ = functionMap["name"].(string)
+			// the description is nil if the function has no description
+			functionInfo.Description, _ = functionMap["description"].(string)
+			if flags, ok := functionMap["flags"].(map[string]struct{}); ok {
+				functionInfo.Flags = flags
+			}
+			info.Functions = append(info.Functions, functionInfo)
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+func handleFunctionStatsResponse(response *C.struct_CommandResponse) (map[string]FunctionStatsResult, error) {
+	defer C.free_command_response(response)
+
+	return convertFunctionStatsResponse(response)
+}
+
+// convertFunctionStatsResponse converts the response of `FUNCTION STATS` routed to multiple nodes, which maps the address
+// of each node to its statistics.
+func convertFunctionStatsResponse(response *C.struct_CommandResponse) (map[string]FunctionStatsResult, error) {
+	data, err := convertStringToAnyMapResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]FunctionStatsResult, len(data))
+	for node, nodeData := range data {
+		stats, err := parseFunctionStats(nodeData)
+		if err != nil {
+			return nil, err
+		}
+		result[node] = stats
+	}
+	return result, nil
+}
+
+func handleFunctionStatsClusterResponse(
+	response *C.struct_CommandResponse,
+	isMultiNode bool,
+) (ClusterValue[FunctionStatsResult], error) {
+	defer C.free_command_response(response)
+
+	if isMultiNode {
+		stats, err := convertFunctionStatsResponse(response)
+		if err != nil {
+			return createEmptyClusterValue[FunctionStatsResult](), err
+		}
+		return createClusterMultiValue[FunctionStatsResult](stats), nil
+	}
+
+	data, err := convertStringToAnyMapResponse(response)
+	if err != nil {
+		return createEmptyClusterValue[FunctionStatsResult](), err
+	}
+	stats, err := parseFunctionStats(data)
+	if err != nil {
+		return createEmptyClusterValue[FunctionStatsResult](), err
+	}
+	return createClusterSingleValue[FunctionStatsResult](stats), nil
+}
+
+// parseFunctionStats converts the parsed response of `FUNCTION STATS` of a single node to a [FunctionStatsResult].
+func parseFunctionStats(data any) (FunctionStatsResult, error) {
+	statsMap, ok := data.(map[string]any)
+	if !ok {
+		return FunctionStatsResult{}, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+
+	result := FunctionStatsResult{
+		Engines:       map[string]FunctionEngineStats{},
+		RunningScript: Result[RunningScript]{isNil: true},
+	}
+	engines, _ := statsMap["engines"].(map[string]any)
+	for engine, engineData := range engines {
+		engineMap, ok := engineData.(map[string]any)
+		if !ok {
+			return FunctionStatsResult{}, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", engineData)}
+		}
+		engineStats := FunctionEngineStats{}
+		engineStats.LibraryCount, _ = engineMap["libraries_count"].(int64)
+		engineStats.FunctionCount, _ = engineMap["functions_count"].(int64)
+		result.Engines[engine] = engineStats
+	}
+
+	if runningScriptMap, ok := statsMap["running_script"].(map[string]any); ok {
+		runningScript := RunningScript{}
+		runningScript.Name, _ = runningScriptMap["name"].(string)
+		runningScript.Duration, _ = runningScriptMap["duration_ms"].(int64)
+		command, _ := runningScriptMap["command"].([]any)
+		commandArgs, err := convertToStringArray(command)
+		if err != nil {
+			return FunctionStatsResult{}, err
+		}
+		runningScript.Command = commandArgs
+		result.RunningScript = Result[RunningScript]{val: runningScript}
+	}
+	return result, nil
+}
+
+// asStrings converts a parsed array or set of strings, in the order of the array.
+func asStrings(data any) ([]string, error) {
+	switch data := data.(type) {
+	case nil:
+		return []string{}, nil
+	case []any:
+		return convertToStringArray(data)
+	case map[string]struct{}:
+		result := make([]string, 0, len(data))
+		for value := range data {
+			result = append(result, value)
+		}
+		sort.Strings(result)
+		return result, nil
+	}
+	return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+}
+
+// asAclPatterns converts the key or channel patterns of a user, which are returned as a list before Valkey 7.0.
+func asAclPatterns(data any) string {
+	if patterns, err := asStrings(data); err == nil {
+		return strings.Join(patterns, " ")
+	}
+	patterns, _ := data.(string)
+	return patterns
+}
+
+func handleAclGetUserResponse(response *C.struct_CommandResponse) (Result[AclUserInfo], error) {
+	defer C.free_command_response(response)
+
+	if response == nil || response.response_type == uint32(C.Null) {
+		return Result[AclUserInfo]{isNil: true}, nil
+	}
+	data, err := parseInterface(response)
+	if err != nil {
+		return Result[AclUserInfo]{isNil: true}, err
+	}
+	userMap, err := asStringToAnyMap(data)
+	if err != nil {
+		return Result[AclUserInfo]{isNil: true}, err
+	}
+
+	info := AclUserInfo{Selectors: []AclSelectorInfo{}}
+	if info.Flags, err = asStrings(userMap["flags"]); err != nil {
+		return Result[AclUserInfo]{isNil: true}, err
+	}
+	if info.Passwords, err = asStrings(userMap["passwords"]); err != nil {
+		return Result[AclUserInfo]{isNil: true}, err
+	}
+	info.Commands, _ = userMap["commands"].(string)
+	info.Keys = asAclPatterns(userMap["keys"])
+	info.Channels = asAclPatterns(userMap["channels"])
+
+	selectors, _ := userMap["selectors"].([]any)
+	for _, selector := range selectors {
+		selectorMap, err := asStringToAnyMap(selector)
+		if err != nil {
+			return Result[AclUserInfo]{isNil: true}, err
+		}
+		selectorInfo := AclSelectorInfo{}
+		selectorInfo.Commands, _ = selectorMap["commands"].(string)
+		selectorInfo.Keys = asAclPatterns(selectorMap["keys"])
+		selectorInfo.Channels = asAclPatterns(selectorMap["channels"])
+		info.Selectors = append(info.Selectors, selectorInfo)
+	}
+	return Result[AclUserInfo]{val: info}, nil
+}
+
+func handleAclLogResponse(response *C.struct_CommandResponse) ([]AclLogEntry, error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.Array, false)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+	data, err := parseArray(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseAclLogEntries(data)
+}
+
+// parseAclLogEntries converts the parsed response of `ACL LOG` to a slice of [AclLogEntry].
+func parseAclLogEntries(data any) ([]AclLogEntry, error) {
+	entries, ok := data.([]any)
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+
+	result := make([]AclLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		entryMap, err := asStringToAnyMap(entry)
+		if err != nil {
+			return nil, err
+		}
+		logEntry := AclLogEntry{}
+		logEntry.Count, _ = entryMap["count"].(int64)
+		logEntry.Reason, _ = entryMap["reason"].(string)
+		logEntry.Context, _ = entryMap["context"].(string)
+		logEntry.Object, _ = entryMap["object"].(string)
+		logEntry.Username, _ = entryMap["username"].(string)
+		// the age is a double with RESP3, and a string with RESP2
+		switch age := entryMap["age-seconds"].(type) {
+		case float64:
+			logEntry.AgeSeconds = age
+		case string:
+			logEntry.AgeSeconds, _ = strconv.ParseFloat(age, 64)
+		}
+		logEntry.ClientInfo, _ = entryMap["client-info"].(string)
+		logEntry.EntryId, _ = entryMap["entry-id"].(int64)
+		logEntry.TimestampCreated, _ = entryMap["timestamp-created"].(int64)
+		logEntry.TimestampLastUpdated, _ = entryMap["timestamp-last-updated"].(int64)
+		result = append(result, logEntry)
+	}
+	return result, nil
+}
+
+// handleClusterResponse converts the response of a command routed to the nodes of a route, with the given conversion of
+// the parsed response of a single node.
+func handleClusterResponse[T any](
+	response *C.struct_CommandResponse,
+	isMultiNode bool,
+	convert func(data any) (T, error),
+) (ClusterValue[T], error) {
+	defer C.free_command_response(response)
+
+	data, err := parseInterface(response)
+	if err != nil {
+		return createEmptyClusterValue[T](), err
+	}
+	if !isMultiNode {
+		value, err := convert(data)
+		if err != nil {
+			return createEmptyClusterValue[T](), err
+		}
+		return createClusterSingleValue[T](value), nil
+	}
+
+	nodes, ok := data.(map[string]any)
+	if !ok {
+		return createEmptyClusterValue[T](), &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+	result := make(map[string]T, len(nodes))
+	for node, nodeData := range nodes {
+		value, err := convert(nodeData)
+		if err != nil {
+			return createEmptyClusterValue[T](), err
+		}
+		result[node] = value
+	}
+	return createClusterMultiValue[T](result), nil
+}
+
+func asString(data any) (string, error) {
+	value, ok := data.(string)
+	if !ok {
+		return "", &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+	return value, nil
+}
+
+// parseSlotRanges converts a flat array of the first and the last slot of each range.
+func parseSlotRanges(data any) ([]SlotRange, error) {
+	bounds, ok := data.([]any)
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type of slot bounds: %T", data)}
+	}
+	if len(bounds)%2 != 0 {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected number of slot bounds: %d", len(bounds))}
+	}
+	result := make([]SlotRange, 0, len(bounds)/2)
+	for i := 0; i < len(bounds); i += 2 {
+		start, startOk := bounds[i].(int64)
+		end, endOk := bounds[i+1].(int64)
+		if !startOk || !endOk {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot range: %v", bounds[i:i+2])}
+		}
+		result = append(result, SlotRange{Start: start, End: end})
+	}
+	return result, nil
+}
+
+// parseShardInfos converts the parsed response of `CLUSTER SHARDS` to a slice of [ShardInfo].
+func parseShardInfos(data any) ([]ShardInfo, error) {
+	shards, ok := data.([]any)
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+
+	result := make([]ShardInfo, 0, len(shards))
+	for _, shard := range shards {
+		shardMap, err := asStringToAnyMap(shard)
+		if err != nil {
+			return nil, err
+		}
+		info := ShardInfo{Nodes: []ShardNode{}}
+		if info.Slots, err = parseSlotRanges(shardMap["slots"]); err != nil {
+			return nil, err
+		}
+		nodes, _ := shardMap["nodes"].([]any)
+		for _, node := range nodes {
+			nodeMap, err := asStringToAnyMap(node)
+			if err != nil {
+				return nil, err
+			}
+			shardNode := ShardNode{}
+			shardNode.Id, _ = nodeMap["id"].(string)
+			shardNode.Endpoint, _ = nodeMap["endpoint"].(string)
+			shardNode.Ip, _ = nodeMap["ip"].(string)
+			shardNode.Hostname, _ = nodeMap["hostname"].(string)
+			shardNode.Port, _ = nodeMap["port"].(int64)
+			shardNode.TlsPort, _ = nodeMap["tls-port"].(int64)
+			shardNode.Role, _ = nodeMap["role"].(string)
+			shardNode.ReplicationOffset, _ = nodeMap["replication-offset"].(int64)
+			shardNode.Health, _ = nodeMap["health"].(string)
+			info.Nodes = append(info.Nodes, shardNode)
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// parseClusterNodes converts the response of `CLUSTER NODES` to a slice of [ClusterNode]. Each line describes a node:
+//
+//	<id> <ip:port@cport[,hostname]> <flags> <primary> <ping-sent> <pong-recv> <config-epoch> <link-state> <slot> ...
+func parseClusterNodes(data any) ([]ClusterNode, error) {
+	text, err := asString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []ClusterNode{}
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 8 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected cluster node: %q", line)}
+		}
+		node := ClusterNode{
+			Id:        fields[0],
+			Flags:     strings.Split(fields[2], ","),
+			LinkState: fields[7],
+			Slots:     []SlotRange{},
+		}
+
+		address, hostname, _ := strings.Cut(fields[1], ",")
+		node.Hostname = hostname
+		address, busPort, _ := strings.Cut(address, "@")
+		if separator := strings.LastIndex(address, ":"); separator >= 0 {
+			node.Ip = address[:separator]
+			node.Port, _ = strconv.ParseInt(address[separator+1:], 10, 64)
+		}
+		node.ClusterBusPort, _ = strconv.ParseInt(busPort, 10, 64)
+		if fields[3] != "-" {
+			node.PrimaryId = fields[3]
+		}
+		node.PingSent, _ = strconv.ParseInt(fields[4], 10, 64)
+		node.PongReceived, _ = strconv.ParseInt(fields[5], 10, 64)
+		node.ConfigEpoch, _ = strconv.ParseInt(fields[6], 10, 64)
+
+		for _, slots := range fields[8:] {
+			// the slots being imported or migrated are described as [slot-<-id] or [slot->-id]
+			if strings.HasPrefix(slots, "[") {
+				continue
+			}
+			startSlot, endSlot, isRange := strings.Cut(slots, "-")
+			if !isRange {
+				endSlot = startSlot
+			}
+			start, startErr := strconv.ParseInt(startSlot, 10, 64)
+			end, endErr := strconv.ParseInt(endSlot, 10, 64)
+			if startErr != nil || endErr != nil {
+				return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot range: %q", slots)}
+			}
+			node.Slots = append(node.Slots, SlotRange{Start: start, End: end})
+		}
+		result = append(result, node)
+	}
+	return result, nil
+}
+
+// parseSlotInfos converts the parsed response of `CLUSTER SLOTS` to a slice of [SlotInfo].
+func parseSlotInfos(data any) ([]SlotInfo, error) {
+	slots, ok := data.([]any)
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+
+	result := make([]SlotInfo, 0, len(slots))
+	for _, slot := range slots {
+		slotData, ok := slot.([]any)
+		if !ok || len(slotData) < 3 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot info: %v", slot)}
+		}
+		ranges, err := parseSlotRanges(slotData[:2])
+		if err != nil {
+			return nil, err
+		}
+		info := SlotInfo{Range: ranges[0], Replicas: []SlotNode{}}
+		for i, node := range slotData[2:] {
+			nodeData, ok := node.([]any)
+			if !ok || len(nodeData) < 2 {
+				return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot node: %v", node)}
+			}
+			slotNode := SlotNode{}
+			slotNode.Endpoint, _ = nodeData[0].(string)
+			slotNode.Port, _ = nodeData[1].(int64)
+			if len(nodeData) > 2 {
+				slotNode.Id, _ = nodeData[2].(string)
+			}
+			if len(nodeData) > 3 {
+				if metadata, err := asStringToAnyMap(nodeData[3]); err == nil {
+					slotNode.Hostname, _ = metadata["hostname"].(string)
+				}
+			}
+			if i == 0 {
+				info.Primary = slotNode
+			} else {
+				info.Replicas = append(info.Replicas, slotNode)
+			}
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// parseClusterInfo converts the response of `CLUSTER INFO`, which has a "field:value" line per field, to a map.
+func parseClusterInfo(data any) (map[string]string, error) {
+	text, err := asString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, line := range strings.Split(text, "\n") {
+		field, value, found := strings.Cut(strings.TrimSpace(line), ":")
+		if found {
+			result[field] = value
+		}
+	}
+	return result, nil
+}
+
+func asInt64(data any) (int64, error) {
+	value, ok := data.(int64)
+	if !ok {
+		return 0, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+	return value, nil
+}
+
+// parseClientInfo converts a line of `CLIENT LIST` or the response of `CLIENT INFO`, which have space-separated
+// "field=value" pairs, to a [ClientInfo].
+func parseClientInfo(data any) (ClientInfo, error) {
+	text, err := asString(data)
+	if err != nil {
+		return ClientInfo{}, err
+	}
+
+	info := ClientInfo{Fields: map[string]string{}}
+	for _, field := range strings.Fields(text) {
+		name, value, _ := strings.Cut(field, "=")
+		info.Fields[name] = value
+	}
+	info.Id, _ = strconv.ParseInt(info.Fields["id"], 10, 64)
+	info.Addr = info.Fields["addr"]
+	info.LAddr = info.Fields["laddr"]
+	info.Name = info.Fields["name"]
+	info.Age, _ = strconv.ParseInt(info.Fields["age"], 10, 64)
+	info.Idle, _ = strconv.ParseInt(info.Fields["idle"], 10, 64)
+	info.Flags = info.Fields["flags"]
+	info.Db, _ = strconv.ParseInt(info.Fields["db"], 10, 64)
+	info.User = info.Fields["user"]
+	info.Cmd = info.Fields["cmd"]
+	return info, nil
+}
+
+// parseClientInfos converts the response of `CLIENT LIST`, which has a line per connection, to a slice of [ClientInfo].
+func parseClientInfos(data any) ([]ClientInfo, error) {
+	text, err := asString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []ClientInfo{}
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		info, err := parseClientInfo(line)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+func asBool(data any) (bool, error) {
+	value, err := asInt64(data)
+	return value == 1, err
+}
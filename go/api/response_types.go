@@ -35,6 +35,13 @@ type MemberAndScore struct {
 	Score  float64
 }
 
+// FieldAndValue is used by [HashCommands.HSetBytes] and [HashCommands.HGetAllBytes], and consists of a binary field of a
+// hash and its binary value.
+type FieldAndValue struct {
+	Field []byte
+	Value []byte
+}
+
 // Response type of [XRange] and [XRevRange] commands.
 type XRangeResponse struct {
 	StreamId string
@@ -71,6 +78,14 @@ func CreateNilStringResult() Result[string] {
 	return Result[string]{val: "", isNil: true}
 }
 
+func CreateBytesResult(bytes []byte) Result[[]byte] {
+	return Result[[]byte]{val: bytes, isNil: false}
+}
+
+func CreateNilBytesResult() Result[[]byte] {
+	return Result[[]byte]{val: nil, isNil: true}
+}
+
 func CreateInt64Result(intVal int64) Result[int64] {
 	return Result[int64]{val: intVal, isNil: false}
 }
@@ -120,19 +135,22 @@ const (
 // Example:
 //
 //	// Command failed:
-//	value, err := clusterClient.CustomCommand(args)
+//	value, err := clusterClient.CustomCommand(context.Background(), args)
 //	value.IsEmpty(): true
 //	err != nil: true
 //
 //	// Command returns response from multiple nodes:
-//	value, _ := clusterClient.Info()
+//	value, _ := clusterClient.Info(context.Background())
 //	for node, nodeResponse := range value.MultiValue() {
 //	    response := nodeResponse
 //	    // `node` stores cluster node IP/hostname, `response` stores the command output from that node
 //	}
 //
 //	// Command returns a response from single node:
-//	value, _ := clusterClient.InfoWithOptions(api.ClusterInfoOptions{InfoOptions: nil, Route: api.RandomRoute.ToPtr()})
+//	value, _ := clusterClient.InfoWithOptions(
+//	    context.Background(),
+//	    api.ClusterInfoOptions{InfoOptions: nil, Route: api.RandomRoute.ToPtr()},
+//	)
 //	response := value.SingleValue()
 //	// `response` stores the command output from a cluster node
 type ClusterValue[T any] struct {
@@ -280,3 +298,215 @@ type XInfoGroupInfo struct {
 	// Included in the response only on valkey 7.0.0 and above.
 	Lag Result[int64]
 }
+
+// FunctionInfo represents a function of a library returned by `FunctionList` command.
+type FunctionInfo struct {
+	// The name of the function.
+	Name string
+	// The description of the function, or an empty string if the function has no description.
+	Description string
+	// The flags of the function.
+	Flags map[string]struct{}
+}
+
+// LibraryInfo represents a library returned by `FunctionList` command.
+type LibraryInfo struct {
+	// The name of the library.
+	Name string
+	// The engine of the library.
+	Engine string
+	// The functions of the library.
+	Functions []FunctionInfo
+	// The code of the library. It is only set if the code was requested with [options.FunctionListQuery.SetWithCode].
+	Code string
+}
+
+// RunningScript represents the function currently executed by a node, returned by `FunctionStats` command.
+type RunningScript struct {
+	// The name of the function.
+	Name string
+	// The command used to invoke the function and its arguments.
+	Command []string
+	// The execution duration of the function in milliseconds.
+	Duration int64
+}
+
+// FunctionEngineStats represents the statistics of a function engine returned by `FunctionStats` command.
+type FunctionEngineStats struct {
+	// The number of libraries loaded with the engine.
+	LibraryCount int64
+	// The number of functions loaded with the engine.
+	FunctionCount int64
+}
+
+// FunctionStatsResult represents the function statistics of a node returned by `FunctionStats` command.
+type FunctionStatsResult struct {
+	// The statistics of each function engine, keyed by the engine name.
+	Engines map[string]FunctionEngineStats
+	// The function currently executed by the node, or a nil result if no function is running.
+	RunningScript Result[RunningScript]
+}
+
+// AclSelectorInfo represents a selector of a user returned by `AclGetUser` command.
+type AclSelectorInfo struct {
+	// The commands allowed and denied by the selector, such as "-@all +get".
+	Commands string
+	// The key patterns of the selector, such as "~tenant1:*".
+	Keys string
+	// The channel patterns of the selector, such as "&tenant1:*".
+	Channels string
+}
+
+// AclUserInfo represents the rules of a user returned by `AclGetUser` command.
+type AclUserInfo struct {
+	// The flags of the user, such as "on", "off" or "nopass".
+	Flags []string
+	// The SHA-256 hashes of the passwords of the user, in hexadecimal.
+	Passwords []string
+	// The commands allowed and denied for the user, such as "+@all -flushall".
+	Commands string
+	// The key patterns of the user, such as "~tenant1:*".
+	Keys string
+	// The channel patterns of the user, such as "&tenant1:*".
+	Channels string
+	// The selectors of the user, which grant additional permissions.
+	Selectors []AclSelectorInfo
+}
+
+// AclLogEntry represents a security event returned by `AclLog` command.
+type AclLogEntry struct {
+	// The number of times the event occurred within 60 seconds of the previous occurrence.
+	Count int64
+	// The reason of the event, which is "command", "key", "channel" or "auth".
+	Reason string
+	// The context of the event, which is "toplevel", "multi", "lua" or "module".
+	Context string
+	// The object of the event, such as the denied command or key.
+	Object string
+	// The name of the user which caused the event.
+	Username string
+	// The age of the event in seconds.
+	AgeSeconds float64
+	// The information about the client which caused the event, in the format of `CLIENT LIST`.
+	ClientInfo string
+	// The unique identifier of the event.
+	EntryId int64
+	// The UNIX time in milliseconds of the first occurrence of the event.
+	TimestampCreated int64
+	// The UNIX time in milliseconds of the last occurrence of the event.
+	TimestampLastUpdated int64
+}
+
+// SlotRange represents a range of hash slots, from `Start` to `End` inclusive.
+type SlotRange struct {
+	// The first slot of the range.
+	Start int64
+	// The last slot of the range.
+	End int64
+}
+
+// ShardNode represents a node of a shard returned by `ClusterShards` command.
+type ShardNode struct {
+	// The unique identifier of the node.
+	Id string
+	// The preferred endpoint to reach the node, which is its IP address, its hostname or "?" if it is unknown.
+	Endpoint string
+	// The IP address of the node.
+	Ip string
+	// The hostname of the node, or an empty string if it is not announced.
+	Hostname string
+	// The plain port of the node, or 0 if it is not enabled.
+	Port int64
+	// The TLS port of the node, or 0 if it is not enabled.
+	TlsPort int64
+	// The role of the node, which is "master" or "replica".
+	Role string
+	// The replication offset of the node.
+	ReplicationOffset int64
+	// The health of the node, which is "online", "failed" or "loading".
+	Health string
+}
+
+// ShardInfo represents a shard returned by `ClusterShards` command.
+type ShardInfo struct {
+	// The slot ranges served by the shard.
+	Slots []SlotRange
+	// The nodes of the shard.
+	Nodes []ShardNode
+}
+
+// ClusterNode represents a node returned by `ClusterNodes` command.
+type ClusterNode struct {
+	// The unique identifier of the node.
+	Id string
+	// The IP address of the node, which is empty if it is unknown.
+	Ip string
+	// The port of the node.
+	Port int64
+	// The cluster bus port of the node.
+	ClusterBusPort int64
+	// The hostname of the node, or an empty string if it is not announced.
+	Hostname string
+	// The flags of the node, such as "myself", "master", "slave" or "fail".
+	Flags []string
+	// The identifier of the primary of the node, or an empty string if the node is a primary.
+	PrimaryId string
+	// The UNIX time in milliseconds at which the last ping was sent, or 0 if there are no pending pings.
+	PingSent int64
+	// The UNIX time in milliseconds at which the last pong was received.
+	PongReceived int64
+	// The configuration epoch of the node, or of its primary if the node is a replica.
+	ConfigEpoch int64
+	// The state of the link to the node, which is "connected" or "disconnected".
+	LinkState string
+	// The slot ranges served by the node. Slots which are being imported or migrated are not included.
+	Slots []SlotRange
+}
+
+// SlotNode represents a node serving a slot range, returned by `ClusterSlots` command.
+type SlotNode struct {
+	// The preferred endpoint to reach the node, which is its IP address, its hostname or "?" if it is unknown.
+	Endpoint string
+	// The port of the node.
+	Port int64
+	// The unique identifier of the node.
+	Id string
+	// The hostname of the node, or an empty string if it is not announced.
+	Hostname string
+}
+
+// SlotInfo represents a slot range and the nodes serving it, returned by `ClusterSlots` command.
+type SlotInfo struct {
+	// The slot range.
+	Range SlotRange
+	// The primary serving the slot range.
+	Primary SlotNode
+	// The replicas serving the slot range.
+	Replicas []SlotNode
+}
+
+// ClientInfo represents a client connection returned by `ClientList` and `ClientInfo` commands.
+type ClientInfo struct {
+	// The unique identifier of the connection.
+	Id int64
+	// The address of the client, in the "ip:port" format.
+	Addr string
+	// The local address of the server to which the client is connected, in the "ip:port" format.
+	LAddr string
+	// The name of the connection, or an empty string if no name is set.
+	Name string
+	// The age of the connection in seconds.
+	Age int64
+	// The idle time of the connection in seconds.
+	Idle int64
+	// The flags of the connection, such as "N" for a normal client or "P" for a Pub/Sub subscriber.
+	Flags string
+	// The selected database.
+	Db int64
+	// The name of the authenticated user.
+	User string
+	// The last command executed by the client.
+	Cmd string
+	// All the fields describing the connection, including the ones above, keyed by their names.
+	Fields map[string]string
+}
@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// Script is a Lua script which is invoked by its SHA1 hash with EVALSHA. If the script is not cached by the server, it is
+// loaded transparently and invoked again.
+//
+// The code of the script is kept by the client until [Script.Close] is called.
+type Script struct {
+	hash      string
+	closeOnce sync.Once
+}
+
+// Creates a new Script with the given Lua code. The SHA1 hash of the code is computed on creation.
+//
+// Parameters:
+//
+//	code - The Lua code of the script.
+//
+// Return value:
+//
+//	A new Script, which should be released with [Script.Close] once it is no longer used.
+func NewScript(code string) *Script {
+	cCode := C.CString(code)
+	defer C.free(unsafe.Pointer(cCode))
+	cHash := C.store_script((*C.uint8_t)(unsafe.Pointer(cCode)), C.uintptr_t(len(code)))
+	defer C.free_script_hash(cHash)
+	return &Script{hash: C.GoString(cHash)}
+}
+
+// Returns the SHA1 hash of the script code.
+func (script *Script) GetHash() string {
+	return script.hash
+}
+
+// Releases the code of the script kept by the client, once all the scripts with the same code are closed. Invoking the
+// script after it was closed fails if the script is not cached by the server. Calling Close more than once has no effect.
+func (script *Script) Close() {
+	script.closeOnce.Do(func() {
+		cHash := C.CString(script.hash)
+		defer C.free(unsafe.Pointer(cHash))
+		C.drop_script(cHash)
+	})
+}
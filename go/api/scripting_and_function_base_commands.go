@@ -2,6 +2,12 @@
 
 package api
 
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
 // Supports commands and transactions for the "Scripting and Function" group for a standalone
 // or cluster client.
 //
@@ -9,19 +15,49 @@ package api
 //
 // [valkey.io]: https://valkey.io/commands/?group=scripting
 type ScriptingAndFunctionBaseCommands interface {
-	FunctionLoad(libraryCode string, replace bool) (string, error)
+	FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error)
+
+	FunctionFlush(ctx context.Context) (string, error)
+
+	FunctionFlushSync(ctx context.Context) (string, error)
+
+	FunctionFlushAsync(ctx context.Context) (string, error)
+
+	FunctionList(ctx context.Context, query options.FunctionListQuery) ([]LibraryInfo, error)
+
+	FunctionStats(ctx context.Context) (map[string]FunctionStatsResult, error)
+
+	FunctionDelete(ctx context.Context, libName string) (string, error)
+
+	FunctionKill(ctx context.Context) (string, error)
+
+	FunctionDump(ctx context.Context) (string, error)
+
+	FunctionRestore(ctx context.Context, payload string) (string, error)
+
+	FunctionRestoreWithPolicy(ctx context.Context, payload string, policy options.FunctionRestorePolicy) (string, error)
+
+	FCall(ctx context.Context, function string) (any, error)
+
+	FCallReadOnly(ctx context.Context, function string) (any, error)
+
+	FCallWithKeysAndArgs(ctx context.Context, function string, keys []string, args []string) (any, error)
+
+	FCallReadOnlyWithKeysAndArgs(ctx context.Context, function string, keys []string, args []string) (any, error)
+
+	InvokeScript(ctx context.Context, script *Script) (any, error)
 
-	FunctionFlush() (string, error)
+	InvokeScriptWithOptions(ctx context.Context, script *Script, scriptOptions options.ScriptOptions) (any, error)
 
-	FunctionFlushSync() (string, error)
+	ScriptExists(ctx context.Context, sha1s []string) ([]bool, error)
 
-	FunctionFlushAsync() (string, error)
+	ScriptFlush(ctx context.Context) (string, error)
 
-	FCall(function string) (any, error)
+	ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error)
 
-	FCallReadOnly(function string) (any, error)
+	ScriptKill(ctx context.Context) (string, error)
 
-	FCallWithKeysAndArgs(function string, keys []string, args []string) (any, error)
+	ScriptShow(ctx context.Context, sha1 string) (string, error)
 
-	FCallReadOnlyWithKeysAndArgs(function string, keys []string, args []string) (any, error)
+	ScriptLoad(ctx context.Context, script string) (string, error)
 }
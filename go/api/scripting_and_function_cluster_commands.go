@@ -3,6 +3,8 @@
 package api
 
 import (
+	"context"
+
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
@@ -13,23 +15,74 @@ import (
 //
 // [valkey.io]: https://valkey.io/commands/?group=scripting
 type ScriptingAndFunctionClusterCommands interface {
-	FunctionLoadWithRoute(libraryCode string, replace bool, route options.RouteOption) (string, error)
+	FunctionLoadWithRoute(ctx context.Context, libraryCode string, replace bool, route options.RouteOption) (string, error)
+
+	FunctionFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error)
+
+	FunctionFlushSyncWithRoute(ctx context.Context, route options.RouteOption) (string, error)
+
+	FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error)
+
+	FunctionListWithRoute(
+		ctx context.Context,
+		query options.FunctionListQuery,
+		route options.RouteOption,
+	) (ClusterValue[[]LibraryInfo], error)
+
+	FunctionStatsWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[FunctionStatsResult], error)
+
+	FunctionDeleteWithRoute(ctx context.Context, libName string, route options.RouteOption) (string, error)
+
+	FunctionKillWithRoute(ctx context.Context, route options.RouteOption) (string, error)
+
+	FunctionDumpWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[string], error)
+
+	FunctionRestoreWithRoute(ctx context.Context, payload string, route options.RouteOption) (string, error)
+
+	FunctionRestoreWithPolicyWithRoute(
+		ctx context.Context,
+		payload string,
+		policy options.FunctionRestorePolicy,
+		route options.RouteOption,
+	) (string, error)
+
+	FCallWithRoute(ctx context.Context, function string, route options.RouteOption) (ClusterValue[any], error)
+
+	FCallReadOnlyWithRoute(ctx context.Context, function string, route options.RouteOption) (ClusterValue[any], error)
+
+	FCallWithArgs(ctx context.Context, function string, args []string) (ClusterValue[any], error)
+
+	FCallReadOnlyWithArgs(ctx context.Context, function string, args []string) (ClusterValue[any], error)
 
-	FunctionFlushWithRoute(route options.RouteOption) (string, error)
+	FCallWithArgsWithRoute(
+		ctx context.Context,
+		function string,
+		args []string,
+		route options.RouteOption,
+	) (ClusterValue[any], error)
 
-	FunctionFlushSyncWithRoute(route options.RouteOption) (string, error)
+	FCallReadOnlyWithArgsWithRoute(
+		ctx context.Context,
+		function string,
+		args []string,
+		route options.RouteOption,
+	) (ClusterValue[any], error)
 
-	FunctionFlushAsyncWithRoute(route options.RouteOption) (string, error)
+	InvokeScriptWithRoute(ctx context.Context, script *Script, route options.RouteOption) (ClusterValue[any], error)
 
-	FCallWithRoute(function string, route options.RouteOption) (ClusterValue[any], error)
+	InvokeScriptWithClusterOptions(
+		ctx context.Context,
+		script *Script,
+		clusterScriptOptions options.ClusterScriptOptions,
+	) (ClusterValue[any], error)
 
-	FCallReadOnlyWithRoute(function string, route options.RouteOption) (ClusterValue[any], error)
+	ScriptExistsWithRoute(ctx context.Context, sha1s []string, route options.RouteOption) ([]bool, error)
 
-	FCallWithArgs(function string, args []string) (ClusterValue[any], error)
+	ScriptFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error)
 
-	FCallReadOnlyWithArgs(function string, args []string) (ClusterValue[any], error)
+	ScriptFlushWithOptions(ctx context.Context, flushOptions options.FlushClusterOptions) (string, error)
 
-	FCallWithArgsWithRoute(function string, args []string, route options.RouteOption) (ClusterValue[any], error)
+	ScriptKillWithRoute(ctx context.Context, route options.RouteOption) (string, error)
 
-	FCallReadOnlyWithArgsWithRoute(function string, args []string, route options.RouteOption) (ClusterValue[any], error)
+	ScriptShowWithRoute(ctx context.Context, sha1 string, route options.RouteOption) (ClusterValue[string], error)
 }
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -20,7 +21,7 @@ var (
 func ExampleGlideClient_FunctionLoad() {
 	client := getExampleGlideClient()
 
-	result, err := client.FunctionLoad(libraryCode, true)
+	result, err := client.FunctionLoad(context.Background(), libraryCode, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -34,7 +35,7 @@ func ExampleGlideClient_FunctionLoad() {
 func ExampleGlideClusterClient_FunctionLoad() {
 	client := getExampleGlideClusterClient()
 
-	result, err := client.FunctionLoad(libraryCode, true)
+	result, err := client.FunctionLoad(context.Background(), libraryCode, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -52,7 +53,7 @@ func ExampleGlideClusterClient_FunctionLoadWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	result, err := client.FunctionLoadWithRoute(libraryCode, true, opts)
+	result, err := client.FunctionLoadWithRoute(context.Background(), libraryCode, true, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -67,7 +68,7 @@ func ExampleGlideClusterClient_FunctionLoadWithRoute() {
 func ExampleGlideClient_FunctionFlush() {
 	client := getExampleGlideClient()
 
-	result, err := client.FunctionFlush()
+	result, err := client.FunctionFlush(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -81,7 +82,7 @@ func ExampleGlideClient_FunctionFlush() {
 func ExampleGlideClusterClient_FunctionFlush() {
 	client := getExampleGlideClusterClient()
 
-	result, err := client.FunctionFlush()
+	result, err := client.FunctionFlush(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -99,7 +100,7 @@ func ExampleGlideClusterClient_FunctionFlushWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	result, err := client.FunctionFlushWithRoute(opts)
+	result, err := client.FunctionFlushWithRoute(context.Background(), opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -113,7 +114,7 @@ func ExampleGlideClusterClient_FunctionFlushWithRoute() {
 func ExampleGlideClient_FunctionFlushSync() {
 	client := getExampleGlideClient()
 
-	result, err := client.FunctionFlushSync()
+	result, err := client.FunctionFlushSync(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -127,7 +128,7 @@ func ExampleGlideClient_FunctionFlushSync() {
 func ExampleGlideClusterClient_FunctionFlushSync() {
 	client := getExampleGlideClient()
 
-	result, err := client.FunctionFlushSync()
+	result, err := client.FunctionFlushSync(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -145,7 +146,7 @@ func ExampleGlideClusterClient_FunctionFlushSyncWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	result, err := client.FunctionFlushSyncWithRoute(opts)
+	result, err := client.FunctionFlushSyncWithRoute(context.Background(), opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -159,7 +160,7 @@ func ExampleGlideClusterClient_FunctionFlushSyncWithRoute() {
 func ExampleGlideClient_FunctionFlushAsync() {
 	client := getExampleGlideClient()
 
-	result, err := client.FunctionFlushAsync()
+	result, err := client.FunctionFlushAsync(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -173,7 +174,7 @@ func ExampleGlideClient_FunctionFlushAsync() {
 func ExampleGlideClusterClient_FunctionFlushAsync() {
 	client := getExampleGlideClusterClient()
 
-	result, err := client.FunctionFlushAsync()
+	result, err := client.FunctionFlushAsync(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -188,7 +189,7 @@ func ExampleGlideClusterClient_FunctionFlushAsyncWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	result, err := client.FunctionFlushAsyncWithRoute(opts)
+	result, err := client.FunctionFlushAsyncWithRoute(context.Background(), opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -204,13 +205,13 @@ func ExampleGlideClient_FCall() {
 	client := getExampleGlideClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCode, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCode, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	fcallResult, err := client.FCall("myfunc")
+	fcallResult, err := client.FCall(context.Background(), "myfunc")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -225,13 +226,13 @@ func ExampleGlideClusterClient_FCall() {
 	client := getExampleGlideClusterClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCode, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCode, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	fcallResult, err := client.FCall("myfunc")
+	fcallResult, err := client.FCall(context.Background(), "myfunc")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -250,13 +251,13 @@ func ExampleGlideClusterClient_FCallWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	_, err := client.FunctionLoadWithRoute(libraryCode, true, opts)
+	_, err := client.FunctionLoadWithRoute(context.Background(), libraryCode, true, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	result, err := client.FCallWithRoute("myfunc", opts)
+	result, err := client.FCallWithRoute(context.Background(), "myfunc", opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -274,7 +275,7 @@ func ExampleGlideClient_FCallWithKeysAndArgs() {
 	client := getExampleGlideClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCodeWithArgs, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCodeWithArgs, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -282,7 +283,7 @@ func ExampleGlideClient_FCallWithKeysAndArgs() {
 	// Call function
 	key1 := "{testKey}-" + uuid.New().String()
 	key2 := "{testKey}-" + uuid.New().String()
-	result, err := client.FCallWithKeysAndArgs("myfunc", []string{key1, key2}, []string{"3", "4"})
+	result, err := client.FCallWithKeysAndArgs(context.Background(), "myfunc", []string{key1, key2}, []string{"3", "4"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -297,7 +298,7 @@ func ExampleGlideClusterClient_FCallWithKeysAndArgs() {
 	client := getExampleGlideClusterClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCodeWithArgs, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCodeWithArgs, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -305,7 +306,7 @@ func ExampleGlideClusterClient_FCallWithKeysAndArgs() {
 	// Call function
 	key1 := "{testKey}-" + uuid.New().String()
 	key2 := "{testKey}-" + uuid.New().String()
-	result, err := client.FCallWithKeysAndArgs("myfunc", []string{key1, key2}, []string{"3", "4"})
+	result, err := client.FCallWithKeysAndArgs(context.Background(), "myfunc", []string{key1, key2}, []string{"3", "4"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -320,13 +321,13 @@ func ExampleGlideClusterClient_FCallWithArgs() {
 	client := getExampleGlideClusterClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCodeWithArgs, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCodeWithArgs, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	result, err := client.FCallWithArgs("myfunc", []string{"1", "2"})
+	result, err := client.FCallWithArgs(context.Background(), "myfunc", []string{"1", "2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -345,13 +346,13 @@ func ExampleGlideClusterClient_FCallWithArgsWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	_, err := client.FunctionLoadWithRoute(libraryCodeWithArgs, true, opts)
+	_, err := client.FunctionLoadWithRoute(context.Background(), libraryCodeWithArgs, true, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	result, err := client.FCallWithArgsWithRoute("myfunc", []string{"1", "2"}, opts)
+	result, err := client.FCallWithArgsWithRoute(context.Background(), "myfunc", []string{"1", "2"}, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -369,13 +370,13 @@ func ExampleGlideClient_FCallReadOnly() {
 	client := getExampleGlideClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCode, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCode, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	fcallResult, err := client.FCallReadOnly("myfunc")
+	fcallResult, err := client.FCallReadOnly(context.Background(), "myfunc")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -390,13 +391,13 @@ func ExampleGlideClusterClient_FCallReadOnly() {
 	client := getExampleGlideClusterClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCode, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCode, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	fcallResult, err := client.FCallReadOnly("myfunc")
+	fcallResult, err := client.FCallReadOnly(context.Background(), "myfunc")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -415,13 +416,13 @@ func ExampleGlideClusterClient_FCallReadOnlyWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	_, err := client.FunctionLoadWithRoute(libraryCode, true, opts)
+	_, err := client.FunctionLoadWithRoute(context.Background(), libraryCode, true, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	result, err := client.FCallReadOnlyWithRoute("myfunc", opts)
+	result, err := client.FCallReadOnlyWithRoute(context.Background(), "myfunc", opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -439,7 +440,7 @@ func ExampleGlideClient_FCallReadOnlyWithKeysAndArgs() {
 	client := getExampleGlideClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCodeWithArgs, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCodeWithArgs, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -447,7 +448,12 @@ func ExampleGlideClient_FCallReadOnlyWithKeysAndArgs() {
 	// Call function
 	key1 := "{testKey}-" + uuid.New().String()
 	key2 := "{testKey}-" + uuid.New().String()
-	result, err := client.FCallReadOnlyWithKeysAndArgs("myfunc", []string{key1, key2}, []string{"3", "4"})
+	result, err := client.FCallReadOnlyWithKeysAndArgs(
+		context.Background(),
+		"myfunc",
+		[]string{key1, key2},
+		[]string{"3", "4"},
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -462,7 +468,7 @@ func ExampleGlideClusterClient_FCallReadOnlyWithKeysAndArgs() {
 	client := getExampleGlideClusterClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCodeWithArgs, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCodeWithArgs, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -470,7 +476,12 @@ func ExampleGlideClusterClient_FCallReadOnlyWithKeysAndArgs() {
 	// Call function
 	key1 := "{testKey}-" + uuid.New().String()
 	key2 := "{testKey}-" + uuid.New().String()
-	result, err := client.FCallReadOnlyWithKeysAndArgs("myfunc", []string{key1, key2}, []string{"3", "4"})
+	result, err := client.FCallReadOnlyWithKeysAndArgs(
+		context.Background(),
+		"myfunc",
+		[]string{key1, key2},
+		[]string{"3", "4"},
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -485,13 +496,13 @@ func ExampleGlideClusterClient_FCallReadOnlyWithArgs() {
 	client := getExampleGlideClusterClient()
 
 	// Load function
-	_, err := client.FunctionLoad(libraryCodeWithArgs, true)
+	_, err := client.FunctionLoad(context.Background(), libraryCodeWithArgs, true)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	result, err := client.FCallReadOnlyWithArgs("myfunc", []string{"1", "2"})
+	result, err := client.FCallReadOnlyWithArgs(context.Background(), "myfunc", []string{"1", "2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -510,13 +521,13 @@ func ExampleGlideClusterClient_FCallReadOnlyWithArgsWithRoute() {
 	opts := options.RouteOption{
 		Route: route,
 	}
-	_, err := client.FunctionLoadWithRoute(libraryCodeWithArgs, true, opts)
+	_, err := client.FunctionLoadWithRoute(context.Background(), libraryCodeWithArgs, true, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
 
 	// Call function
-	result, err := client.FCallReadOnlyWithArgsWithRoute("myfunc", []string{"1", "2"}, opts)
+	result, err := client.FCallReadOnlyWithArgsWithRoute(context.Background(), "myfunc", []string{"1", "2"}, opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -529,3 +540,501 @@ func ExampleGlideClusterClient_FCallReadOnlyWithArgsWithRoute() {
 	// Output:
 	// 1
 }
+
+func ExampleGlideClient_InvokeScript() {
+	client := getExampleGlideClient()
+
+	script := NewScript("return 'Hello'")
+	defer script.Close()
+	result, err := client.InvokeScript(context.Background(), script)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// Hello
+}
+
+func ExampleGlideClient_InvokeScriptWithOptions() {
+	client := getExampleGlideClient()
+
+	script := NewScript("return {KEYS[1], ARGV[1]}")
+	defer script.Close()
+	result, err := client.InvokeScriptWithOptions(
+		context.Background(),
+		script,
+		options.ScriptOptions{Keys: []string{"key"}, Args: []string{"arg"}},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// [key arg]
+}
+
+func ExampleGlideClusterClient_InvokeScript() {
+	client := getExampleGlideClusterClient()
+
+	script := NewScript("return 'Hello'")
+	defer script.Close()
+	result, err := client.InvokeScript(context.Background(), script)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// Hello
+}
+
+func ExampleGlideClusterClient_InvokeScriptWithOptions() {
+	client := getExampleGlideClusterClient()
+
+	script := NewScript("return {KEYS[1], ARGV[1]}")
+	defer script.Close()
+	result, err := client.InvokeScriptWithOptions(
+		context.Background(),
+		script,
+		options.ScriptOptions{Keys: []string{"key"}, Args: []string{"arg"}},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// [key arg]
+}
+
+func ExampleGlideClusterClient_InvokeScriptWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	script := NewScript("return 'Hello'")
+	defer script.Close()
+	result, err := client.InvokeScriptWithRoute(
+		context.Background(),
+		script,
+		options.RouteOption{Route: config.RandomRoute},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result.SingleValue())
+
+	// Output:
+	// Hello
+}
+
+func ExampleGlideClusterClient_InvokeScriptWithClusterOptions() {
+	client := getExampleGlideClusterClient()
+
+	script := NewScript("return ARGV[1]")
+	defer script.Close()
+	opts := options.ClusterScriptOptions{
+		Args:        []string{"Hello"},
+		RouteOption: &options.RouteOption{Route: config.AllPrimaries},
+	}
+	result, err := client.InvokeScriptWithClusterOptions(context.Background(), script, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	for _, value := range result.MultiValue() {
+		fmt.Println(value)
+		break
+	}
+
+	// Output:
+	// Hello
+}
+
+func ExampleGlideClient_ScriptExists() {
+	client := getExampleGlideClient()
+
+	sha1, _ := client.ScriptLoad(context.Background(), "return 'Hello'")
+	result, err := client.ScriptExists(context.Background(), []string{sha1})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// [true]
+}
+
+func ExampleGlideClient_ScriptFlush() {
+	client := getExampleGlideClient()
+
+	result, err := client.ScriptFlush(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClient_ScriptFlushWithMode() {
+	client := getExampleGlideClient()
+
+	result, err := client.ScriptFlushWithMode(context.Background(), options.ASYNC)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClient_ScriptKill() {
+	client := getExampleGlideClient()
+
+	// fails when no script is running
+	_, err := client.ScriptKill(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+}
+
+func ExampleGlideClient_ScriptShow() {
+	client := getExampleGlideClient()
+
+	sha1, _ := client.ScriptLoad(context.Background(), "return 'Hello'")
+	result, err := client.ScriptShow(context.Background(), sha1)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// return 'Hello'
+}
+
+func ExampleGlideClient_ScriptLoad() {
+	client := getExampleGlideClient()
+
+	result, err := client.ScriptLoad(context.Background(), "return 'Hello'")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// af6b5d19da06755d789858a67760f34bbd2e9e52
+}
+
+func ExampleGlideClusterClient_ScriptExistsWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	sha1, _ := client.ScriptLoad(context.Background(), "return 'Hello'")
+	result, err := client.ScriptExistsWithRoute(
+		context.Background(),
+		[]string{sha1},
+		options.RouteOption{Route: config.AllPrimaries},
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// [true]
+}
+
+func ExampleGlideClusterClient_ScriptFlushWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	result, err := client.ScriptFlushWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClusterClient_ScriptFlushWithOptions() {
+	client := getExampleGlideClusterClient()
+
+	mode := options.ASYNC
+	result, err := client.ScriptFlushWithOptions(context.Background(), options.FlushClusterOptions{FlushMode: &mode})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClusterClient_ScriptKill() {
+	client := getExampleGlideClusterClient()
+
+	// fails when no script is running
+	_, err := client.ScriptKill(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+}
+
+func ExampleGlideClusterClient_ScriptKillWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	// fails when no script is running
+	_, err := client.ScriptKillWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+}
+
+func ExampleGlideClusterClient_ScriptShowWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	sha1, _ := client.ScriptLoad(context.Background(), "return 'Hello'")
+	result, err := client.ScriptShowWithRoute(context.Background(), sha1, options.RouteOption{Route: config.RandomRoute})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result.SingleValue())
+
+	// Output:
+	// return 'Hello'
+}
+
+func ExampleGlideClient_FunctionList() {
+	client := getExampleGlideClient()
+
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	query := options.NewFunctionListQuery().SetLibraryName("mylib")
+	result, err := client.FunctionList(context.Background(), *query)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// [{mylib LUA [{myfunc  map[no-writes:{}]}] }]
+}
+
+func ExampleGlideClient_FunctionStats() {
+	client := getExampleGlideClient()
+
+	client.FunctionFlushSync(context.Background())
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	result, err := client.FunctionStats(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	for _, nodeStats := range result {
+		fmt.Println(nodeStats.Engines["LUA"])
+		break
+	}
+
+	// Output:
+	// {1 1}
+}
+
+func ExampleGlideClient_FunctionDelete() {
+	client := getExampleGlideClient()
+
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	result, err := client.FunctionDelete(context.Background(), "mylib")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClient_FunctionKill() {
+	client := getExampleGlideClient()
+
+	// fails when no function is running
+	_, err := client.FunctionKill(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+}
+
+func ExampleGlideClient_FunctionDump() {
+	client := getExampleGlideClient()
+
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	payload, err := client.FunctionDump(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(len(payload) > 0)
+
+	// Output:
+	// true
+}
+
+func ExampleGlideClient_FunctionRestore() {
+	client := getExampleGlideClient()
+
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	payload, _ := client.FunctionDump(context.Background())
+	client.FunctionFlushSync(context.Background())
+	result, err := client.FunctionRestore(context.Background(), payload)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClient_FunctionRestoreWithPolicy() {
+	client := getExampleGlideClient()
+
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	payload, _ := client.FunctionDump(context.Background())
+	result, err := client.FunctionRestoreWithPolicy(context.Background(), payload, options.ReplacePolicy)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClusterClient_FunctionListWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	client.FunctionLoad(context.Background(), libraryCode, true)
+	query := options.NewFunctionListQuery().SetLibraryName("mylib")
+	result, err := client.FunctionListWithRoute(context.Background(), *query, options.RouteOption{Route: config.RandomRoute})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result.SingleValue()[0].Name)
+
+	// Output:
+	// mylib
+}
+
+func ExampleGlideClusterClient_FunctionStatsWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	result, err := client.FunctionStatsWithRoute(context.Background(), options.RouteOption{Route: config.RandomRoute})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result.SingleValue().RunningScript.IsNil())
+
+	// Output:
+	// true
+}
+
+func ExampleGlideClusterClient_FunctionDeleteWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	route := options.RouteOption{Route: config.AllPrimaries}
+	client.FunctionLoadWithRoute(context.Background(), libraryCode, true, route)
+	result, err := client.FunctionDeleteWithRoute(context.Background(), "mylib", route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClusterClient_FunctionKillWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	// fails when no function is running
+	_, err := client.FunctionKillWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+}
+
+func ExampleGlideClusterClient_FunctionDumpWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	result, err := client.FunctionDumpWithRoute(context.Background(), options.RouteOption{Route: config.RandomRoute})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(len(result.SingleValue()) > 0)
+
+	// Output:
+	// true
+}
+
+func ExampleGlideClusterClient_FunctionRestoreWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	route := options.RouteOption{Route: config.AllPrimaries}
+	client.FunctionLoadWithRoute(context.Background(), libraryCode, true, route)
+	payload, _ := client.FunctionDumpWithRoute(context.Background(), options.RouteOption{Route: config.RandomRoute})
+	client.FunctionFlushSyncWithRoute(context.Background(), route)
+	result, err := client.FunctionRestoreWithRoute(context.Background(), payload.SingleValue(), route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
+
+func ExampleGlideClusterClient_FunctionRestoreWithPolicyWithRoute() {
+	client := getExampleGlideClusterClient()
+
+	route := options.RouteOption{Route: config.AllPrimaries}
+	client.FunctionLoadWithRoute(context.Background(), libraryCode, true, route)
+	payload, _ := client.FunctionDumpWithRoute(context.Background(), options.RouteOption{Route: config.RandomRoute})
+	result, err := client.FunctionRestoreWithPolicyWithRoute(
+		context.Background(),
+		payload.SingleValue(),
+		options.FlushPolicy,
+		route,
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	fmt.Println(result)
+
+	// Output:
+	// OK
+}
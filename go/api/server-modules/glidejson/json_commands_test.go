@@ -2,6 +2,7 @@
 package glidejson
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/valkey-io/valkey-glide/go/api"
@@ -11,7 +12,7 @@ import (
 
 func Example_jsonSet() {
 	var client *api.GlideClient = getExampleGlideClient()
-	result, err := Set(client, "my_key", "$", "{\"a\":1.0,\"b\":2}")
+	result, err := Set(context.Background(), client, "my_key", "$", "{\"a\":1.0,\"b\":2}")
 	if err != nil {
 		fmt.Println("JSON.SET example failed with an error: ", err)
 	}
@@ -22,7 +23,7 @@ func Example_jsonSet() {
 
 func ExampleGlideClusterClient_jsonSet() {
 	var client *api.GlideClusterClient = getExampleGlideClusterClient()
-	result, err := Set(client, "my_key", "$", "{\"a\":1.0,\"b\":2}")
+	result, err := Set(context.Background(), client, "my_key", "$", "{\"a\":1.0,\"b\":2}")
 	if err != nil {
 		fmt.Println("JSON.SET example failed with an error: ", err)
 	}
@@ -34,6 +35,7 @@ func ExampleGlideClusterClient_jsonSet() {
 func Example_jsonSetWithOptions() {
 	var client *api.GlideClient = getExampleGlideClient()
 	jsonSetResult, err := SetWithOptions(
+		context.Background(),
 		client,
 		"key",
 		"$",
@@ -51,6 +53,7 @@ func Example_jsonSetWithOptions() {
 func ExampleGlideClusterClient_jsonSetWithOptions() {
 	var client *api.GlideClusterClient = getExampleGlideClusterClient()
 	jsonSetResult, err := SetWithOptions(
+		context.Background(),
 		client,
 		"key",
 		"$",
@@ -68,9 +71,9 @@ func ExampleGlideClusterClient_jsonSetWithOptions() {
 func Example_jsonGet() {
 	var client *api.GlideClient = getExampleGlideClient()
 	jsonValue := "{\"a\":1.0,\"b\":2}"
-	_, err := Set(client, "key", "$", jsonValue)
+	_, err := Set(context.Background(), client, "key", "$", jsonValue)
 
-	jsonGetResult, err := Get(client, "key")
+	jsonGetResult, err := Get(context.Background(), client, "key")
 	if err != nil {
 		fmt.Println("JSON.SET example failed with an error: ", err)
 	}
@@ -82,9 +85,9 @@ func Example_jsonGet() {
 func ExampleGlideClusterClient_jsonGet() {
 	var client *api.GlideClusterClient = getExampleGlideClusterClient()
 	jsonValue := "{\"a\":1.0,\"b\":2}"
-	_, err := Set(client, "key", "$", jsonValue)
+	_, err := Set(context.Background(), client, "key", "$", jsonValue)
 
-	jsonGetResult, err := Get(client, "key")
+	jsonGetResult, err := Get(context.Background(), client, "key")
 	if err != nil {
 		fmt.Println("JSON.SET example failed with an error: ", err)
 	}
@@ -96,8 +99,9 @@ func ExampleGlideClusterClient_jsonGet() {
 func Example_jsonGetWithOptions() {
 	var client *api.GlideClient = getExampleGlideClient()
 	jsonValue := "{\"a\": {\"c\": 1, \"d\": 4}, \"b\": {\"c\": 2}, \"c\": true}"
-	_, err := Set(client, "key", "$", jsonValue)
+	_, err := Set(context.Background(), client, "key", "$", jsonValue)
 	jsonGetResult, err := GetWithOptions(
+		context.Background(),
 		client, "key", *jsonOptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$..c"}))
 	if err != nil {
 		fmt.Println("JSON.SET example failed with an error: ", err)
@@ -110,8 +114,9 @@ func Example_jsonGetWithOptions() {
 func ExampleGlideClusterClient_jsonGetWithOptions() {
 	var client *api.GlideClusterClient = getExampleGlideClusterClient()
 	jsonValue := "{\"a\": {\"c\": 1, \"d\": 4}, \"b\": {\"c\": 2}, \"c\": true}"
-	_, err := Set(client, "key", "$", jsonValue)
+	_, err := Set(context.Background(), client, "key", "$", jsonValue)
 	jsonGetResult, err := GetWithOptions(
+		context.Background(),
 		client, "key", *jsonOptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$..c"}))
 	if err != nil {
 		fmt.Println("JSON.SET example failed with an error: ", err)
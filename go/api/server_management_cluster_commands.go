@@ -2,7 +2,11 @@
 
 package api
 
-import "github.com/valkey-io/valkey-glide/go/api/options"
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
 
 // ServerManagementCommands supports commands for the "Server Management" group for a cluster client.
 //
@@ -10,43 +14,47 @@ import "github.com/valkey-io/valkey-glide/go/api/options"
 //
 // [valkey.io]: https://valkey.io/commands/#server
 type ServerManagementClusterCommands interface {
-	Info() (map[string]string, error)
+	Info(ctx context.Context) (map[string]string, error)
 
-	InfoWithOptions(options options.ClusterInfoOptions) (ClusterValue[string], error)
+	InfoWithOptions(ctx context.Context, options options.ClusterInfoOptions) (ClusterValue[string], error)
 
-	TimeWithOptions(routeOption options.RouteOption) (ClusterValue[[]string], error)
+	TimeWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[[]string], error)
 
-	DBSizeWithOptions(routeOption options.RouteOption) (int64, error)
+	DBSizeWithOptions(ctx context.Context, routeOption options.RouteOption) (int64, error)
 
-	FlushAll() (string, error)
+	FlushAll(ctx context.Context) (string, error)
 
-	FlushAllWithOptions(options options.FlushClusterOptions) (string, error)
+	FlushAllWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error)
 
-	FlushDB() (string, error)
+	FlushDB(ctx context.Context) (string, error)
 
-	FlushDBWithOptions(options options.FlushClusterOptions) (string, error)
+	FlushDBWithOptions(ctx context.Context, options options.FlushClusterOptions) (string, error)
 
-	Lolwut() (string, error)
+	Lolwut(ctx context.Context) (string, error)
 
-	LolwutWithOptions(lolwutOptions options.ClusterLolwutOptions) (ClusterValue[string], error)
+	LolwutWithOptions(ctx context.Context, lolwutOptions options.ClusterLolwutOptions) (ClusterValue[string], error)
 
-	LastSave() (ClusterValue[int64], error)
+	LastSave(ctx context.Context) (ClusterValue[int64], error)
 
-	LastSaveWithOptions(routeOption options.RouteOption) (ClusterValue[int64], error)
+	LastSaveWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[int64], error)
 
-	ConfigResetStat() (string, error)
+	ConfigResetStat(ctx context.Context) (string, error)
 
-	ConfigResetStatWithOptions(routeOption options.RouteOption) (string, error)
+	ConfigResetStatWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
 
-	ConfigSet(parameters map[string]string) (string, error)
+	ConfigSet(ctx context.Context, parameters map[string]string) (string, error)
 
-	ConfigSetWithOptions(parameters map[string]string, routeOption options.RouteOption) (string, error)
+	ConfigSetWithOptions(ctx context.Context, parameters map[string]string, routeOption options.RouteOption) (string, error)
 
-	ConfigGet(parameters []string) (ClusterValue[interface{}], error)
+	ConfigGet(ctx context.Context, parameters []string) (ClusterValue[interface{}], error)
 
-	ConfigGetWithOptions(parameters []string, routeOption options.RouteOption) (ClusterValue[interface{}], error)
+	ConfigGetWithOptions(
+		ctx context.Context,
+		parameters []string,
+		routeOption options.RouteOption,
+	) (ClusterValue[interface{}], error)
 
-	ConfigRewrite() (string, error)
+	ConfigRewrite(ctx context.Context) (string, error)
 
-	ConfigRewriteWithOptions(routeOption options.RouteOption) (string, error)
+	ConfigRewriteWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
 }
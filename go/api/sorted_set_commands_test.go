@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -12,7 +13,7 @@ import (
 func ExampleGlideClient_ZAdd() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -24,7 +25,7 @@ func ExampleGlideClient_ZAdd() {
 func ExampleGlideClusterClient_ZAdd() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -38,6 +39,7 @@ func ExampleGlideClient_ZAddWithOptions() {
 
 	opts, err := options.NewZAddOptions().SetChanged(true)
 	result, err := client.ZAddWithOptions(
+		context.Background(),
 		"key1",
 		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0},
 		*opts,
@@ -55,6 +57,7 @@ func ExampleGlideClusterClient_ZAddWithOptions() {
 
 	opts, err := options.NewZAddOptions().SetChanged(true)
 	result, err := client.ZAddWithOptions(
+		context.Background(),
 		"key1",
 		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0},
 		*opts,
@@ -70,7 +73,7 @@ func ExampleGlideClusterClient_ZAddWithOptions() {
 func ExampleGlideClient_ZAddIncr() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAddIncr("key1", "one", 1.0)
+	result, err := client.ZAddIncr(context.Background(), "key1", "one", 1.0)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -83,7 +86,7 @@ func ExampleGlideClient_ZAddIncr() {
 func ExampleGlideClusterClient_ZAddIncr() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAddIncr("key1", "one", 1.0)
+	result, err := client.ZAddIncr(context.Background(), "key1", "one", 1.0)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -97,7 +100,7 @@ func ExampleGlideClient_ZAddIncrWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
 	opts, err := options.NewZAddOptions().SetChanged(true) // should return an error
-	result, err := client.ZAddIncrWithOptions("key1", "one", 1.0, *opts)
+	result, err := client.ZAddIncrWithOptions(context.Background(), "key1", "one", 1.0, *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -112,7 +115,7 @@ func ExampleGlideClusterClient_ZAddIncrWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
 	opts, err := options.NewZAddOptions().SetChanged(true) // should return an error
-	result, err := client.ZAddIncrWithOptions("key1", "one", 1.0, *opts)
+	result, err := client.ZAddIncrWithOptions(context.Background(), "key1", "one", 1.0, *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -126,8 +129,8 @@ func ExampleGlideClusterClient_ZAddIncrWithOptions() {
 func ExampleGlideClient_ZIncrBy() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZIncrBy("key1", 3.0, "two")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZIncrBy(context.Background(), "key1", 3.0, "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -142,8 +145,8 @@ func ExampleGlideClient_ZIncrBy() {
 func ExampleGlideClusterClient_ZIncrBy() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZIncrBy("key1", 3.0, "two")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZIncrBy(context.Background(), "key1", 3.0, "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -158,8 +161,8 @@ func ExampleGlideClusterClient_ZIncrBy() {
 func ExampleGlideClient_ZPopMin() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZPopMin("key1")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZPopMin(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -174,8 +177,8 @@ func ExampleGlideClient_ZPopMin() {
 func ExampleGlideClusterClient_ZPopMin() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZPopMin("key1")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZPopMin(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -190,9 +193,9 @@ func ExampleGlideClusterClient_ZPopMin() {
 func ExampleGlideClient_ZPopMinWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	opts := options.NewZPopOptions().SetCount(2)
-	result1, err := client.ZPopMinWithOptions("key1", *opts)
+	result1, err := client.ZPopMinWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -207,9 +210,9 @@ func ExampleGlideClient_ZPopMinWithOptions() {
 func ExampleGlideClusterClient_ZPopMinWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	opts := options.NewZPopOptions().SetCount(2)
-	result1, err := client.ZPopMinWithOptions("key1", *opts)
+	result1, err := client.ZPopMinWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -224,8 +227,8 @@ func ExampleGlideClusterClient_ZPopMinWithOptions() {
 func ExampleGlideClient_ZPopMax() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZPopMax("key1")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZPopMax(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -240,8 +243,8 @@ func ExampleGlideClient_ZPopMax() {
 func ExampleGlideClusterClient_ZPopMax() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZPopMax("key1")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZPopMax(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -256,9 +259,9 @@ func ExampleGlideClusterClient_ZPopMax() {
 func ExampleGlideClient_ZPopMaxWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	opts := options.NewZPopOptions().SetCount(2)
-	result1, err := client.ZPopMaxWithOptions("key1", *opts)
+	result1, err := client.ZPopMaxWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -273,9 +276,9 @@ func ExampleGlideClient_ZPopMaxWithOptions() {
 func ExampleGlideClusterClient_ZPopMaxWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	opts := options.NewZPopOptions().SetCount(2)
-	result1, err := client.ZPopMaxWithOptions("key1", *opts)
+	result1, err := client.ZPopMaxWithOptions(context.Background(), "key1", *opts)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -290,8 +293,8 @@ func ExampleGlideClusterClient_ZPopMaxWithOptions() {
 func ExampleGlideClient_ZRem() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRem("key1", []string{"one", "two", "nonMember"})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRem(context.Background(), "key1", []string{"one", "two", "nonMember"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -306,8 +309,8 @@ func ExampleGlideClient_ZRem() {
 func ExampleGlideClusterClient_ZRem() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRem("key1", []string{"one", "two", "nonMember"})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRem(context.Background(), "key1", []string{"one", "two", "nonMember"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -322,8 +325,8 @@ func ExampleGlideClusterClient_ZRem() {
 func ExampleGlideClient_ZCard() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZCard("key1")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZCard(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -338,8 +341,8 @@ func ExampleGlideClient_ZCard() {
 func ExampleGlideClusterClient_ZCard() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZCard("key1")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZCard(context.Background(), "key1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -354,9 +357,9 @@ func ExampleGlideClusterClient_ZCard() {
 func ExampleGlideClient_BZPopMin() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	zaddResult1, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 1.5})
-	zaddResult2, err := client.ZAdd("key2", map[string]float64{"c": 2.0})
-	result1, err := client.BZPopMin([]string{"key1", "key2"}, 0.5)
+	zaddResult1, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 1.5})
+	zaddResult2, err := client.ZAdd(context.Background(), "key2", map[string]float64{"c": 2.0})
+	result1, err := client.BZPopMin(context.Background(), []string{"key1", "key2"}, 0.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -373,9 +376,9 @@ func ExampleGlideClient_BZPopMin() {
 func ExampleGlideClusterClient_BZPopMin() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	zaddResult1, err := client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 1.5})
-	zaddResult2, err := client.ZAdd("{key}2", map[string]float64{"c": 2.0})
-	result1, err := client.BZPopMin([]string{"{key}1", "{key}2"}, 0.5)
+	zaddResult1, err := client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 1.5})
+	zaddResult2, err := client.ZAdd(context.Background(), "{key}2", map[string]float64{"c": 2.0})
+	result1, err := client.BZPopMin(context.Background(), []string{"{key}1", "{key}2"}, 0.5)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -392,14 +395,14 @@ func ExampleGlideClusterClient_BZPopMin() {
 func ExampleGlideClient_ZRange() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRange("key1", options.NewRangeByIndexQuery(0, -1)) // Ascending order
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRange(context.Background(), "key1", options.NewRangeByIndexQuery(0, -1)) // Ascending order
 
 	// Retrieve members within a score range in descending order
 	query := options.NewRangeByScoreQuery(
 		options.NewScoreBoundary(3, false),
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity)).SetReverse()
-	result2, err := client.ZRange("key1", query)
+	result2, err := client.ZRange(context.Background(), "key1", query)
 	// `result` contains members which have scores within the range of negative infinity to 3, in descending order
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
@@ -417,14 +420,14 @@ func ExampleGlideClient_ZRange() {
 func ExampleGlideClusterClient_ZRange() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRange("key1", options.NewRangeByIndexQuery(0, -1)) // Ascending order
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRange(context.Background(), "key1", options.NewRangeByIndexQuery(0, -1)) // Ascending order
 
 	// Retrieve members within a score range in descending order
 	query := options.NewRangeByScoreQuery(
 		options.NewScoreBoundary(3, false),
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity)).SetReverse()
-	result2, err := client.ZRange("key1", query)
+	result2, err := client.ZRange(context.Background(), "key1", query)
 	// `result` contains members which have scores within the range of negative infinity to 3, in descending order
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
@@ -442,13 +445,13 @@ func ExampleGlideClusterClient_ZRange() {
 func ExampleGlideClient_ZRangeWithScores() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRangeWithScores("key1", options.NewRangeByIndexQuery(0, -1))
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRangeWithScores(context.Background(), "key1", options.NewRangeByIndexQuery(0, -1))
 
 	query := options.NewRangeByScoreQuery(
 		options.NewScoreBoundary(3, false),
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity)).SetReverse()
-	result2, err := client.ZRangeWithScores("key1", query)
+	result2, err := client.ZRangeWithScores(context.Background(), "key1", query)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -465,13 +468,13 @@ func ExampleGlideClient_ZRangeWithScores() {
 func ExampleGlideClusterClient_ZRangeWithScores() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRangeWithScores("key1", options.NewRangeByIndexQuery(0, -1))
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRangeWithScores(context.Background(), "key1", options.NewRangeByIndexQuery(0, -1))
 
 	query := options.NewRangeByScoreQuery(
 		options.NewScoreBoundary(3, false),
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity)).SetReverse()
-	result2, err := client.ZRangeWithScores("key1", query)
+	result2, err := client.ZRangeWithScores(context.Background(), "key1", query)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -488,11 +491,11 @@ func ExampleGlideClusterClient_ZRangeWithScores() {
 func ExampleGlideClient_ZRangeStore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	query := options.NewRangeByScoreQuery(
 		options.NewScoreBoundary(3, false),
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity)).SetReverse()
-	result, err := client.ZRangeStore("dest", "key1", query)
+	result, err := client.ZRangeStore(context.Background(), "dest", "key1", query)
 	// `result` contains members which have scores within the range of negative infinity to 3, in descending order
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
@@ -505,11 +508,11 @@ func ExampleGlideClient_ZRangeStore() {
 func ExampleGlideClusterClient_ZRangeStore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 	query := options.NewRangeByScoreQuery(
 		options.NewScoreBoundary(3, false),
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity)).SetReverse()
-	result, err := client.ZRangeStore("{key}dest", "{key}1", query)
+	result, err := client.ZRangeStore(context.Background(), "{key}dest", "{key}1", query)
 	// `result` contains members which have scores within the range of negative infinity to 3, in descending order
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
@@ -522,8 +525,8 @@ func ExampleGlideClusterClient_ZRangeStore() {
 func ExampleGlideClient_ZRank() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRank("key1", "two")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRank(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -538,8 +541,8 @@ func ExampleGlideClient_ZRank() {
 func ExampleGlideClusterClient_ZRank() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	result1, err := client.ZRank("key1", "two")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	result1, err := client.ZRank(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -554,8 +557,8 @@ func ExampleGlideClusterClient_ZRank() {
 func ExampleGlideClient_ZRankWithScore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	resRank, resScore, err := client.ZRankWithScore("key1", "two")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	resRank, resScore, err := client.ZRankWithScore(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -572,8 +575,8 @@ func ExampleGlideClient_ZRankWithScore() {
 func ExampleGlideClusterClient_ZRankWithScore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
-	resRank, resScore, err := client.ZRankWithScore("key1", "two")
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+	resRank, resScore, err := client.ZRankWithScore(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -590,8 +593,12 @@ func ExampleGlideClusterClient_ZRankWithScore() {
 func ExampleGlideClient_ZRevRank() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	result1, err := client.ZRevRank("key1", "two")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	result1, err := client.ZRevRank(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -606,8 +613,12 @@ func ExampleGlideClient_ZRevRank() {
 func ExampleGlideClusterClient_ZRevRank() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	result1, err := client.ZRevRank("key1", "two")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	result1, err := client.ZRevRank(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -622,8 +633,12 @@ func ExampleGlideClusterClient_ZRevRank() {
 func ExampleGlideClient_ZRevRankWithScore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	resRank, resScore, err := client.ZRevRankWithScore("key1", "two")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	resRank, resScore, err := client.ZRevRankWithScore(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -640,8 +655,12 @@ func ExampleGlideClient_ZRevRankWithScore() {
 func ExampleGlideClusterClient_ZRevRankWithScore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	resRank, resScore, err := client.ZRevRankWithScore("key1", "two")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	resRank, resScore, err := client.ZRevRankWithScore(context.Background(), "key1", "two")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -658,8 +677,12 @@ func ExampleGlideClusterClient_ZRevRankWithScore() {
 func ExampleGlideClient_ZScore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	result1, err := client.ZScore("key1", "three")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	result1, err := client.ZScore(context.Background(), "key1", "three")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -674,8 +697,12 @@ func ExampleGlideClient_ZScore() {
 func ExampleGlideClusterClient_ZScore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	result1, err := client.ZScore("key1", "three")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	result1, err := client.ZScore(context.Background(), "key1", "three")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -694,8 +721,12 @@ func ExampleGlideClient_ZCount() {
 		options.NewInclusiveScoreBoundary(2.0),
 		options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 	)
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	result1, err := client.ZCount("key1", *zCountRange)
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	result1, err := client.ZCount(context.Background(), "key1", *zCountRange)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -714,8 +745,12 @@ func ExampleGlideClusterClient_ZCount() {
 		options.NewInclusiveScoreBoundary(2.0),
 		options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 	)
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	result1, err := client.ZCount("key1", *zCountRange)
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	result1, err := client.ZCount(context.Background(), "key1", *zCountRange)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -730,8 +765,12 @@ func ExampleGlideClusterClient_ZCount() {
 func ExampleGlideClient_ZScan() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	resCursor, resCol, err := client.ZScan("key1", "0")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	resCursor, resCol, err := client.ZScan(context.Background(), "key1", "0")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -748,8 +787,12 @@ func ExampleGlideClient_ZScan() {
 func ExampleGlideClusterClient_ZScan() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	resCursor, resCol, err := client.ZScan("key1", "0")
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	resCursor, resCol, err := client.ZScan(context.Background(), "key1", "0")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -766,8 +809,17 @@ func ExampleGlideClusterClient_ZScan() {
 func ExampleGlideClient_ZScanWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	resCursor, resCol, err := client.ZScanWithOptions("key1", "0", *options.NewZScanOptions().SetMatch("*"))
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	resCursor, resCol, err := client.ZScanWithOptions(
+		context.Background(),
+		"key1",
+		"0",
+		*options.NewZScanOptions().SetMatch("*"),
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -784,8 +836,17 @@ func ExampleGlideClient_ZScanWithOptions() {
 func ExampleGlideClusterClient_ZScanWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
-	resCursor, resCol, err := client.ZScanWithOptions("key1", "0", *options.NewZScanOptions().SetMatch("*"))
+	result, err := client.ZAdd(
+		context.Background(),
+		"key1",
+		map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+	)
+	resCursor, resCol, err := client.ZScanWithOptions(
+		context.Background(),
+		"key1",
+		"0",
+		*options.NewZScanOptions().SetMatch("*"),
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -802,8 +863,9 @@ func ExampleGlideClusterClient_ZScanWithOptions() {
 func ExampleGlideClient_ZRemRangeByLex() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 	result1, err := client.ZRemRangeByLex(
+		context.Background(),
 		"key1",
 		*options.NewRangeByLexQuery(options.NewLexBoundary("a", false), options.NewLexBoundary("c", true)),
 	)
@@ -821,8 +883,9 @@ func ExampleGlideClient_ZRemRangeByLex() {
 func ExampleGlideClusterClient_ZRemRangeByLex() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 	result1, err := client.ZRemRangeByLex(
+		context.Background(),
 		"key1",
 		*options.NewRangeByLexQuery(options.NewLexBoundary("a", false), options.NewLexBoundary("c", true)),
 	)
@@ -840,8 +903,8 @@ func ExampleGlideClusterClient_ZRemRangeByLex() {
 func ExampleGlideClient_ZRemRangeByRank() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result1, err := client.ZRemRangeByRank("key1", 1, 3)
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result1, err := client.ZRemRangeByRank(context.Background(), "key1", 1, 3)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -856,8 +919,8 @@ func ExampleGlideClient_ZRemRangeByRank() {
 func ExampleGlideClusterClient_ZRemRangeByRank() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result1, err := client.ZRemRangeByRank("key1", 1, 3)
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result1, err := client.ZRemRangeByRank(context.Background(), "key1", 1, 3)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -872,8 +935,8 @@ func ExampleGlideClusterClient_ZRemRangeByRank() {
 func ExampleGlideClient_ZRemRangeByScore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result1, err := client.ZRemRangeByScore("key1", *options.NewRangeByScoreQuery(
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result1, err := client.ZRemRangeByScore(context.Background(), "key1", *options.NewRangeByScoreQuery(
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 		options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 	))
@@ -891,8 +954,8 @@ func ExampleGlideClient_ZRemRangeByScore() {
 func ExampleGlideClusterClient_ZRemRangeByScore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result1, err := client.ZRemRangeByScore("key1", *options.NewRangeByScoreQuery(
+	result, err := client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result1, err := client.ZRemRangeByScore(context.Background(), "key1", *options.NewRangeByScoreQuery(
 		options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 		options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 	))
@@ -910,8 +973,8 @@ func ExampleGlideClusterClient_ZRemRangeByScore() {
 func ExampleGlideClient_BZMPop() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.BZMPop([]string{"key1"}, options.MAX, float64(0.5))
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.BZMPop(context.Background(), []string{"key1"}, options.MAX, float64(0.5))
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -923,8 +986,8 @@ func ExampleGlideClient_BZMPop() {
 func ExampleGlideClusterClient_BZMPop() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.BZMPop([]string{"key1"}, options.MAX, float64(0.5))
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.BZMPop(context.Background(), []string{"key1"}, options.MAX, float64(0.5))
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -936,9 +999,15 @@ func ExampleGlideClusterClient_BZMPop() {
 func ExampleGlideClient_BZMPopWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 
-	result, err := client.BZMPopWithOptions([]string{"key1"}, options.MAX, 0.1, *options.NewZMPopOptions().SetCount(2))
+	result, err := client.BZMPopWithOptions(
+		context.Background(),
+		[]string{"key1"},
+		options.MAX,
+		0.1,
+		*options.NewZMPopOptions().SetCount(2),
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -963,9 +1032,15 @@ func ExampleGlideClient_BZMPopWithOptions() {
 func ExampleGlideClusterClient_BZMPopWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 
-	result, err := client.BZMPopWithOptions([]string{"key1"}, options.MAX, 0.1, *options.NewZMPopOptions().SetCount(1))
+	result, err := client.BZMPopWithOptions(
+		context.Background(),
+		[]string{"key1"},
+		options.MAX,
+		0.1,
+		*options.NewZMPopOptions().SetCount(1),
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -978,8 +1053,8 @@ func ExampleGlideClusterClient_BZMPopWithOptions() {
 func ExampleGlideClient_ZRandMember() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.ZRandMember("key2")
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZRandMember(context.Background(), "key2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -993,8 +1068,8 @@ func ExampleGlideClient_ZRandMember() {
 func ExampleGlideClusterClient_ZRandMember() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.ZRandMember("key2")
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZRandMember(context.Background(), "key2")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1008,8 +1083,8 @@ func ExampleGlideClusterClient_ZRandMember() {
 func ExampleGlideClient_ZRandMemberWithCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.ZRandMemberWithCount("key1", 4)
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZRandMemberWithCount(context.Background(), "key1", 4)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1023,8 +1098,8 @@ func ExampleGlideClient_ZRandMemberWithCount() {
 func ExampleGlideClusterClient_ZRandMemberWithCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.ZRandMemberWithCount("key1", 4)
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZRandMemberWithCount(context.Background(), "key1", 4)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1038,8 +1113,8 @@ func ExampleGlideClusterClient_ZRandMemberWithCount() {
 func ExampleGlideClient_ZRandMemberWithCountWithScores() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.ZRandMemberWithCountWithScores("key1", 4)
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZRandMemberWithCountWithScores(context.Background(), "key1", 4)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1053,8 +1128,8 @@ func ExampleGlideClient_ZRandMemberWithCountWithScores() {
 func ExampleGlideClusterClient_ZRandMemberWithCountWithScores() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	result, err := client.ZRandMemberWithCountWithScores("key1", 4)
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	result, err := client.ZRandMemberWithCountWithScores(context.Background(), "key1", 4)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1068,8 +1143,8 @@ func ExampleGlideClusterClient_ZRandMemberWithCountWithScores() {
 func ExampleGlideClient_ZMScore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	result, err := client.ZMScore("key1", []string{"c", "b", "e"})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	result, err := client.ZMScore(context.Background(), "key1", []string{"c", "b", "e"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1083,8 +1158,8 @@ func ExampleGlideClient_ZMScore() {
 func ExampleGlideClusterClient_ZMScore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	result, err := client.ZMScore("key1", []string{"c", "b", "e"})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	result, err := client.ZMScore(context.Background(), "key1", []string{"c", "b", "e"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1098,10 +1173,10 @@ func ExampleGlideClusterClient_ZMScore() {
 func ExampleGlideClient_ZInter() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
 
-	result, err := client.ZInter(options.KeyArray{
+	result, err := client.ZInter(context.Background(), options.KeyArray{
 		Keys: []string{"key1", "key2"},
 	})
 	if err != nil {
@@ -1115,10 +1190,10 @@ func ExampleGlideClient_ZInter() {
 func ExampleGlideClusterClient_ZInter() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
 
-	result, err := client.ZInter(options.KeyArray{
+	result, err := client.ZInter(context.Background(), options.KeyArray{
 		Keys: []string{"{key}1", "{key}2"},
 	})
 	if err != nil {
@@ -1132,9 +1207,10 @@ func ExampleGlideClusterClient_ZInter() {
 func ExampleGlideClient_ZInterWithScores() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
 	result, err := client.ZInterWithScores(
+		context.Background(),
 		options.KeyArray{
 			Keys: []string{"key1", "key2"},
 		},
@@ -1150,9 +1226,10 @@ func ExampleGlideClient_ZInterWithScores() {
 func ExampleGlideClusterClient_ZInterWithScores() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
 	result, err := client.ZInterWithScores(
+		context.Background(),
 		options.KeyArray{
 			Keys: []string{"{key}1", "{key}2"},
 		},
@@ -1169,9 +1246,9 @@ func ExampleGlideClusterClient_ZInterWithScores() {
 func ExampleGlideClient_ZInterStore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZInterStore("dest", options.KeyArray{
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZInterStore(context.Background(), "dest", options.KeyArray{
 		Keys: []string{"key1", "key2"},
 	})
 	if err != nil {
@@ -1185,9 +1262,9 @@ func ExampleGlideClient_ZInterStore() {
 func ExampleGlideClusterClient_ZInterStore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZInterStore("{key}dest", options.KeyArray{
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZInterStore(context.Background(), "{key}dest", options.KeyArray{
 		Keys: []string{"{key}1", "{key}2"},
 	})
 	if err != nil {
@@ -1201,9 +1278,10 @@ func ExampleGlideClusterClient_ZInterStore() {
 func ExampleGlideClient_ZInterStoreWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
 	result, err := client.ZInterStoreWithOptions(
+		context.Background(),
 		"dest",
 		options.KeyArray{
 			Keys: []string{"key1", "key2"},
@@ -1221,9 +1299,10 @@ func ExampleGlideClient_ZInterStoreWithOptions() {
 func ExampleGlideClusterClient_ZInterStoreWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
 	result, err := client.ZInterStoreWithOptions(
+		context.Background(),
 		"{key}dest",
 		options.KeyArray{
 			Keys: []string{"{key}1", "{key}2"},
@@ -1241,9 +1320,9 @@ func ExampleGlideClusterClient_ZInterStoreWithOptions() {
 func ExampleGlideClient_ZDiff() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZDiff([]string{"key1", "key2"})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZDiff(context.Background(), []string{"key1", "key2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1254,9 +1333,9 @@ func ExampleGlideClient_ZDiff() {
 func ExampleGlideClusterClient_ZDiff() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZDiff([]string{"{key}1", "{key}2"})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZDiff(context.Background(), []string{"{key}1", "{key}2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1268,9 +1347,9 @@ func ExampleGlideClusterClient_ZDiff() {
 func ExampleGlideClient_ZDiffWithScores() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZDiffWithScores([]string{"key1", "key2"})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZDiffWithScores(context.Background(), []string{"key1", "key2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1281,9 +1360,9 @@ func ExampleGlideClient_ZDiffWithScores() {
 func ExampleGlideClusterClient_ZDiffWithScores() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZDiffWithScores([]string{"{key}1", "{key}2"})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZDiffWithScores(context.Background(), []string{"{key}1", "{key}2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1295,9 +1374,9 @@ func ExampleGlideClusterClient_ZDiffWithScores() {
 func ExampleGlideClient_ZDiffStore() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZDiffStore("dest", []string{"key1", "key2"})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZDiffStore(context.Background(), "dest", []string{"key1", "key2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1309,9 +1388,9 @@ func ExampleGlideClient_ZDiffStore() {
 func ExampleGlideClusterClient_ZDiffStore() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
-	client.ZAdd("{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
-	result, err := client.ZDiffStore("{key}dest", []string{"{key}1", "{key}2"})
+	client.ZAdd(context.Background(), "{key}1", map[string]float64{"a": 1.0, "b": 2.5, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "{key}2", map[string]float64{"b": 1.0, "c": 2.5, "d": 3.0, "e": 4.0})
+	result, err := client.ZDiffStore(context.Background(), "{key}dest", []string{"{key}1", "{key}2"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1332,10 +1411,10 @@ func ExampleGlideClient_ZUnion() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("key1", memberScoreMap1)
-	client.ZAdd("key2", memberScoreMap2)
+	client.ZAdd(context.Background(), "key1", memberScoreMap1)
+	client.ZAdd(context.Background(), "key2", memberScoreMap2)
 
-	zUnionResult, _ := client.ZUnion(options.KeyArray{Keys: []string{"key1", "key2"}})
+	zUnionResult, _ := client.ZUnion(context.Background(), options.KeyArray{Keys: []string{"key1", "key2"}})
 	fmt.Println(zUnionResult)
 
 	// Output:
@@ -1354,10 +1433,10 @@ func ExampleGlideClusterClient_ZUnion() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("{key}1", memberScoreMap1)
-	client.ZAdd("{key}2", memberScoreMap2)
+	client.ZAdd(context.Background(), "{key}1", memberScoreMap1)
+	client.ZAdd(context.Background(), "{key}2", memberScoreMap2)
 
-	zUnionResult, _ := client.ZUnion(options.KeyArray{Keys: []string{"{key}1", "{key}2"}})
+	zUnionResult, _ := client.ZUnion(context.Background(), options.KeyArray{Keys: []string{"{key}1", "{key}2"}})
 	fmt.Println(zUnionResult)
 
 	// Output:
@@ -1376,10 +1455,11 @@ func ExampleGlideClient_ZUnionWithScores() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("key1", memberScoreMap1)
-	client.ZAdd("key2", memberScoreMap2)
+	client.ZAdd(context.Background(), "key1", memberScoreMap1)
+	client.ZAdd(context.Background(), "key2", memberScoreMap2)
 
 	zUnionResult, _ := client.ZUnionWithScores(
+		context.Background(),
 		options.KeyArray{Keys: []string{"key1", "key2"}},
 		options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 	)
@@ -1401,10 +1481,11 @@ func ExampleGlideClusterClient_ZUnionWithScores() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("{key}1", memberScoreMap1)
-	client.ZAdd("{key}2", memberScoreMap2)
+	client.ZAdd(context.Background(), "{key}1", memberScoreMap1)
+	client.ZAdd(context.Background(), "{key}2", memberScoreMap2)
 
 	zUnionResult, _ := client.ZUnionWithScores(
+		context.Background(),
 		options.KeyArray{Keys: []string{"{key}1", "{key}2"}},
 		options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 	)
@@ -1426,10 +1507,11 @@ func ExampleGlideClient_ZUnionStore() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("key1", memberScoreMap1)
-	client.ZAdd("key2", memberScoreMap2)
+	client.ZAdd(context.Background(), "key1", memberScoreMap1)
+	client.ZAdd(context.Background(), "key2", memberScoreMap2)
 
 	zUnionStoreResult, err := client.ZUnionStore(
+		context.Background(),
 		"dest",
 		options.KeyArray{Keys: []string{"key1", "key2"}},
 	)
@@ -1454,10 +1536,11 @@ func ExampleGlideClusterClient_ZUnionStore() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("{key}1", memberScoreMap1)
-	client.ZAdd("{key}2", memberScoreMap2)
+	client.ZAdd(context.Background(), "{key}1", memberScoreMap1)
+	client.ZAdd(context.Background(), "{key}2", memberScoreMap2)
 
 	zUnionStoreResult, err := client.ZUnionStore(
+		context.Background(),
 		"{key}dest",
 		options.KeyArray{Keys: []string{"{key}1", "{key}2"}},
 	)
@@ -1482,10 +1565,11 @@ func ExampleGlideClient_ZUnionStoreWithOptions() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("key1", memberScoreMap1)
-	client.ZAdd("key2", memberScoreMap2)
+	client.ZAdd(context.Background(), "key1", memberScoreMap1)
+	client.ZAdd(context.Background(), "key2", memberScoreMap2)
 
 	zUnionStoreWithOptionsResult, err := client.ZUnionStoreWithOptions(
+		context.Background(),
 		"dest",
 		options.KeyArray{Keys: []string{"key1", "key2"}},
 		options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
@@ -1511,10 +1595,11 @@ func ExampleGlideClusterClient_ZUnionStoreWithOptions() {
 		"three": 3.0,
 	}
 
-	client.ZAdd("{key}1", memberScoreMap1)
-	client.ZAdd("{key}2", memberScoreMap2)
+	client.ZAdd(context.Background(), "{key}1", memberScoreMap1)
+	client.ZAdd(context.Background(), "{key}2", memberScoreMap2)
 
 	zUnionStoreWithOptionsResult, err := client.ZUnionStoreWithOptions(
+		context.Background(),
 		"{key}dest",
 		options.KeyArray{Keys: []string{"{key}1", "{key}2"}},
 		options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
@@ -1533,10 +1618,10 @@ func ExampleGlideClient_ZInterCard() {
 	key1 := "{testkey}-1"
 	key2 := "{testkey}-2"
 
-	client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	client.ZAdd(key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
 
-	res, err := client.ZInterCard([]string{key1, key2})
+	res, err := client.ZInterCard(context.Background(), []string{key1, key2})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1551,10 +1636,10 @@ func ExampleGlideClusterClient_ZInterCard() {
 	key1 := "{testkey}-1"
 	key2 := "{testkey}-2"
 
-	client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	client.ZAdd(key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
 
-	res, err := client.ZInterCard([]string{key1, key2})
+	res, err := client.ZInterCard(context.Background(), []string{key1, key2})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1569,10 +1654,11 @@ func ExampleGlideClient_ZInterCardWithOptions() {
 	key1 := "{testkey}-1"
 	key2 := "{testkey}-2"
 
-	client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	client.ZAdd(key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
 
 	res, err := client.ZInterCardWithOptions(
+		context.Background(),
 		[]string{key1, key2},
 		options.NewZInterCardOptions().SetLimit(5),
 	)
@@ -1590,10 +1676,11 @@ func ExampleGlideClusterClient_ZInterCardWithOptions() {
 	key1 := "{testkey}-1"
 	key2 := "{testkey}-2"
 
-	client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
-	client.ZAdd(key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
+	client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), key2, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "e": 4.0})
 
 	res, err := client.ZInterCardWithOptions(
+		context.Background(),
 		[]string{key1, key2},
 		options.NewZInterCardOptions().SetLimit(5),
 	)
@@ -1609,9 +1696,9 @@ func ExampleGlideClusterClient_ZInterCardWithOptions() {
 func ExampleGlideClient_ZLexCount() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 
-	result, err := client.ZLexCount("key1",
+	result, err := client.ZLexCount(context.Background(), "key1",
 		options.NewRangeByLexQuery(
 			options.NewLexBoundary("a", false),
 			options.NewLexBoundary("c", true),
@@ -1629,9 +1716,9 @@ func ExampleGlideClient_ZLexCount() {
 func ExampleGlideClusterClient_ZLexCount() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.ZAdd("key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+	client.ZAdd(context.Background(), "key1", map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 
-	result, err := client.ZLexCount("key1",
+	result, err := client.ZLexCount(context.Background(), "key1",
 		options.NewRangeByLexQuery(
 			options.NewLexBoundary("a", false),
 			options.NewLexBoundary("c", true),
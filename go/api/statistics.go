@@ -0,0 +1,191 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// latencyBuckets are the upper bounds of the buckets of the latency histogram of the requests of a client.
+var latencyBuckets = []time.Duration{
+	500 * time.Microsecond,
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+// ClientStatistics is a snapshot of the statistics of a client, returned by its Statistics method.
+type ClientStatistics struct {
+	// The number of requests sent by the client which are waiting for a response.
+	PendingRequests int
+	// The number of requests which completed since the client was created, whether they succeeded or not, including the
+	// requests which failed before being sent, such as the requests of a closed client. A batch counts as a single request.
+	TotalRequests uint64
+	// The number of requests which failed, by type of error.
+	Errors ErrorStatistics
+	// The distribution of the latency of the completed requests, from the call of the command to its response, including
+	// the submission of the request.
+	Latency LatencyHistogram
+}
+
+// ErrorStatistics counts the requests of a client which failed, by type of error.
+type ErrorStatistics struct {
+	// The number of requests which failed with an `errors.TimeoutError`.
+	Timeout uint64
+	// The number of requests which failed with an `errors.DisconnectError`.
+	Disconnect uint64
+	// The number of requests which failed with an `errors.ExecAbortError`.
+	ExecAbort uint64
+	// The number of requests which failed with an `errors.InflightRequestsLimitError`.
+	InflightRequestsLimit uint64
+	// The number of requests which failed with an `errors.ClosingError`.
+	Closing uint64
+	// The number of requests which failed with an `errors.RequestError`, or with another error.
+	Request uint64
+	// The number of requests whose context was cancelled or expired before the response was received.
+	Cancelled uint64
+}
+
+// Total returns the total number of failed requests.
+func (statistics ErrorStatistics) Total() uint64 {
+	return statistics.Timeout + statistics.Disconnect + statistics.ExecAbort + statistics.InflightRequestsLimit +
+		statistics.Closing + statistics.Request + statistics.Cancelled
+}
+
+// LatencyHistogram is the distribution of the latency of the requests of a client, from the time a request is sent until
+// its response is received.
+type LatencyHistogram struct {
+	// The upper bounds of the buckets, from 500µs to 1s.
+	Bounds []time.Duration
+	// The number of requests in each bucket. Counts[i] is the number of requests with a latency greater than Bounds[i-1]
+	// and lower than or equal to Bounds[i]. The last count, Counts[len(Bounds)], is the number of requests with a
+	// latency greater than all the bounds.
+	Counts []uint64
+	// The number of requests in the histogram.
+	Count uint64
+	// The sum of the latencies of the requests in the histogram.
+	Sum time.Duration
+}
+
+// StatisticsExporter receives the completed requests of a client, for example to export them to a metrics system such as
+// Prometheus. It is set with WithStatisticsExporter in the advanced configuration of the client.
+//
+// RecordRequest is called synchronously once each request completes, so it should return quickly and be safe for
+// concurrent use.
+type StatisticsExporter interface {
+	// RecordRequest records a completed request, with its latency and the error it failed with, nil if it succeeded.
+	// Requests whose context was cancelled or expired are recorded with the error of their context, and the time waited
+	// until then.
+	RecordRequest(latency time.Duration, err error)
+}
+
+// clientStatistics holds the counters of the requests of a client.
+type clientStatistics struct {
+	totalRequests atomic.Uint64
+	timeout       atomic.Uint64
+	disconnect    atomic.Uint64
+	execAbort     atomic.Uint64
+	inflightLimit atomic.Uint64
+	closing       atomic.Uint64
+	request       atomic.Uint64
+	cancelled     atomic.Uint64
+	// the last count is for the latencies above all the latencyBuckets
+	latencyCounts []atomic.Uint64
+	latencySum    atomic.Int64
+	exporter      StatisticsExporter
+}
+
+func newClientStatistics(exporter StatisticsExporter) *clientStatistics {
+	return &clientStatistics{
+		latencyCounts: make([]atomic.Uint64, len(latencyBuckets)+1),
+		exporter:      exporter,
+	}
+}
+
+// recordRequest records a request which completed after the given latency, with the given error if it failed.
+func (statistics *clientStatistics) recordRequest(latency time.Duration, err error) {
+	statistics.totalRequests.Add(1)
+	if err != nil {
+		statistics.recordError(err)
+	}
+	// the latency of a cancelled request is unknown, since its response was not received
+	if err == nil || !isContextError(err) {
+		bucket := len(latencyBuckets)
+		for i, bound := range latencyBuckets {
+			if latency <= bound {
+				bucket = i
+				break
+			}
+		}
+		statistics.latencyCounts[bucket].Add(1)
+		statistics.latencySum.Add(int64(latency))
+	}
+	if statistics.exporter != nil {
+		statistics.exporter.RecordRequest(latency, err)
+	}
+}
+
+func (statistics *clientStatistics) recordError(err error) {
+	switch err.(type) {
+	case *errors.TimeoutError:
+		statistics.timeout.Add(1)
+	case *errors.DisconnectError:
+		statistics.disconnect.Add(1)
+	case *errors.ExecAbortError:
+		statistics.execAbort.Add(1)
+	case *errors.InflightRequestsLimitError:
+		statistics.inflightLimit.Add(1)
+	case *errors.ClosingError:
+		statistics.closing.Add(1)
+	case *errors.RequestError:
+		statistics.request.Add(1)
+	default:
+		if isContextError(err) {
+			statistics.cancelled.Add(1)
+		} else {
+			statistics.request.Add(1)
+		}
+	}
+}
+
+func isContextError(err error) bool {
+	return err == context.Canceled || err == context.DeadlineExceeded
+}
+
+// snapshot returns the current statistics, with the given number of pending requests.
+func (statistics *clientStatistics) snapshot(pendingRequests int) ClientStatistics {
+	latency := LatencyHistogram{
+		Bounds: append([]time.Duration(nil), latencyBuckets...),
+		Counts: make([]uint64, len(statistics.latencyCounts)),
+		Sum:    time.Duration(statistics.latencySum.Load()),
+	}
+	for i := range statistics.latencyCounts {
+		latency.Counts[i] = statistics.latencyCounts[i].Load()
+		latency.Count += latency.Counts[i]
+	}
+	return ClientStatistics{
+		PendingRequests: pendingRequests,
+		TotalRequests:   statistics.totalRequests.Load(),
+		Errors: ErrorStatistics{
+			Timeout:               statistics.timeout.Load(),
+			Disconnect:            statistics.disconnect.Load(),
+			ExecAbort:             statistics.execAbort.Load(),
+			InflightRequestsLimit: statistics.inflightLimit.Load(),
+			Closing:               statistics.closing.Load(),
+			Request:               statistics.request.Load(),
+			Cancelled:             statistics.cancelled.Load(),
+		},
+		Latency: latency,
+	}
+}
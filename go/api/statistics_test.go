@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+type recordedRequest struct {
+	latency time.Duration
+	err     error
+}
+
+type recordingExporter struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+func (exporter *recordingExporter) RecordRequest(latency time.Duration, err error) {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	exporter.requests = append(exporter.requests, recordedRequest{latency, err})
+}
+
+func TestClientStatistics(t *testing.T) {
+	exporter := &recordingExporter{}
+	statistics := newClientStatistics(exporter)
+
+	statistics.recordRequest(100*time.Microsecond, nil)
+	statistics.recordRequest(time.Millisecond, nil)
+	statistics.recordRequest(3*time.Millisecond, &errors.RequestError{Msg: "WRONGTYPE"})
+	statistics.recordRequest(2*time.Second, &errors.TimeoutError{})
+	statistics.recordRequest(time.Millisecond, &errors.DisconnectError{})
+	statistics.recordRequest(0, &errors.InflightRequestsLimitError{})
+	statistics.recordRequest(time.Millisecond, &errors.ClosingError{})
+	statistics.recordRequest(time.Millisecond, &errors.ExecAbortError{})
+	// the latency of a cancelled request is not recorded in the histogram
+	statistics.recordRequest(time.Millisecond, context.Canceled)
+	statistics.recordRequest(time.Millisecond, context.DeadlineExceeded)
+
+	snapshot := statistics.snapshot(3)
+	assert.Equal(t, 3, snapshot.PendingRequests)
+	assert.Equal(t, uint64(10), snapshot.TotalRequests)
+	assert.Equal(t, ErrorStatistics{
+		Timeout:               1,
+		Disconnect:            1,
+		ExecAbort:             1,
+		InflightRequestsLimit: 1,
+		Closing:               1,
+		Request:               1,
+		Cancelled:             2,
+	}, snapshot.Errors)
+	assert.Equal(t, uint64(8), snapshot.Errors.Total())
+
+	assert.Equal(t, latencyBuckets, snapshot.Latency.Bounds)
+	assert.Len(t, snapshot.Latency.Counts, len(latencyBuckets)+1)
+	assert.Equal(t, uint64(8), snapshot.Latency.Count)
+	assert.Equal(t, 100*time.Microsecond+7*time.Millisecond+2*time.Second, snapshot.Latency.Sum)
+	// <= 500µs
+	assert.Equal(t, uint64(2), snapshot.Latency.Counts[0])
+	// <= 1ms
+	assert.Equal(t, uint64(4), snapshot.Latency.Counts[1])
+	// <= 5ms
+	assert.Equal(t, uint64(1), snapshot.Latency.Counts[3])
+	// > 1s
+	assert.Equal(t, uint64(1), snapshot.Latency.Counts[len(latencyBuckets)])
+
+	assert.Len(t, exporter.requests, 10)
+	assert.Equal(t, recordedRequest{2 * time.Second, &errors.TimeoutError{}}, exporter.requests[3])
+	assert.Equal(t, recordedRequest{time.Millisecond, context.Canceled}, exporter.requests[8])
+
+	// the snapshot is not affected by later requests
+	statistics.recordRequest(time.Millisecond, nil)
+	assert.Equal(t, uint64(10), snapshot.TotalRequests)
+	assert.Equal(t, uint64(4), snapshot.Latency.Counts[1])
+}
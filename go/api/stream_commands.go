@@ -2,7 +2,11 @@
 
 package api
 
-import "github.com/valkey-io/valkey-glide/go/api/options"
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
 
 // Supports commands and transactions for the "Stream" group of commands for standalone and cluster clients.
 //
@@ -10,17 +14,25 @@ import "github.com/valkey-io/valkey-glide/go/api/options"
 //
 // [valkey.io]: https://valkey.io/commands/#stream
 type StreamCommands interface {
-	XAdd(key string, values [][]string) (Result[string], error)
+	XAdd(ctx context.Context, key string, values [][]string) (Result[string], error)
 
-	XAddWithOptions(key string, values [][]string, options options.XAddOptions) (Result[string], error)
+	XAddWithOptions(ctx context.Context, key string, values [][]string, options options.XAddOptions) (Result[string], error)
 
-	XTrim(key string, options options.XTrimOptions) (int64, error)
+	XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error)
 
-	XLen(key string) (int64, error)
+	XLen(ctx context.Context, key string) (int64, error)
 
-	XAutoClaim(key string, group string, consumer string, minIdleTime int64, start string) (XAutoClaimResponse, error)
+	XAutoClaim(
+		ctx context.Context,
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		start string,
+	) (XAutoClaimResponse, error)
 
 	XAutoClaimWithOptions(
+		ctx context.Context,
 		key string,
 		group string,
 		consumer string,
@@ -30,6 +42,7 @@ type StreamCommands interface {
 	) (XAutoClaimResponse, error)
 
 	XAutoClaimJustId(
+		ctx context.Context,
 		key string,
 		group string,
 		consumer string,
@@ -38,6 +51,7 @@ type StreamCommands interface {
 	) (XAutoClaimJustIdResponse, error)
 
 	XAutoClaimJustIdWithOptions(
+		ctx context.Context,
 		key string,
 		group string,
 		consumer string,
@@ -46,42 +60,70 @@ type StreamCommands interface {
 		options options.XAutoClaimOptions,
 	) (XAutoClaimJustIdResponse, error)
 
-	XReadGroup(group string, consumer string, keysAndIds map[string]string) (map[string]map[string][][]string, error)
+	XReadGroup(
+		ctx context.Context,
+		group string,
+		consumer string,
+		keysAndIds map[string]string,
+	) (map[string]map[string][][]string, error)
 
 	XReadGroupWithOptions(
+		ctx context.Context,
 		group string,
 		consumer string,
 		keysAndIds map[string]string,
 		options options.XReadGroupOptions,
 	) (map[string]map[string][][]string, error)
 
-	XRead(keysAndIds map[string]string) (map[string]map[string][][]string, error)
+	XRead(ctx context.Context, keysAndIds map[string]string) (map[string]map[string][][]string, error)
 
-	XReadWithOptions(keysAndIds map[string]string, options options.XReadOptions) (map[string]map[string][][]string, error)
+	XReadWithOptions(
+		ctx context.Context,
+		keysAndIds map[string]string,
+		options options.XReadOptions,
+	) (map[string]map[string][][]string, error)
 
-	XDel(key string, ids []string) (int64, error)
+	XDel(ctx context.Context, key string, ids []string) (int64, error)
 
-	XPending(key string, group string) (XPendingSummary, error)
+	XPending(ctx context.Context, key string, group string) (XPendingSummary, error)
 
-	XPendingWithOptions(key string, group string, options options.XPendingOptions) ([]XPendingDetail, error)
+	XPendingWithOptions(
+		ctx context.Context,
+		key string,
+		group string,
+		options options.XPendingOptions,
+	) ([]XPendingDetail, error)
 
-	XGroupSetId(key string, group string, id string) (string, error)
+	XGroupSetId(ctx context.Context, key string, group string, id string) (string, error)
 
-	XGroupSetIdWithOptions(key string, group string, id string, opts options.XGroupSetIdOptions) (string, error)
+	XGroupSetIdWithOptions(
+		ctx context.Context,
+		key string,
+		group string,
+		id string,
+		opts options.XGroupSetIdOptions,
+	) (string, error)
 
-	XGroupCreate(key string, group string, id string) (string, error)
+	XGroupCreate(ctx context.Context, key string, group string, id string) (string, error)
 
-	XGroupCreateWithOptions(key string, group string, id string, opts options.XGroupCreateOptions) (string, error)
+	XGroupCreateWithOptions(
+		ctx context.Context,
+		key string,
+		group string,
+		id string,
+		opts options.XGroupCreateOptions,
+	) (string, error)
 
-	XGroupDestroy(key string, group string) (bool, error)
+	XGroupDestroy(ctx context.Context, key string, group string) (bool, error)
 
-	XGroupCreateConsumer(key string, group string, consumer string) (bool, error)
+	XGroupCreateConsumer(ctx context.Context, key string, group string, consumer string) (bool, error)
 
-	XGroupDelConsumer(key string, group string, consumer string) (int64, error)
+	XGroupDelConsumer(ctx context.Context, key string, group string, consumer string) (int64, error)
 
-	XAck(key string, group string, ids []string) (int64, error)
+	XAck(ctx context.Context, key string, group string, ids []string) (int64, error)
 
 	XClaim(
+		ctx context.Context,
 		key string,
 		group string,
 		consumer string,
@@ -90,6 +132,7 @@ type StreamCommands interface {
 	) (map[string][][]string, error)
 
 	XClaimWithOptions(
+		ctx context.Context,
 		key string,
 		group string,
 		consumer string,
@@ -98,9 +141,17 @@ type StreamCommands interface {
 		options options.XClaimOptions,
 	) (map[string][][]string, error)
 
-	XClaimJustId(key string, group string, consumer string, minIdleTime int64, ids []string) ([]string, error)
+	XClaimJustId(
+		ctx context.Context,
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		ids []string,
+	) ([]string, error)
 
 	XClaimJustIdWithOptions(
+		ctx context.Context,
 		key string,
 		group string,
 		consumer string,
@@ -109,26 +160,33 @@ type StreamCommands interface {
 		options options.XClaimOptions,
 	) ([]string, error)
 
-	XInfoStream(key string) (map[string]any, error)
+	XInfoStream(ctx context.Context, key string) (map[string]any, error)
 
-	XInfoStreamFullWithOptions(key string, options *options.XInfoStreamOptions) (map[string]any, error)
+	XInfoStreamFullWithOptions(ctx context.Context, key string, options *options.XInfoStreamOptions) (map[string]any, error)
 
-	XInfoConsumers(key string, group string) ([]XInfoConsumerInfo, error)
+	XInfoConsumers(ctx context.Context, key string, group string) ([]XInfoConsumerInfo, error)
 
-	XInfoGroups(key string) ([]XInfoGroupInfo, error)
+	XInfoGroups(ctx context.Context, key string) ([]XInfoGroupInfo, error)
 
-	XRange(key string, start options.StreamBoundary, end options.StreamBoundary) ([]XRangeResponse, error)
+	XRange(ctx context.Context, key string, start options.StreamBoundary, end options.StreamBoundary) ([]XRangeResponse, error)
 
 	XRangeWithOptions(
+		ctx context.Context,
 		key string,
 		start options.StreamBoundary,
 		end options.StreamBoundary,
 		options options.XRangeOptions,
 	) ([]XRangeResponse, error)
 
-	XRevRange(key string, start options.StreamBoundary, end options.StreamBoundary) ([]XRangeResponse, error)
+	XRevRange(
+		ctx context.Context,
+		key string,
+		start options.StreamBoundary,
+		end options.StreamBoundary,
+	) ([]XRangeResponse, error)
 
 	XRevRangeWithOptions(
+		ctx context.Context,
 		key string,
 		start options.StreamBoundary,
 		end options.StreamBoundary,
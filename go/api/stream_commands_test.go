@@ -3,6 +3,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -16,7 +17,7 @@ import (
 func ExampleGlideClient_XAdd() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	result, err := client.XAdd("mystream", [][]string{
+	result, err := client.XAdd(context.Background(), "mystream", [][]string{
 		{"key1", "value1"},
 		{"key2", "value2"},
 	})
@@ -35,7 +36,7 @@ func ExampleGlideClient_XAdd() {
 func ExampleGlideClusterClient_XAdd() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	result, err := client.XAdd("mystream", [][]string{
+	result, err := client.XAdd(context.Background(), "mystream", [][]string{
 		{"key1", "value1"},
 		{"key2", "value2"},
 	})
@@ -60,7 +61,7 @@ func ExampleGlideClient_XAddWithOptions() {
 		{"key1", "value1"},
 		{"key2", "value2"},
 	}
-	result, err := client.XAddWithOptions("mystream", values, *options)
+	result, err := client.XAddWithOptions(context.Background(), "mystream", values, *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -78,7 +79,7 @@ func ExampleGlideClusterClient_XAddWithOptions() {
 		{"key1", "value1"},
 		{"key2", "value2"},
 	}
-	result, err := client.XAddWithOptions("mystream", values, *options)
+	result, err := client.XAddWithOptions(context.Background(), "mystream", values, *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -90,10 +91,10 @@ func ExampleGlideClusterClient_XAddWithOptions() {
 func ExampleGlideClient_XTrim() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.XAdd("mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
-	client.XAdd("mystream", [][]string{{"field3", "foo4"}, {"field4", "bar4"}})
+	client.XAdd(context.Background(), "mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
+	client.XAdd(context.Background(), "mystream", [][]string{{"field3", "foo4"}, {"field4", "bar4"}})
 
-	count, err := client.XTrim("mystream", *options.NewXTrimOptionsWithMaxLen(0).SetExactTrimming())
+	count, err := client.XTrim(context.Background(), "mystream", *options.NewXTrimOptionsWithMaxLen(0).SetExactTrimming())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -105,10 +106,10 @@ func ExampleGlideClient_XTrim() {
 func ExampleGlideClusterClient_XTrim() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.XAdd("mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
-	client.XAdd("mystream", [][]string{{"field3", "foo4"}, {"field4", "bar4"}})
+	client.XAdd(context.Background(), "mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
+	client.XAdd(context.Background(), "mystream", [][]string{{"field3", "foo4"}, {"field4", "bar4"}})
 
-	count, err := client.XTrim("mystream", *options.NewXTrimOptionsWithMaxLen(0).SetExactTrimming())
+	count, err := client.XTrim(context.Background(), "mystream", *options.NewXTrimOptionsWithMaxLen(0).SetExactTrimming())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -120,8 +121,8 @@ func ExampleGlideClusterClient_XTrim() {
 func ExampleGlideClient_XLen() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 
-	client.XAdd("mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
-	count, err := client.XLen("mystream")
+	client.XAdd(context.Background(), "mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
+	count, err := client.XLen(context.Background(), "mystream")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -133,8 +134,8 @@ func ExampleGlideClient_XLen() {
 func ExampleGlideClusterClient_XLen() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 
-	client.XAdd("mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
-	count, err := client.XLen("mystream")
+	client.XAdd(context.Background(), "mystream", [][]string{{"field1", "foo4"}, {"field2", "bar4"}})
+	count, err := client.XLen(context.Background(), "mystream")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -149,21 +150,23 @@ func ExampleGlideClient_XAutoClaim() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	response, err := client.XAutoClaim(key, group, consumer, 0, "0-1")
+	response, err := client.XAutoClaim(context.Background(), key, group, consumer, 0, "0-1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -179,21 +182,23 @@ func ExampleGlideClusterClient_XAutoClaim() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	response, err := client.XAutoClaim(key, group, consumer, 0, "0-1")
+	response, err := client.XAutoClaim(context.Background(), key, group, consumer, 0, "0-1")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -209,22 +214,24 @@ func ExampleGlideClient_XAutoClaimWithOptions() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
 	options := options.NewXAutoClaimOptions().SetCount(1)
-	response, err := client.XAutoClaimWithOptions(key, group, consumer, 0, "0-1", *options)
+	response, err := client.XAutoClaimWithOptions(context.Background(), key, group, consumer, 0, "0-1", *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -239,22 +246,24 @@ func ExampleGlideClusterClient_XAutoClaimWithOptions() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
 	options := options.NewXAutoClaimOptions().SetCount(1)
-	response, err := client.XAutoClaimWithOptions(key, group, consumer, 0, "0-1", *options)
+	response, err := client.XAutoClaimWithOptions(context.Background(), key, group, consumer, 0, "0-1", *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -269,21 +278,23 @@ func ExampleGlideClient_XAutoClaimJustId() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	response, err := client.XAutoClaimJustId(key, group, consumer, 0, "0-0")
+	response, err := client.XAutoClaimJustId(context.Background(), key, group, consumer, 0, "0-0")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -298,21 +309,23 @@ func ExampleGlideClusterClient_XAutoClaimJustId() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	response, err := client.XAutoClaimJustId(key, group, consumer, 0, "0-0")
+	response, err := client.XAutoClaimJustId(context.Background(), key, group, consumer, 0, "0-0")
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -327,22 +340,24 @@ func ExampleGlideClient_XAutoClaimJustIdWithOptions() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
 	options := options.NewXAutoClaimOptions().SetCount(1)
-	response, err := client.XAutoClaimJustIdWithOptions(key, group, consumer, 0, "0-1", *options)
+	response, err := client.XAutoClaimJustIdWithOptions(context.Background(), key, group, consumer, 0, "0-1", *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -357,22 +372,24 @@ func ExampleGlideClusterClient_XAutoClaimJustIdWithOptions() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry2_field1", "entry2_value1"}},
 		*options.NewXAddOptions().SetId("0-2"),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
 	options := options.NewXAutoClaimOptions().SetCount(1)
-	response, err := client.XAutoClaimJustIdWithOptions(key, group, consumer, 0, "0-1", *options)
+	response, err := client.XAutoClaimJustIdWithOptions(context.Background(), key, group, consumer, 0, "0-1", *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -388,15 +405,16 @@ func ExampleGlideClient_XReadGroup() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
 
-	response, err := client.XReadGroup(group, consumer, map[string]string{key: "0"})
+	response, err := client.XReadGroup(context.Background(), group, consumer, map[string]string{key: "0"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -412,15 +430,16 @@ func ExampleGlideClusterClient_XReadGroup() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
 
-	response, err := client.XReadGroup(group, consumer, map[string]string{key: "0"})
+	response, err := client.XReadGroup(context.Background(), group, consumer, map[string]string{key: "0"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -436,16 +455,17 @@ func ExampleGlideClient_XReadGroupWithOptions() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
 
 	options := options.NewXReadGroupOptions().SetNoAck()
-	response, err := client.XReadGroupWithOptions(group, consumer, map[string]string{key: ">"}, *options)
+	response, err := client.XReadGroupWithOptions(context.Background(), group, consumer, map[string]string{key: ">"}, *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -461,16 +481,17 @@ func ExampleGlideClusterClient_XReadGroupWithOptions() {
 	group := uuid.NewString()
 	consumer := uuid.NewString()
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
 
 	options := options.NewXReadGroupOptions().SetNoAck()
-	response, err := client.XReadGroupWithOptions(group, consumer, map[string]string{key: ">"}, *options)
+	response, err := client.XReadGroupWithOptions(context.Background(), group, consumer, map[string]string{key: ">"}, *options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -485,12 +506,13 @@ func ExampleGlideClient_XRead() {
 	streamId := "12345-1"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
 
-	response, err := client.XRead(map[string]string{key: "0-0"})
+	response, err := client.XRead(context.Background(), map[string]string{key: "0-0"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -505,12 +527,13 @@ func ExampleGlideClusterClient_XRead() {
 	streamId := "12345-1"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
 
-	response, err := client.XRead(map[string]string{key: "0-0"})
+	response, err := client.XRead(context.Background(), map[string]string{key: "0-0"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -527,17 +550,20 @@ func ExampleGlideClient_XReadWithOptions() {
 	genStreamId := func(key string, base int, offset int) string { return fmt.Sprintf("%s-%d", key, base+offset) } // helper function to generate stream ids
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(genStreamId(key, streambase, 0)),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field3", "value3"}, {"field4", "value4"}},
 		*options.NewXAddOptions().SetId(genStreamId(key, streambase, 1)),
 	)
 
 	response, err := client.XReadWithOptions(
+		context.Background(),
 		map[string]string{key: genStreamId(key, streambase, 0)},
 		*options.NewXReadOptions().SetCount(1),
 	)
@@ -557,17 +583,20 @@ func ExampleGlideClusterClient_XReadWithOptions() {
 	genStreamId := func(key string, base int, offset int) string { return fmt.Sprintf("%s-%d", key, base+offset) } // helper function to generate stream ids
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(genStreamId(key, streambase, 0)),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field3", "value3"}, {"field4", "value4"}},
 		*options.NewXAddOptions().SetId(genStreamId(key, streambase, 1)),
 	)
 
 	response, err := client.XReadWithOptions(
+		context.Background(),
 		map[string]string{key: genStreamId(key, streambase, 0)},
 		*options.NewXReadOptions().SetCount(1),
 	)
@@ -584,12 +613,13 @@ func ExampleGlideClient_XDel() {
 	key := "12345"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 
-	count, err := client.XDel(key, []string{"0-1", "0-2", "0-3"})
+	count, err := client.XDel(context.Background(), key, []string{"0-1", "0-2", "0-3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -603,12 +633,13 @@ func ExampleGlideClusterClient_XDel() {
 	key := "12345"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId("0-1"),
 	)
 
-	count, err := client.XDel(key, []string{"0-1", "0-2", "0-3"})
+	count, err := client.XDel(context.Background(), key, []string{"0-1", "0-2", "0-3"})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -624,16 +655,17 @@ func ExampleGlideClient_XPending() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	summary, err := client.XPending(key, group)
+	summary, err := client.XPending(context.Background(), key, group)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -650,16 +682,17 @@ func ExampleGlideClusterClient_XPending() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	summary, err := client.XPending(key, group)
+	summary, err := client.XPending(context.Background(), key, group)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -676,16 +709,18 @@ func ExampleGlideClient_XPendingWithOptions() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
 	details, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer),
@@ -718,16 +753,18 @@ func ExampleGlideClusterClient_XPendingWithOptions() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
 	details, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer),
@@ -760,20 +797,30 @@ func ExampleGlideClient_XGroupSetId() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
-	client.XAck(key, group, []string{streamId}) // ack the message and remove it from the pending list
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+	client.XAck(context.Background(), key, group, []string{streamId}) // ack the message and remove it from the pending list
 
-	client.XGroupSetId(key, group, "0-0")                           // reset the last acknowledged message to 0-0
-	client.XReadGroup(group, consumer, map[string]string{key: ">"}) // read the group again
+	client.XGroupSetId(
+		context.Background(),
+		key,
+		group,
+		"0-0",
+	) // reset the last acknowledged message to 0-0
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"}) // read the group again
 
-	summary, err := client.XPending(key, group) // get the pending messages, which should include the entry we previously acked
+	summary, err := client.XPending(
+		context.Background(),
+		key,
+		group,
+	) // get the pending messages, which should include the entry we previously acked
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -790,20 +837,30 @@ func ExampleGlideClusterClient_XGroupSetId() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
-	client.XAck(key, group, []string{streamId}) // ack the message and remove it from the pending list
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+	client.XAck(context.Background(), key, group, []string{streamId}) // ack the message and remove it from the pending list
 
-	client.XGroupSetId(key, group, "0-0")                           // reset the last acknowledged message to 0-0
-	client.XReadGroup(group, consumer, map[string]string{key: ">"}) // read the group again
+	client.XGroupSetId(
+		context.Background(),
+		key,
+		group,
+		"0-0",
+	) // reset the last acknowledged message to 0-0
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"}) // read the group again
 
-	summary, err := client.XPending(key, group) // get the pending messages, which should include the entry we previously acked
+	summary, err := client.XPending(
+		context.Background(),
+		key,
+		group,
+	) // get the pending messages, which should include the entry we previously acked
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -821,26 +878,43 @@ func ExampleGlideClient_XGroupSetIdWithOptions() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId1),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field3", "value3"}, {"field4", "value4"}},
 		*options.NewXAddOptions().SetId(streamId2),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
-	client.XAck(key, group, []string{streamId1, streamId2}) // ack the message and remove it from the pending list
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+	client.XAck(
+		context.Background(),
+		key,
+		group,
+		[]string{streamId1, streamId2},
+	) // ack the message and remove it from the pending list
 
 	opts := options.NewXGroupSetIdOptionsOptions().SetEntriesRead(1)
-	client.XGroupSetIdWithOptions(key, group, "0-0", *opts)         // reset the last acknowledged message to 0-0
-	client.XReadGroup(group, consumer, map[string]string{key: ">"}) // read the group again
+	client.XGroupSetIdWithOptions(
+		context.Background(),
+		key,
+		group,
+		"0-0",
+		*opts,
+	) // reset the last acknowledged message to 0-0
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"}) // read the group again
 
-	summary, err := client.XPending(key, group) // get the pending messages, which should include the entry we previously acked
+	summary, err := client.XPending(
+		context.Background(),
+		key,
+		group,
+	) // get the pending messages, which should include the entry we previously acked
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -858,26 +932,43 @@ func ExampleGlideClusterClient_XGroupSetIdWithOptions() {
 	group := "g12345"
 	consumer := "c12345"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId1),
 	)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field3", "value3"}, {"field4", "value4"}},
 		*options.NewXAddOptions().SetId(streamId2),
 	)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
-	client.XAck(key, group, []string{streamId1, streamId2}) // ack the message and remove it from the pending list
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
+	client.XAck(
+		context.Background(),
+		key,
+		group,
+		[]string{streamId1, streamId2},
+	) // ack the message and remove it from the pending list
 
 	opts := options.NewXGroupSetIdOptionsOptions().SetEntriesRead(1)
-	client.XGroupSetIdWithOptions(key, group, "0-0", *opts)         // reset the last acknowledged message to 0-0
-	client.XReadGroup(group, consumer, map[string]string{key: ">"}) // read the group again
+	client.XGroupSetIdWithOptions(
+		context.Background(),
+		key,
+		group,
+		"0-0",
+		*opts,
+	) // reset the last acknowledged message to 0-0
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"}) // read the group again
 
-	summary, err := client.XPending(key, group) // get the pending messages, which should include the entry we previously acked
+	summary, err := client.XPending(
+		context.Background(),
+		key,
+		group,
+	) // get the pending messages, which should include the entry we previously acked
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -894,12 +985,13 @@ func ExampleGlideClient_XGroupCreate() {
 	group := "g12345"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	) // This will create the stream if it does not exist
 
-	response, err := client.XGroupCreate(key, group, "0") // create the group (no MKSTREAM needed)
+	response, err := client.XGroupCreate(context.Background(), key, group, "0") // create the group (no MKSTREAM needed)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -915,12 +1007,13 @@ func ExampleGlideClusterClient_XGroupCreate() {
 	group := "g12345"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	) // This will create the stream if it does not exist
 
-	response, err := client.XGroupCreate(key, group, "0") // create the group (no MKSTREAM needed)
+	response, err := client.XGroupCreate(context.Background(), key, group, "0") // create the group (no MKSTREAM needed)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -935,7 +1028,13 @@ func ExampleGlideClient_XGroupCreateWithOptions() {
 	group := "g12345"
 
 	opts := options.NewXGroupCreateOptions().SetMakeStream()
-	response, err := client.XGroupCreateWithOptions(key, group, "0", *opts) // create the group (no MKSTREAM needed)
+	response, err := client.XGroupCreateWithOptions(
+		context.Background(),
+		key,
+		group,
+		"0",
+		*opts,
+	) // create the group (no MKSTREAM needed)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -950,7 +1049,13 @@ func ExampleGlideClusterClient_XGroupCreateWithOptions() {
 	group := "g12345"
 
 	opts := options.NewXGroupCreateOptions().SetMakeStream()
-	response, err := client.XGroupCreateWithOptions(key, group, "0", *opts) // create the group (no MKSTREAM needed)
+	response, err := client.XGroupCreateWithOptions(
+		context.Background(),
+		key,
+		group,
+		"0",
+		*opts,
+	) // create the group (no MKSTREAM needed)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -965,9 +1070,9 @@ func ExampleGlideClient_XGroupDestroy() {
 	group := "g12345"
 
 	opts := options.NewXGroupCreateOptions().SetMakeStream()
-	client.XGroupCreateWithOptions(key, group, "0", *opts) // create the group (no MKSTREAM needed)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *opts) // create the group (no MKSTREAM needed)
 
-	success, err := client.XGroupDestroy(key, group) // destroy the group
+	success, err := client.XGroupDestroy(context.Background(), key, group) // destroy the group
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -982,9 +1087,9 @@ func ExampleGlideClusterClient_XGroupDestroy() {
 	group := "g12345"
 
 	opts := options.NewXGroupCreateOptions().SetMakeStream()
-	client.XGroupCreateWithOptions(key, group, "0", *opts) // create the group (no MKSTREAM needed)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *opts) // create the group (no MKSTREAM needed)
 
-	success, err := client.XGroupDestroy(key, group) // destroy the group
+	success, err := client.XGroupDestroy(context.Background(), key, group) // destroy the group
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1000,9 +1105,9 @@ func ExampleGlideClient_XGroupCreateConsumer() {
 	consumer := "c12345"
 
 	opts := options.NewXGroupCreateOptions().SetMakeStream()
-	client.XGroupCreateWithOptions(key, group, "0", *opts) // create the group (no MKSTREAM needed)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *opts) // create the group (no MKSTREAM needed)
 
-	success, err := client.XGroupCreateConsumer(key, group, consumer)
+	success, err := client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1018,9 +1123,9 @@ func ExampleGlideClusterClient_XGroupCreateConsumer() {
 	consumer := "c12345"
 
 	opts := options.NewXGroupCreateOptions().SetMakeStream()
-	client.XGroupCreateWithOptions(key, group, "0", *opts) // create the group (no MKSTREAM needed)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *opts) // create the group (no MKSTREAM needed)
 
-	success, err := client.XGroupCreateConsumer(key, group, consumer)
+	success, err := client.XGroupCreateConsumer(context.Background(), key, group, consumer)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1037,15 +1142,16 @@ func ExampleGlideClient_XGroupDelConsumer() {
 	streamId := "12345-1"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XGroupCreate(key, group, "0")
-	client.XGroupCreateConsumer(key, group, consumer)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XGroupCreate(context.Background(), key, group, "0")
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	count, err := client.XGroupDelConsumer(key, group, consumer)
+	count, err := client.XGroupDelConsumer(context.Background(), key, group, consumer)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1063,15 +1169,16 @@ func ExampleGlideClusterClient_XGroupDelConsumer() {
 	streamId := "12345-1"
 
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"field1", "value1"}, {"field2", "value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XGroupCreate(key, group, "0")
-	client.XGroupCreateConsumer(key, group, consumer)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XGroupCreate(context.Background(), key, group, "0")
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	count, err := client.XGroupDelConsumer(key, group, consumer)
+	count, err := client.XGroupDelConsumer(context.Background(), key, group, consumer)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1088,14 +1195,20 @@ func ExampleGlideClient_XAck() {
 	consumer := "c12345"
 
 	streamId, _ := client.XAdd(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 	)
-	client.XGroupCreate(key, group, "0")
-	client.XGroupCreateConsumer(key, group, consumer)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XGroupCreate(context.Background(), key, group, "0")
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	count, err := client.XAck(key, group, []string{streamId.Value()}) // ack the message and remove it from the pending list
+	count, err := client.XAck(
+		context.Background(),
+		key,
+		group,
+		[]string{streamId.Value()},
+	) // ack the message and remove it from the pending list
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1111,14 +1224,20 @@ func ExampleGlideClusterClient_XAck() {
 	consumer := "c12345"
 
 	streamId, _ := client.XAdd(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 	)
-	client.XGroupCreate(key, group, "0")
-	client.XGroupCreateConsumer(key, group, consumer)
-	client.XReadGroup(group, consumer, map[string]string{key: ">"})
+	client.XGroupCreate(context.Background(), key, group, "0")
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer)
+	client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 
-	count, err := client.XAck(key, group, []string{streamId.Value()}) // ack the message and remove it from the pending list
+	count, err := client.XAck(
+		context.Background(),
+		key,
+		group,
+		[]string{streamId.Value()},
+	) // ack the message and remove it from the pending list
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1135,16 +1254,18 @@ func ExampleGlideClient_XClaim() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1157,7 +1278,7 @@ func ExampleGlideClient_XClaim() {
 		return
 	}
 
-	response, err := client.XClaim(key, group, consumer2, result[0].IdleTime, []string{result[0].Id})
+	response, err := client.XClaim(context.Background(), key, group, consumer2, result[0].IdleTime, []string{result[0].Id})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1174,16 +1295,18 @@ func ExampleGlideClusterClient_XClaim() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1196,7 +1319,7 @@ func ExampleGlideClusterClient_XClaim() {
 		return
 	}
 
-	response, err := client.XClaim(key, group, consumer2, result[0].IdleTime, []string{result[0].Id})
+	response, err := client.XClaim(context.Background(), key, group, consumer2, result[0].IdleTime, []string{result[0].Id})
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1213,16 +1336,18 @@ func ExampleGlideClient_XClaimWithOptions() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1236,7 +1361,15 @@ func ExampleGlideClient_XClaimWithOptions() {
 	}
 
 	opts := options.NewXClaimOptions().SetRetryCount(3)
-	response, err := client.XClaimWithOptions(key, group, consumer2, result[0].IdleTime, []string{result[0].Id}, *opts)
+	response, err := client.XClaimWithOptions(
+		context.Background(),
+		key,
+		group,
+		consumer2,
+		result[0].IdleTime,
+		[]string{result[0].Id},
+		*opts,
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1253,16 +1386,18 @@ func ExampleGlideClusterClient_XClaimWithOptions() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1276,7 +1411,15 @@ func ExampleGlideClusterClient_XClaimWithOptions() {
 	}
 
 	opts := options.NewXClaimOptions().SetRetryCount(3)
-	response, err := client.XClaimWithOptions(key, group, consumer2, result[0].IdleTime, []string{result[0].Id}, *opts)
+	response, err := client.XClaimWithOptions(
+		context.Background(),
+		key,
+		group,
+		consumer2,
+		result[0].IdleTime,
+		[]string{result[0].Id},
+		*opts,
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1293,16 +1436,18 @@ func ExampleGlideClient_XClaimJustId() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1315,7 +1460,14 @@ func ExampleGlideClient_XClaimJustId() {
 		return
 	}
 
-	response, err := client.XClaimJustId(key, group, consumer2, result[0].IdleTime, []string{result[0].Id})
+	response, err := client.XClaimJustId(
+		context.Background(),
+		key,
+		group,
+		consumer2,
+		result[0].IdleTime,
+		[]string{result[0].Id},
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1332,16 +1484,18 @@ func ExampleGlideClusterClient_XClaimJustId() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1354,7 +1508,14 @@ func ExampleGlideClusterClient_XClaimJustId() {
 		return
 	}
 
-	response, err := client.XClaimJustId(key, group, consumer2, result[0].IdleTime, []string{result[0].Id})
+	response, err := client.XClaimJustId(
+		context.Background(),
+		key,
+		group,
+		consumer2,
+		result[0].IdleTime,
+		[]string{result[0].Id},
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1371,16 +1532,18 @@ func ExampleGlideClient_XClaimJustIdWithOptions() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1394,7 +1557,15 @@ func ExampleGlideClient_XClaimJustIdWithOptions() {
 	}
 
 	opts := options.NewXClaimOptions().SetRetryCount(3)
-	response, err := client.XClaimJustIdWithOptions(key, group, consumer2, result[0].IdleTime, []string{result[0].Id}, *opts)
+	response, err := client.XClaimJustIdWithOptions(
+		context.Background(),
+		key,
+		group,
+		consumer2,
+		result[0].IdleTime,
+		[]string{result[0].Id},
+		*opts,
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1411,16 +1582,18 @@ func ExampleGlideClusterClient_XClaimJustIdWithOptions() {
 	consumer1 := "c12345-1"
 	consumer2 := "c12345-2"
 
-	client.XGroupCreateWithOptions(key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
-	client.XGroupCreateConsumer(key, group, consumer1)
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateConsumer(context.Background(), key, group, consumer1)
 	client.XAddWithOptions(
+		context.Background(),
 		key,
 		[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 		*options.NewXAddOptions().SetId(streamId),
 	)
-	client.XReadGroup(group, consumer1, map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, consumer1, map[string]string{key: ">"})
 
 	result, err := client.XPendingWithOptions(
+		context.Background(),
 		key,
 		group,
 		*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -1434,7 +1607,15 @@ func ExampleGlideClusterClient_XClaimJustIdWithOptions() {
 	}
 
 	opts := options.NewXClaimOptions().SetRetryCount(3)
-	response, err := client.XClaimJustIdWithOptions(key, group, consumer2, result[0].IdleTime, []string{result[0].Id}, *opts)
+	response, err := client.XClaimJustIdWithOptions(
+		context.Background(),
+		key,
+		group,
+		consumer2,
+		result[0].IdleTime,
+		[]string{result[0].Id},
+		*opts,
+	)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1447,10 +1628,10 @@ func ExampleGlideClient_XRange() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	key := "12345"
 
-	client.XAdd(key, [][]string{{"field1", "value1"}})
-	client.XAdd(key, [][]string{{"field2", "value2"}})
+	client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
+	client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 
-	response, err := client.XRange(key,
+	response, err := client.XRange(context.Background(), key,
 		options.NewInfiniteStreamBoundary(options.NegativeInfinity),
 		options.NewInfiniteStreamBoundary(options.PositiveInfinity))
 	if err != nil {
@@ -1465,10 +1646,10 @@ func ExampleGlideClusterClient_XRange() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	key := "12345"
 
-	client.XAdd(key, [][]string{{"field1", "value1"}})
-	client.XAdd(key, [][]string{{"field2", "value2"}})
+	client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
+	client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 
-	response, err := client.XRange(key,
+	response, err := client.XRange(context.Background(), key,
 		options.NewInfiniteStreamBoundary(options.NegativeInfinity),
 		options.NewInfiniteStreamBoundary(options.PositiveInfinity))
 	if err != nil {
@@ -1483,10 +1664,10 @@ func ExampleGlideClient_XRangeWithOptions() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	key := "12345"
 
-	streamId1, _ := client.XAdd(key, [][]string{{"field1", "value1"}})
-	streamId2, _ := client.XAdd(key, [][]string{{"field2", "value2"}})
+	streamId1, _ := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
+	streamId2, _ := client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 
-	response, err := client.XRangeWithOptions(key,
+	response, err := client.XRangeWithOptions(context.Background(), key,
 		options.NewStreamBoundary(streamId1.Value(), true),
 		options.NewStreamBoundary(streamId2.Value(), true),
 		*options.NewXRangeOptions().SetCount(1))
@@ -1502,10 +1683,10 @@ func ExampleGlideClusterClient_XRangeWithOptions() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
 	key := "12345"
 
-	streamId1, _ := client.XAdd(key, [][]string{{"field1", "value1"}})
-	streamId2, _ := client.XAdd(key, [][]string{{"field2", "value2"}})
+	streamId1, _ := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
+	streamId2, _ := client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 
-	response, err := client.XRangeWithOptions(key,
+	response, err := client.XRangeWithOptions(context.Background(), key,
 		options.NewStreamBoundary(streamId1.Value(), true),
 		options.NewStreamBoundary(streamId2.Value(), true),
 		*options.NewXRangeOptions().SetCount(1))
@@ -1523,10 +1704,20 @@ func ExampleGlideClient_XRevRange() {
 	streamId1 := "12345-1"
 	streamId2 := "12345-2"
 
-	client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId(streamId1))
-	client.XAddWithOptions(key, [][]string{{"field2", "value2"}}, *options.NewXAddOptions().SetId(streamId2))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field1", "value1"}},
+		*options.NewXAddOptions().SetId(streamId1),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field2", "value2"}},
+		*options.NewXAddOptions().SetId(streamId2),
+	)
 
-	response, err := client.XRevRange(key,
+	response, err := client.XRevRange(context.Background(), key,
 		options.NewStreamBoundary(streamId2, true),
 		options.NewStreamBoundary(streamId1, true))
 	if err != nil {
@@ -1543,10 +1734,20 @@ func ExampleGlideClusterClient_XRevRange() {
 	streamId1 := "12345-1"
 	streamId2 := "12345-2"
 
-	client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId(streamId1))
-	client.XAddWithOptions(key, [][]string{{"field2", "value2"}}, *options.NewXAddOptions().SetId(streamId2))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field1", "value1"}},
+		*options.NewXAddOptions().SetId(streamId1),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field2", "value2"}},
+		*options.NewXAddOptions().SetId(streamId2),
+	)
 
-	response, err := client.XRevRange(key,
+	response, err := client.XRevRange(context.Background(), key,
 		options.NewStreamBoundary(streamId2, true),
 		options.NewStreamBoundary(streamId1, true))
 	if err != nil {
@@ -1563,10 +1764,20 @@ func ExampleGlideClient_XRevRangeWithOptions() {
 	streamId1 := "12345-1"
 	streamId2 := "12345-2"
 
-	client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId(streamId1))
-	client.XAddWithOptions(key, [][]string{{"field2", "value2"}}, *options.NewXAddOptions().SetId(streamId2))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field1", "value1"}},
+		*options.NewXAddOptions().SetId(streamId1),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field2", "value2"}},
+		*options.NewXAddOptions().SetId(streamId2),
+	)
 
-	response, err := client.XRevRangeWithOptions(key,
+	response, err := client.XRevRangeWithOptions(context.Background(), key,
 		options.NewStreamBoundary(streamId2, true),
 		options.NewStreamBoundary(streamId1, true),
 		*options.NewXRangeOptions().SetCount(2))
@@ -1584,10 +1795,20 @@ func ExampleGlideClusterClient_XRevRangeWithOptions() {
 	streamId1 := "12345-1"
 	streamId2 := "12345-2"
 
-	client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId(streamId1))
-	client.XAddWithOptions(key, [][]string{{"field2", "value2"}}, *options.NewXAddOptions().SetId(streamId2))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field1", "value1"}},
+		*options.NewXAddOptions().SetId(streamId1),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field2", "value2"}},
+		*options.NewXAddOptions().SetId(streamId2),
+	)
 
-	response, err := client.XRevRangeWithOptions(key,
+	response, err := client.XRevRangeWithOptions(context.Background(), key,
 		options.NewStreamBoundary(streamId2, true),
 		options.NewStreamBoundary(streamId1, true),
 		*options.NewXRangeOptions().SetCount(2))
@@ -1604,8 +1825,13 @@ func ExampleGlideClient_XInfoStream() {
 	key := "12345"
 	streamId1 := "12345-1"
 
-	client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId(streamId1))
-	response, err := client.XInfoStream(key)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field1", "value1"}},
+		*options.NewXAddOptions().SetId(streamId1),
+	)
+	response, err := client.XInfoStream(context.Background(), key)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1644,8 +1870,13 @@ func ExampleGlideClusterClient_XInfoStream() {
 	key := "12345"
 	streamId1 := "12345-1"
 
-	client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId(streamId1))
-	response, err := client.XInfoStream(key)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"field1", "value1"}},
+		*options.NewXAddOptions().SetId(streamId1),
+	)
+	response, err := client.XInfoStream(context.Background(), key)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1688,11 +1919,16 @@ func ExampleGlideClient_XInfoStreamFullWithOptions() {
 		value := fmt.Sprintf("value%d", i)
 		streamId := fmt.Sprintf("%s-%d", key, i)
 
-		client.XAddWithOptions(key, [][]string{{field, value}}, *options.NewXAddOptions().SetId(streamId))
+		client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{field, value}},
+			*options.NewXAddOptions().SetId(streamId),
+		)
 	}
 
 	options := options.NewXInfoStreamOptionsOptions().SetCount(2)
-	response, err := client.XInfoStreamFullWithOptions(key, options)
+	response, err := client.XInfoStreamFullWithOptions(context.Background(), key, options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1738,11 +1974,16 @@ func ExampleGlideClusterClient_XInfoStreamFullWithOptions() {
 		value := fmt.Sprintf("value%d", i)
 		streamId := fmt.Sprintf("%s-%d", key, i)
 
-		client.XAddWithOptions(key, [][]string{{field, value}}, *options.NewXAddOptions().SetId(streamId))
+		client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{field, value}},
+			*options.NewXAddOptions().SetId(streamId),
+		)
 	}
 
 	options := options.NewXInfoStreamOptionsOptions().SetCount(2)
-	response, err := client.XInfoStreamFullWithOptions(key, options)
+	response, err := client.XInfoStreamFullWithOptions(context.Background(), key, options)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1785,15 +2026,25 @@ func ExampleGlideClient_XInfoConsumers() {
 	group := "myGroup"
 
 	// create an empty stream with a group
-	client.XGroupCreateWithOptions(key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
 	// add couple of entries
-	client.XAddWithOptions(key, [][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}}, *options.NewXAddOptions().SetId("0-1"))
-	client.XAddWithOptions(key, [][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}}, *options.NewXAddOptions().SetId("0-2"))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}},
+		*options.NewXAddOptions().SetId("0-2"),
+	)
 	// read them
-	client.XReadGroup(group, "myConsumer", map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, "myConsumer", map[string]string{key: ">"})
 	// get the info
 	time.Sleep(100 * time.Millisecond)
-	response, err := client.XInfoConsumers(key, group)
+	response, err := client.XInfoConsumers(context.Background(), key, group)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1818,15 +2069,31 @@ func ExampleGlideClusterClient_XInfoConsumers() {
 	consumer := "myConsumer"
 
 	// create an empty stream with a group
-	client.XGroupCreateWithOptions(key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
 	// add couple of entries
-	client.XAddWithOptions(key, [][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}}, *options.NewXAddOptions().SetId("0-1"))
-	client.XAddWithOptions(key, [][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}}, *options.NewXAddOptions().SetId("0-2"))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}},
+		*options.NewXAddOptions().SetId("0-2"),
+	)
 	// read them
-	client.XReadGroupWithOptions(group, consumer, map[string]string{key: ">"}, *options.NewXReadGroupOptions().SetCount(1))
+	client.XReadGroupWithOptions(
+		context.Background(),
+		group,
+		consumer,
+		map[string]string{key: ">"},
+		*options.NewXReadGroupOptions().SetCount(1),
+	)
 	// get the info
 	time.Sleep(100 * time.Millisecond)
-	response, err := client.XInfoConsumers(key, group)
+	response, err := client.XInfoConsumers(context.Background(), key, group)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1850,14 +2117,24 @@ func ExampleGlideClient_XInfoGroups() {
 	group := "myGroup"
 
 	// create an empty stream with a group
-	client.XGroupCreateWithOptions(key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
 	// add couple of entries
-	client.XAddWithOptions(key, [][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}}, *options.NewXAddOptions().SetId("0-1"))
-	client.XAddWithOptions(key, [][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}}, *options.NewXAddOptions().SetId("0-2"))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}},
+		*options.NewXAddOptions().SetId("0-2"),
+	)
 	// read them
-	client.XReadGroup(group, "myConsumer", map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, "myConsumer", map[string]string{key: ">"})
 	// get the info
-	response, err := client.XInfoGroups(key)
+	response, err := client.XInfoGroups(context.Background(), key)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -1886,14 +2163,24 @@ func ExampleGlideClusterClient_XInfoGroups() {
 	group := "myGroup"
 
 	// create an empty stream with a group
-	client.XGroupCreateWithOptions(key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
 	// add couple of entries
-	client.XAddWithOptions(key, [][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}}, *options.NewXAddOptions().SetId("0-1"))
-	client.XAddWithOptions(key, [][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}}, *options.NewXAddOptions().SetId("0-2"))
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}},
+		*options.NewXAddOptions().SetId("0-1"),
+	)
+	client.XAddWithOptions(
+		context.Background(),
+		key,
+		[][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}},
+		*options.NewXAddOptions().SetId("0-2"),
+	)
 	// read them
-	client.XReadGroup(group, "myConsumer", map[string]string{key: ">"})
+	client.XReadGroup(context.Background(), group, "myConsumer", map[string]string{key: ">"})
 	// get the info
-	response, err := client.XInfoGroups(key)
+	response, err := client.XInfoGroups(context.Background(), key)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
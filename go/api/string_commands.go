@@ -3,6 +3,8 @@
 package api
 
 import (
+	"context"
+
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
@@ -12,45 +14,53 @@ import (
 //
 // [valkey.io]: https://valkey.io/commands/#string
 type StringCommands interface {
-	Set(key string, value string) (string, error)
+	Set(ctx context.Context, key string, value string) (string, error)
+
+	SetBytes(ctx context.Context, key []byte, value []byte) (string, error)
+
+	SetWithOptions(ctx context.Context, key string, value string, options options.SetOptions) (Result[string], error)
+
+	Get(ctx context.Context, key string) (Result[string], error)
+
+	GetBytes(ctx context.Context, key []byte) (Result[[]byte], error)
 
-	SetWithOptions(key string, value string, options options.SetOptions) (Result[string], error)
+	GetEx(ctx context.Context, key string) (Result[string], error)
 
-	Get(key string) (Result[string], error)
+	GetExWithOptions(ctx context.Context, key string, options options.GetExOptions) (Result[string], error)
 
-	GetEx(key string) (Result[string], error)
+	MSet(ctx context.Context, keyValueMap map[string]string) (string, error)
 
-	GetExWithOptions(key string, options options.GetExOptions) (Result[string], error)
+	MGet(ctx context.Context, keys []string) ([]Result[string], error)
 
-	MSet(keyValueMap map[string]string) (string, error)
+	MGetBytes(ctx context.Context, keys [][]byte) ([]Result[[]byte], error)
 
-	MGet(keys []string) ([]Result[string], error)
+	MSetNX(ctx context.Context, keyValueMap map[string]string) (bool, error)
 
-	MSetNX(keyValueMap map[string]string) (bool, error)
+	Incr(ctx context.Context, key string) (int64, error)
 
-	Incr(key string) (int64, error)
+	IncrBy(ctx context.Context, key string, amount int64) (int64, error)
 
-	IncrBy(key string, amount int64) (int64, error)
+	IncrByFloat(ctx context.Context, key string, amount float64) (float64, error)
 
-	IncrByFloat(key string, amount float64) (float64, error)
+	Decr(ctx context.Context, key string) (int64, error)
 
-	Decr(key string) (int64, error)
+	DecrBy(ctx context.Context, key string, amount int64) (int64, error)
 
-	DecrBy(key string, amount int64) (int64, error)
+	Strlen(ctx context.Context, key string) (int64, error)
 
-	Strlen(key string) (int64, error)
+	SetRange(ctx context.Context, key string, offset int, value string) (int64, error)
 
-	SetRange(key string, offset int, value string) (int64, error)
+	GetRange(ctx context.Context, key string, start int, end int) (string, error)
 
-	GetRange(key string, start int, end int) (string, error)
+	Append(ctx context.Context, key string, value string) (int64, error)
 
-	Append(key string, value string) (int64, error)
+	LCS(ctx context.Context, key1 string, key2 string) (string, error)
 
-	LCS(key1 string, key2 string) (string, error)
+	LCSLen(ctx context.Context, key1 string, key2 string) (int64, error)
 
-	LCSLen(key1 string, key2 string) (int64, error)
+	LCSWithOptions(ctx context.Context, key1, key2 string, opts options.LCSIdxOptions) (map[string]interface{}, error)
 
-	LCSWithOptions(key1, key2 string, opts options.LCSIdxOptions) (map[string]interface{}, error)
+	GetDel(ctx context.Context, key string) (Result[string], error)
 
-	GetDel(key string) (Result[string], error)
+	GetDelBytes(ctx context.Context, key []byte) (Result[[]byte], error)
 }
@@ -3,12 +3,13 @@
 package api
 
 import (
+	"context"
 	"fmt"
 )
 
 func ExampleGlideClient_UpdateConnectionPassword() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	response, err := client.UpdateConnectionPassword("", false)
+	response, err := client.UpdateConnectionPassword(context.Background(), "", false)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -19,7 +20,7 @@ func ExampleGlideClient_UpdateConnectionPassword() {
 
 func ExampleGlideClient_ResetConnectionPassword() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
-	response, err := client.ResetConnectionPassword()
+	response, err := client.ResetConnectionPassword(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -30,7 +31,7 @@ func ExampleGlideClient_ResetConnectionPassword() {
 
 func ExampleGlideClusterClient_UpdateConnectionPassword() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	response, err := client.UpdateConnectionPassword("", false)
+	response, err := client.UpdateConnectionPassword(context.Background(), "", false)
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -41,7 +42,7 @@ func ExampleGlideClusterClient_UpdateConnectionPassword() {
 
 func ExampleGlideClusterClient_ResetConnectionPassword() {
 	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
-	response, err := client.ResetConnectionPassword()
+	response, err := client.ResetConnectionPassword(context.Background())
 	if err != nil {
 		fmt.Println("Glide example failed with an error: ", err)
 	}
@@ -0,0 +1,227 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// urlConfiguration holds the settings parsed from a connection URL.
+type urlConfiguration struct {
+	baseClientConfiguration
+	databaseId int
+}
+
+// ParseURL returns a [GlideClientConfiguration] for a standalone client, with the settings of the given connection URL.
+// The URL has the following format, where the parts in brackets are optional:
+//
+//	scheme://[[username]:password@]host[:port][,host[:port]...][/database][?option=value[&option=value...]]
+//
+// The scheme is valkey:// or redis:// for plain connections, and valkeys:// or rediss:// for TLS connections. The port
+// defaults to [DefaultPort], and the username to "default" if only a password is given. Special characters in the
+// username and the password must be percent-encoded. The following options are supported:
+//   - tls - true to use TLS with the valkey:// and redis:// schemes.
+//   - read_from - the [ReadFrom] strategy, one of primary, preferReplica, AZAffinity and AZAffinityReplicasAndPrimary.
+//   - client_az - the Availability Zone of the client, required by the AZ affinity strategies.
+//   - client_name - the name of the client, set with CLIENT SETNAME.
+//   - timeout - the request timeout in milliseconds, see WithRequestTimeout.
+//   - connection_timeout - the connection timeout in milliseconds, unless another one is set with WithConnectionTimeout
+//     in the advanced configuration.
+//
+// For example:
+//
+//	config, err := api.ParseURL(os.Getenv("VALKEY_URL"))
+//	if err != nil {
+//	    return err
+//	}
+//	client, err := api.NewGlideClient(config.WithReconnectStrategy(api.NewBackoffStrategy(5, 10, 2)))
+func ParseURL(rawURL string) (*GlideClientConfiguration, error) {
+	parsed, err := parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	config := &GlideClientConfiguration{
+		baseClientConfiguration: parsed.baseClientConfiguration,
+		databaseId:              parsed.databaseId,
+	}
+	return config, nil
+}
+
+// ParseClusterURL returns a [GlideClusterClientConfiguration] for a cluster client, with the settings of the given
+// connection URL. The URL has the same format as for [ParseURL], where the hosts are the addresses of some nodes of the
+// cluster, and without a database since cluster clients always use database 0.
+func ParseClusterURL(rawURL string) (*GlideClusterClientConfiguration, error) {
+	parsed, err := parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.databaseId != 0 {
+		return nil, errors.New("the connection URL of a cluster client must not set a database")
+	}
+	return &GlideClusterClientConfiguration{baseClientConfiguration: parsed.baseClientConfiguration}, nil
+}
+
+func parseURL(rawURL string) (*urlConfiguration, error) {
+	// the hosts are parsed separately, since url.Parse accepts a single host
+	hosts, rawURL := splitHosts(rawURL)
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		// the error of url.Parse contains the URL, which may contain a password
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("invalid connection URL: %v", err)
+	}
+
+	config := &urlConfiguration{}
+	switch parsedURL.Scheme {
+	case "valkey", "redis":
+	case "valkeys", "rediss":
+		config.useTLS = true
+	default:
+		return nil, fmt.Errorf(
+			"unsupported connection URL scheme %q, expected valkey, valkeys, redis or rediss",
+			parsedURL.Scheme,
+		)
+	}
+
+	if hosts == "" {
+		config.addresses = []NodeAddress{{}}
+	}
+	for _, hostPort := range strings.Split(hosts, ",") {
+		if hostPort == "" {
+			continue
+		}
+		address, err := parseHostPort(hostPort)
+		if err != nil {
+			return nil, err
+		}
+		config.addresses = append(config.addresses, address)
+	}
+
+	if parsedURL.User != nil {
+		username := parsedURL.User.Username()
+		password, hasPassword := parsedURL.User.Password()
+		switch {
+		case !hasPassword:
+			return nil, errors.New("the connection URL sets a username without a password")
+		case username == "":
+			config.credentials = NewServerCredentialsWithDefaultUsername(password)
+		default:
+			config.credentials = NewServerCredentials(username, password)
+		}
+	}
+
+	if database := strings.TrimPrefix(parsedURL.Path, "/"); database != "" {
+		config.databaseId, err = strconv.Atoi(database)
+		if err != nil || config.databaseId < 0 {
+			return nil, fmt.Errorf("invalid database %q in the connection URL", database)
+		}
+	}
+
+	options, err := url.ParseQuery(parsedURL.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options in the connection URL: %v", err)
+	}
+	for name, values := range options {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("the option %q is set more than once in the connection URL", name)
+		}
+		if err := config.setOption(name, values[0]); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// splitHosts returns the comma-separated hosts of the URL, and the URL without them.
+func splitHosts(rawURL string) (string, string) {
+	schemeEnd := strings.Index(rawURL, "://")
+	if schemeEnd < 0 {
+		return "", rawURL
+	}
+	authorityStart := schemeEnd + len("://")
+	authorityEnd := len(rawURL)
+	if i := strings.IndexAny(rawURL[authorityStart:], "/?#"); i >= 0 {
+		authorityEnd = authorityStart + i
+	}
+	hostsStart := authorityStart
+	if i := strings.LastIndex(rawURL[authorityStart:authorityEnd], "@"); i >= 0 {
+		hostsStart = authorityStart + i + 1
+	}
+	return rawURL[hostsStart:authorityEnd], rawURL[:hostsStart] + rawURL[authorityEnd:]
+}
+
+func parseHostPort(hostPort string) (NodeAddress, error) {
+	// the port is optional, and IPv6 addresses are enclosed in brackets
+	if strings.LastIndex(hostPort, ":") <= strings.LastIndex(hostPort, "]") {
+		return NodeAddress{Host: strings.Trim(hostPort, "[]")}, nil
+	}
+	host, portString, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return NodeAddress{}, fmt.Errorf("invalid address %q in the connection URL: %v", hostPort, err)
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil || port <= 0 || port > 65535 {
+		return NodeAddress{}, fmt.Errorf("invalid port %q in the connection URL", portString)
+	}
+	return NodeAddress{Host: host, Port: port}, nil
+}
+
+func (config *urlConfiguration) setOption(name string, value string) error {
+	var err error
+	switch name {
+	case "tls":
+		var useTLS bool
+		useTLS, err = strconv.ParseBool(value)
+		if err == nil && config.useTLS && !useTLS {
+			return errors.New("the connection URL disables TLS with a TLS scheme")
+		}
+		config.useTLS = config.useTLS || useTLS
+	case "read_from":
+		config.readFrom, err = parseReadFrom(value)
+	case "client_az":
+		config.clientAZ = value
+	case "client_name":
+		config.clientName = value
+	case "timeout":
+		config.requestTimeout, err = parseTimeout(value)
+	case "connection_timeout":
+		config.urlConnectionTimeout, err = parseTimeout(value)
+	default:
+		return fmt.Errorf("unsupported option %q in the connection URL", name)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q of the option %q in the connection URL", value, name)
+	}
+	return nil
+}
+
+func parseReadFrom(value string) (ReadFrom, error) {
+	switch strings.ToLower(value) {
+	case "primary":
+		return Primary, nil
+	case "preferreplica":
+		return PreferReplica, nil
+	case "azaffinity":
+		return AzAffinity, nil
+	case "azaffinityreplicasandprimary":
+		return AzAffinityReplicaAndPrimary, nil
+	default:
+		return Primary, errors.New("unknown read from strategy")
+	}
+}
+
+func parseTimeout(value string) (int, error) {
+	timeout, err := strconv.Atoi(value)
+	if err == nil && timeout <= 0 {
+		err = errors.New("the timeout must be positive")
+	}
+	return timeout, err
+}
@@ -0,0 +1,204 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func (suite *GlideTestSuite) TestAclSetUserAndGetUser() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		username := "user_" + uuid.NewString()
+		defer client.AclDelUser(context.Background(), []string{username})
+
+		rules := options.NewAclRuleBuilder().
+			Reset().
+			On().
+			AddPassword("secret").
+			KeyPattern("tenant1:*").
+			ChannelPattern("tenant1:*").
+			AllowCategory("read").
+			AllowCommand("set").
+			Selector(options.NewAclRuleBuilder().ReadKeyPattern("shared:*").AllowCommand("get"))
+		suite.verifyOK(client.AclSetUser(context.Background(), username, rules))
+
+		user, err := client.AclGetUser(context.Background(), username)
+		assert.NoError(suite.T(), err)
+		assert.False(suite.T(), user.IsNil())
+		assert.Contains(suite.T(), user.Value().Flags, "on")
+		assert.Len(suite.T(), user.Value().Passwords, 1)
+		assert.Equal(suite.T(), "-@all +@read +set", user.Value().Commands)
+		assert.Equal(suite.T(), "~tenant1:*", user.Value().Keys)
+		assert.Equal(suite.T(), "&tenant1:*", user.Value().Channels)
+		assert.Equal(
+			suite.T(),
+			[]api.AclSelectorInfo{{Commands: "-@all +get", Keys: "%R~shared:*", Channels: ""}},
+			user.Value().Selectors,
+		)
+
+		users, err := client.AclUsers(context.Background())
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), users, username)
+
+		list, err := client.AclList(context.Background())
+		assert.NoError(suite.T(), err)
+		found := false
+		for _, rule := range list {
+			found = found || strings.HasPrefix(rule, "user "+username+" ")
+		}
+		assert.True(suite.T(), found)
+
+		deleted, err := client.AclDelUser(context.Background(), []string{username, "user_" + uuid.NewString()})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), deleted)
+
+		user, err = client.AclGetUser(context.Background(), username)
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), user.IsNil())
+	})
+}
+
+func (suite *GlideTestSuite) TestAclSetUser_Authentication() {
+	username := "user_" + uuid.NewString()
+	rules := options.NewAclRuleBuilder().Reset().On().AddPassword("secret").AllKeys().AllCommands()
+
+	client := suite.defaultClient()
+	suite.verifyOK(client.AclSetUser(context.Background(), username, rules))
+	defer client.AclDelUser(context.Background(), []string{username})
+	userClient := suite.client(suite.defaultClientConfig().WithCredentials(api.NewServerCredentials(username, "secret")))
+	whoami, err := userClient.AclWhoami(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), username, whoami)
+
+	// the user is created on all the nodes of the cluster
+	clusterClient := suite.defaultClusterClient()
+	suite.verifyOK(clusterClient.AclSetUser(context.Background(), username, rules))
+	defer clusterClient.AclDelUser(context.Background(), []string{username})
+	userClusterClient := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithCredentials(api.NewServerCredentials(username, "secret")),
+	)
+	whoami, err = userClusterClient.AclWhoami(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), username, whoami)
+}
+
+func (suite *GlideTestSuite) TestAclCat() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		categories, err := client.AclCat(context.Background())
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), categories, "read")
+
+		commands, err := client.AclCatWithCategory(context.Background(), "string")
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), commands, "get")
+
+		_, err = client.AclCatWithCategory(context.Background(), "unknown")
+		assert.Error(suite.T(), err)
+	})
+}
+
+func (suite *GlideTestSuite) TestAclDryRun() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		username := "user_" + uuid.NewString()
+		defer client.AclDelUser(context.Background(), []string{username})
+		rules := options.NewAclRuleBuilder().Reset().On().NoPass().KeyPattern("tenant1:*").AllowCommand("get")
+		suite.verifyOK(client.AclSetUser(context.Background(), username, rules))
+
+		suite.verifyOK(client.AclDryRun(context.Background(), username, "get", []string{"tenant1:key"}))
+		denied, err := client.AclDryRun(context.Background(), username, "get", []string{"tenant2:key"})
+		assert.NoError(suite.T(), err)
+		assert.NotEqual(suite.T(), api.OK, denied)
+		denied, err = client.AclDryRun(context.Background(), username, "set", []string{"tenant1:key", "value"})
+		assert.NoError(suite.T(), err)
+		assert.NotEqual(suite.T(), api.OK, denied)
+	})
+}
+
+func (suite *GlideTestSuite) TestAclGenPass() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		password, err := client.AclGenPass(context.Background())
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), password, 64)
+
+		password, err = client.AclGenPassWithBits(context.Background(), 30)
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), password, 8)
+	})
+}
+
+func (suite *GlideTestSuite) TestAclLog() {
+	username := "user_" + uuid.NewString()
+	client := suite.defaultClient()
+	suite.verifyOK(client.AclLogReset(context.Background()))
+	rules := options.NewAclRuleBuilder().Reset().On().AddPassword("secret")
+	suite.verifyOK(client.AclSetUser(context.Background(), username, rules))
+	defer client.AclDelUser(context.Background(), []string{username})
+
+	_, err := client.CustomCommand(context.Background(), []string{"AUTH", username, "wrong"})
+	assert.Error(suite.T(), err)
+
+	entries, err := client.AclLog(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), entries, 1)
+	assert.Equal(suite.T(), "auth", entries[0].Reason)
+	assert.Equal(suite.T(), username, entries[0].Username)
+	assert.Equal(suite.T(), int64(1), entries[0].Count)
+
+	entries, err = client.AclLogWithCount(context.Background(), 0)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), entries)
+
+	suite.verifyOK(client.AclLogReset(context.Background()))
+	entries, err = client.AclLog(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), entries)
+}
+
+func (suite *GlideTestSuite) TestAclWhoami() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		whoami, err := client.AclWhoami(context.Background())
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "default", whoami)
+	})
+}
+
+func (suite *GlideTestSuite) TestAclLogCluster() {
+	username := "user_" + uuid.NewString()
+	client := suite.defaultClusterClient()
+	suite.verifyOK(client.AclLogReset(context.Background()))
+	rules := options.NewAclRuleBuilder().Reset().On().AddPassword("secret")
+	suite.verifyOK(client.AclSetUser(context.Background(), username, rules))
+	defer client.AclDelUser(context.Background(), []string{username})
+
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, username)}
+	_, err := client.CustomCommandWithRoute(context.Background(), []string{"AUTH", username, "wrong"}, route.Route)
+	assert.Error(suite.T(), err)
+
+	entries, err := client.AclLogWithRoute(context.Background(), route)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), entries.IsSingleValue())
+	assert.Len(suite.T(), entries.SingleValue(), 1)
+	assert.Equal(suite.T(), username, entries.SingleValue()[0].Username)
+
+	limited, err := client.AclLogWithCountWithRoute(context.Background(), 0, route)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), limited.SingleValue())
+
+	// the log of every node is cleared
+	suite.verifyOK(client.AclLogReset(context.Background()))
+	allEntries, err := client.AclLogWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), allEntries.IsMultiValue())
+	for _, nodeEntries := range allEntries.MultiValue() {
+		assert.Empty(suite.T(), nodeEntries)
+	}
+}
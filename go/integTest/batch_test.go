@@ -0,0 +1,179 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func (suite *GlideTestSuite) TestExecAtomicBatch() {
+	client := suite.defaultClient()
+	key := uuid.NewString()
+
+	batch := api.NewBatch(true).
+		Set(key, "1").
+		Incr(key).
+		Get(key)
+	result, err := client.Exec(context.Background(), batch, true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"OK", int64(2), api.CreateStringResult("2")}, result)
+}
+
+func (suite *GlideTestSuite) TestExecNonAtomicBatch() {
+	client := suite.defaultClient()
+	key := uuid.NewString()
+
+	batch := api.NewBatch(false).
+		LPush(key, []string{"a", "b"}).
+		LRange(key, 0, -1)
+	result, err := client.ExecWithOptions(context.Background(), batch, true, options.BatchOptions{Timeout: 1000})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{int64(2), []string{"b", "a"}}, result)
+}
+
+func (suite *GlideTestSuite) TestExecBatchErrors() {
+	client := suite.defaultClient()
+	key := uuid.NewString()
+
+	batch := api.NewBatch(true).
+		Set(key, "value").
+		LPush(key, []string{"a"}).
+		Get(key)
+
+	// the error is returned in place of the result of the failed command
+	result, err := client.Exec(context.Background(), batch, false)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 3)
+	assert.Equal(suite.T(), "OK", result[0])
+	assert.IsType(suite.T(), &errors.RequestError{}, result[1])
+	assert.Equal(suite.T(), api.CreateStringResult("value"), result[2])
+
+	result, err = client.Exec(context.Background(), batch, true)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+	result, err = client.Exec(context.Background(), api.NewBatch(true), true)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestClusterExecAtomicBatch() {
+	client := suite.defaultClusterClient()
+	key1 := "{batch}-1-" + uuid.NewString()
+	key2 := "{batch}-2-" + uuid.NewString()
+
+	batch := api.NewBatch(true).
+		MSet(map[string]string{key1: "value1", key2: "value2"}).
+		MGet([]string{key1, key2})
+	result, err := client.Exec(context.Background(), batch, true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		[]any{"OK", []api.Result[string]{api.CreateStringResult("value1"), api.CreateStringResult("value2")}},
+		result,
+	)
+}
+
+func (suite *GlideTestSuite) TestClusterExecNonAtomicBatch() {
+	client := suite.defaultClusterClient()
+	key1 := uuid.NewString()
+	key2 := uuid.NewString()
+
+	// keys of a non-atomic batch may map to different slots
+	batch := api.NewBatch(false).
+		Set(key1, "value1").
+		Set(key2, "value2").
+		Get(key1).
+		Get(key2)
+	opts := options.ClusterBatchOptions{
+		RetryStrategy: &options.ClusterBatchRetryStrategy{RetryServerError: true},
+	}
+	result, err := client.ExecWithOptions(context.Background(), batch, true, opts)
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		[]any{"OK", "OK", api.CreateStringResult("value1"), api.CreateStringResult("value2")},
+		result,
+	)
+}
+
+func (suite *GlideTestSuite) TestClusterExecBatchWithRoute() {
+	client := suite.defaultClusterClient()
+	key := uuid.NewString()
+
+	batch := api.NewBatch(true).
+		Set(key, "value").
+		Get(key)
+	opts := options.ClusterBatchOptions{
+		RouteOption: &options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, key)},
+	}
+	result, err := client.ExecWithOptions(context.Background(), batch, true, opts)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"OK", api.CreateStringResult("value")}, result)
+
+	// multi-node routes are not supported
+	opts = options.ClusterBatchOptions{RouteOption: &options.RouteOption{Route: config.AllPrimaries}}
+	result, err = client.ExecWithOptions(context.Background(), batch, true, opts)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+	// retry strategies are not supported for atomic batches
+	opts = options.ClusterBatchOptions{RetryStrategy: &options.ClusterBatchRetryStrategy{RetryConnectionError: true}}
+	result, err = client.ExecWithOptions(context.Background(), batch, true, opts)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestWatch() {
+	client := suite.defaultClient()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "1"))
+
+	// the transaction is aborted when a watched key is modified
+	suite.verifyOK(client.Watch(context.Background(), []string{key}))
+	suite.verifyOK(client.Set(context.Background(), key, "2"))
+	result, err := client.Exec(context.Background(), api.NewBatch(true).Incr(key), true)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.TransactionAbortedError{}, err)
+
+	// the transaction is executed when the watched keys are not modified
+	suite.verifyOK(client.Watch(context.Background(), []string{key}))
+	result, err = client.Exec(context.Background(), api.NewBatch(true).Incr(key), true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{int64(3)}, result)
+
+	// the keys are no longer watched after Unwatch
+	suite.verifyOK(client.Watch(context.Background(), []string{key}))
+	suite.verifyOK(client.Unwatch(context.Background()))
+	suite.verifyOK(client.Set(context.Background(), key, "5"))
+	result, err = client.Exec(context.Background(), api.NewBatch(true).Incr(key), true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{int64(6)}, result)
+}
+
+func (suite *GlideTestSuite) TestClusterWatch() {
+	client := suite.defaultClusterClient()
+	key1 := "{watch}-1-" + uuid.NewString()
+	key2 := "{watch}-2-" + uuid.NewString()
+
+	suite.verifyOK(client.Watch(context.Background(), []string{key1, key2}))
+	suite.verifyOK(client.Set(context.Background(), key2, "value"))
+	result, err := client.Exec(context.Background(), api.NewBatch(true).Set(key1, "value"), true)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.TransactionAbortedError{}, err)
+
+	suite.verifyOK(client.Watch(context.Background(), []string{key1, key2}))
+	suite.verifyOK(client.Unwatch(context.Background()))
+	suite.verifyOK(client.UnwatchWithOptions(context.Background(), options.RouteOption{Route: config.RandomRoute}))
+	suite.verifyOK(client.Set(context.Background(), key2, "value"))
+	result, err = client.Exec(context.Background(), api.NewBatch(true).Set(key1, "value"), true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"OK"}, result)
+}
@@ -3,12 +3,16 @@
 package integTest
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
 	"github.com/valkey-io/valkey-glide/go/api/config"
 	"github.com/valkey-io/valkey-glide/go/api/errors"
 	"github.com/valkey-io/valkey-glide/go/api/options"
@@ -16,7 +20,7 @@ import (
 
 func (suite *GlideTestSuite) TestClusterCustomCommandInfo() {
 	client := suite.defaultClusterClient()
-	result, err := client.CustomCommand([]string{"INFO"})
+	result, err := client.CustomCommand(context.Background(), []string{"INFO"})
 
 	assert.Nil(suite.T(), err)
 	// INFO is routed to all primary nodes by default
@@ -27,7 +31,7 @@ func (suite *GlideTestSuite) TestClusterCustomCommandInfo() {
 
 func (suite *GlideTestSuite) TestClusterCustomCommandEcho() {
 	client := suite.defaultClusterClient()
-	result, err := client.CustomCommand([]string{"ECHO", "GO GLIDE GO"})
+	result, err := client.CustomCommand(context.Background(), []string{"ECHO", "GO GLIDE GO"})
 
 	assert.Nil(suite.T(), err)
 	// ECHO is routed to a single random node
@@ -53,7 +57,7 @@ func (suite *GlideTestSuite) TestInfoCluster() {
 	t := suite.T()
 
 	// info without options
-	data, err := client.Info()
+	data, err := client.Info(context.Background())
 	assert.NoError(t, err)
 	for _, info := range data {
 		for _, section := range DEFAULT_INFO_SECTIONS {
@@ -70,7 +74,7 @@ func (suite *GlideTestSuite) TestInfoCluster() {
 		InfoOptions: &options.InfoOptions{Sections: sections},
 		RouteOption: nil,
 	}
-	response, err := client.InfoWithOptions(opts)
+	response, err := client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsMultiValue())
 	for _, info := range response.MultiValue() {
@@ -84,7 +88,7 @@ func (suite *GlideTestSuite) TestInfoCluster() {
 		InfoOptions: &options.InfoOptions{Sections: sections},
 		RouteOption: &options.RouteOption{Route: config.RandomRoute},
 	}
-	response, err = client.InfoWithOptions(opts)
+	response, err = client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 	for _, section := range sections {
@@ -101,7 +105,7 @@ func (suite *GlideTestSuite) TestInfoCluster() {
 		InfoOptions: nil,
 		RouteOption: &options.RouteOption{Route: config.AllPrimaries},
 	}
-	response, err = client.InfoWithOptions(opts)
+	response, err = client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsMultiValue())
 	for _, info := range response.MultiValue() {
@@ -114,7 +118,7 @@ func (suite *GlideTestSuite) TestInfoCluster() {
 func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_Info() {
 	client := suite.defaultClusterClient()
 	route := config.SimpleNodeRoute(config.AllPrimaries)
-	result, err := client.CustomCommandWithRoute([]string{"INFO"}, route)
+	result, err := client.CustomCommandWithRoute(context.Background(), []string{"INFO"}, route)
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), result.IsMultiValue())
 	multiValue := result.MultiValue()
@@ -126,7 +130,7 @@ func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_Info() {
 func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_Echo() {
 	client := suite.defaultClusterClient()
 	route := config.SimpleNodeRoute(config.RandomRoute)
-	result, err := client.CustomCommandWithRoute([]string{"ECHO", "GO GLIDE GO"}, route)
+	result, err := client.CustomCommandWithRoute(context.Background(), []string{"ECHO", "GO GLIDE GO"}, route)
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), result.IsSingleValue())
 	assert.Equal(suite.T(), "GO GLIDE GO", result.SingleValue().(string))
@@ -135,7 +139,7 @@ func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_Echo() {
 func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_InvalidRoute() {
 	client := suite.defaultClusterClient()
 	invalidRoute := config.NewByAddressRoute("invalidHost", 9999)
-	result, err := client.CustomCommandWithRoute([]string{"PING"}, invalidRoute)
+	result, err := client.CustomCommandWithRoute(context.Background(), []string{"PING"}, invalidRoute)
 	assert.NotNil(suite.T(), err)
 	assert.True(suite.T(), result.IsEmpty())
 }
@@ -143,7 +147,7 @@ func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_InvalidRoute() {
 func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_AllNodes() {
 	client := suite.defaultClusterClient()
 	route := config.SimpleNodeRoute(config.AllNodes)
-	result, err := client.CustomCommandWithRoute([]string{"PING"}, route)
+	result, err := client.CustomCommandWithRoute(context.Background(), []string{"PING"}, route)
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), result.IsSingleValue())
 	assert.Equal(suite.T(), "PONG", result.SingleValue())
@@ -157,7 +161,7 @@ func (suite *GlideTestSuite) TestPingWithOptions_NoRoute() {
 		},
 		RouteOption: nil,
 	}
-	result, err := client.PingWithOptions(options)
+	result, err := client.PingWithOptions(context.Background(), options)
 	assert.Nil(suite.T(), err)
 	assert.Equal(suite.T(), "hello", result)
 }
@@ -170,7 +174,7 @@ func (suite *GlideTestSuite) TestPingWithOptions_WithRoute() {
 		},
 		RouteOption: &options.RouteOption{Route: config.AllNodes},
 	}
-	result, err := client.PingWithOptions(options)
+	result, err := client.PingWithOptions(context.Background(), options)
 	assert.Nil(suite.T(), err)
 	assert.Equal(suite.T(), "hello", result)
 }
@@ -184,7 +188,7 @@ func (suite *GlideTestSuite) TestPingWithOptions_InvalidRoute() {
 		},
 		RouteOption: &options.RouteOption{Route: invalidRoute},
 	}
-	result, err := client.PingWithOptions(options)
+	result, err := client.PingWithOptions(context.Background(), options)
 	assert.NotNil(suite.T(), err)
 	assert.Empty(suite.T(), result)
 }
@@ -192,7 +196,7 @@ func (suite *GlideTestSuite) TestPingWithOptions_InvalidRoute() {
 func (suite *GlideTestSuite) TestTimeWithoutRoute() {
 	client := suite.defaultClusterClient()
 	options := options.RouteOption{Route: nil}
-	result, err := client.TimeWithOptions(options)
+	result, err := client.TimeWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.False(suite.T(), result.IsEmpty())
@@ -205,7 +209,7 @@ func (suite *GlideTestSuite) TestTimeWithoutRoute() {
 func (suite *GlideTestSuite) TestTimeWithAllNodesRoute() {
 	client := suite.defaultClusterClient()
 	options := options.RouteOption{Route: config.AllNodes}
-	result, err := client.TimeWithOptions(options)
+	result, err := client.TimeWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.False(suite.T(), result.IsEmpty())
@@ -226,7 +230,7 @@ func (suite *GlideTestSuite) TestTimeWithRandomRoute() {
 	client := suite.defaultClusterClient()
 	route := config.Route(config.RandomRoute)
 	options := options.RouteOption{Route: route}
-	result, err := client.TimeWithOptions(options)
+	result, err := client.TimeWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.False(suite.T(), result.IsEmpty())
@@ -240,7 +244,7 @@ func (suite *GlideTestSuite) TestTimeWithInvalidRoute() {
 	client := suite.defaultClusterClient()
 	invalidRoute := config.Route(config.NewByAddressRoute("invalidHost", 9999))
 	options := options.RouteOption{Route: invalidRoute}
-	result, err := client.TimeWithOptions(options)
+	result, err := client.TimeWithOptions(context.Background(), options)
 	assert.NotNil(suite.T(), err)
 	assert.True(suite.T(), result.IsEmpty())
 	assert.Empty(suite.T(), result.SingleValue())
@@ -250,7 +254,7 @@ func (suite *GlideTestSuite) TestDBSizeRandomRoute() {
 	client := suite.defaultClusterClient()
 	route := config.Route(config.RandomRoute)
 	options := options.RouteOption{Route: route}
-	result, err := client.DBSizeWithOptions(options)
+	result, err := client.DBSizeWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.GreaterOrEqual(suite.T(), result, int64(0))
@@ -267,7 +271,7 @@ func (suite *GlideTestSuite) TestEchoCluster() {
 		},
 		RouteOption: &options.RouteOption{Route: nil},
 	}
-	response, err := client.EchoWithOptions(opts)
+	response, err := client.EchoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 
@@ -279,7 +283,7 @@ func (suite *GlideTestSuite) TestEchoCluster() {
 		},
 		RouteOption: &route,
 	}
-	response, err = client.EchoWithOptions(opts)
+	response, err = client.EchoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 
@@ -291,7 +295,7 @@ func (suite *GlideTestSuite) TestEchoCluster() {
 		},
 		RouteOption: &route,
 	}
-	response, err = client.EchoWithOptions(opts)
+	response, err = client.EchoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsMultiValue())
 	for _, messages := range response.MultiValue() {
@@ -304,7 +308,7 @@ func (suite *GlideTestSuite) TestBasicClusterScan() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	// Iterate over all keys in the cluster
@@ -314,7 +318,7 @@ func (suite *GlideTestSuite) TestBasicClusterScan() {
 		"key3": "value3",
 	}
 
-	_, err = client.MSet(keysToSet)
+	_, err = client.MSet(context.Background(), keysToSet)
 	assert.NoError(t, err)
 
 	cursor := *options.NewClusterScanCursor()
@@ -322,7 +326,7 @@ func (suite *GlideTestSuite) TestBasicClusterScan() {
 	var keys []string
 
 	for !cursor.HasFinished() {
-		cursor, keys, err = client.Scan(cursor)
+		cursor, keys, err = client.Scan(context.Background(), cursor)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -333,7 +337,7 @@ func (suite *GlideTestSuite) TestBasicClusterScan() {
 	assert.ElementsMatch(t, allKeys, []string{"key1", "key2", "key3"})
 
 	// Ensure clean start
-	_, err = client.CustomCommand([]string{"FLUSHALL"})
+	_, err = client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	expectedKeys := make([]string, 0, 100)
@@ -343,7 +347,7 @@ func (suite *GlideTestSuite) TestBasicClusterScan() {
 
 		expectedKeys = append(expectedKeys, key)
 
-		_, err := client.Set(key, "value")
+		_, err := client.Set(context.Background(), key, "value")
 		assert.NoError(t, err)
 	}
 
@@ -351,7 +355,7 @@ func (suite *GlideTestSuite) TestBasicClusterScan() {
 	allKeys = make([]string, 0, 100)
 
 	for !cursor.HasFinished() {
-		cursor, keys, err = client.Scan(cursor)
+		cursor, keys, err = client.Scan(context.Background(), cursor)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -367,7 +371,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	// Iterate over all keys in the cluster
@@ -377,7 +381,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 		"key3": "value3",
 	}
 
-	_, err = client.MSet(keysToSet)
+	_, err = client.MSet(context.Background(), keysToSet)
 	assert.NoError(t, err)
 
 	cursor := *options.NewClusterScanCursor()
@@ -386,7 +390,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 	var keys []string
 
 	for !cursor.HasFinished() {
-		cursor, keys, err = client.ScanWithOptions(cursor, *opts)
+		cursor, keys, err = client.ScanWithOptions(context.Background(), cursor, *opts)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -404,7 +408,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 		"somethingElse": "value4",
 	}
 
-	_, err = client.MSet(keysToSet)
+	_, err = client.MSet(context.Background(), keysToSet)
 	assert.NoError(t, err)
 
 	cursor = *options.NewClusterScanCursor()
@@ -412,7 +416,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 	matchedKeys := []string{}
 
 	for !cursor.HasFinished() {
-		cursor, keys, err = client.ScanWithOptions(cursor, *opts)
+		cursor, keys, err = client.ScanWithOptions(context.Background(), cursor, *opts)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -429,10 +433,10 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 		"key2": "value2",
 		"key3": "value3",
 	}
-	_, err = client.MSet(keysToSet)
+	_, err = client.MSet(context.Background(), keysToSet)
 	assert.NoError(t, err)
 
-	_, err = client.SAdd("thisIsASet", []string{"someValue"})
+	_, err = client.SAdd(context.Background(), "thisIsASet", []string{"someValue"})
 	assert.NoError(t, err)
 
 	cursor = *options.NewClusterScanCursor()
@@ -440,7 +444,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithOptions() {
 	matchedTypeKeys := []string{}
 
 	for !cursor.HasFinished() {
-		cursor, keys, err = client.ScanWithOptions(cursor, *opts)
+		cursor, keys, err = client.ScanWithOptions(context.Background(), cursor, *opts)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -459,7 +463,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithNonUTF8Pattern() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	// Iterate over all keys in the cluster
@@ -471,7 +475,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithNonUTF8Pattern() {
 		"\xc0\xc1key-5": "value5",
 	}
 
-	_, err = client.MSet(keysToSet)
+	_, err = client.MSet(context.Background(), keysToSet)
 	assert.NoError(t, err)
 
 	cursor := *options.NewClusterScanCursor()
@@ -480,7 +484,7 @@ func (suite *GlideTestSuite) TestBasicClusterScanWithNonUTF8Pattern() {
 
 	for !cursor.HasFinished() {
 		var keys []string
-		cursor, keys, err = client.ScanWithOptions(cursor, *opts)
+		cursor, keys, err = client.ScanWithOptions(context.Background(), cursor, *opts)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -496,7 +500,7 @@ func (suite *GlideTestSuite) TestClusterScanWithObjectTypeAndPattern() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	expectedKeys := make([]string, 0, 100)
@@ -512,13 +516,13 @@ func (suite *GlideTestSuite) TestClusterScanWithObjectTypeAndPattern() {
 		unexpectedTypeKeys = append(unexpectedTypeKeys, unexpectedTypeKey)
 		unexpectedPatternKeys = append(unexpectedPatternKeys, unexpectedPatternKey)
 
-		_, err := client.Set(key, "value")
+		_, err := client.Set(context.Background(), key, "value")
 		assert.NoError(t, err)
 
-		_, err = client.SAdd(unexpectedTypeKey, []string{"value"})
+		_, err = client.SAdd(context.Background(), unexpectedTypeKey, []string{"value"})
 		assert.NoError(t, err)
 
-		_, err = client.Set(unexpectedPatternKey, "value")
+		_, err = client.Set(context.Background(), unexpectedPatternKey, "value")
 		assert.NoError(t, err)
 	}
 
@@ -528,7 +532,7 @@ func (suite *GlideTestSuite) TestClusterScanWithObjectTypeAndPattern() {
 
 	for !cursor.HasFinished() {
 		var keys []string
-		cursor, keys, err = client.ScanWithOptions(cursor, *opts)
+		cursor, keys, err = client.ScanWithOptions(context.Background(), cursor, *opts)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -550,7 +554,7 @@ func (suite *GlideTestSuite) TestClusterScanWithCount() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	expectedKeys := make([]string, 0, 100)
@@ -558,7 +562,7 @@ func (suite *GlideTestSuite) TestClusterScanWithCount() {
 	for i := 0; i < 100; i++ {
 		key := "key-" + uuid.NewString()
 		expectedKeys = append(expectedKeys, key)
-		_, err := client.Set(key, "value")
+		_, err := client.Set(context.Background(), key, "value")
 		assert.NoError(t, err)
 	}
 
@@ -571,7 +575,7 @@ func (suite *GlideTestSuite) TestClusterScanWithCount() {
 		keysOf100 := []string{}
 
 		var keys []string
-		cursor, keys, err = client.ScanWithOptions(cursor, *options.NewClusterScanOptions().SetCount(1))
+		cursor, keys, err = client.ScanWithOptions(context.Background(), cursor, *options.NewClusterScanOptions().SetCount(1))
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -583,7 +587,11 @@ func (suite *GlideTestSuite) TestClusterScanWithCount() {
 			break
 		}
 
-		cursor, keys, err = client.ScanWithOptions(cursor, *options.NewClusterScanOptions().SetCount(100))
+		cursor, keys, err = client.ScanWithOptions(
+			context.Background(),
+			cursor,
+			*options.NewClusterScanOptions().SetCount(100),
+		)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -605,7 +613,7 @@ func (suite *GlideTestSuite) TestClusterScanWithMatch() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	expectedKeys := []string{}
@@ -618,10 +626,10 @@ func (suite *GlideTestSuite) TestClusterScanWithMatch() {
 		expectedKeys = append(expectedKeys, key)
 		unexpectedKeys = append(unexpectedKeys, unexpectedKey)
 
-		_, err := client.Set(key, "value")
+		_, err := client.Set(context.Background(), key, "value")
 		assert.NoError(t, err)
 
-		_, err = client.Set(unexpectedKey, "value")
+		_, err = client.Set(context.Background(), unexpectedKey, "value")
 		assert.NoError(t, err)
 	}
 
@@ -630,7 +638,11 @@ func (suite *GlideTestSuite) TestClusterScanWithMatch() {
 
 	for !cursor.HasFinished() {
 		var keys []string
-		cursor, keys, err = client.ScanWithOptions(cursor, *options.NewClusterScanOptions().SetMatch("key-*"))
+		cursor, keys, err = client.ScanWithOptions(
+			context.Background(),
+			cursor,
+			*options.NewClusterScanOptions().SetMatch("key-*"),
+		)
 		if err != nil {
 			assert.NoError(t, err) // Use this to print error statement
 			break                  // prevent infinite loop
@@ -650,7 +662,7 @@ func (suite *GlideTestSuite) TestClusterScanWithDifferentTypes() {
 	t := suite.T()
 
 	// Ensure clean start
-	_, err := client.CustomCommand([]string{"FLUSHALL"})
+	_, err := client.CustomCommand(context.Background(), []string{"FLUSHALL"})
 	assert.NoError(t, err)
 
 	stringKeys := []string{}
@@ -679,22 +691,22 @@ func (suite *GlideTestSuite) TestClusterScanWithDifferentTypes() {
 		streamKey := "{streamKey}-" + uuid.NewString()
 		streamKeys = append(streamKeys, streamKey)
 
-		_, err := client.Set(key, "value")
+		_, err := client.Set(context.Background(), key, "value")
 		assert.NoError(t, err)
 
-		_, err = client.SAdd(setKey, []string{"value"})
+		_, err = client.SAdd(context.Background(), setKey, []string{"value"})
 		assert.NoError(t, err)
 
-		_, err = client.HSet(hashKey, map[string]string{"field": "value"})
+		_, err = client.HSet(context.Background(), hashKey, map[string]string{"field": "value"})
 		assert.NoError(t, err)
 
-		_, err = client.LPush(listKey, []string{"value"})
+		_, err = client.LPush(context.Background(), listKey, []string{"value"})
 		assert.NoError(t, err)
 
-		_, err = client.ZAdd(zsetKey, map[string]float64{"value": 1})
+		_, err = client.ZAdd(context.Background(), zsetKey, map[string]float64{"value": 1})
 		assert.NoError(t, err)
 
-		_, err = client.XAdd(streamKey, [][]string{{"field", "value"}})
+		_, err = client.XAdd(context.Background(), streamKey, [][]string{{"field", "value"}})
 		assert.NoError(t, err)
 	}
 
@@ -704,6 +716,7 @@ func (suite *GlideTestSuite) TestClusterScanWithDifferentTypes() {
 	for !cursor.HasFinished() {
 		var keys []string
 		cursor, keys, err = client.ScanWithOptions(
+			context.Background(),
 			cursor,
 			*options.NewClusterScanOptions().SetType(options.ObjectTypeList),
 		)
@@ -737,14 +750,14 @@ func (suite *GlideTestSuite) TestFlushDB_Success() {
 	client := suite.defaultClusterClient()
 
 	key := uuid.New().String()
-	_, err := client.Set(key, "test-value")
+	_, err := client.Set(context.Background(), key, "test-value")
 	assert.NoError(suite.T(), err)
 
-	result, err := client.FlushDB()
+	result, err := client.FlushDB(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 
-	val, err := client.Get(key)
+	val, err := client.Get(context.Background(), key)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val.Value())
 }
@@ -753,7 +766,7 @@ func (suite *GlideTestSuite) TestFlushDB_Failure() {
 	client := suite.defaultClusterClient()
 	client.Close()
 
-	result, err := client.FlushDB()
+	result, err := client.FlushDB(context.Background())
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), "", result)
 	assert.IsType(suite.T(), &errors.ClosingError{}, err)
@@ -763,14 +776,14 @@ func (suite *GlideTestSuite) TestFlushAll_Success() {
 	client := suite.defaultClusterClient()
 
 	key := uuid.New().String()
-	_, err := client.Set(key, "test-value")
+	_, err := client.Set(context.Background(), key, "test-value")
 	assert.NoError(suite.T(), err)
 
-	result, err := client.FlushAll()
+	result, err := client.FlushAll(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 
-	val, err := client.Get(key)
+	val, err := client.Get(context.Background(), key)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val.Value())
 }
@@ -779,7 +792,7 @@ func (suite *GlideTestSuite) TestFlushAll_Failure() {
 	client := suite.defaultClusterClient()
 	client.Close()
 
-	result, err := client.FlushAll()
+	result, err := client.FlushAll(context.Background())
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), "", result)
 	assert.IsType(suite.T(), &errors.ClosingError{}, err)
@@ -790,16 +803,16 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_AllNodes() {
 
 	key1 := uuid.New().String()
 	key2 := uuid.New().String()
-	_, err := client.Set(key1, "value3")
+	_, err := client.Set(context.Background(), key1, "value3")
 	assert.NoError(suite.T(), err)
-	_, err = client.Set(key2, "value4")
+	_, err = client.Set(context.Background(), key2, "value4")
 	assert.NoError(suite.T(), err)
 
 	routeOption := &options.RouteOption{
 		Route: config.AllNodes,
 	}
 	asyncMode := options.FlushMode(options.ASYNC)
-	result, err := client.FlushAllWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushAllWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &asyncMode,
 		RouteOption: routeOption,
 	})
@@ -814,16 +827,16 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_AllPrimaries() {
 
 	key1 := uuid.New().String()
 	key2 := uuid.New().String()
-	_, err := client.Set(key1, "value3")
+	_, err := client.Set(context.Background(), key1, "value3")
 	assert.NoError(suite.T(), err)
-	_, err = client.Set(key2, "value4")
+	_, err = client.Set(context.Background(), key2, "value4")
 	assert.NoError(suite.T(), err)
 
 	routeOption := &options.RouteOption{
 		Route: config.AllPrimaries,
 	}
 	asyncMode := options.FlushMode(options.ASYNC)
-	result, err := client.FlushAllWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushAllWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &asyncMode,
 		RouteOption: routeOption,
 	})
@@ -831,11 +844,11 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_AllPrimaries() {
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 
-	val1, err := client.Get(key1)
+	val1, err := client.Get(context.Background(), key1)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val1.Value())
 
-	val2, err := client.Get(key2)
+	val2, err := client.Get(context.Background(), key2)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val2.Value())
 }
@@ -848,7 +861,7 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_InvalidRoute() {
 		Route: invalidRoute,
 	}
 	syncMode := options.SYNC
-	result, err := client.FlushAllWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushAllWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &syncMode,
 		RouteOption: routeOption,
 	})
@@ -861,7 +874,7 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_AsyncMode() {
 	client := suite.defaultClusterClient()
 
 	key := uuid.New().String()
-	_, err := client.Set(key, "value5")
+	_, err := client.Set(context.Background(), key, "value5")
 	assert.NoError(suite.T(), err)
 
 	routeOption := &options.RouteOption{
@@ -869,7 +882,7 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_AsyncMode() {
 	}
 
 	asyncMode := options.FlushMode(options.ASYNC)
-	result, err := client.FlushAllWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushAllWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &asyncMode,
 		RouteOption: routeOption,
 	})
@@ -877,7 +890,7 @@ func (suite *GlideTestSuite) TestFlushAllWithOptions_AsyncMode() {
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 
-	val, err := client.Get(key)
+	val, err := client.Get(context.Background(), key)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val.Value())
 }
@@ -887,16 +900,16 @@ func (suite *GlideTestSuite) TestFlushDBWithOptions_AllNodes() {
 
 	key1 := uuid.New().String()
 	key2 := uuid.New().String()
-	_, err := client.Set(key1, "value3")
+	_, err := client.Set(context.Background(), key1, "value3")
 	assert.NoError(suite.T(), err)
-	_, err = client.Set(key2, "value4")
+	_, err = client.Set(context.Background(), key2, "value4")
 	assert.NoError(suite.T(), err)
 
 	routeOption := &options.RouteOption{
 		Route: config.AllNodes,
 	}
 	asyncMode := options.ASYNC
-	result, err := client.FlushDBWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushDBWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &asyncMode,
 		RouteOption: routeOption,
 	})
@@ -910,27 +923,27 @@ func (suite *GlideTestSuite) TestFlushDBWithOptions_AllPrimaries() {
 
 	key1 := uuid.New().String()
 	key2 := uuid.New().String()
-	_, err := client.Set(key1, "value3")
+	_, err := client.Set(context.Background(), key1, "value3")
 	assert.NoError(suite.T(), err)
-	_, err = client.Set(key2, "value4")
+	_, err = client.Set(context.Background(), key2, "value4")
 	assert.NoError(suite.T(), err)
 
 	routeOption := &options.RouteOption{
 		Route: config.AllPrimaries,
 	}
 	asyncMode := options.ASYNC
-	result, err := client.FlushDBWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushDBWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &asyncMode,
 		RouteOption: routeOption,
 	})
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 
-	val1, err := client.Get(key1)
+	val1, err := client.Get(context.Background(), key1)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val1.Value())
 
-	val2, err := client.Get(key2)
+	val2, err := client.Get(context.Background(), key2)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val2.Value())
 }
@@ -943,7 +956,7 @@ func (suite *GlideTestSuite) TestFlushDBWithOptions_InvalidRoute() {
 		Route: invalidRoute,
 	}
 	syncMode := options.SYNC
-	result, err := client.FlushDBWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushDBWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &syncMode,
 		RouteOption: routeOption,
 	})
@@ -955,21 +968,21 @@ func (suite *GlideTestSuite) TestFlushDBWithOptions_AsyncMode() {
 	client := suite.defaultClusterClient()
 
 	key := uuid.New().String()
-	_, err := client.Set(key, "value5")
+	_, err := client.Set(context.Background(), key, "value5")
 	assert.NoError(suite.T(), err)
 
 	routeOption := &options.RouteOption{
 		Route: config.AllPrimaries,
 	}
 	syncMode := options.SYNC
-	result, err := client.FlushDBWithOptions(options.FlushClusterOptions{
+	result, err := client.FlushDBWithOptions(context.Background(), options.FlushClusterOptions{
 		FlushMode:   &syncMode,
 		RouteOption: routeOption,
 	})
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 
-	val, err := client.Get(key)
+	val, err := client.Get(context.Background(), key)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), val.Value())
 }
@@ -988,34 +1001,34 @@ func (suite *GlideTestSuite) TestUpdateConnectionPasswordCluster() {
 	pwd := uuid.NewString()
 
 	// Validate that we can use the test client
-	_, err := testClient.Info()
+	_, err := testClient.Info(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// Update password without re-authentication
-	_, err = testClient.UpdateConnectionPassword(pwd, false)
+	_, err = testClient.UpdateConnectionPassword(context.Background(), pwd, false)
 	assert.NoError(suite.T(), err)
 
 	// Verify client still works with old auth
-	_, err = testClient.Info()
+	_, err = testClient.Info(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// Update server password and kill all other clients to force reconnection
-	_, err = adminClient.CustomCommand([]string{"CONFIG", "SET", "requirepass", pwd})
+	_, err = adminClient.CustomCommand(context.Background(), []string{"CONFIG", "SET", "requirepass", pwd})
 	assert.NoError(suite.T(), err)
 
-	_, err = adminClient.CustomCommand([]string{"CLIENT", "KILL", "TYPE", "NORMAL"})
+	_, err = adminClient.CustomCommand(context.Background(), []string{"CLIENT", "KILL", "TYPE", "NORMAL"})
 	assert.NoError(suite.T(), err)
 
 	// Verify client auto-reconnects with new password
-	_, err = testClient.Info()
+	_, err = testClient.Info(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// test reset connection password
-	_, err = testClient.ResetConnectionPassword()
+	_, err = testClient.ResetConnectionPassword(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// Cleanup: config set reset password
-	_, err = adminClient.CustomCommand([]string{"CONFIG", "SET", "requirepass", ""})
+	_, err = adminClient.CustomCommand(context.Background(), []string{"CONFIG", "SET", "requirepass", ""})
 	assert.NoError(suite.T(), err)
 }
 
@@ -1025,7 +1038,7 @@ func (suite *GlideTestSuite) TestUpdateConnectionPasswordCluster_InvalidParamete
 	defer testClient.Close()
 
 	// Test empty password
-	_, err := testClient.UpdateConnectionPassword("", true)
+	_, err := testClient.UpdateConnectionPassword(context.Background(), "", true)
 	assert.NotNil(suite.T(), err)
 	assert.IsType(suite.T(), &errors.RequestError{}, err)
 }
@@ -1036,12 +1049,12 @@ func (suite *GlideTestSuite) TestUpdateConnectionPasswordCluster_NoServerAuth()
 	defer testClient.Close()
 
 	// Validate that we can use the client
-	_, err := testClient.Info()
+	_, err := testClient.Info(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// Test immediate re-authentication fails when no server password is set
 	pwd := uuid.NewString()
-	_, err = testClient.UpdateConnectionPassword(pwd, true)
+	_, err = testClient.UpdateConnectionPassword(context.Background(), pwd, true)
 	assert.NotNil(suite.T(), err)
 	assert.IsType(suite.T(), &errors.RequestError{}, err)
 }
@@ -1059,11 +1072,11 @@ func (suite *GlideTestSuite) TestUpdateConnectionPasswordCluster_LongPassword()
 	}
 
 	// Validate that we can use the client
-	_, err := testClient.Info()
+	_, err := testClient.Info(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// Test replacing connection password with a long password string
-	_, err = testClient.UpdateConnectionPassword(string(pwd), false)
+	_, err = testClient.UpdateConnectionPassword(context.Background(), string(pwd), false)
 	assert.NoError(suite.T(), err)
 }
 
@@ -1080,31 +1093,31 @@ func (suite *GlideTestSuite) TestUpdateConnectionPasswordCluster_ImmediateAuthWr
 	notThePwd := uuid.NewString()
 
 	// Validate that we can use the client
-	_, err := testClient.Info()
+	_, err := testClient.Info(context.Background())
 	assert.NoError(suite.T(), err)
 
 	// Set the password to something else
-	_, err = adminClient.CustomCommand([]string{"CONFIG", "SET", "requirepass", notThePwd})
+	_, err = adminClient.CustomCommand(context.Background(), []string{"CONFIG", "SET", "requirepass", notThePwd})
 	assert.NoError(suite.T(), err)
 
 	// Test that re-authentication fails when using wrong password
-	_, err = testClient.UpdateConnectionPassword(pwd, true)
+	_, err = testClient.UpdateConnectionPassword(context.Background(), pwd, true)
 	assert.NotNil(suite.T(), err)
 	assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 	// But using correct password returns OK
-	_, err = testClient.UpdateConnectionPassword(notThePwd, true)
+	_, err = testClient.UpdateConnectionPassword(context.Background(), notThePwd, true)
 	assert.NoError(suite.T(), err)
 
 	// Cleanup: Reset password
-	_, err = adminClient.CustomCommand([]string{"CONFIG", "SET", "requirepass", ""})
+	_, err = adminClient.CustomCommand(context.Background(), []string{"CONFIG", "SET", "requirepass", ""})
 	assert.NoError(suite.T(), err)
 }
 
 func (suite *GlideTestSuite) TestClusterLolwut() {
 	client := suite.defaultClusterClient()
 
-	result, err := client.Lolwut()
+	result, err := client.Lolwut(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), result)
 	assert.Contains(suite.T(), result, "Redis ver.")
@@ -1119,7 +1132,7 @@ func (suite *GlideTestSuite) TestLolwutWithOptions_WithAllNodes() {
 		},
 		RouteOption: &options.RouteOption{Route: config.AllNodes},
 	}
-	result, err := client.LolwutWithOptions(options)
+	result, err := client.LolwutWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 
 	assert.True(suite.T(), result.IsMultiValue())
@@ -1138,7 +1151,7 @@ func (suite *GlideTestSuite) TestLolwutWithOptions_WithAllPrimaries() {
 		},
 		RouteOption: &options.RouteOption{Route: config.AllPrimaries},
 	}
-	result, err := client.LolwutWithOptions(options)
+	result, err := client.LolwutWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 
 	assert.True(suite.T(), result.IsMultiValue())
@@ -1157,7 +1170,7 @@ func (suite *GlideTestSuite) TestLolwutWithOptions_WithRandomRoute() {
 		},
 		RouteOption: &options.RouteOption{Route: config.RandomRoute},
 	}
-	result, err := client.LolwutWithOptions(options)
+	result, err := client.LolwutWithOptions(context.Background(), options)
 	assert.NoError(suite.T(), err)
 
 	assert.True(suite.T(), result.IsSingleValue())
@@ -1168,7 +1181,7 @@ func (suite *GlideTestSuite) TestLolwutWithOptions_WithRandomRoute() {
 func (suite *GlideTestSuite) TestClientIdCluster() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
-	response, err := client.ClientId()
+	response, err := client.ClientId(context.Background())
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 }
@@ -1179,21 +1192,21 @@ func (suite *GlideTestSuite) TestClientIdWithOptionsCluster() {
 
 	// ClientId with option or with multiple options without route
 	opts := options.RouteOption{Route: nil}
-	response, err := client.ClientIdWithOptions(opts)
+	response, err := client.ClientIdWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 
 	// same sections with random route
 	route := config.Route(config.RandomRoute)
 	opts = options.RouteOption{Route: route}
-	response, err = client.ClientIdWithOptions(opts)
+	response, err = client.ClientIdWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 
 	// default sections, multi node route
 	route = config.Route(config.AllPrimaries)
 	opts = options.RouteOption{Route: route}
-	response, err = client.ClientIdWithOptions(opts)
+	response, err = client.ClientIdWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsMultiValue())
 }
@@ -1201,7 +1214,7 @@ func (suite *GlideTestSuite) TestClientIdWithOptionsCluster() {
 func (suite *GlideTestSuite) TestLastSaveCluster() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
-	response, err := client.LastSave()
+	response, err := client.LastSave(context.Background())
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 }
@@ -1210,7 +1223,7 @@ func (suite *GlideTestSuite) TestLastSaveWithOptionCluster() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
 	opts := options.RouteOption{Route: nil}
-	response, err := client.LastSaveWithOptions(opts)
+	response, err := client.LastSaveWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 }
@@ -1219,7 +1232,7 @@ func (suite *GlideTestSuite) TestConfigResetStatCluster() {
 	client := suite.defaultClusterClient()
 
 	// ConfigResetStat with option or with multiple options without route
-	suite.verifyOK(client.ConfigResetStat())
+	suite.verifyOK(client.ConfigResetStat(context.Background()))
 }
 
 func (suite *GlideTestSuite) TestConfigResetStatWithOptions() {
@@ -1227,26 +1240,26 @@ func (suite *GlideTestSuite) TestConfigResetStatWithOptions() {
 
 	// ConfigResetStat with option or with multiple options without route
 	opts := options.RouteOption{Route: nil}
-	suite.verifyOK(client.ConfigResetStatWithOptions(opts))
+	suite.verifyOK(client.ConfigResetStatWithOptions(context.Background(), opts))
 
 	// same sections with random route
 	route := config.Route(config.RandomRoute)
 	opts = options.RouteOption{Route: route}
-	suite.verifyOK(client.ConfigResetStatWithOptions(opts))
+	suite.verifyOK(client.ConfigResetStatWithOptions(context.Background(), opts))
 
 	// default sections, multi node route
 	route = config.Route(config.AllPrimaries)
 	opts = options.RouteOption{Route: route}
-	suite.verifyOK(client.ConfigResetStatWithOptions(opts))
+	suite.verifyOK(client.ConfigResetStatWithOptions(context.Background(), opts))
 }
 
 func (suite *GlideTestSuite) TestConfigSetGet() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
 	configParam := map[string]string{"timeout": "1000"}
-	suite.verifyOK(client.ConfigSet(configParam))
+	suite.verifyOK(client.ConfigSet(context.Background(), configParam))
 	configGetParam := []string{"timeout"}
-	resp, err := client.ConfigGet(configGetParam)
+	resp, err := client.ConfigGet(context.Background(), configGetParam)
 	assert.NoError(t, err)
 	assert.Contains(t, strings.ToLower(fmt.Sprint(resp)), strings.ToLower("timeout"))
 }
@@ -1257,25 +1270,25 @@ func (suite *GlideTestSuite) TestConfigSetGetWithOptions() {
 	// ConfigResetStat with option or with multiple options without route
 	opts := options.RouteOption{Route: nil}
 	configParam := map[string]string{"timeout": "1000"}
-	suite.verifyOK(client.ConfigSetWithOptions(configParam, opts))
+	suite.verifyOK(client.ConfigSetWithOptions(context.Background(), configParam, opts))
 	configGetParam := []string{"timeout"}
-	resp, err := client.ConfigGetWithOptions(configGetParam, opts)
+	resp, err := client.ConfigGetWithOptions(context.Background(), configGetParam, opts)
 	assert.NoError(t, err)
 	assert.Contains(t, strings.ToLower(fmt.Sprint(resp)), strings.ToLower("timeout"))
 
 	// same sections with random route
 	route := config.Route(config.RandomRoute)
 	opts = options.RouteOption{Route: route}
-	suite.verifyOK(client.ConfigSetWithOptions(configParam, opts))
-	resp, err = client.ConfigGetWithOptions(configGetParam, opts)
+	suite.verifyOK(client.ConfigSetWithOptions(context.Background(), configParam, opts))
+	resp, err = client.ConfigGetWithOptions(context.Background(), configGetParam, opts)
 	assert.NoError(t, err)
 	assert.Contains(t, strings.ToLower(fmt.Sprint(resp)), strings.ToLower("timeout"))
 
 	// default sections, multi node route
 	route = config.Route(config.AllPrimaries)
 	opts = options.RouteOption{Route: route}
-	suite.verifyOK(client.ConfigSetWithOptions(configParam, opts))
-	resp, err = client.ConfigGetWithOptions(configGetParam, opts)
+	suite.verifyOK(client.ConfigSetWithOptions(context.Background(), configParam, opts))
+	resp, err = client.ConfigGetWithOptions(context.Background(), configGetParam, opts)
 	assert.NoError(t, err)
 	assert.True(t, resp.IsMultiValue())
 	for _, messages := range resp.MultiValue() {
@@ -1288,8 +1301,8 @@ func (suite *GlideTestSuite) TestClientSetGetName() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
 	connectionName := "ConnectionName-" + uuid.NewString()
-	client.ClientSetName(connectionName)
-	response, err := client.ClientGetName()
+	client.ClientSetName(context.Background(), connectionName)
+	response, err := client.ClientGetName(context.Background())
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 }
@@ -1301,10 +1314,10 @@ func (suite *GlideTestSuite) TestClientSetGetNameWithRoute() {
 	// ClientGetName with option or with multiple options without route
 	opts := options.RouteOption{Route: nil}
 	connectionName := "ConnectionName-" + uuid.NewString()
-	response, err := client.ClientSetNameWithOptions(connectionName, opts)
+	response, err := client.ClientSetNameWithOptions(context.Background(), connectionName, opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
-	response, err = client.ClientGetNameWithOptions(opts)
+	response, err = client.ClientGetNameWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 
@@ -1312,10 +1325,10 @@ func (suite *GlideTestSuite) TestClientSetGetNameWithRoute() {
 	connectionName = "ConnectionName-" + uuid.NewString()
 	route := config.Route(config.RandomRoute)
 	opts = options.RouteOption{Route: route}
-	response, err = client.ClientSetNameWithOptions(connectionName, opts)
+	response, err = client.ClientSetNameWithOptions(context.Background(), connectionName, opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
-	response, err = client.ClientGetNameWithOptions(opts)
+	response, err = client.ClientGetNameWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	assert.True(t, response.IsSingleValue())
 }
@@ -1326,7 +1339,7 @@ func (suite *GlideTestSuite) TestConfigRewriteCluster() {
 	opts := options.ClusterInfoOptions{
 		InfoOptions: &options.InfoOptions{Sections: []options.Section{options.Server}},
 	}
-	res, err := client.InfoWithOptions(opts)
+	res, err := client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	for _, data := range res.MultiValue() {
 		lines := strings.Split(data, "\n")
@@ -1338,7 +1351,7 @@ func (suite *GlideTestSuite) TestConfigRewriteCluster() {
 			}
 		}
 		if len(configFile) > 0 {
-			responseRewrite, err := client.ConfigRewrite()
+			responseRewrite, err := client.ConfigRewrite(context.Background())
 			assert.NoError(t, err)
 			assert.Equal(t, "OK", responseRewrite)
 		}
@@ -1355,7 +1368,7 @@ func (suite *GlideTestSuite) TestConfigRewriteWithOptions() {
 		InfoOptions: &options.InfoOptions{Sections: sections},
 		RouteOption: nil,
 	}
-	response, err := client.InfoWithOptions(opts)
+	response, err := client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	for _, data := range response.MultiValue() {
 		lines := strings.Split(data, "\n")
@@ -1367,7 +1380,7 @@ func (suite *GlideTestSuite) TestConfigRewriteWithOptions() {
 			}
 		}
 		if len(configFile) > 0 {
-			responseRewrite, err := client.ConfigRewrite()
+			responseRewrite, err := client.ConfigRewrite(context.Background())
 			assert.NoError(t, err)
 			assert.Equal(t, "OK", responseRewrite)
 			break
@@ -1379,7 +1392,7 @@ func (suite *GlideTestSuite) TestConfigRewriteWithOptions() {
 		InfoOptions: &options.InfoOptions{Sections: sections},
 		RouteOption: &options.RouteOption{Route: config.RandomRoute},
 	}
-	response, err = client.InfoWithOptions(opts)
+	response, err = client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	lines := strings.Split(response.SingleValue(), "\n")
 	var configFile string
@@ -1390,7 +1403,7 @@ func (suite *GlideTestSuite) TestConfigRewriteWithOptions() {
 		}
 	}
 	if len(configFile) > 0 {
-		responseRewrite, err := client.ConfigRewrite()
+		responseRewrite, err := client.ConfigRewrite(context.Background())
 		assert.NoError(t, err)
 		assert.Equal(t, "OK", responseRewrite)
 	}
@@ -1400,7 +1413,7 @@ func (suite *GlideTestSuite) TestConfigRewriteWithOptions() {
 		InfoOptions: nil,
 		RouteOption: &options.RouteOption{Route: config.AllPrimaries},
 	}
-	response, err = client.InfoWithOptions(opts)
+	response, err = client.InfoWithOptions(context.Background(), opts)
 	assert.NoError(t, err)
 	for _, data := range response.MultiValue() {
 		lines := strings.Split(data, "\n")
@@ -1412,7 +1425,7 @@ func (suite *GlideTestSuite) TestConfigRewriteWithOptions() {
 			}
 		}
 		if len(configFile) > 0 {
-			responseRewrite, err := client.ConfigRewrite()
+			responseRewrite, err := client.ConfigRewrite(context.Background())
 			assert.NoError(t, err)
 			assert.Equal(t, "OK", responseRewrite)
 			break
@@ -1424,7 +1437,7 @@ func (suite *GlideTestSuite) TestClusterRandomKey() {
 	client := suite.defaultClusterClient()
 	// Test 1: Check if the command return random key
 	t := suite.T()
-	result, err := client.RandomKey()
+	result, err := client.RandomKey(context.Background())
 	assert.Nil(t, err)
 	assert.NotNil(t, result)
 }
@@ -1435,7 +1448,7 @@ func (suite *GlideTestSuite) TestRandomKeyWithRoute() {
 	t := suite.T()
 	route := config.Route(config.RandomRoute)
 	options := options.RouteOption{Route: route}
-	result, err := client.RandomKeyWithRoute(options)
+	result, err := client.RandomKeyWithRoute(context.Background(), options)
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 }
@@ -1458,17 +1471,17 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithRoute() {
 
 	// Flush all functions with SYNC option and single node route
 	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "1")}
-	result, err := client.FunctionFlushSyncWithRoute(route)
+	result, err := client.FunctionFlushSyncWithRoute(context.Background(), route)
 	assert.NoError(t, err)
 	assert.Equal(t, "OK", result)
 
 	// Load function with single node route
-	result, err = client.FunctionLoadWithRoute(code, false, route)
+	result, err = client.FunctionLoadWithRoute(context.Background(), code, false, route)
 	assert.NoError(t, err)
 	assert.Equal(t, libName, result)
 
 	// Test FCALL with single node route
-	functionResult, err := client.FCallWithArgsWithRoute(funcName, []string{"one", "two"}, route)
+	functionResult, err := client.FCallWithArgsWithRoute(context.Background(), funcName, []string{"one", "two"}, route)
 	assert.NoError(t, err)
 	if functionResult.IsSingleValue() {
 		assert.Equal(t, "one", functionResult.SingleValue())
@@ -1479,7 +1492,7 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithRoute() {
 	}
 
 	// Test FCALL_RO with single node route
-	functionResult, err = client.FCallReadOnlyWithArgsWithRoute(funcName, []string{"one", "two"}, route)
+	functionResult, err = client.FCallReadOnlyWithArgsWithRoute(context.Background(), funcName, []string{"one", "two"}, route)
 	assert.NoError(t, err)
 	if functionResult.IsSingleValue() {
 		assert.Equal(t, "one", functionResult.SingleValue())
@@ -1499,17 +1512,17 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithRoute() {
 
 	// Flush all functions with SYNC option and all primaries route
 	route = options.RouteOption{Route: config.AllPrimaries}
-	result, err = client.FunctionFlushSyncWithRoute(route)
+	result, err = client.FunctionFlushSyncWithRoute(context.Background(), route)
 	assert.NoError(t, err)
 	assert.Equal(t, "OK", result)
 
 	// Load function with all primaries route
-	result, err = client.FunctionLoadWithRoute(code, false, route)
+	result, err = client.FunctionLoadWithRoute(context.Background(), code, false, route)
 	assert.NoError(t, err)
 	assert.Equal(t, libName, result)
 
 	// Test FCALL with all primaries route
-	functionResult, err = client.FCallWithArgsWithRoute(funcName, []string{"one", "two"}, route)
+	functionResult, err = client.FCallWithArgsWithRoute(context.Background(), funcName, []string{"one", "two"}, route)
 	assert.NoError(t, err)
 	if functionResult.IsSingleValue() {
 		assert.Equal(t, "one", functionResult.SingleValue())
@@ -1520,7 +1533,7 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithRoute() {
 	}
 
 	// Test FCALL_RO with all primaries route
-	functionResult, err = client.FCallReadOnlyWithArgsWithRoute(funcName, []string{"one", "two"}, route)
+	functionResult, err = client.FCallReadOnlyWithArgsWithRoute(context.Background(), funcName, []string{"one", "two"}, route)
 	assert.NoError(t, err)
 	if functionResult.IsSingleValue() {
 		assert.Equal(t, "one", functionResult.SingleValue())
@@ -1540,7 +1553,7 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithoutKeysAndWithoutRoute() {
 	t := suite.T()
 
 	// Flush all functions with SYNC option
-	result, err := client.FunctionFlushSync()
+	result, err := client.FunctionFlushSync(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, "OK", result)
 
@@ -1553,12 +1566,12 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithoutKeysAndWithoutRoute() {
 	code := GenerateLuaLibCode(libName, functions, true)
 
 	// Load function
-	result, err = client.FunctionLoad(code, false)
+	result, err = client.FunctionLoad(context.Background(), code, false)
 	assert.NoError(t, err)
 	assert.Equal(t, libName, result)
 
 	// Test FCALL
-	functionResult, err := client.FCallWithArgs(funcName, []string{"one", "two"})
+	functionResult, err := client.FCallWithArgs(context.Background(), funcName, []string{"one", "two"})
 	assert.NoError(t, err)
 	if functionResult.IsSingleValue() {
 		assert.Equal(t, "one", functionResult.SingleValue())
@@ -1569,7 +1582,7 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithoutKeysAndWithoutRoute() {
 	}
 
 	// Test FCALL_RO
-	functionResult, err = client.FCallReadOnlyWithArgs(funcName, []string{"one", "two"})
+	functionResult, err = client.FCallReadOnlyWithArgs(context.Background(), funcName, []string{"one", "two"})
 	assert.NoError(t, err)
 	if functionResult.IsSingleValue() {
 		assert.Equal(t, "one", functionResult.SingleValue())
@@ -1579,3 +1592,375 @@ func (suite *GlideTestSuite) TestFunctionCommandsWithoutKeysAndWithoutRoute() {
 		}
 	}
 }
+
+func (suite *GlideTestSuite) TestClusterShards() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	client := suite.defaultClusterClient()
+
+	shards, err := client.ClusterShards(context.Background())
+	assert.NoError(suite.T(), err)
+	slots := int64(0)
+	for _, shard := range shards {
+		assert.NotEmpty(suite.T(), shard.Nodes)
+		for _, slotRange := range shard.Slots {
+			slots += slotRange.End - slotRange.Start + 1
+		}
+	}
+	assert.Equal(suite.T(), int64(16384), slots)
+
+	route := options.RouteOption{Route: config.AllPrimaries}
+	allShards, err := client.ClusterShardsWithRoute(context.Background(), route)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), allShards.MultiValue(), len(shards))
+}
+
+func (suite *GlideTestSuite) TestClusterNodesAndMyId() {
+	client := suite.defaultClusterClient()
+
+	nodes, err := client.ClusterNodes(context.Background())
+	assert.NoError(suite.T(), err)
+	primaryIds := map[string]bool{}
+	for _, node := range nodes {
+		if node.PrimaryId == "" {
+			primaryIds[node.Id] = true
+		}
+	}
+
+	// the identifiers of the primaries match the topology
+	ids, err := client.ClusterMyIdWithRoute(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), ids.MultiValue(), len(primaryIds))
+	for address, id := range ids.MultiValue() {
+		assert.True(suite.T(), primaryIds[id], address)
+
+		route, err := config.NewByAddressRouteWithHost(address)
+		assert.NoError(suite.T(), err)
+		nodeNodes, err := client.ClusterNodesWithRoute(context.Background(), options.RouteOption{Route: route})
+		assert.NoError(suite.T(), err)
+		myself := 0
+		for _, node := range nodeNodes.SingleValue() {
+			if slices.Contains(node.Flags, "myself") {
+				myself++
+				assert.Equal(suite.T(), id, node.Id)
+				assert.NotEmpty(suite.T(), node.Slots)
+			}
+		}
+		assert.Equal(suite.T(), 1, myself)
+	}
+
+	id, err := client.ClusterMyId(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), id, 40)
+}
+
+func (suite *GlideTestSuite) TestClusterMyShardId() {
+	suite.SkipIfServerVersionLowerThanBy("7.2.0")
+	client := suite.defaultClusterClient()
+
+	shardIds, err := client.ClusterMyShardIdWithRoute(
+		context.Background(),
+		options.RouteOption{Route: config.AllPrimaries},
+	)
+	assert.NoError(suite.T(), err)
+	distinctIds := map[string]bool{}
+	for _, shardId := range shardIds.MultiValue() {
+		distinctIds[shardId] = true
+	}
+	assert.Len(suite.T(), distinctIds, len(shardIds.MultiValue()))
+
+	shardId, err := client.ClusterMyShardId(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), shardId, 40)
+}
+
+func (suite *GlideTestSuite) TestClusterSlots() {
+	client := suite.defaultClusterClient()
+
+	slotInfos, err := client.ClusterSlots(context.Background())
+	assert.NoError(suite.T(), err)
+	slots := int64(0)
+	for _, slotInfo := range slotInfos {
+		slots += slotInfo.Range.End - slotInfo.Range.Start + 1
+		assert.NotEmpty(suite.T(), slotInfo.Primary.Id)
+		assert.NotZero(suite.T(), slotInfo.Primary.Port)
+	}
+	assert.Equal(suite.T(), int64(16384), slots)
+
+	allSlots, err := client.ClusterSlotsWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	assert.NoError(suite.T(), err)
+	for _, nodeSlotInfos := range allSlots.MultiValue() {
+		assert.Len(suite.T(), nodeSlotInfos, len(slotInfos))
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterInfo() {
+	client := suite.defaultClusterClient()
+
+	info, err := client.ClusterInfo(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ok", info["cluster_state"])
+	assert.Equal(suite.T(), "16384", info["cluster_slots_assigned"])
+
+	allInfo, err := client.ClusterInfoWithRoute(context.Background(), options.RouteOption{Route: config.AllNodes})
+	assert.NoError(suite.T(), err)
+	for _, nodeInfo := range allInfo.MultiValue() {
+		assert.Equal(suite.T(), "ok", nodeInfo["cluster_state"])
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterKeySlotAndKeysInSlot() {
+	client := suite.defaultClusterClient()
+	key := "{" + uuid.NewString() + "}"
+
+	slot, err := client.ClusterKeySlot(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	otherSlot, err := client.ClusterKeySlot(context.Background(), key+"other")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), slot, otherSlot)
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	suite.verifyOK(client.Set(context.Background(), key+"other", "value"))
+
+	count, err := client.ClusterCountKeysInSlot(context.Background(), slot)
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), count, int64(2))
+
+	keys, err := client.ClusterGetKeysInSlot(context.Background(), slot, count)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), keys, key)
+	assert.Contains(suite.T(), keys, key+"other")
+}
+
+// primaryRoute returns the address route to the primary serving the first slot range.
+func (suite *GlideTestSuite) primaryRoute(client api.GlideClusterClientCommands) (*config.ByAddressRoute, api.SlotInfo) {
+	slots, err := client.ClusterSlots(context.Background())
+	assert.NoError(suite.T(), err)
+	return config.NewByAddressRoute(slots[0].Primary.Endpoint, int32(slots[0].Primary.Port)), slots[0]
+}
+
+func (suite *GlideTestSuite) TestClusterAdministrationCommands() {
+	client := suite.defaultClusterClient()
+	route, slotInfo := suite.primaryRoute(client)
+
+	suite.verifyOK(client.ClusterSaveConfig(context.Background(), route))
+	epoch, err := client.ClusterBumpEpoch(context.Background(), route)
+	assert.NoError(suite.T(), err)
+	assert.Regexp(suite.T(), "^(BUMPED|STILL) [0-9]+$", epoch)
+	suite.verifyOK(
+		client.ClusterSetSlot(context.Background(), slotInfo.Range.Start, options.NewClusterSetSlotStable(), route),
+	)
+
+	// the slots are already assigned, and a node cannot forget or replicate itself
+	_, err = client.ClusterAddSlots(context.Background(), []int64{slotInfo.Range.Start}, route)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+	_, err = client.ClusterForget(context.Background(), slotInfo.Primary.Id, route)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+	_, err = client.ClusterReplicate(context.Background(), slotInfo.Primary.Id, route)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+	// a primary cannot fail over
+	_, err = client.ClusterFailoverWithMode(context.Background(), options.FailoverForce, route)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+	_, err = client.ClusterSaveConfig(context.Background(), nil)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestClusterAddSlotsRange() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	client := suite.defaultClusterClient()
+	route, slotInfo := suite.primaryRoute(client)
+
+	// the slots are already assigned
+	_, err := client.ClusterAddSlotsRange(context.Background(), []api.SlotRange{slotInfo.Range}, route)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestMigrate() {
+	if suite.tls {
+		suite.T().Skip("The nodes do not connect to each other with TLS")
+	}
+	client := suite.defaultClusterClient()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	slot, err := client.ClusterKeySlot(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	slots, err := client.ClusterSlots(context.Background())
+	assert.NoError(suite.T(), err)
+	var route *config.ByAddressRoute
+	for _, slotInfo := range slots {
+		if slotInfo.Range.Start <= slot && slot <= slotInfo.Range.End {
+			route = config.NewByAddressRoute(slotInfo.Primary.Endpoint, int32(slotInfo.Primary.Port))
+		}
+	}
+
+	// the key is copied to the standalone server
+	standaloneClient := suite.defaultClient()
+	destination := suite.standaloneHosts[0]
+	migrateOptions := options.NewMigrateOptions().SetCopy().SetReplace()
+	suite.verifyOK(client.Migrate(
+		context.Background(), destination.Host, int64(destination.Port), key, 5000, migrateOptions, route,
+	))
+	value, err := standaloneClient.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+	value, err = client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	result, err := client.Migrate(
+		context.Background(), destination.Host, int64(destination.Port), uuid.NewString(), 5000, nil, route,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "NOKEY", result)
+	_, err = standaloneClient.Del(context.Background(), []string{key})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestKeySlotMatchesServer() {
+	client := suite.defaultClusterClient()
+	keys := []string{"", "foo", "{user1000}.following", "foo{}{bar}", "foo{{bar}}zap", uuid.NewString()}
+	for _, key := range keys {
+		slot, err := client.ClusterKeySlot(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), slot, int64(api.KeySlot(key)), key)
+	}
+}
+
+func (suite *GlideTestSuite) TestClientListAndInfoCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	clients, err := client.ClientList(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, clients.IsSingleValue())
+	assert.NotEmpty(t, clients.SingleValue())
+
+	allClients, err := client.ClientListWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	assert.NoError(t, err)
+	assert.True(t, allClients.IsMultiValue())
+	for _, nodeClients := range allClients.MultiValue() {
+		assert.NotEmpty(t, nodeClients)
+	}
+
+	info, err := client.ClientInfo(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, info.IsSingleValue())
+	assert.Equal(t, "client|info", info.SingleValue().Cmd)
+
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")}
+	id, err := client.ClientIdWithOptions(context.Background(), route)
+	assert.NoError(t, err)
+	routedInfo, err := client.ClientInfoWithOptions(context.Background(), route)
+	assert.NoError(t, err)
+	assert.Equal(t, id.SingleValue(), routedInfo.SingleValue().Id)
+
+	allInfos, err := client.ClientInfoWithOptions(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(t, err)
+	assert.True(t, allInfos.IsMultiValue())
+	assert.NotEmpty(t, allInfos.MultiValue())
+}
+
+func (suite *GlideTestSuite) TestClientKillCluster() {
+	client := suite.defaultClusterClient()
+	other := suite.defaultClusterClient()
+	t := suite.T()
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")}
+	otherId, err := other.ClientIdWithOptions(context.Background(), route)
+	assert.NoError(t, err)
+
+	filter := options.NewClientKillFilter().SetId(otherId.SingleValue())
+	killed, err := client.ClientKillWithOptions(context.Background(), filter, route)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), killed.SingleValue())
+
+	killed, err = client.ClientKill(context.Background(), options.NewClientKillFilter().SetAddr("127.0.0.1:1"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), killed.SingleValue())
+}
+
+func (suite *GlideTestSuite) TestClientPauseAndUnpauseCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	route := options.RouteOption{Route: config.AllPrimaries}
+
+	paused, err := client.ClientPauseWithOptions(context.Background(), 10000, options.PauseWrite, route)
+	assert.NoError(t, err)
+	for _, response := range paused.MultiValue() {
+		assert.Equal(t, api.OK, response)
+	}
+	unpaused, err := client.ClientUnpauseWithOptions(context.Background(), route)
+	assert.NoError(t, err)
+	assert.Equal(t, len(paused.MultiValue()), len(unpaused.MultiValue()))
+	for _, response := range unpaused.MultiValue() {
+		assert.Equal(t, api.OK, response)
+	}
+	suite.verifyOK(client.Set(context.Background(), uuid.NewString(), "value"))
+
+	single, err := client.ClientPause(context.Background(), 100, options.PauseAll)
+	assert.NoError(t, err)
+	assert.Equal(t, api.OK, single.SingleValue())
+	single, err = client.ClientUnpause(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, api.OK, single.SingleValue())
+}
+
+func (suite *GlideTestSuite) TestClientNoEvictAndNoTouchCluster() {
+	client := suite.defaultClusterClient()
+	suite.SkipIfServerVersionLowerThanBy("7.2.0")
+	t := suite.T()
+
+	noEvict, err := client.ClientNoEvict(context.Background(), true)
+	assert.NoError(t, err)
+	assert.True(t, noEvict.IsMultiValue())
+	for _, response := range noEvict.MultiValue() {
+		assert.Equal(t, api.OK, response)
+	}
+	noTouch, err := client.ClientNoTouch(context.Background(), true)
+	assert.NoError(t, err)
+	assert.True(t, noTouch.IsMultiValue())
+
+	infos, err := client.ClientInfoWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	assert.NoError(t, err)
+	for _, info := range infos.MultiValue() {
+		assert.Contains(t, info.Flags, "e")
+		assert.Contains(t, info.Flags, "T")
+	}
+
+	route := options.RouteOption{Route: config.RandomRoute}
+	single, err := client.ClientNoEvictWithOptions(context.Background(), false, route)
+	assert.NoError(t, err)
+	assert.Equal(t, api.OK, single.SingleValue())
+	single, err = client.ClientNoTouchWithOptions(context.Background(), false, route)
+	assert.NoError(t, err)
+	assert.Equal(t, api.OK, single.SingleValue())
+}
+
+func (suite *GlideTestSuite) TestClientUnblockCluster() {
+	client := suite.defaultClusterClient()
+	blocked := suite.defaultClusterClient()
+	t := suite.T()
+	key := uuid.NewString()
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, key)}
+	blockedId, err := blocked.ClientIdWithOptions(context.Background(), route)
+	assert.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		_, err := blocked.BLPop(context.Background(), []string{key}, 0)
+		done <- err
+	}()
+	assert.Eventually(t, func() bool {
+		unblocked, err := client.ClientUnblockWithOptions(
+			context.Background(),
+			blockedId.SingleValue(),
+			options.UnblockTimeout,
+			route,
+		)
+		return err == nil && unblocked.SingleValue()
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.NoError(t, <-done)
+
+	unblocked, err := client.ClientUnblock(context.Background(), blockedId.SingleValue(), options.UnblockError)
+	assert.NoError(t, err)
+	assert.False(t, unblocked.SingleValue())
+}
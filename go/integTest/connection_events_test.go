@@ -0,0 +1,107 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// waitForConnectionEvent waits until an event of the given type is received, ignoring the other events.
+func (suite *GlideTestSuite) waitForConnectionEvent(
+	events <-chan api.ConnectionEvent,
+	eventType api.ConnectionEventType,
+	whileWaiting func(),
+) api.ConnectionEvent {
+	deadline := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Type == eventType {
+				return event
+			}
+		case <-deadline:
+			suite.T().Fatalf("No %s event received", eventType)
+		case <-time.After(100 * time.Millisecond):
+			whileWaiting()
+		}
+	}
+}
+
+func (suite *GlideTestSuite) TestConnectionEventListener_Standalone() {
+	events := make(chan api.ConnectionEvent, 100)
+	client := suite.client(suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().WithConnectionEventListener(
+			func(event api.ConnectionEvent) { events <- event },
+		)))
+	adminClient := suite.defaultClient()
+	defer adminClient.Close()
+
+	connected := suite.waitForConnectionEvent(events, api.ConnectionEventConnected, func() {})
+	assert.NotEmpty(suite.T(), connected.Address)
+
+	clientId, err := client.CustomCommand(context.Background(), []string{"CLIENT", "ID"})
+	assert.NoError(suite.T(), err)
+	_, err = adminClient.CustomCommand(context.Background(), []string{"CLIENT", "KILL", "ID", fmt.Sprint(clientId)})
+	assert.NoError(suite.T(), err)
+
+	// the loss of the connection is detected by the next requests, or by the periodic checks of the client
+	sendPing := func() { _, _ = client.Ping(context.Background()) }
+	disconnected := suite.waitForConnectionEvent(events, api.ConnectionEventDisconnected, sendPing)
+	assert.Equal(suite.T(), connected.Address, disconnected.Address)
+	reconnected := suite.waitForConnectionEvent(events, api.ConnectionEventReconnected, sendPing)
+	assert.Equal(suite.T(), connected.Address, reconnected.Address)
+
+	_, err = client.Ping(context.Background())
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestConnectionEventListener_Cluster() {
+	events := make(chan api.ConnectionEvent, 100)
+	client := suite.clusterClient(suite.defaultClusterClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClusterClientConfiguration().WithConnectionEventListener(
+			func(event api.ConnectionEvent) { events <- event },
+		)))
+
+	adminClient := suite.defaultClusterClient()
+	defer adminClient.Close()
+
+	connected := suite.waitForConnectionEvent(events, api.ConnectionEventConnected, func() {})
+	assert.NotEmpty(suite.T(), connected.Address)
+
+	// kill the connections of the client to the primaries
+	clientIds, err := client.CustomCommandWithRoute(
+		context.Background(),
+		[]string{"CLIENT", "ID"},
+		config.AllPrimaries,
+	)
+	assert.NoError(suite.T(), err)
+	for address, clientId := range clientIds.MultiValue() {
+		route, err := config.NewByAddressRouteWithHost(address)
+		assert.NoError(suite.T(), err)
+		_, err = adminClient.CustomCommandWithRoute(
+			context.Background(),
+			[]string{"CLIENT", "KILL", "ID", fmt.Sprint(clientId)},
+			route,
+		)
+		assert.NoError(suite.T(), err)
+	}
+
+	// the loss of the connections is detected by the next requests, or by the periodic checks of the client
+	sendPing := func() {
+		_, _ = client.PingWithOptions(
+			context.Background(),
+			options.ClusterPingOptions{RouteOption: &options.RouteOption{Route: config.AllPrimaries}},
+		)
+	}
+	disconnected := suite.waitForConnectionEvent(events, api.ConnectionEventDisconnected, sendPing)
+	assert.NotEmpty(suite.T(), disconnected.Address)
+	reconnected := suite.waitForConnectionEvent(events, api.ConnectionEventReconnected, sendPing)
+	assert.NotEmpty(suite.T(), reconnected.Address)
+}
@@ -3,10 +3,12 @@
 package integTest
 
 import (
+	"context"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/valkey-io/valkey-glide/go/api"
 	"github.com/valkey-io/valkey-glide/go/api/config"
@@ -50,6 +52,144 @@ func (suite *GlideTestSuite) TestClusterConnect_singlePort() {
 	client.Close()
 }
 
+func (suite *GlideTestSuite) TestConnectWithResp2() {
+	standaloneClient := suite.client(suite.defaultClientConfig().WithProtocol(api.RESP2))
+	clusterClient := suite.clusterClient(suite.defaultClusterClientConfig().WithProtocol(api.RESP2))
+
+	for _, client := range []api.BaseClient{standaloneClient, clusterClient} {
+		hashKey := "{resp2}-hash-" + uuid.NewString()
+		setKey := "{resp2}-set-" + uuid.NewString()
+		fields := map[string]string{"field1": "value1", "field2": "value2"}
+
+		_, err := client.HSet(context.Background(), hashKey, fields)
+		assert.NoError(suite.T(), err)
+		hash, err := client.HGetAll(context.Background(), hashKey)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), fields, hash)
+		hashBytes, err := client.HGetAllBytes(context.Background(), []byte(hashKey))
+		assert.NoError(suite.T(), err)
+		assert.ElementsMatch(
+			suite.T(),
+			[]api.FieldAndValue{
+				{Field: []byte("field1"), Value: []byte("value1")},
+				{Field: []byte("field2"), Value: []byte("value2")},
+			},
+			hashBytes,
+		)
+
+		_, err = client.SAdd(context.Background(), setKey, []string{"a", "b"})
+		assert.NoError(suite.T(), err)
+		members, err := client.SMembers(context.Background(), setKey)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), map[string]struct{}{"a": {}, "b": {}}, members)
+	}
+
+	config, err := standaloneClient.ConfigGet(context.Background(), []string{"timeout"})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), config, "timeout")
+}
+
+func (suite *GlideTestSuite) TestConnectWithResp2AndSubscriptions() {
+	subscriptionConfig := api.NewStandaloneSubscriptionConfig().WithSubscription(api.ExactChannelMode, "channel")
+	config := suite.defaultClientConfig().WithProtocol(api.RESP2).WithSubscriptionConfig(subscriptionConfig)
+	client, err := api.NewGlideClient(config)
+
+	assert.Nil(suite.T(), client)
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestClusterConnect_periodicChecks() {
+	for _, periodicChecks := range []*api.PeriodicChecks{
+		api.NewPeriodicChecksEnabledDefaultConfigs(),
+		api.NewPeriodicChecksManualInterval(30),
+		api.NewPeriodicChecksDisabled(),
+	} {
+		client := suite.clusterClient(suite.defaultClusterClientConfig().WithPeriodicChecks(periodicChecks))
+		result, err := client.Ping(context.Background())
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "PONG", result)
+	}
+}
+
+func (suite *GlideTestSuite) TestInflightRequestsLimit() {
+	config := suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().WithInflightRequestsLimit(1))
+	client := suite.client(config)
+	key := "{inflight}-" + uuid.NewString()
+
+	// the blocking request occupies the only inflight slot of the client
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := client.BLPop(context.Background(), []string{key}, 1)
+		assert.NoError(suite.T(), err)
+	}()
+	time.Sleep(200 * time.Millisecond)
+
+	_, err := client.Get(context.Background(), key)
+	assert.IsType(suite.T(), &errors.InflightRequestsLimitError{}, err)
+
+	<-done
+	_, err = client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestInflightRequestsNotLimitedByDefault() {
+	client := suite.defaultClient()
+	key := uuid.NewString()
+
+	// more concurrent requests than the default limit of the core, which only applies when a limit is configured
+	var wg sync.WaitGroup
+	errs := make(chan error, 2000)
+	for range 2000 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Get(context.Background(), key); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(suite.T(), err)
+	}
+}
+
+func (suite *GlideTestSuite) TestConnectWithInsecureTls() {
+	if !suite.tls {
+		suite.T().Skip("TLS is not enabled")
+	}
+	tlsConfig := api.NewTlsConfiguration().WithInsecureTLS(true)
+
+	client := suite.client(suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().WithTlsConfiguration(tlsConfig)))
+	result, err := client.Ping(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "PONG", result)
+
+	clusterClient := suite.clusterClient(suite.defaultClusterClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClusterClientConfiguration().WithTlsConfiguration(tlsConfig)))
+	result, err = clusterClient.Ping(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "PONG", result)
+}
+
+func (suite *GlideTestSuite) TestConnectWithUntrustedRootCertificates() {
+	if !suite.tls {
+		suite.T().Skip("TLS is not enabled")
+	}
+	// no root certificate is trusted, so the certificate of the server can't be verified
+	tlsConfig := api.NewTlsConfiguration().WithRootCertificates([]byte("not a certificate"))
+	config := suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().WithTlsConfiguration(tlsConfig))
+	client, err := api.NewGlideClient(config)
+
+	assert.Nil(suite.T(), client)
+	assert.IsType(suite.T(), &errors.ConnectionError{}, err)
+}
+
 func (suite *GlideTestSuite) TestConnectWithInvalidAddress() {
 	config := api.NewGlideClientConfiguration().
 		WithAddress(&api.NodeAddress{Host: "invalid-host"})
@@ -73,12 +213,16 @@ func (suite *GlideTestSuite) TestConnectionTimeout() {
 				}
 			}()
 			if clusterClient, ok := client.(api.GlideClusterClientCommands); ok {
-				_, err := clusterClient.CustomCommandWithRoute([]string{"DEBUG", "sleep", "7"}, config.AllNodes)
+				_, err := clusterClient.CustomCommandWithRoute(
+					context.Background(),
+					[]string{"DEBUG", "sleep", "7"},
+					config.AllNodes,
+				)
 				if err != nil {
 					suite.T().Errorf("Error during DEBUG SLEEP command: %v", err)
 				}
 			} else if glideClient, ok := client.(api.GlideClientCommands); ok {
-				_, err := glideClient.CustomCommand([]string{"DEBUG", "sleep", "7"})
+				_, err := glideClient.CustomCommand(context.Background(), []string{"DEBUG", "sleep", "7"})
 				if err != nil {
 					suite.T().Errorf("Error during DEBUG SLEEP command: %v", err)
 				}
@@ -121,7 +265,7 @@ func (suite *GlideTestSuite) TestConnectionTimeout() {
 			assert.NoError(suite.T(), err)
 			if timeoutClient != nil {
 				defer timeoutClient.Close()
-				result, err := timeoutClient.Set("key", "value")
+				result, err := timeoutClient.Set(context.Background(), "key", "value")
 				assert.NoError(suite.T(), err)
 				assert.Equal(suite.T(), "OK", result)
 			}
@@ -3,6 +3,7 @@
 package integTest
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -155,7 +156,10 @@ func getServerVersion(suite *GlideTestSuite) string {
 		client, err := api.NewGlideClient(clientConfig)
 		if err == nil && client != nil {
 			defer client.Close()
-			info, _ := client.InfoWithOptions(options.InfoOptions{Sections: []options.Section{options.Server}})
+			info, _ := client.InfoWithOptions(
+				context.Background(),
+				options.InfoOptions{Sections: []options.Section{options.Server}},
+			)
 			return extractServerVersion(suite, info)
 		}
 	}
@@ -176,6 +180,7 @@ func getServerVersion(suite *GlideTestSuite) string {
 		defer client.Close()
 
 		info, _ := client.InfoWithOptions(
+			context.Background(),
 			options.ClusterInfoOptions{
 				InfoOptions: &options.InfoOptions{Sections: []options.Section{options.Server}},
 				RouteOption: &options.RouteOption{Route: config.RandomRoute},
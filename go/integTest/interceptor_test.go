@@ -0,0 +1,115 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func (suite *GlideTestSuite) TestInterceptors() {
+	type interceptedCommand struct {
+		name     string
+		args     []string
+		value    any
+		err      error
+		duration time.Duration
+	}
+	var mu sync.Mutex
+	var commands []interceptedCommand
+	audit := func(ctx context.Context, command *api.CommandInfo, invoker api.CommandInvoker) (*api.CommandResult, error) {
+		start := time.Now()
+		result, err := invoker(ctx, command)
+		intercepted := interceptedCommand{name: command.Name, args: command.Args, err: err, duration: time.Since(start)}
+		if err == nil {
+			intercepted.value, _ = result.Value()
+		}
+		mu.Lock()
+		commands = append(commands, intercepted)
+		mu.Unlock()
+		return result, err
+	}
+	prefix := "{" + uuid.NewString() + "}:"
+	prefixKeys := func(ctx context.Context, command *api.CommandInfo, invoker api.CommandInvoker) (*api.CommandResult, error) {
+		if command.Name != "GET" && command.Name != "SET" {
+			return invoker(ctx, command)
+		}
+		prefixed := *command
+		prefixed.Args = append([]string{prefix + command.Args[0]}, command.Args[1:]...)
+		return invoker(ctx, &prefixed)
+	}
+	injectFailure := func(ctx context.Context, command *api.CommandInfo, invoker api.CommandInvoker) (*api.CommandResult, error) {
+		if len(command.Args) > 0 && strings.HasSuffix(command.Args[0], "fail") {
+			return nil, &errors.DisconnectError{}
+		}
+		return invoker(ctx, command)
+	}
+
+	client := suite.client(suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().
+			WithInterceptors(audit, prefixKeys, injectFailure)))
+
+	_, err := client.Set(context.Background(), "key", "value")
+	assert.NoError(suite.T(), err)
+	value, err := client.Get(context.Background(), "key")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	// the key was prefixed by the interceptor
+	prefixed, err := client.CustomCommand(context.Background(), []string{"GET", prefix + "key"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", prefixed)
+
+	_, err = client.Get(context.Background(), "fail")
+	assert.IsType(suite.T(), &errors.DisconnectError{}, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(suite.T(), commands, 4)
+	assert.Equal(suite.T(), "SET", commands[0].name)
+	// the audit interceptor is the outermost, so it sees the arguments before they are prefixed
+	assert.Equal(suite.T(), []string{"key", "value"}, commands[0].args)
+	assert.Equal(suite.T(), "OK", commands[0].value)
+	assert.Equal(suite.T(), "GET", commands[1].name)
+	assert.Equal(suite.T(), "value", commands[1].value)
+	assert.Positive(suite.T(), commands[1].duration)
+	assert.Equal(suite.T(), "", commands[2].name)
+	assert.Equal(suite.T(), []string{"GET", prefix + "key"}, commands[2].args)
+	assert.IsType(suite.T(), &errors.DisconnectError{}, commands[3].err)
+}
+
+func (suite *GlideTestSuite) TestInterceptorsWithInvalidResult() {
+	fakeResult := func(ctx context.Context, command *api.CommandInfo, invoker api.CommandInvoker) (*api.CommandResult, error) {
+		if command.Name == "GET" {
+			return &api.CommandResult{}, nil
+		}
+		return invoker(ctx, command)
+	}
+	renamed := func(ctx context.Context, command *api.CommandInfo, invoker api.CommandInvoker) (*api.CommandResult, error) {
+		if command.Name == "STRLEN" {
+			command.Name = "GET"
+		}
+		return invoker(ctx, command)
+	}
+
+	client := suite.client(suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().
+			WithInterceptors(fakeResult, renamed)))
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+	_, err := client.Get(context.Background(), key)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+	// the name is read-only, so STRLEN is still sent
+	length, err := client.Strlen(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), length)
+}
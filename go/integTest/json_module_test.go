@@ -3,6 +3,7 @@
 package integTest
 
 import (
+	"context"
 	"strings"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ import (
 func (suite *GlideTestSuite) TestModuleVerifyJsonLoaded() {
 	client := suite.defaultClusterClient()
 	result, err := client.InfoWithOptions(
+		context.Background(),
 		options.ClusterInfoOptions{
 			InfoOptions: &options.InfoOptions{Sections: []options.Section{options.Server}},
 			RouteOption: nil,
@@ -33,22 +35,24 @@ func (suite *GlideTestSuite) TestModuleGetSetCommand() {
 	key := uuid.New().String()
 	jsonValue := "{\"a\":1.0,\"b\":2}"
 
-	suite.verifyOK(glidejson.Set(client, key, "$", jsonValue))
+	suite.verifyOK(glidejson.Set(context.Background(), client, key, "$", jsonValue))
 
-	jsonGetResult, err := glidejson.Get(client, key)
+	jsonGetResult, err := glidejson.Get(context.Background(), client, key)
 	assert.NoError(t, err)
 	assert.Equal(t, jsonValue, jsonGetResult.Value())
 
 	jsonGetResultWithMultiPaths, err := glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$.a", "$.b"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "{\"$.a\":[1.0],\"$.b\":[2]}", jsonGetResultWithMultiPaths.Value())
 
-	jsonGetResult, err = glidejson.Get(client, "non_existing_key")
+	jsonGetResult, err = glidejson.Get(context.Background(), client, "non_existing_key")
 	assert.NoError(t, err)
 	assert.True(t, jsonGetResult.IsNil())
 
 	jsonGetResult, err = glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$.d"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "[]", jsonGetResult.Value())
@@ -59,20 +63,23 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandMultipleValues() {
 	t := suite.T()
 	key := uuid.New().String()
 	jsonValue := "{\"a\": {\"c\": 1, \"d\": 4}, \"b\": {\"c\": 2}, \"c\": true}"
-	suite.verifyOK(glidejson.Set(client, key, "$", jsonValue))
+	suite.verifyOK(glidejson.Set(context.Background(), client, key, "$", jsonValue))
 
 	jsonGetResult, err := glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$..c"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "[true,1,2]", jsonGetResult.Value())
 
 	jsonGetResultWithMultiPaths, err := glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$..c", "$.c"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "{\"$..c\":[true,1,2],\"$.c\":[true]}", jsonGetResultWithMultiPaths.Value())
-	suite.verifyOK(glidejson.Set(client, key, "$..c", "\"new_value\""))
+	suite.verifyOK(glidejson.Set(context.Background(), client, key, "$..c", "\"new_value\""))
 
 	jsonGetResult, err = glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{"$..c"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "[\"new_value\",\"new_value\",\"new_value\"]", jsonGetResult.Value())
@@ -85,6 +92,7 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandConditionalSet() {
 	jsonValue := "{\"a\": 1.0, \"b\": 2}"
 
 	jsonSetResult, err := glidejson.SetWithOptions(
+		context.Background(),
 		client,
 		key,
 		"$",
@@ -95,6 +103,7 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandConditionalSet() {
 	assert.True(t, jsonSetResult.IsNil())
 
 	jsonSetResult, err = glidejson.SetWithOptions(
+		context.Background(),
 		client,
 		key,
 		"$",
@@ -105,6 +114,7 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandConditionalSet() {
 	assert.Equal(t, "OK", jsonSetResult.Value())
 
 	jsonSetResult, err = glidejson.SetWithOptions(
+		context.Background(),
 		client,
 		key,
 		"$.a",
@@ -115,11 +125,13 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandConditionalSet() {
 	assert.True(t, jsonSetResult.IsNil())
 
 	jsonGetResult, err := glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{".a"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "1.0", jsonGetResult.Value())
 
 	jsonSetResult, err = glidejson.SetWithOptions(
+		context.Background(),
 		client,
 		key,
 		"$.a",
@@ -130,6 +142,7 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandConditionalSet() {
 	assert.Equal(t, "OK", jsonSetResult.Value())
 
 	jsonGetResult, err = glidejson.GetWithOptions(
+		context.Background(),
 		client, key, *glideoptions.NewJsonGetOptionsBuilder().SetPaths([]string{".a"}))
 	assert.NoError(t, err)
 	assert.Equal(t, "4.5", jsonGetResult.Value())
@@ -139,7 +152,9 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandFormatting() {
 	client := suite.defaultClusterClient()
 	t := suite.T()
 	key := uuid.New().String()
-	suite.verifyOK(glidejson.Set(client, key, "$", "{\"a\": 1.0, \"b\": 2, \"c\": {\"d\": 3, \"e\": 4}}"))
+	suite.verifyOK(
+		glidejson.Set(context.Background(), client, key, "$", "{\"a\": 1.0, \"b\": 2, \"c\": {\"d\": 3, \"e\": 4}}"),
+	)
 	expectedGetResult := "[\n" +
 		"  {\n" +
 		"    \"a\": 1.0,\n" +
@@ -152,6 +167,7 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandFormatting() {
 		"]"
 
 	actualGetResult, err := glidejson.GetWithOptions(
+		context.Background(),
 		client,
 		key,
 		*glideoptions.NewJsonGetOptionsBuilder().
@@ -162,6 +178,7 @@ func (suite *GlideTestSuite) TestModuleGetSetCommandFormatting() {
 
 	expectedGetResult2 := "[\n~{\n~~\"a\":*1.0,\n~~\"b\":*2,\n~~\"c\":*{\n~~~\"d\":*3,\n~~~\"e\":*4\n~~}\n~}\n]"
 	actualGetResult2, err := glidejson.GetWithOptions(
+		context.Background(),
 		client,
 		key,
 		*glideoptions.NewJsonGetOptionsBuilder().
@@ -0,0 +1,67 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+// recordingHandler is a slog.Handler recording the messages of the logs it handles.
+type recordingHandler struct {
+	mu       sync.Mutex
+	level    slog.Level
+	messages []string
+}
+
+func (handler *recordingHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= handler.level
+}
+
+func (handler *recordingHandler) Handle(_ context.Context, record slog.Record) error {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	handler.messages = append(handler.messages, record.Level.String()+" "+record.Message)
+	return nil
+}
+
+func (handler *recordingHandler) WithAttrs(_ []slog.Attr) slog.Handler { return handler }
+
+func (handler *recordingHandler) WithGroup(_ string) slog.Handler { return handler }
+
+func (handler *recordingHandler) contains(substring string) bool {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	for _, message := range handler.messages {
+		if strings.Contains(message, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *GlideTestSuite) TestLoggerSlogHandler() {
+	handler := &recordingHandler{level: slog.LevelDebug}
+	api.Logger.SetSlogHandler(api.InfoLevel, handler)
+	defer api.Logger.SetLoggerConfig(api.WarnLevel, "")
+
+	identifier := uuid.NewString()
+	api.Logger.Log(api.WarnLevel, identifier, "warning")
+	api.Logger.Log(api.InfoLevel, identifier, "information")
+	// below the level of the logger
+	api.Logger.Log(api.DebugLevel, identifier, "debugging")
+
+	assert.True(suite.T(), handler.contains("WARN "+identifier+" - warning"))
+	assert.True(suite.T(), handler.contains("INFO "+identifier+" - information"))
+	assert.False(suite.T(), handler.contains(identifier+" - debugging"))
+
+	api.Logger.SetLoggerConfig(api.WarnLevel, "")
+	api.Logger.Log(api.WarnLevel, identifier, "not forwarded")
+	assert.False(suite.T(), handler.contains(identifier+" - not forwarded"))
+}
@@ -0,0 +1,75 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+// readSpanNames returns the names of the spans exported to the spans.json file of the given directory.
+func readSpanNames(dir string) []string {
+	file, err := os.Open(filepath.Join(dir, "spans.json"))
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var span struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(scanner.Bytes(), &span) == nil {
+			names = append(names, span.Name)
+		}
+	}
+	return names
+}
+
+func (suite *GlideTestSuite) TestOpenTelemetrySpans() {
+	if api.IsOpenTelemetryInitialized() {
+		suite.T().Skip("OpenTelemetry was already initialized by another test")
+	}
+	dir := suite.T().TempDir()
+	config := api.NewOpenTelemetryConfig("file://" + dir).
+		WithFlushIntervalMs(100).
+		WithSamplePercentage(100)
+	assert.NoError(suite.T(), api.InitOpenTelemetry(config))
+	// don't trace the commands of the other tests
+	defer func() { assert.NoError(suite.T(), api.SetOpenTelemetrySamplePercentage(0)) }()
+
+	assert.Error(suite.T(), api.InitOpenTelemetry(config))
+
+	client := suite.defaultClient()
+	key := uuid.NewString()
+	_, err := client.Set(context.Background(), key, "value")
+	assert.NoError(suite.T(), err)
+	_, err = client.Exec(context.Background(), api.NewBatch(true).Get(key), true)
+	assert.NoError(suite.T(), err)
+	script := api.NewScript("return 'Hello'")
+	defer script.Close()
+	_, err = client.InvokeScript(context.Background(), script)
+	assert.NoError(suite.T(), err)
+
+	assert.Eventually(suite.T(), func() bool {
+		names := readSpanNames(dir)
+		return slices.Contains(names, "SET") &&
+			slices.Contains(names, "send_command") &&
+			slices.Contains(names, "Batch") &&
+			slices.Contains(names, "send_batch") &&
+			slices.Contains(names, "InvokeScript") &&
+			slices.Contains(names, "send_script")
+	}, 5*time.Second, 100*time.Millisecond)
+}
@@ -3,6 +3,7 @@
 package integTest
 
 import (
+	"context"
 	"runtime"
 	"time"
 
@@ -16,6 +17,6 @@ func (suite *GlideTestSuite) TestParallelizedSetWithGC() {
 		runtime.GC()
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 	})
 }
@@ -0,0 +1,261 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// The time given to the subscribed clients to complete their subscriptions after they are created.
+const subscriptionDelay = 500 * time.Millisecond
+
+func (suite *GlideTestSuite) receivePubSubMessage(client api.BaseClient) *api.PubSubMessage {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	message, err := client.GetPubSubMessage(ctx)
+	assert.NoError(suite.T(), err)
+	return message
+}
+
+func (suite *GlideTestSuite) TestPubSubQueue() {
+	channel := "channel-" + uuid.NewString()
+	pattern := "pattern-" + uuid.NewString()
+	subscriptionConfig := api.NewStandaloneSubscriptionConfig().
+		WithSubscription(api.ExactChannelMode, channel).
+		WithSubscription(api.PatternChannelMode, pattern+"*")
+	subscriber := suite.client(suite.defaultClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	publisher := suite.defaultClient()
+	time.Sleep(subscriptionDelay)
+
+	message, err := subscriber.TryGetPubSubMessage()
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), message)
+
+	_, err = publisher.Publish(context.Background(), channel, "message1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		&api.PubSubMessage{Message: "message1", Channel: channel, Pattern: api.CreateNilStringResult()},
+		suite.receivePubSubMessage(subscriber),
+	)
+
+	_, err = publisher.Publish(context.Background(), pattern+"-1", "message2")
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		&api.PubSubMessage{Message: "message2", Channel: pattern + "-1", Pattern: api.CreateStringResult(pattern + "*")},
+		suite.receivePubSubMessage(subscriber),
+	)
+}
+
+func (suite *GlideTestSuite) TestPubSubCallback() {
+	channel := "channel-" + uuid.NewString()
+	received := make(chan *api.PubSubMessage, 1)
+	callback := func(message *api.PubSubMessage, userContext any) {
+		userContext.(chan *api.PubSubMessage) <- message
+	}
+	subscriptionConfig := api.NewStandaloneSubscriptionConfig().
+		WithSubscription(api.ExactChannelMode, channel).
+		WithCallback(callback, received)
+	subscriber := suite.client(suite.defaultClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	publisher := suite.defaultClient()
+	time.Sleep(subscriptionDelay)
+
+	_, err := publisher.Publish(context.Background(), channel, "message")
+	assert.NoError(suite.T(), err)
+	select {
+	case message := <-received:
+		assert.Equal(
+			suite.T(),
+			&api.PubSubMessage{Message: "message", Channel: channel, Pattern: api.CreateNilStringResult()},
+			message,
+		)
+	case <-time.After(5 * time.Second):
+		assert.Fail(suite.T(), "The message was not delivered to the callback")
+	}
+
+	// messages are not queued when a callback is configured
+	message, err := subscriber.TryGetPubSubMessage()
+	assert.Nil(suite.T(), message)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestPubSubWithoutSubscriptions() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		message, err := client.TryGetPubSubMessage()
+		assert.Nil(suite.T(), message)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+		message, err = client.GetPubSubMessage(context.Background())
+		assert.Nil(suite.T(), message)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestPubSubClosedClient() {
+	subscriptionConfig := api.NewStandaloneSubscriptionConfig().
+		WithSubscription(api.ExactChannelMode, "channel-"+uuid.NewString())
+	subscriber := suite.client(suite.defaultClientConfig().WithSubscriptionConfig(subscriptionConfig))
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		subscriber.Close()
+	}()
+	message, err := subscriber.GetPubSubMessage(context.Background())
+	assert.Nil(suite.T(), message)
+	assert.IsType(suite.T(), &errors.ClosingError{}, err)
+}
+
+func (suite *GlideTestSuite) TestClusterPubSubQueue() {
+	channel := "channel-" + uuid.NewString()
+	pattern := "pattern-" + uuid.NewString()
+	subscriptionConfig := api.NewClusterSubscriptionConfig().
+		WithSubscription(api.ExactClusterChannelMode, channel).
+		WithSubscription(api.PatternClusterChannelMode, pattern+"*")
+	subscriber := suite.clusterClient(suite.defaultClusterClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	publisher := suite.defaultClusterClient()
+	time.Sleep(subscriptionDelay)
+
+	_, err := publisher.Publish(context.Background(), channel, "message1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		&api.PubSubMessage{Message: "message1", Channel: channel, Pattern: api.CreateNilStringResult()},
+		suite.receivePubSubMessage(subscriber),
+	)
+
+	_, err = publisher.Publish(context.Background(), pattern+"-1", "message2")
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		&api.PubSubMessage{Message: "message2", Channel: pattern + "-1", Pattern: api.CreateStringResult(pattern + "*")},
+		suite.receivePubSubMessage(subscriber),
+	)
+}
+
+func (suite *GlideTestSuite) TestClusterPubSubSharded() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	channel := "shard-channel-" + uuid.NewString()
+	subscriptionConfig := api.NewClusterSubscriptionConfig().
+		WithSubscription(api.ShardedClusterChannelMode, channel)
+	subscriber := suite.clusterClient(suite.defaultClusterClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	publisher := suite.defaultClusterClient()
+	time.Sleep(subscriptionDelay)
+
+	_, err := publisher.SPublish(context.Background(), channel, "message")
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		&api.PubSubMessage{Message: "message", Channel: channel, Pattern: api.CreateNilStringResult()},
+		suite.receivePubSubMessage(subscriber),
+	)
+}
+
+func (suite *GlideTestSuite) TestPublish() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		result, err := client.Publish(context.Background(), "channel-"+uuid.NewString(), "message")
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(0), result)
+	})
+
+	channel := "channel-" + uuid.NewString()
+	subscriptionConfig := api.NewStandaloneSubscriptionConfig().WithSubscription(api.ExactChannelMode, channel)
+	suite.client(suite.defaultClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	time.Sleep(subscriptionDelay)
+
+	result, err := suite.defaultClient().Publish(context.Background(), channel, "message")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), result)
+}
+
+func (suite *GlideTestSuite) TestClusterSPublish() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	client := suite.defaultClusterClient()
+	channel := "shard-channel-" + uuid.NewString()
+
+	result, err := client.SPublish(context.Background(), channel, "message")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), result)
+
+	subscriptionConfig := api.NewClusterSubscriptionConfig().WithSubscription(api.ShardedClusterChannelMode, channel)
+	suite.clusterClient(suite.defaultClusterClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	time.Sleep(subscriptionDelay)
+
+	result, err = client.SPublish(context.Background(), channel, "message")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), result)
+}
+
+func (suite *GlideTestSuite) TestPubSubIntrospection() {
+	prefix := "channel-" + uuid.NewString()
+	channel := prefix + "-1"
+	pattern := prefix + "-pattern*"
+	subscriptionConfig := api.NewStandaloneSubscriptionConfig().
+		WithSubscription(api.ExactChannelMode, channel).
+		WithSubscription(api.PatternChannelMode, pattern)
+	suite.client(suite.defaultClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	client := suite.defaultClient()
+	time.Sleep(subscriptionDelay)
+
+	channels, err := client.PubSubChannelsWithPattern(context.Background(), prefix+"*")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{channel}, channels)
+
+	channels, err = client.PubSubChannels(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), channels, channel)
+
+	numPat, err := client.PubSubNumPat(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), numPat, int64(1))
+
+	numSub, err := client.PubSubNumSub(context.Background(), []string{channel, prefix + "-2"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]int64{channel: 1, prefix + "-2": 0}, numSub)
+}
+
+func (suite *GlideTestSuite) TestClusterPubSubIntrospection() {
+	prefix := "channel-" + uuid.NewString()
+	channel := prefix + "-1"
+	subscriptionConfig := api.NewClusterSubscriptionConfig().WithSubscription(api.ExactClusterChannelMode, channel)
+	suite.clusterClient(suite.defaultClusterClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	client := suite.defaultClusterClient()
+	time.Sleep(subscriptionDelay)
+
+	// the results of all the nodes are aggregated
+	channels, err := client.PubSubChannelsWithPattern(context.Background(), prefix+"*")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{channel}, channels)
+
+	numSub, err := client.PubSubNumSub(context.Background(), []string{channel, prefix + "-2"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]int64{channel: 1, prefix + "-2": 0}, numSub)
+}
+
+func (suite *GlideTestSuite) TestClusterPubSubShardIntrospection() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	prefix := "shard-channel-" + uuid.NewString()
+	channel := prefix + "-1"
+	subscriptionConfig := api.NewClusterSubscriptionConfig().WithSubscription(api.ShardedClusterChannelMode, channel)
+	suite.clusterClient(suite.defaultClusterClientConfig().WithSubscriptionConfig(subscriptionConfig))
+	client := suite.defaultClusterClient()
+	time.Sleep(subscriptionDelay)
+
+	channels, err := client.PubSubShardChannelsWithPattern(context.Background(), prefix+"*")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{channel}, channels)
+
+	channels, err = client.PubSubShardChannels(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), channels, channel)
+
+	numSub, err := client.PubSubShardNumSub(context.Background(), []string{channel, prefix + "-2"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]int64{channel: 1, prefix + "-2": 0}, numSub)
+}
@@ -3,6 +3,7 @@
 package integTest
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -23,14 +24,16 @@ func (suite *GlideTestSuite) TestRoutingWithAzAffinityStrategyTo1Replica() {
 
 	// Reset the availability zone for all nodes
 	_, err := clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "SET", "availability-zone", ""}, config.AllNodes)
 	assert.NoError(suite.T(), err)
-	resetStatResult, err := clientForConfigSet.CustomCommand([]string{"CONFIG", "RESETSTAT"})
+	resetStatResult, err := clientForConfigSet.CustomCommand(context.Background(), []string{"CONFIG", "RESETSTAT"})
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "OK", resetStatResult.SingleValue())
 
 	// 12182 is the slot of "foo"
 	_, err = clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "SET", "availability-zone", az}, config.NewSlotIdRoute(config.SlotTypeReplica, 12182))
 	assert.NoError(suite.T(), err)
 
@@ -40,11 +43,12 @@ func (suite *GlideTestSuite) TestRoutingWithAzAffinityStrategyTo1Replica() {
 		WithClientAZ(az))
 
 	for i := 0; i < GET_CALLS; i++ {
-		_, err := clientForTestingAz.Get("foo")
+		_, err := clientForTestingAz.Get(context.Background(), "foo")
 		assert.NoError(suite.T(), err)
 	}
 
 	infoResult, err := clientForTestingAz.InfoWithOptions(
+		context.Background(),
 		options.ClusterInfoOptions{
 			InfoOptions: &options.InfoOptions{Sections: []options.Section{options.Server, options.Commandstats}},
 			RouteOption: &options.RouteOption{Route: config.AllNodes},
@@ -82,14 +86,16 @@ func (suite *GlideTestSuite) TestRoutingBySlotToReplicaWithAzAffinityStrategyToA
 
 	// Reset the availability zone for all nodes
 	_, err := clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "SET", "availability-zone", ""}, config.AllNodes)
 	assert.NoError(suite.T(), err)
-	resetStatResult, err := clientForConfigSet.CustomCommand([]string{"CONFIG", "RESETSTAT"})
+	resetStatResult, err := clientForConfigSet.CustomCommand(context.Background(), []string{"CONFIG", "RESETSTAT"})
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "OK", resetStatResult.SingleValue())
 
 	// Get Replica Count for current cluster
 	clusterInfo, err := clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"INFO", "REPLICATION"}, config.NewSlotKeyRoute(config.SlotTypePrimary, "key"))
 	assert.NoError(suite.T(), err)
 	nReplicas := 0
@@ -108,6 +114,7 @@ func (suite *GlideTestSuite) TestRoutingBySlotToReplicaWithAzAffinityStrategyToA
 
 	// Setting AZ for all Nodes
 	_, err = clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "SET", "availability-zone", az}, config.AllNodes)
 	assert.NoError(suite.T(), err)
 	clientForConfigSet.Close()
@@ -119,6 +126,7 @@ func (suite *GlideTestSuite) TestRoutingBySlotToReplicaWithAzAffinityStrategyToA
 		WithClientAZ(az))
 
 	azGetResult, err := clientForTestingAz.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "GET", "availability-zone"}, config.AllNodes)
 	assert.NoError(suite.T(), err)
 	for _, value := range azGetResult.MultiValue() {
@@ -131,11 +139,12 @@ func (suite *GlideTestSuite) TestRoutingBySlotToReplicaWithAzAffinityStrategyToA
 
 	// Execute GET commands
 	for i := 0; i < nGetCalls; i++ {
-		_, err := clientForTestingAz.Get("foo")
+		_, err := clientForTestingAz.Get(context.Background(), "foo")
 		assert.NoError(suite.T(), err)
 	}
 
 	infoResult, err := clientForTestingAz.InfoWithOptions(
+		context.Background(),
 		options.ClusterInfoOptions{
 			InfoOptions: &options.InfoOptions{Sections: []options.Section{options.All}},
 			RouteOption: &options.RouteOption{Route: config.AllNodes},
@@ -171,17 +180,19 @@ func (suite *GlideTestSuite) TestAzAffinityNonExistingAz() {
 
 	// Reset stats
 	resetStatResult, err := clientForTestingAz.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "RESETSTAT"}, config.AllNodes)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "OK", resetStatResult.SingleValue())
 
 	// Execute GET commands
 	for i := 0; i < nGetCalls; i++ {
-		_, err := clientForTestingAz.Get("foo")
+		_, err := clientForTestingAz.Get(context.Background(), "foo")
 		assert.NoError(suite.T(), err)
 	}
 
 	infoResult, err := clientForTestingAz.InfoWithOptions(
+		context.Background(),
 		options.ClusterInfoOptions{
 			InfoOptions: &options.InfoOptions{Sections: []options.Section{options.Commandstats}},
 			RouteOption: &options.RouteOption{Route: config.AllNodes},
@@ -217,21 +228,25 @@ func (suite *GlideTestSuite) TestAzAffinityReplicasAndPrimaryRoutesToPrimary() {
 
 	// Reset stats and set all nodes to otherAz
 	resetStatResult, err := clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "RESETSTAT"}, config.AllNodes)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "OK", resetStatResult.SingleValue())
 
 	_, err = clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "SET", "availability-zone", otherAz}, config.AllNodes)
 	assert.NoError(suite.T(), err)
 
 	// Set primary for slot 12182 to az
 	_, err = clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "SET", "availability-zone", az}, config.NewSlotIdRoute(config.SlotTypePrimary, 12182))
 	assert.NoError(suite.T(), err)
 
 	// Verify primary AZ
 	primaryAzResult, err := clientForConfigSet.CustomCommandWithRoute(
+		context.Background(),
 		[]string{"CONFIG", "GET", "availability-zone"}, config.NewSlotIdRoute(config.SlotTypePrimary, 12182))
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), az, primaryAzResult.MultiValue()["availability-zone"])
@@ -248,11 +263,12 @@ func (suite *GlideTestSuite) TestAzAffinityReplicasAndPrimaryRoutesToPrimary() {
 
 	// Execute GET commands
 	for i := 0; i < nGetCalls; i++ {
-		_, err := clientForTestingAz.Get("foo")
+		_, err := clientForTestingAz.Get(context.Background(), "foo")
 		assert.NoError(suite.T(), err)
 	}
 
 	infoResult, err := clientForTestingAz.InfoWithOptions(
+		context.Background(),
 		options.ClusterInfoOptions{
 			InfoOptions: &options.InfoOptions{Sections: []options.Section{options.All}},
 			RouteOption: &options.RouteOption{Route: config.AllNodes},
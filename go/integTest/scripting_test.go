@@ -0,0 +1,407 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func (suite *GlideTestSuite) TestInvokeScript() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		script := api.NewScript("return 'Hello'")
+		defer script.Close()
+
+		result, err := client.InvokeScript(context.Background(), script)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "Hello", result)
+	})
+}
+
+func (suite *GlideTestSuite) TestInvokeScriptWithOptions() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		script := api.NewScript("return redis.call('SET', KEYS[1], ARGV[1])")
+		defer script.Close()
+
+		result, err := client.InvokeScriptWithOptions(
+			context.Background(),
+			script,
+			options.ScriptOptions{Keys: []string{key}, Args: []string{"value"}},
+		)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "OK", result)
+
+		value, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", value.Value())
+	})
+}
+
+func (suite *GlideTestSuite) TestInvokeScriptHash() {
+	script := api.NewScript("return 'Hello'")
+	defer script.Close()
+	assert.Equal(suite.T(), fmt.Sprintf("%x", sha1.Sum([]byte("return 'Hello'"))), script.GetHash())
+
+	// scripts with the same code have the same hash
+	other := api.NewScript("return 'Hello'")
+	defer other.Close()
+	assert.Equal(suite.T(), script.GetHash(), other.GetHash())
+}
+
+func (suite *GlideTestSuite) TestInvokeScriptAfterScriptFlush() {
+	client := suite.defaultClient()
+	script := api.NewScript("return ARGV[1]")
+	defer script.Close()
+
+	result, err := client.InvokeScriptWithOptions(context.Background(), script, options.ScriptOptions{Args: []string{"1"}})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "1", result)
+
+	// the script is loaded again when it is no longer cached by the server
+	_, err = client.CustomCommand(context.Background(), []string{"SCRIPT", "FLUSH"})
+	assert.NoError(suite.T(), err)
+	result, err = client.InvokeScriptWithOptions(context.Background(), script, options.ScriptOptions{Args: []string{"2"}})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "2", result)
+}
+
+func (suite *GlideTestSuite) TestInvokeScriptAfterClosingIdenticalScript() {
+	client := suite.defaultClient()
+	value := uuid.NewString()
+	code := "return '" + value + "'"
+	script := api.NewScript(code)
+	defer script.Close()
+	other := api.NewScript(code)
+
+	// the code of a script is kept as long as a script with the same code is not closed
+	other.Close()
+	_, err := client.CustomCommand(context.Background(), []string{"SCRIPT", "FLUSH"})
+	assert.NoError(suite.T(), err)
+	result, err := client.InvokeScript(context.Background(), script)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), value, result)
+}
+
+func (suite *GlideTestSuite) TestInvokeScriptAfterClose() {
+	client := suite.defaultClient()
+	script := api.NewScript("return '" + uuid.NewString() + "'")
+	script.Close()
+	script.Close()
+
+	// the script is neither kept by the client nor cached by the server
+	result, err := client.InvokeScript(context.Background(), script)
+	assert.Nil(suite.T(), result)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestClusterInvokeScriptWithRoute() {
+	client := suite.defaultClusterClient()
+	script := api.NewScript("return 'Hello'")
+	defer script.Close()
+
+	result, err := client.InvokeScriptWithRoute(context.Background(), script, options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsMultiValue())
+	for _, value := range result.MultiValue() {
+		assert.Equal(suite.T(), "Hello", value)
+	}
+
+	result, err = client.InvokeScriptWithRoute(context.Background(), script, options.RouteOption{Route: config.RandomRoute})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsSingleValue())
+	assert.Equal(suite.T(), "Hello", result.SingleValue())
+}
+
+func (suite *GlideTestSuite) TestClusterInvokeScriptWithClusterOptions() {
+	client := suite.defaultClusterClient()
+	script := api.NewScript("return ARGV[1]")
+	defer script.Close()
+
+	opts := options.ClusterScriptOptions{
+		Args:        []string{"value"},
+		RouteOption: &options.RouteOption{Route: config.AllPrimaries},
+	}
+	result, err := client.InvokeScriptWithClusterOptions(context.Background(), script, opts)
+	assert.NoError(suite.T(), err)
+	for _, value := range result.MultiValue() {
+		assert.Equal(suite.T(), "value", value)
+	}
+
+	// the command is routed to a random node without a route
+	result, err = client.InvokeScriptWithClusterOptions(
+		context.Background(),
+		script,
+		options.ClusterScriptOptions{Args: []string{"value"}},
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.SingleValue())
+}
+
+func (suite *GlideTestSuite) TestScriptLoadAndExists() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		code := "return '" + uuid.NewString() + "'"
+		script := api.NewScript(code)
+		defer script.Close()
+
+		result, err := client.ScriptExists(context.Background(), []string{script.GetHash()})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []bool{false}, result)
+
+		sha1, err := client.ScriptLoad(context.Background(), code)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), script.GetHash(), sha1)
+
+		result, err = client.ScriptExists(context.Background(), []string{sha1, "0000000000000000000000000000000000000000"})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []bool{true, false}, result)
+	})
+}
+
+func (suite *GlideTestSuite) TestScriptShow() {
+	suite.SkipIfServerVersionLowerThanBy("8.0.0")
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		code := "return '" + uuid.NewString() + "'"
+		sha1, err := client.ScriptLoad(context.Background(), code)
+		assert.NoError(suite.T(), err)
+
+		result, err := client.ScriptShow(context.Background(), sha1)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), code, result)
+
+		_, err = client.ScriptShow(context.Background(), "0000000000000000000000000000000000000000")
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestScriptFlush() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		sha1, err := client.ScriptLoad(context.Background(), "return 1")
+		assert.NoError(suite.T(), err)
+		suite.verifyOK(client.ScriptFlush(context.Background()))
+		result, err := client.ScriptExists(context.Background(), []string{sha1})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []bool{false}, result)
+
+		sha1, err = client.ScriptLoad(context.Background(), "return 1")
+		assert.NoError(suite.T(), err)
+		suite.verifyOK(client.ScriptFlushWithMode(context.Background(), options.ASYNC))
+		result, err = client.ScriptExists(context.Background(), []string{sha1})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []bool{false}, result)
+	})
+}
+
+func (suite *GlideTestSuite) TestScriptKillWithoutRunningScript() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		_, err := client.ScriptKill(context.Background())
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+		assert.Contains(suite.T(), err.Error(), "NOTBUSY")
+	})
+}
+
+func (suite *GlideTestSuite) TestClusterScriptCommandsWithRoute() {
+	client := suite.defaultClusterClient()
+	route := options.RouteOption{Route: config.AllPrimaries}
+
+	sha1, err := client.ScriptLoad(context.Background(), "return '"+uuid.NewString()+"'")
+	assert.NoError(suite.T(), err)
+	result, err := client.ScriptExistsWithRoute(context.Background(), []string{sha1}, route)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []bool{true}, result)
+
+	suite.verifyOK(client.ScriptFlushWithRoute(context.Background(), route))
+	result, err = client.ScriptExistsWithRoute(context.Background(), []string{sha1}, route)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []bool{false}, result)
+
+	mode := options.SYNC
+	suite.verifyOK(client.ScriptFlushWithOptions(context.Background(), options.FlushClusterOptions{FlushMode: &mode}))
+	suite.verifyOK(client.ScriptFlushWithOptions(
+		context.Background(),
+		options.FlushClusterOptions{FlushMode: &mode, RouteOption: &options.RouteOption{Route: config.RandomRoute}},
+	))
+
+	_, err = client.ScriptKillWithRoute(context.Background(), route)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestClusterScriptShowWithRoute() {
+	suite.SkipIfServerVersionLowerThanBy("8.0.0")
+	client := suite.defaultClusterClient()
+	code := "return '" + uuid.NewString() + "'"
+	sha1, err := client.ScriptLoad(context.Background(), code)
+	assert.NoError(suite.T(), err)
+
+	result, err := client.ScriptShowWithRoute(context.Background(), sha1, options.RouteOption{Route: config.RandomRoute})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsSingleValue())
+	assert.Equal(suite.T(), code, result.SingleValue())
+
+	result, err = client.ScriptShowWithRoute(context.Background(), sha1, options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsMultiValue())
+	for _, nodeCode := range result.MultiValue() {
+		assert.Equal(suite.T(), code, nodeCode)
+	}
+}
+
+func (suite *GlideTestSuite) TestFunctionListAndDelete() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		libName := "lib_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		code := GenerateLuaLibCode(libName, map[string]string{libName + "_func": "return args[1]"}, true)
+		_, err := client.FunctionLoad(context.Background(), code, true)
+		assert.NoError(suite.T(), err)
+
+		query := options.NewFunctionListQuery().SetLibraryName(libName)
+		libraries, err := client.FunctionList(context.Background(), *query)
+		assert.NoError(suite.T(), err)
+		assert.Equal(
+			suite.T(),
+			[]api.LibraryInfo{{
+				Name:   libName,
+				Engine: "LUA",
+				Functions: []api.FunctionInfo{
+					{Name: libName + "_func", Flags: map[string]struct{}{"no-writes": {}}},
+				},
+			}},
+			libraries,
+		)
+
+		libraries, err = client.FunctionList(context.Background(), *query.SetWithCode(true))
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), libraries, 1)
+		assert.Equal(suite.T(), code, libraries[0].Code)
+
+		suite.verifyOK(client.FunctionDelete(context.Background(), libName))
+		libraries, err = client.FunctionList(context.Background(), *query)
+		assert.NoError(suite.T(), err)
+		assert.Empty(suite.T(), libraries)
+
+		_, err = client.FunctionDelete(context.Background(), libName)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestFunctionDumpAndRestore() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		suite.verifyOK(client.FunctionFlushSync(context.Background()))
+		libName := "lib_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		code := GenerateLuaLibCode(libName, map[string]string{libName + "_func": "return 42"}, false)
+		_, err := client.FunctionLoad(context.Background(), code, false)
+		assert.NoError(suite.T(), err)
+
+		payload, err := client.FunctionDump(context.Background())
+		assert.NoError(suite.T(), err)
+
+		// the library already exists
+		_, err = client.FunctionRestore(context.Background(), payload)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+		_, err = client.FunctionRestoreWithPolicy(context.Background(), payload, options.AppendPolicy)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+		suite.verifyOK(client.FunctionRestoreWithPolicy(context.Background(), payload, options.ReplacePolicy))
+		suite.verifyOK(client.FunctionRestoreWithPolicy(context.Background(), payload, options.FlushPolicy))
+
+		suite.verifyOK(client.FunctionFlushSync(context.Background()))
+		suite.verifyOK(client.FunctionRestore(context.Background(), payload))
+		libraries, err := client.FunctionList(context.Background(), *options.NewFunctionListQuery())
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), libraries, 1)
+		assert.Equal(suite.T(), libName, libraries[0].Name)
+
+		suite.verifyOK(client.FunctionFlushSync(context.Background()))
+	})
+}
+
+func (suite *GlideTestSuite) TestFunctionStatsAndKill() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		suite.verifyOK(client.FunctionFlushSync(context.Background()))
+		libName := "lib_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		code := GenerateLuaLibCode(libName, map[string]string{"f1": "return 1", "f2": "return 2"}, true)
+		_, err := client.FunctionLoad(context.Background(), code, false)
+		assert.NoError(suite.T(), err)
+
+		stats, err := client.FunctionStats(context.Background())
+		assert.NoError(suite.T(), err)
+		assert.NotEmpty(suite.T(), stats)
+		for _, nodeStats := range stats {
+			assert.True(suite.T(), nodeStats.RunningScript.IsNil())
+			assert.Equal(suite.T(), api.FunctionEngineStats{LibraryCount: 1, FunctionCount: 2}, nodeStats.Engines["LUA"])
+		}
+
+		// no function is running
+		_, err = client.FunctionKill(context.Background())
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+		assert.Contains(suite.T(), err.Error(), "NOTBUSY")
+
+		suite.verifyOK(client.FunctionFlushSync(context.Background()))
+	})
+}
+
+func (suite *GlideTestSuite) TestClusterFunctionCommandsWithRoute() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0")
+	client := suite.defaultClusterClient()
+	allPrimaries := options.RouteOption{Route: config.AllPrimaries}
+	randomRoute := options.RouteOption{Route: config.RandomRoute}
+
+	suite.verifyOK(client.FunctionFlushSyncWithRoute(context.Background(), allPrimaries))
+	libName := "lib_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	code := GenerateLuaLibCode(libName, map[string]string{libName + "_func": "return 42"}, true)
+	_, err := client.FunctionLoadWithRoute(context.Background(), code, false, allPrimaries)
+	assert.NoError(suite.T(), err)
+
+	query := *options.NewFunctionListQuery().SetLibraryName(libName)
+	libraries, err := client.FunctionListWithRoute(context.Background(), query, allPrimaries)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), libraries.IsMultiValue())
+	for _, nodeLibraries := range libraries.MultiValue() {
+		assert.Len(suite.T(), nodeLibraries, 1)
+		assert.Equal(suite.T(), libName, nodeLibraries[0].Name)
+	}
+	libraries, err = client.FunctionListWithRoute(context.Background(), query, randomRoute)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), libraries.SingleValue(), 1)
+
+	stats, err := client.FunctionStatsWithRoute(context.Background(), allPrimaries)
+	assert.NoError(suite.T(), err)
+	for _, nodeStats := range stats.MultiValue() {
+		assert.Equal(suite.T(), int64(1), nodeStats.Engines["LUA"].LibraryCount)
+	}
+	stats, err = client.FunctionStatsWithRoute(context.Background(), randomRoute)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), stats.IsSingleValue())
+	assert.True(suite.T(), stats.SingleValue().RunningScript.IsNil())
+
+	payloads, err := client.FunctionDumpWithRoute(context.Background(), randomRoute)
+	assert.NoError(suite.T(), err)
+	payload := payloads.SingleValue()
+	suite.verifyOK(client.FunctionDeleteWithRoute(context.Background(), libName, allPrimaries))
+	suite.verifyOK(client.FunctionRestoreWithRoute(context.Background(), payload, allPrimaries))
+	suite.verifyOK(
+		client.FunctionRestoreWithPolicyWithRoute(context.Background(), payload, options.ReplacePolicy, allPrimaries),
+	)
+
+	payloads, err = client.FunctionDumpWithRoute(context.Background(), allPrimaries)
+	assert.NoError(suite.T(), err)
+	for _, nodePayload := range payloads.MultiValue() {
+		assert.Equal(suite.T(), payload, nodePayload)
+	}
+
+	_, err = client.FunctionKillWithRoute(context.Background(), allPrimaries)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+
+	suite.verifyOK(client.FunctionFlushSyncWithRoute(context.Background(), allPrimaries))
+}
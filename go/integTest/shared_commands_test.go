@@ -3,9 +3,11 @@
 package integTest
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"reflect"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -25,8 +27,8 @@ const (
 
 func (suite *GlideTestSuite) TestSetAndGet_noOptions() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		suite.verifyOK(client.Set(keyName, initialValue))
-		result, err := client.Get(keyName)
+		suite.verifyOK(client.Set(context.Background(), keyName, initialValue))
+		result, err := client.Get(context.Background(), keyName)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
@@ -36,8 +38,8 @@ func (suite *GlideTestSuite) TestSetAndGet_noOptions() {
 func (suite *GlideTestSuite) TestSetAndGet_byteString() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		invalidUTF8Value := "\xff\xfe\xfd"
-		suite.verifyOK(client.Set(keyName, invalidUTF8Value))
-		result, err := client.Get(keyName)
+		suite.verifyOK(client.Set(context.Background(), keyName, invalidUTF8Value))
+		result, err := client.Get(context.Background(), keyName)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), invalidUTF8Value, result.Value())
@@ -46,10 +48,10 @@ func (suite *GlideTestSuite) TestSetAndGet_byteString() {
 
 func (suite *GlideTestSuite) TestSetWithOptions_ReturnOldValue() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		suite.verifyOK(client.Set(keyName, initialValue))
+		suite.verifyOK(client.Set(context.Background(), keyName, initialValue))
 
 		opts := options.NewSetOptions().SetReturnOldValue(true)
-		result, err := client.SetWithOptions(keyName, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), keyName, anotherValue, *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
@@ -59,14 +61,14 @@ func (suite *GlideTestSuite) TestSetWithOptions_ReturnOldValue() {
 func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfExists_overwrite() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
 
 		opts := options.NewSetOptions().SetOnlyIfExists()
-		result, err := client.SetWithOptions(key, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), anotherValue, result.Value())
 	})
@@ -76,7 +78,7 @@ func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfExists_missingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		opts := options.NewSetOptions().SetOnlyIfExists()
-		result, err := client.SetWithOptions(key, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
@@ -87,11 +89,11 @@ func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfDoesNotExist_missingKey()
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		opts := options.NewSetOptions().SetOnlyIfDoesNotExist()
-		result, err := client.SetWithOptions(key, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), anotherValue, result.Value())
 	})
@@ -101,14 +103,14 @@ func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfDoesNotExist_existingKey()
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		opts := options.NewSetOptions().SetOnlyIfDoesNotExist()
-		suite.verifyOK(client.Set(key, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
 
-		result, err := client.SetWithOptions(key, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
@@ -120,26 +122,26 @@ func (suite *GlideTestSuite) TestSetWithOptions_KeepExistingExpiry() {
 		key := uuid.New().String()
 		opts := options.NewSetOptions().
 			SetExpiry(options.NewExpiry().SetType(options.Milliseconds).SetCount(uint64(2000)))
-		result, err := client.SetWithOptions(key, initialValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, initialValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
 		opts = options.NewSetOptions().SetExpiry(options.NewExpiry().SetType(options.KeepExisting))
-		result, err = client.SetWithOptions(key, anotherValue, *opts)
+		result, err = client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), anotherValue, result.Value())
 
 		time.Sleep(2222 * time.Millisecond)
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
@@ -151,26 +153,26 @@ func (suite *GlideTestSuite) TestSetWithOptions_UpdateExistingExpiry() {
 		key := uuid.New().String()
 		opts := options.NewSetOptions().
 			SetExpiry(options.NewExpiry().SetType(options.Milliseconds).SetCount(uint64(100500)))
-		result, err := client.SetWithOptions(key, initialValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, initialValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
 		opts = options.NewSetOptions().
 			SetExpiry(options.NewExpiry().SetType(options.Milliseconds).SetCount(uint64(2000)))
-		result, err = client.SetWithOptions(key, anotherValue, *opts)
+		result, err = client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), anotherValue, result.Value())
 
 		time.Sleep(2222 * time.Millisecond)
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
@@ -181,21 +183,21 @@ func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfEquals() {
 	suite.SkipIfServerVersionLowerThanBy("8.1.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
 
 		// successful set
 		opts := options.NewSetOptions().SetOnlyIfEquals(initialValue)
-		result, err := client.SetWithOptions(key, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), anotherValue, result.Value())
 
 		// unsuccessful set
 		opts = options.NewSetOptions().SetOnlyIfEquals(initialValue)
-		result, err = client.SetWithOptions(key, initialValue, *opts)
+		result, err = client.SetWithOptions(context.Background(), key, initialValue, *opts)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), result.IsNil())
 	})
@@ -204,14 +206,14 @@ func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfEquals() {
 func (suite *GlideTestSuite) TestGetEx_existingAndNonExistingKeys() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
 
-		result, err := client.GetEx(key)
+		result, err := client.GetEx(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
 		key = uuid.New().String()
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
 	})
@@ -220,22 +222,22 @@ func (suite *GlideTestSuite) TestGetEx_existingAndNonExistingKeys() {
 func (suite *GlideTestSuite) TestGetExWithOptions_PersistKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
 
 		opts := options.NewGetExOptions().
 			SetExpiry(options.NewExpiry().SetType(options.Milliseconds).SetCount(uint64(2000)))
-		result, err := client.GetExWithOptions(key, *opts)
+		result, err := client.GetExWithOptions(context.Background(), key, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
 		time.Sleep(1000 * time.Millisecond)
 
 		opts = options.NewGetExOptions().SetExpiry(options.NewExpiry().SetType(options.Persist))
-		result, err = client.GetExWithOptions(key, *opts)
+		result, err = client.GetExWithOptions(context.Background(), key, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 	})
@@ -244,21 +246,21 @@ func (suite *GlideTestSuite) TestGetExWithOptions_PersistKey() {
 func (suite *GlideTestSuite) TestGetExWithOptions_UpdateExpiry() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
 
 		opts := options.NewGetExOptions().
 			SetExpiry(options.NewExpiry().SetType(options.Milliseconds).SetCount(uint64(2000)))
-		result, err := client.GetExWithOptions(key, *opts)
+		result, err := client.GetExWithOptions(context.Background(), key, *opts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), initialValue, result.Value())
 
 		time.Sleep(2222 * time.Millisecond)
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
 	})
@@ -269,7 +271,7 @@ func (suite *GlideTestSuite) TestSetWithOptions_ReturnOldValue_nonExistentKey()
 		key := uuid.New().String()
 		opts := options.NewSetOptions().SetReturnOldValue(true)
 
-		result, err := client.SetWithOptions(key, anotherValue, *opts)
+		result, err := client.SetWithOptions(context.Background(), key, anotherValue, *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
@@ -283,17 +285,17 @@ func (suite *GlideTestSuite) TestMSetAndMGet_existingAndNonExistingKeys() {
 		key3 := uuid.New().String()
 		oldValue := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key1, oldValue))
+		suite.verifyOK(client.Set(context.Background(), key1, oldValue))
 		keyValueMap := map[string]string{
 			key1: value,
 			key2: value,
 		}
-		suite.verifyOK(client.MSet(keyValueMap))
+		suite.verifyOK(client.MSet(context.Background(), keyValueMap))
 		keys := []string{key1, key2, key3}
 		stringValue := api.CreateStringResult(value)
 		nullResult := api.CreateNilStringResult()
 		values := []api.Result[string]{stringValue, stringValue, nullResult}
-		result, err := client.MGet(keys)
+		result, err := client.MGet(context.Background(), keys)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), values, result)
@@ -311,13 +313,13 @@ func (suite *GlideTestSuite) TestMSetNXAndMGet_nonExistingKey_valuesSet() {
 			key2: value,
 			key3: value,
 		}
-		res, err := client.MSetNX(keyValueMap)
+		res, err := client.MSetNX(context.Background(), keyValueMap)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res)
 		keys := []string{key1, key2, key3}
 		stringValue := api.CreateStringResult(value)
 		values := []api.Result[string]{stringValue, stringValue, stringValue}
-		result, err := client.MGet(keys)
+		result, err := client.MGet(context.Background(), keys)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), values, result)
@@ -331,20 +333,20 @@ func (suite *GlideTestSuite) TestMSetNXAndMGet_existingKey_valuesNotUpdated() {
 		key3 := "{key}" + uuid.New().String()
 		oldValue := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key1, oldValue))
+		suite.verifyOK(client.Set(context.Background(), key1, oldValue))
 		keyValueMap := map[string]string{
 			key1: value,
 			key2: value,
 			key3: value,
 		}
-		res, err := client.MSetNX(keyValueMap)
+		res, err := client.MSetNX(context.Background(), keyValueMap)
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res)
 		keys := []string{key1, key2, key3}
 		oldResult := api.CreateStringResult(oldValue)
 		nullResult := api.CreateNilStringResult()
 		values := []api.Result[string]{oldResult, nullResult, nullResult}
-		result, err := client.MGet(keys)
+		result, err := client.MGet(context.Background(), keys)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), values, result)
@@ -354,17 +356,17 @@ func (suite *GlideTestSuite) TestMSetNXAndMGet_existingKey_valuesNotUpdated() {
 func (suite *GlideTestSuite) TestIncrCommands_existingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, "10"))
+		suite.verifyOK(client.Set(context.Background(), key, "10"))
 
-		res1, err := client.Incr(key)
+		res1, err := client.Incr(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(11), res1)
 
-		res2, err := client.IncrBy(key, 10)
+		res2, err := client.IncrBy(context.Background(), key, 10)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(21), res2)
 
-		res3, err := client.IncrByFloat(key, float64(10.1))
+		res3, err := client.IncrByFloat(context.Background(), key, float64(10.1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), float64(31.1), res3)
 	})
@@ -373,17 +375,17 @@ func (suite *GlideTestSuite) TestIncrCommands_existingKey() {
 func (suite *GlideTestSuite) TestIncrCommands_nonExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key1 := uuid.New().String()
-		res1, err := client.Incr(key1)
+		res1, err := client.Incr(context.Background(), key1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res1)
 
 		key2 := uuid.New().String()
-		res2, err := client.IncrBy(key2, 10)
+		res2, err := client.IncrBy(context.Background(), key2, 10)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(10), res2)
 
 		key3 := uuid.New().String()
-		res3, err := client.IncrByFloat(key3, float64(10.1))
+		res3, err := client.IncrByFloat(context.Background(), key3, float64(10.1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), float64(10.1), res3)
 	})
@@ -392,19 +394,19 @@ func (suite *GlideTestSuite) TestIncrCommands_nonExistingKey() {
 func (suite *GlideTestSuite) TestIncrCommands_TypeError() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, "stringValue"))
+		suite.verifyOK(client.Set(context.Background(), key, "stringValue"))
 
-		res1, err := client.Incr(key)
+		res1, err := client.Incr(context.Background(), key)
 		assert.Equal(suite.T(), int64(0), res1)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res2, err := client.IncrBy(key, 10)
+		res2, err := client.IncrBy(context.Background(), key, 10)
 		assert.Equal(suite.T(), int64(0), res2)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res3, err := client.IncrByFloat(key, float64(10.1))
+		res3, err := client.IncrByFloat(context.Background(), key, float64(10.1))
 		assert.Equal(suite.T(), float64(0), res3)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -414,13 +416,13 @@ func (suite *GlideTestSuite) TestIncrCommands_TypeError() {
 func (suite *GlideTestSuite) TestDecrCommands_existingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, "10"))
+		suite.verifyOK(client.Set(context.Background(), key, "10"))
 
-		res1, err := client.Decr(key)
+		res1, err := client.Decr(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(9), res1)
 
-		res2, err := client.DecrBy(key, 10)
+		res2, err := client.DecrBy(context.Background(), key, 10)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-1), res2)
 	})
@@ -429,12 +431,12 @@ func (suite *GlideTestSuite) TestDecrCommands_existingKey() {
 func (suite *GlideTestSuite) TestDecrCommands_nonExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key1 := uuid.New().String()
-		res1, err := client.Decr(key1)
+		res1, err := client.Decr(context.Background(), key1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-1), res1)
 
 		key2 := uuid.New().String()
-		res2, err := client.DecrBy(key2, 10)
+		res2, err := client.DecrBy(context.Background(), key2, 10)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-10), res2)
 	})
@@ -444,9 +446,9 @@ func (suite *GlideTestSuite) TestStrlen_existingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		res, err := client.Strlen(key)
+		res, err := client.Strlen(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(len(value)), res)
 	})
@@ -455,7 +457,7 @@ func (suite *GlideTestSuite) TestStrlen_existingKey() {
 func (suite *GlideTestSuite) TestStrlen_nonExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		res, err := client.Strlen(key)
+		res, err := client.Strlen(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 	})
@@ -464,25 +466,25 @@ func (suite *GlideTestSuite) TestStrlen_nonExistingKey() {
 func (suite *GlideTestSuite) TestSetRange_existingAndNonExistingKeys() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		res, err := client.SetRange(key, 0, "Dummy string")
+		res, err := client.SetRange(context.Background(), key, 0, "Dummy string")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(12), res)
 
-		res, err = client.SetRange(key, 6, "values")
+		res, err = client.SetRange(context.Background(), key, 6, "values")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(12), res)
-		res1, err := client.Get(key)
+		res1, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Dummy values", res1.Value())
 
-		res, err = client.SetRange(key, 15, "test")
+		res, err = client.SetRange(context.Background(), key, 15, "test")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(19), res)
-		res1, err = client.Get(key)
+		res1, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Dummy values\x00\x00\x00test", res1.Value())
 
-		res, err = client.SetRange(key, math.MaxInt32, "test")
+		res, err = client.SetRange(context.Background(), key, math.MaxInt32, "test")
 		assert.Equal(suite.T(), int64(0), res)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -493,21 +495,21 @@ func (suite *GlideTestSuite) TestSetRange_existingAndNonExistingKeys_binaryStrin
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		nonUtf8String := "Dummy \xFF string"
 		key := uuid.New().String()
-		res, err := client.SetRange(key, 0, nonUtf8String)
+		res, err := client.SetRange(context.Background(), key, 0, nonUtf8String)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(14), res)
 
-		res, err = client.SetRange(key, 6, "values ")
+		res, err = client.SetRange(context.Background(), key, 6, "values ")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(14), res)
-		res1, err := client.Get(key)
+		res1, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Dummy values g", res1.Value())
 
-		res, err = client.SetRange(key, 15, "test")
+		res, err = client.SetRange(context.Background(), key, 15, "test")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(19), res)
-		res1, err = client.Get(key)
+		res1, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Dummy values g\x00test", res1.Value())
 	})
@@ -516,26 +518,26 @@ func (suite *GlideTestSuite) TestSetRange_existingAndNonExistingKeys_binaryStrin
 func (suite *GlideTestSuite) TestGetRange_existingAndNonExistingKeys() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		suite.verifyOK(client.Set(key, "Dummy string"))
+		suite.verifyOK(client.Set(context.Background(), key, "Dummy string"))
 
-		res, err := client.GetRange(key, 0, 4)
+		res, err := client.GetRange(context.Background(), key, 0, 4)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Dummy", res)
 
-		res, err = client.GetRange(key, -6, -1)
+		res, err = client.GetRange(context.Background(), key, -6, -1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "string", res)
 
-		res, err = client.GetRange(key, -1, -6)
+		res, err = client.GetRange(context.Background(), key, -1, -6)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", res)
 
-		res, err = client.GetRange(key, 15, 16)
+		res, err = client.GetRange(context.Background(), key, 15, 16)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", res)
 
 		nonExistingKey := uuid.New().String()
-		res, err = client.GetRange(nonExistingKey, 0, 5)
+		res, err = client.GetRange(context.Background(), nonExistingKey, 0, 5)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", res)
 	})
@@ -545,9 +547,9 @@ func (suite *GlideTestSuite) TestGetRange_binaryString() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		nonUtf8String := "Dummy \xFF string"
-		suite.verifyOK(client.Set(key, nonUtf8String))
+		suite.verifyOK(client.Set(context.Background(), key, nonUtf8String))
 
-		res, err := client.GetRange(key, 4, 6)
+		res, err := client.GetRange(context.Background(), key, 4, 6)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "y \xFF", res)
 	})
@@ -559,17 +561,17 @@ func (suite *GlideTestSuite) TestAppend_existingAndNonExistingKeys() {
 		value1 := uuid.New().String()
 		value2 := uuid.New().String()
 
-		res, err := client.Append(key, value1)
+		res, err := client.Append(context.Background(), key, value1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(len(value1)), res)
-		res1, err := client.Get(key)
+		res1, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), value1, res1.Value())
 
-		res, err = client.Append(key, value2)
+		res, err = client.Append(context.Background(), key, value2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(len(value1)+len(value2)), res)
-		res1, err = client.Get(key)
+		res1, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), value1+value2, res1.Value())
 	})
@@ -582,14 +584,14 @@ func (suite *GlideTestSuite) TestLCS_existingAndNonExistingKeys() {
 		key1 := "{key}" + uuid.New().String()
 		key2 := "{key}" + uuid.New().String()
 
-		res, err := client.LCS(key1, key2)
+		res, err := client.LCS(context.Background(), key1, key2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", res)
 
-		suite.verifyOK(client.Set(key1, "Dummy string"))
-		suite.verifyOK(client.Set(key2, "Dummy value"))
+		suite.verifyOK(client.Set(context.Background(), key1, "Dummy string"))
+		suite.verifyOK(client.Set(context.Background(), key2, "Dummy value"))
 
-		res, err = client.LCS(key1, key2)
+		res, err = client.LCS(context.Background(), key1, key2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Dummy ", res)
 	})
@@ -602,14 +604,14 @@ func (suite *GlideTestSuite) TestLCSLen_existingAndNonExistingKeys() {
 		key1 := "{key}" + uuid.New().String()
 		key2 := "{key}" + uuid.New().String()
 
-		res, err := client.LCSLen(key1, key2)
+		res, err := client.LCSLen(context.Background(), key1, key2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 
-		suite.verifyOK(client.Set(key1, "ohmytext"))
-		suite.verifyOK(client.Set(key2, "mynewtext"))
+		suite.verifyOK(client.Set(context.Background(), key1, "ohmytext"))
+		suite.verifyOK(client.Set(context.Background(), key2, "mynewtext"))
 
-		res, err = client.LCSLen(key1, key2)
+		res, err = client.LCSLen(context.Background(), key1, key2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(6), res)
 	})
@@ -619,14 +621,14 @@ func (suite *GlideTestSuite) TestLCS_BasicIDXOption() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		_, err := client.Set("{lcs}key1", "ohmytext")
+		_, err := client.Set(context.Background(), "{lcs}key1", "ohmytext")
 		assert.Nil(suite.T(), err)
 
-		_, err = client.Set("{lcs}key2", "mynewtext")
+		_, err = client.Set(context.Background(), "{lcs}key2", "mynewtext")
 		assert.Nil(suite.T(), err)
 
 		opts := options.NewLCSIdxOptions()
-		lcsIdxResult, err := client.LCSWithOptions("{lcs}key1", "{lcs}key2", *opts)
+		lcsIdxResult, err := client.LCSWithOptions(context.Background(), "{lcs}key1", "{lcs}key2", *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), lcsIdxResult)
@@ -654,17 +656,17 @@ func (suite *GlideTestSuite) TestLCS_MinMatchLengthOption() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		_, err := client.Set("{lcs}key1", "ohmytext")
+		_, err := client.Set(context.Background(), "{lcs}key1", "ohmytext")
 		assert.Nil(suite.T(), err)
 
-		_, err = client.Set("{lcs}key2", "mynewtext")
+		_, err = client.Set(context.Background(), "{lcs}key2", "mynewtext")
 		assert.Nil(suite.T(), err)
 
 		opts := options.NewLCSIdxOptions()
 		minMatchLen := int64(4)
 		opts.SetMinMatchLen(minMatchLen)
 
-		lcsIdxMinMatchResult, err := client.LCSWithOptions("{lcs}key1", "{lcs}key2", *opts)
+		lcsIdxMinMatchResult, err := client.LCSWithOptions(context.Background(), "{lcs}key1", "{lcs}key2", *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), lcsIdxMinMatchResult)
@@ -686,10 +688,10 @@ func (suite *GlideTestSuite) TestLCS_WithMatchLengthOption() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		_, err := client.Set("{lcs}key1", "ohmytext")
+		_, err := client.Set(context.Background(), "{lcs}key1", "ohmytext")
 		assert.Nil(suite.T(), err)
 
-		_, err = client.Set("{lcs}key2", "mynewtext")
+		_, err = client.Set(context.Background(), "{lcs}key2", "mynewtext")
 		assert.Nil(suite.T(), err)
 
 		opts := options.NewLCSIdxOptions()
@@ -697,7 +699,7 @@ func (suite *GlideTestSuite) TestLCS_WithMatchLengthOption() {
 		opts.SetMinMatchLen(minMatchLen)
 		opts.SetWithMatchLen(true)
 
-		lcsIdxFullOptionsResult, err := client.LCSWithOptions("{lcs}key1", "{lcs}key2", *opts)
+		lcsIdxFullOptionsResult, err := client.LCSWithOptions(context.Background(), "{lcs}key1", "{lcs}key2", *opts)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), lcsIdxFullOptionsResult)
@@ -721,12 +723,12 @@ func (suite *GlideTestSuite) TestGetDel_ExistingKey() {
 		key := uuid.New().String()
 		value := "testValue"
 
-		suite.verifyOK(client.Set(key, value))
-		result, err := client.GetDel(key)
+		suite.verifyOK(client.Set(context.Background(), key, value))
+		result, err := client.GetDel(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), value, result.Value())
 
-		result, err = client.Get(key)
+		result, err = client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
 	})
@@ -736,7 +738,7 @@ func (suite *GlideTestSuite) TestGetDel_NonExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 
-		result, err := client.GetDel(key)
+		result, err := client.GetDel(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
@@ -745,7 +747,7 @@ func (suite *GlideTestSuite) TestGetDel_NonExistingKey() {
 
 func (suite *GlideTestSuite) TestGetDel_EmptyKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		result, err := client.GetDel("")
+		result, err := client.GetDel(context.Background(), "")
 
 		assert.NotNil(suite.T(), err)
 		assert.Equal(suite.T(), "", result.Value())
@@ -753,16 +755,54 @@ func (suite *GlideTestSuite) TestGetDel_EmptyKey() {
 	})
 }
 
+func (suite *GlideTestSuite) TestStringBytesCommands() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key1 := []byte("{key}-" + uuid.NewString())
+		key2 := []byte("{key}-" + uuid.NewString())
+		value := []byte{0x00, 0xff, 0xfe, 'v', 0x80}
+
+		suite.verifyOK(client.SetBytes(context.Background(), key1, value))
+		result, err := client.GetBytes(context.Background(), key1)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), api.CreateBytesResult(value), result)
+
+		// the value is stored unchanged and is readable with the string API as well
+		stringResult, err := client.Get(context.Background(), string(key1))
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), string(value), stringResult.Value())
+
+		results, err := client.MGetBytes(context.Background(), [][]byte{key1, key2})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []api.Result[[]byte]{api.CreateBytesResult(value), api.CreateNilBytesResult()}, results)
+
+		suite.verifyOK(client.SetBytes(context.Background(), key2, []byte{}))
+		result, err = client.GetBytes(context.Background(), key2)
+		assert.NoError(suite.T(), err)
+		assert.False(suite.T(), result.IsNil())
+		assert.Empty(suite.T(), result.Value())
+
+		result, err = client.GetDelBytes(context.Background(), key1)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), api.CreateBytesResult(value), result)
+		result, err = client.GetBytes(context.Background(), key1)
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), result.IsNil())
+
+		_, err = client.GetDelBytes(context.Background(), nil)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+	})
+}
+
 func (suite *GlideTestSuite) TestHSet_WithExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.New().String()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HSet(key, fields)
+		res2, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 	})
@@ -781,11 +821,11 @@ func (suite *GlideTestSuite) TestHSet_byteString() {
 		}
 		key := string([]byte{0x01, 0x02, 0x03, 0xFE})
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HGetAll(key)
+		res2, err := client.HGetAll(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), fields, res2)
 	})
@@ -796,16 +836,16 @@ func (suite *GlideTestSuite) TestHSet_WithAddNewField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.New().String()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HSet(key, fields)
+		res2, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 
 		fields["field3"] = "value3"
-		res3, err := client.HSet(key, fields)
+		res3, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res3)
 	})
@@ -816,11 +856,11 @@ func (suite *GlideTestSuite) TestHGet_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HGet(key, "field1")
+		res2, err := client.HGet(context.Background(), key, "field1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "value1", res2.Value())
 	})
@@ -830,7 +870,7 @@ func (suite *GlideTestSuite) TestHGet_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res1, err := client.HGet(key, "field1")
+		res1, err := client.HGet(context.Background(), key, "field1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res1)
 	})
@@ -841,11 +881,11 @@ func (suite *GlideTestSuite) TestHGet_WithNotExistingField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HGet(key, "foo")
+		res2, err := client.HGet(context.Background(), key, "foo")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res2)
 	})
@@ -856,11 +896,11 @@ func (suite *GlideTestSuite) TestHGetAll_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HGetAll(key)
+		res2, err := client.HGetAll(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), fields, res2)
 	})
@@ -870,22 +910,60 @@ func (suite *GlideTestSuite) TestHGetAll_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.HGetAll(key)
+		res, err := client.HGetAll(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res)
 	})
 }
 
+func (suite *GlideTestSuite) TestHashBytesCommands() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := []byte(uuid.NewString())
+		fields := []api.FieldAndValue{
+			{Field: []byte("field1"), Value: []byte{0x00, 0xff}},
+			{Field: []byte{0xff, 0x00, 'f'}, Value: []byte{0x80, 'v'}},
+		}
+
+		res1, err := client.HSetBytes(context.Background(), key, fields)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(2), res1)
+
+		res2, err := client.HGetBytes(context.Background(), key, []byte{0xff, 0x00, 'f'})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), api.CreateBytesResult([]byte{0x80, 'v'}), res2)
+
+		res2, err = client.HGetBytes(context.Background(), key, []byte("field3"))
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), api.CreateNilBytesResult(), res2)
+
+		res3, err := client.HMGetBytes(context.Background(), key, [][]byte{[]byte("field1"), []byte("field3")})
+		assert.NoError(suite.T(), err)
+		assert.Equal(
+			suite.T(),
+			[]api.Result[[]byte]{api.CreateBytesResult([]byte{0x00, 0xff}), api.CreateNilBytesResult()},
+			res3,
+		)
+
+		res4, err := client.HGetAllBytes(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.ElementsMatch(suite.T(), fields, res4)
+
+		res4, err = client.HGetAllBytes(context.Background(), []byte(uuid.NewString()))
+		assert.NoError(suite.T(), err)
+		assert.Empty(suite.T(), res4)
+	})
+}
+
 func (suite *GlideTestSuite) TestHMGet() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HMGet(key, []string{"field1", "field2", "field3"})
+		res2, err := client.HMGet(context.Background(), key, []string{"field1", "field2", "field3"})
 		value1 := api.CreateStringResult("value1")
 		value2 := api.CreateStringResult("value2")
 		nullValue := api.CreateNilStringResult()
@@ -898,7 +976,7 @@ func (suite *GlideTestSuite) TestHMGet_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.HMGet(key, []string{"field1", "field2", "field3"})
+		res, err := client.HMGet(context.Background(), key, []string{"field1", "field2", "field3"})
 		nullValue := api.CreateNilStringResult()
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []api.Result[string]{nullValue, nullValue, nullValue}, res)
@@ -910,11 +988,11 @@ func (suite *GlideTestSuite) TestHMGet_WithNotExistingField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HMGet(key, []string{"field3", "field4"})
+		res2, err := client.HMGet(context.Background(), key, []string{"field3", "field4"})
 		nullValue := api.CreateNilStringResult()
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []api.Result[string]{nullValue, nullValue}, res2)
@@ -926,11 +1004,11 @@ func (suite *GlideTestSuite) TestHSetNX_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HSetNX(key, "field1", "value1")
+		res2, err := client.HSetNX(context.Background(), key, "field1", "value1")
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res2)
 	})
@@ -940,11 +1018,11 @@ func (suite *GlideTestSuite) TestHSetNX_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res1, err := client.HSetNX(key, "field1", "value1")
+		res1, err := client.HSetNX(context.Background(), key, "field1", "value1")
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res1)
 
-		res2, err := client.HGetAll(key)
+		res2, err := client.HGetAll(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]string{"field1": "value1"}, res2)
 	})
@@ -955,11 +1033,11 @@ func (suite *GlideTestSuite) TestHSetNX_WithExistingField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HSetNX(key, "field1", "value1")
+		res2, err := client.HSetNX(context.Background(), key, "field1", "value1")
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res2)
 	})
@@ -970,19 +1048,19 @@ func (suite *GlideTestSuite) TestHDel() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HDel(key, []string{"field1", "field2"})
+		res2, err := client.HDel(context.Background(), key, []string{"field1", "field2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res2)
 
-		res3, err := client.HGetAll(key)
+		res3, err := client.HGetAll(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res3)
 
-		res4, err := client.HDel(key, []string{"field1", "field2"})
+		res4, err := client.HDel(context.Background(), key, []string{"field1", "field2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res4)
 	})
@@ -991,7 +1069,7 @@ func (suite *GlideTestSuite) TestHDel() {
 func (suite *GlideTestSuite) TestHDel_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
-		res, err := client.HDel(key, []string{"field1", "field2"})
+		res, err := client.HDel(context.Background(), key, []string{"field1", "field2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 	})
@@ -1002,11 +1080,11 @@ func (suite *GlideTestSuite) TestHDel_WithNotExistingField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HDel(key, []string{"field3", "field4"})
+		res2, err := client.HDel(context.Background(), key, []string{"field3", "field4"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 	})
@@ -1017,11 +1095,11 @@ func (suite *GlideTestSuite) TestHLen() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HLen(key)
+		res2, err := client.HLen(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res2)
 	})
@@ -1030,7 +1108,7 @@ func (suite *GlideTestSuite) TestHLen() {
 func (suite *GlideTestSuite) TestHLen_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
-		res, err := client.HLen(key)
+		res, err := client.HLen(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
@@ -1042,11 +1120,11 @@ func (suite *GlideTestSuite) TestHVals_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HVals(key)
+		res2, err := client.HVals(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.ElementsMatch(suite.T(), []string{"value1", "value2"}, res2)
 	})
@@ -1056,7 +1134,7 @@ func (suite *GlideTestSuite) TestHVals_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.HVals(key)
+		res, err := client.HVals(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res)
 	})
@@ -1067,11 +1145,11 @@ func (suite *GlideTestSuite) TestHExists_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HExists(key, "field1")
+		res2, err := client.HExists(context.Background(), key, "field1")
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res2)
 	})
@@ -1081,7 +1159,7 @@ func (suite *GlideTestSuite) TestHExists_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.HExists(key, "field1")
+		res, err := client.HExists(context.Background(), key, "field1")
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res)
 	})
@@ -1092,11 +1170,11 @@ func (suite *GlideTestSuite) TestHExists_WithNotExistingField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HExists(key, "field3")
+		res2, err := client.HExists(context.Background(), key, "field3")
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res2)
 	})
@@ -1107,11 +1185,11 @@ func (suite *GlideTestSuite) TestHKeys_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HKeys(key)
+		res2, err := client.HKeys(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.ElementsMatch(suite.T(), []string{"field1", "field2"}, res2)
 	})
@@ -1121,7 +1199,7 @@ func (suite *GlideTestSuite) TestHKeys_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.HKeys(key)
+		res, err := client.HKeys(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res)
 	})
@@ -1132,11 +1210,11 @@ func (suite *GlideTestSuite) TestHStrLen_WithExistingKey() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HStrLen(key, "field1")
+		res2, err := client.HStrLen(context.Background(), key, "field1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(6), res2)
 	})
@@ -1146,7 +1224,7 @@ func (suite *GlideTestSuite) TestHStrLen_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.HStrLen(key, "field1")
+		res, err := client.HStrLen(context.Background(), key, "field1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 	})
@@ -1157,11 +1235,11 @@ func (suite *GlideTestSuite) TestHStrLen_WithNotExistingField() {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.HSet(key, fields)
+		res1, err := client.HSet(context.Background(), key, fields)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.HStrLen(key, "field3")
+		res2, err := client.HStrLen(context.Background(), key, "field3")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 	})
@@ -1173,11 +1251,11 @@ func (suite *GlideTestSuite) TestHIncrBy_WithExistingField() {
 		field := uuid.NewString()
 		fieldValueMap := map[string]string{field: "10"}
 
-		hsetResult, err := client.HSet(key, fieldValueMap)
+		hsetResult, err := client.HSet(context.Background(), key, fieldValueMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), hsetResult)
 
-		hincrByResult, hincrByErr := client.HIncrBy(key, field, 1)
+		hincrByResult, hincrByErr := client.HIncrBy(context.Background(), key, field, 1)
 		assert.Nil(suite.T(), hincrByErr)
 		assert.Equal(suite.T(), int64(11), hincrByResult)
 	})
@@ -1190,11 +1268,11 @@ func (suite *GlideTestSuite) TestHIncrBy_WithNonExistingField() {
 		field2 := uuid.NewString()
 		fieldValueMap := map[string]string{field2: "1"}
 
-		hsetResult, err := client.HSet(key, fieldValueMap)
+		hsetResult, err := client.HSet(context.Background(), key, fieldValueMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), hsetResult)
 
-		hincrByResult, hincrByErr := client.HIncrBy(key, field, 2)
+		hincrByResult, hincrByErr := client.HIncrBy(context.Background(), key, field, 2)
 		assert.Nil(suite.T(), hincrByErr)
 		assert.Equal(suite.T(), int64(2), hincrByResult)
 	})
@@ -1206,11 +1284,11 @@ func (suite *GlideTestSuite) TestHIncrByFloat_WithExistingField() {
 		field := uuid.NewString()
 		fieldValueMap := map[string]string{field: "10"}
 
-		hsetResult, err := client.HSet(key, fieldValueMap)
+		hsetResult, err := client.HSet(context.Background(), key, fieldValueMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), hsetResult)
 
-		hincrByFloatResult, hincrByFloatErr := client.HIncrByFloat(key, field, 1.5)
+		hincrByFloatResult, hincrByFloatErr := client.HIncrByFloat(context.Background(), key, field, 1.5)
 		assert.Nil(suite.T(), hincrByFloatErr)
 		assert.Equal(suite.T(), float64(11.5), hincrByFloatResult)
 	})
@@ -1223,11 +1301,11 @@ func (suite *GlideTestSuite) TestHIncrByFloat_WithNonExistingField() {
 		field2 := uuid.NewString()
 		fieldValueMap := map[string]string{field2: "1"}
 
-		hsetResult, err := client.HSet(key, fieldValueMap)
+		hsetResult, err := client.HSet(context.Background(), key, fieldValueMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), hsetResult)
 
-		hincrByFloatResult, hincrByFloatErr := client.HIncrByFloat(key, field, 1.5)
+		hincrByFloatResult, hincrByFloatErr := client.HIncrByFloat(context.Background(), key, field, 1.5)
 		assert.Nil(suite.T(), hincrByFloatErr)
 		assert.Equal(suite.T(), float64(1.5), hincrByFloatResult)
 	})
@@ -1256,26 +1334,26 @@ func (suite *GlideTestSuite) TestHScan() {
 		t := suite.T()
 
 		// Check for empty set.
-		resCursor, resCollection, err := client.HScan(key1, initialCursor)
+		resCursor, resCollection, err := client.HScan(context.Background(), key1, initialCursor)
 		assert.NoError(t, err)
 		assert.Equal(t, initialCursor, resCursor)
 		assert.Empty(t, resCollection)
 
 		// Negative cursor check.
 		if suite.serverVersion >= "8.0.0" {
-			_, _, err = client.HScan(key1, "-1")
+			_, _, err = client.HScan(context.Background(), key1, "-1")
 			assert.NotEmpty(t, err)
 		} else {
-			resCursor, resCollection, _ = client.HScan(key1, "-1")
+			resCursor, resCollection, _ = client.HScan(context.Background(), key1, "-1")
 			assert.Equal(t, initialCursor, resCursor)
 			assert.Empty(t, resCollection)
 		}
 
 		// Result contains the whole set
-		hsetResult, _ := client.HSet(key1, charMap)
+		hsetResult, _ := client.HSet(context.Background(), key1, charMap)
 		assert.Equal(t, int64(len(charMembers)), hsetResult)
 
-		resCursor, resCollection, _ = client.HScan(key1, initialCursor)
+		resCursor, resCollection, _ = client.HScan(context.Background(), key1, initialCursor)
 		assert.Equal(t, initialCursor, resCursor)
 		// Length includes the score which is twice the map size
 		assert.Equal(t, len(charMap)*2, len(resCollection))
@@ -1292,7 +1370,7 @@ func (suite *GlideTestSuite) TestHScan() {
 		assert.True(t, isSubset(resultValues, valuesList) && isSubset(valuesList, resultValues))
 
 		opts := options.NewHashScanOptions().SetMatch("a")
-		resCursor, resCollection, _ = client.HScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, _ = client.HScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.Equal(t, initialCursor, resCursor)
 		assert.Equal(t, len(resCollection), 2)
 		assert.Equal(t, resCollection[0], "a")
@@ -1307,14 +1385,14 @@ func (suite *GlideTestSuite) TestHScan() {
 			combinedMap[key] = value
 		}
 
-		hsetResult, _ = client.HSet(key1, combinedMap)
+		hsetResult, _ = client.HSet(context.Background(), key1, combinedMap)
 		assert.Equal(t, int64(len(numberMap)), hsetResult)
 		resultCursor := "0"
 		secondResultAllKeys := make([]string, 0)
 		secondResultAllValues := make([]string, 0)
 		isFirstLoop := true
 		for {
-			resCursor, resCollection, _ = client.HScan(key1, resultCursor)
+			resCursor, resCollection, _ = client.HScan(context.Background(), key1, resultCursor)
 			resultCursor = resCursor
 			for i := 0; i < len(resCollection); i += 2 {
 				secondResultAllKeys = append(secondResultAllKeys, resCollection[i])
@@ -1328,7 +1406,7 @@ func (suite *GlideTestSuite) TestHScan() {
 			}
 
 			// Scan with result cursor to get the next set of data.
-			newResultCursor, secondResult, _ := client.HScan(key1, resultCursor)
+			newResultCursor, secondResult, _ := client.HScan(context.Background(), key1, resultCursor)
 			assert.NotEqual(t, resultCursor, newResultCursor)
 			resultCursor = newResultCursor
 			assert.False(t, reflect.DeepEqual(secondResult, resCollection))
@@ -1348,28 +1426,28 @@ func (suite *GlideTestSuite) TestHScan() {
 
 		// Test match pattern
 		opts = options.NewHashScanOptions().SetMatch("*")
-		resCursor, resCollection, _ = client.HScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, _ = client.HScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		resCursorInt, _ := strconv.Atoi(resCursor)
 		assert.True(t, resCursorInt >= 0)
 		assert.True(t, int(len(resCollection)) >= defaultCount)
 
 		// Test count
 		opts = options.NewHashScanOptions().SetCount(int64(20))
-		resCursor, resCollection, _ = client.HScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, _ = client.HScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		resCursorInt, _ = strconv.Atoi(resCursor)
 		assert.True(t, resCursorInt >= 0)
 		assert.True(t, len(resCollection) >= 20)
 
 		// Test count with match returns a non-empty list
 		opts = options.NewHashScanOptions().SetMatch("1*").SetCount(int64(20))
-		resCursor, resCollection, _ = client.HScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, _ = client.HScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		resCursorInt, _ = strconv.Atoi(resCursor)
 		assert.True(t, resCursorInt >= 0)
 		assert.True(t, len(resCollection) >= 0)
 
 		if suite.serverVersion >= "8.0.0" {
 			opts = options.NewHashScanOptions().SetNoValue(true)
-			resCursor, resCollection, _ = client.HScanWithOptions(key1, initialCursor, *opts)
+			resCursor, resCollection, _ = client.HScanWithOptions(context.Background(), key1, initialCursor, *opts)
 			resCursorInt, _ = strconv.Atoi(resCursor)
 			assert.True(t, resCursorInt >= 0)
 
@@ -1385,18 +1463,18 @@ func (suite *GlideTestSuite) TestHScan() {
 		}
 
 		// Check if Non-hash key throws an error.
-		suite.verifyOK(client.Set(key2, "test"))
-		_, _, err = client.HScan(key2, initialCursor)
+		suite.verifyOK(client.Set(context.Background(), key2, "test"))
+		_, _, err = client.HScan(context.Background(), key2, initialCursor)
 		assert.NotEmpty(t, err)
 
 		// Check if Non-hash key throws an error when HSCAN called with options.
 		opts = options.NewHashScanOptions().SetMatch("test").SetCount(int64(1))
-		_, _, err = client.HScanWithOptions(key2, initialCursor, *opts)
+		_, _, err = client.HScanWithOptions(context.Background(), key2, initialCursor, *opts)
 		assert.NotEmpty(t, err)
 
 		// Check if a negative cursor value throws an error.
 		opts = options.NewHashScanOptions().SetCount(int64(-1))
-		_, _, err = client.HScanWithOptions(key1, initialCursor, *opts)
+		_, _, err = client.HScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NotEmpty(t, err)
 	})
 }
@@ -1407,18 +1485,18 @@ func (suite *GlideTestSuite) TestHRandField() {
 		key := uuid.NewString()
 
 		// key does not exist
-		res, err := client.HRandField(key)
+		res, err := client.HRandField(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), res.IsNil())
-		resc, err := client.HRandFieldWithCount(key, 5)
+		resc, err := client.HRandFieldWithCount(context.Background(), key, 5)
 		assert.NoError(suite.T(), err)
 		assert.Empty(suite.T(), resc)
-		rescv, err := client.HRandFieldWithCountWithValues(key, 5)
+		rescv, err := client.HRandFieldWithCountWithValues(context.Background(), key, 5)
 		assert.NoError(suite.T(), err)
 		assert.Empty(suite.T(), rescv)
 
 		data := map[string]string{"f1": "v1", "f2": "v2", "f3": "v3"}
-		hset, err := client.HSet(key, data)
+		hset, err := client.HSet(context.Background(), key, data)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), hset)
 
@@ -1426,17 +1504,17 @@ func (suite *GlideTestSuite) TestHRandField() {
 		for k := range data {
 			fields = append(fields, k)
 		}
-		res, err = client.HRandField(key)
+		res, err = client.HRandField(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Contains(suite.T(), fields, res.Value())
 
 		// With Count - positive count
-		resc, err = client.HRandFieldWithCount(key, 5)
+		resc, err = client.HRandFieldWithCount(context.Background(), key, 5)
 		assert.NoError(suite.T(), err)
 		assert.ElementsMatch(suite.T(), fields, resc)
 
 		// With Count - negative count
-		resc, err = client.HRandFieldWithCount(key, -5)
+		resc, err = client.HRandFieldWithCount(context.Background(), key, -5)
 		assert.NoError(suite.T(), err)
 		assert.Len(suite.T(), resc, 5)
 		for _, field := range resc {
@@ -1444,7 +1522,7 @@ func (suite *GlideTestSuite) TestHRandField() {
 		}
 
 		// With values - positive count
-		rescv, err = client.HRandFieldWithCountWithValues(key, 5)
+		rescv, err = client.HRandFieldWithCountWithValues(context.Background(), key, 5)
 		assert.NoError(suite.T(), err)
 		resvMap := make(map[string]string)
 		for _, pair := range rescv {
@@ -1453,7 +1531,7 @@ func (suite *GlideTestSuite) TestHRandField() {
 		assert.Equal(suite.T(), data, resvMap)
 
 		// With values - negative count
-		rescv, err = client.HRandFieldWithCountWithValues(key, -5)
+		rescv, err = client.HRandFieldWithCountWithValues(context.Background(), key, -5)
 		assert.NoError(suite.T(), err)
 		assert.Len(suite.T(), resc, 5)
 		for _, pair := range rescv {
@@ -1462,12 +1540,12 @@ func (suite *GlideTestSuite) TestHRandField() {
 
 		// key exists but holds non hash type value
 		key = uuid.NewString()
-		suite.verifyOK(client.Set(key, "HRandField"))
-		_, err = client.HRandField(key)
+		suite.verifyOK(client.Set(context.Background(), key, "HRandField"))
+		_, err = client.HRandField(context.Background(), key)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
-		_, err = client.HRandFieldWithCount(key, 42)
+		_, err = client.HRandFieldWithCount(context.Background(), key, 42)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
-		_, err = client.HRandFieldWithCountWithValues(key, 42)
+		_, err = client.HRandFieldWithCountWithValues(context.Background(), key, 42)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
 }
@@ -1477,15 +1555,15 @@ func (suite *GlideTestSuite) TestLPushLPop_WithExistingKey() {
 		list := []string{"value4", "value3", "value2", "value1"}
 		key := uuid.NewString()
 
-		res1, err := client.LPush(key, list)
+		res1, err := client.LPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.LPop(key)
+		res2, err := client.LPop(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "value1", res2.Value())
 
-		res3, err := client.LPopCount(key, 2)
+		res3, err := client.LPopCount(context.Background(), key, 2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value2", "value3"}, res3)
 	})
@@ -1495,11 +1573,11 @@ func (suite *GlideTestSuite) TestLPop_nonExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res1, err := client.LPop(key)
+		res1, err := client.LPop(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res1)
 
-		res2, err := client.LPopCount(key, 2)
+		res2, err := client.LPopCount(context.Background(), key, 2)
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res2)
 	})
@@ -1508,14 +1586,14 @@ func (suite *GlideTestSuite) TestLPop_nonExistingKey() {
 func (suite *GlideTestSuite) TestLPushLPop_typeError() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
-		suite.verifyOK(client.Set(key, "value"))
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
 
-		res1, err := client.LPush(key, []string{"value1"})
+		res1, err := client.LPush(context.Background(), key, []string{"value1"})
 		assert.Equal(suite.T(), int64(0), res1)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res2, err := client.LPopCount(key, 2)
+		res2, err := client.LPopCount(context.Background(), key, 2)
 		assert.Nil(suite.T(), res2)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -1525,30 +1603,31 @@ func (suite *GlideTestSuite) TestLPushLPop_typeError() {
 func (suite *GlideTestSuite) TestLPos_withAndWithoutOptions() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
-		res1, err := client.RPush(key, []string{"a", "a", "b", "c", "a", "b"})
+		res1, err := client.RPush(context.Background(), key, []string{"a", "a", "b", "c", "a", "b"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(6), res1)
 
-		res2, err := client.LPos(key, "a")
+		res2, err := client.LPos(context.Background(), key, "a")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2.Value())
 
-		res3, err := client.LPosWithOptions(key, "b", *options.NewLPosOptions().SetRank(2))
+		res3, err := client.LPosWithOptions(context.Background(), key, "b", *options.NewLPosOptions().SetRank(2))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res3.Value())
 
 		// element doesn't exist
-		res4, err := client.LPos(key, "e")
+		res4, err := client.LPos(context.Background(), key, "e")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.CreateNilInt64Result(), res4)
 
 		// reverse traversal
-		res5, err := client.LPosWithOptions(key, "b", *options.NewLPosOptions().SetRank(-2))
+		res5, err := client.LPosWithOptions(context.Background(), key, "b", *options.NewLPosOptions().SetRank(-2))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res5.Value())
 
 		// unlimited comparisons
 		res6, err := client.LPosWithOptions(
+			context.Background(),
 			key,
 			"a",
 			*options.NewLPosOptions().SetRank(1).SetMaxLen(0),
@@ -1558,6 +1637,7 @@ func (suite *GlideTestSuite) TestLPos_withAndWithoutOptions() {
 
 		// limited comparisons
 		res7, err := client.LPosWithOptions(
+			context.Background(),
 			key,
 			"c",
 			*options.NewLPosOptions().SetRank(1).SetMaxLen(2),
@@ -1566,26 +1646,26 @@ func (suite *GlideTestSuite) TestLPos_withAndWithoutOptions() {
 		assert.Equal(suite.T(), api.CreateNilInt64Result(), res7)
 
 		// invalid rank value
-		res8, err := client.LPosWithOptions(key, "a", *options.NewLPosOptions().SetRank(0))
+		res8, err := client.LPosWithOptions(context.Background(), key, "a", *options.NewLPosOptions().SetRank(0))
 		assert.Equal(suite.T(), api.CreateNilInt64Result(), res8)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// invalid maxlen value
-		res9, err := client.LPosWithOptions(key, "a", *options.NewLPosOptions().SetMaxLen(-1))
+		res9, err := client.LPosWithOptions(context.Background(), key, "a", *options.NewLPosOptions().SetMaxLen(-1))
 		assert.Equal(suite.T(), api.CreateNilInt64Result(), res9)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// non-existent key
-		res10, err := client.LPos("non_existent_key", "a")
+		res10, err := client.LPos(context.Background(), "non_existent_key", "a")
 		assert.Equal(suite.T(), api.CreateNilInt64Result(), res10)
 		assert.Nil(suite.T(), err)
 
 		// wrong key data type
 		keyString := uuid.NewString()
-		suite.verifyOK(client.Set(keyString, "value"))
-		res11, err := client.LPos(keyString, "a")
+		suite.verifyOK(client.Set(context.Background(), keyString, "value"))
+		res11, err := client.LPos(context.Background(), keyString, "a")
 		assert.Equal(suite.T(), api.CreateNilInt64Result(), res11)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -1596,33 +1676,33 @@ func (suite *GlideTestSuite) TestLPosCount() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res1, err := client.RPush(key, []string{"a", "a", "b", "c", "a", "b"})
+		res1, err := client.RPush(context.Background(), key, []string{"a", "a", "b", "c", "a", "b"})
 		assert.Equal(suite.T(), int64(6), res1)
 		assert.Nil(suite.T(), err)
 
-		res2, err := client.LPosCount(key, "a", int64(2))
+		res2, err := client.LPosCount(context.Background(), key, "a", int64(2))
 		assert.Equal(suite.T(), []int64{0, 1}, res2)
 		assert.Nil(suite.T(), err)
 
-		res3, err := client.LPosCount(key, "a", int64(0))
+		res3, err := client.LPosCount(context.Background(), key, "a", int64(0))
 		assert.Equal(suite.T(), []int64{0, 1, 4}, res3)
 		assert.Nil(suite.T(), err)
 
 		// invalid count value
-		res4, err := client.LPosCount(key, "a", int64(-1))
+		res4, err := client.LPosCount(context.Background(), key, "a", int64(-1))
 		assert.Nil(suite.T(), res4)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// non-existent key
-		res5, err := client.LPosCount("non_existent_key", "a", int64(1))
+		res5, err := client.LPosCount(context.Background(), "non_existent_key", "a", int64(1))
 		assert.Empty(suite.T(), res5)
 		assert.Nil(suite.T(), err)
 
 		// wrong key data type
 		keyString := uuid.NewString()
-		suite.verifyOK(client.Set(keyString, "value"))
-		res6, err := client.LPosCount(keyString, "a", int64(1))
+		suite.verifyOK(client.Set(context.Background(), keyString, "value"))
+		res6, err := client.LPosCount(context.Background(), keyString, "a", int64(1))
 		assert.Nil(suite.T(), res6)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -1633,20 +1713,38 @@ func (suite *GlideTestSuite) TestLPosCount_withOptions() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res1, err := client.RPush(key, []string{"a", "a", "b", "c", "a", "b"})
+		res1, err := client.RPush(context.Background(), key, []string{"a", "a", "b", "c", "a", "b"})
 		assert.Equal(suite.T(), int64(6), res1)
 		assert.Nil(suite.T(), err)
 
-		res2, err := client.LPosCountWithOptions(key, "a", int64(0), *options.NewLPosOptions().SetRank(1))
+		res2, err := client.LPosCountWithOptions(
+			context.Background(),
+			key,
+			"a",
+			int64(0),
+			*options.NewLPosOptions().SetRank(1),
+		)
 		assert.Equal(suite.T(), []int64{0, 1, 4}, res2)
 		assert.Nil(suite.T(), err)
 
-		res3, err := client.LPosCountWithOptions(key, "a", int64(0), *options.NewLPosOptions().SetRank(2))
+		res3, err := client.LPosCountWithOptions(
+			context.Background(),
+			key,
+			"a",
+			int64(0),
+			*options.NewLPosOptions().SetRank(2),
+		)
 		assert.Equal(suite.T(), []int64{1, 4}, res3)
 		assert.Nil(suite.T(), err)
 
 		// reverse traversal
-		res4, err := client.LPosCountWithOptions(key, "a", int64(0), *options.NewLPosOptions().SetRank(-1))
+		res4, err := client.LPosCountWithOptions(
+			context.Background(),
+			key,
+			"a",
+			int64(0),
+			*options.NewLPosOptions().SetRank(-1),
+		)
 		assert.Equal(suite.T(), []int64{4, 1, 0}, res4)
 		assert.Nil(suite.T(), err)
 	})
@@ -1657,14 +1755,14 @@ func (suite *GlideTestSuite) TestRPush() {
 		list := []string{"value1", "value2", "value3", "value4"}
 		key := uuid.NewString()
 
-		res1, err := client.RPush(key, list)
+		res1, err := client.RPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res2, err := client.RPush(key2, []string{"value1"})
+		res2, err := client.RPush(context.Background(), key2, []string{"value1"})
 		assert.Equal(suite.T(), int64(0), res2)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -1676,7 +1774,7 @@ func (suite *GlideTestSuite) TestSAdd() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2"}
 
-		res, err := client.SAdd(key, members)
+		res, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 	})
@@ -1687,11 +1785,11 @@ func (suite *GlideTestSuite) TestSAdd_WithExistingKey() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.SAdd(key, members)
+		res2, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 	})
@@ -1702,11 +1800,11 @@ func (suite *GlideTestSuite) TestSRem() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2", "member3"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SRem(key, []string{"member1", "member2"})
+		res2, err := client.SRem(context.Background(), key, []string{"member1", "member2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res2)
 	})
@@ -1717,11 +1815,11 @@ func (suite *GlideTestSuite) TestSRem_WithExistingKey() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.SRem(key, []string{"member3", "member4"})
+		res2, err := client.SRem(context.Background(), key, []string{"member3", "member4"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 	})
@@ -1731,7 +1829,7 @@ func (suite *GlideTestSuite) TestSRem_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res2, err := client.SRem(key, []string{"member1", "member2"})
+		res2, err := client.SRem(context.Background(), key, []string{"member1", "member2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 	})
@@ -1742,11 +1840,11 @@ func (suite *GlideTestSuite) TestSRem_WithExistingKeyAndDifferentMembers() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2", "member3"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SRem(key, []string{"member1", "member3", "member4"})
+		res2, err := client.SRem(context.Background(), key, []string{"member1", "member3", "member4"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res2)
 	})
@@ -1782,80 +1880,80 @@ func (suite *GlideTestSuite) TestSUnionStore() {
 		}
 		t := suite.T()
 
-		res1, err := client.SAdd(key1, memberArray1)
+		res1, err := client.SAdd(context.Background(), key1, memberArray1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res1)
 
-		res2, err := client.SAdd(key2, memberArray2)
+		res2, err := client.SAdd(context.Background(), key2, memberArray2)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res2)
 
-		res3, err := client.SAdd(key3, memberArray3)
+		res3, err := client.SAdd(context.Background(), key3, memberArray3)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res3)
 
 		// store union in new key
-		res4, err := client.SUnionStore(key4, []string{key1, key2})
+		res4, err := client.SUnionStore(context.Background(), key4, []string{key1, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(5), res4)
 
-		res5, err := client.SMembers(key4)
+		res5, err := client.SMembers(context.Background(), key4)
 		assert.NoError(t, err)
 		assert.Len(t, res5, 5)
 		assert.True(t, reflect.DeepEqual(res5, expected1))
 
 		// overwrite existing set
-		res6, err := client.SUnionStore(key1, []string{key4, key2})
+		res6, err := client.SUnionStore(context.Background(), key1, []string{key4, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(5), res6)
 
-		res7, err := client.SMembers(key1)
+		res7, err := client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Len(t, res7, 5)
 		assert.True(t, reflect.DeepEqual(res7, expected1))
 
 		// overwrite one of the source keys
-		res8, err := client.SUnionStore(key2, []string{key4, key2})
+		res8, err := client.SUnionStore(context.Background(), key2, []string{key4, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(5), res8)
 
-		res9, err := client.SMembers(key2)
+		res9, err := client.SMembers(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Len(t, res9, 5)
 		assert.True(t, reflect.DeepEqual(res9, expected1))
 
 		// union with non-existing key
-		res10, err := client.SUnionStore(key2, []string{nonExistingKey})
+		res10, err := client.SUnionStore(context.Background(), key2, []string{nonExistingKey})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res10)
 
 		// check that the key is now empty
-		members1, err := client.SMembers(key2)
+		members1, err := client.SMembers(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Empty(t, members1)
 
 		// invalid argument - key list must not be empty
-		res11, err := client.SUnionStore(key4, []string{})
+		res11, err := client.SUnionStore(context.Background(), key4, []string{})
 		assert.Equal(suite.T(), int64(0), res11)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// non-set key
-		_, err = client.Set(stringKey, "value")
+		_, err = client.Set(context.Background(), stringKey, "value")
 		assert.NoError(t, err)
 
-		res12, err := client.SUnionStore(key4, []string{stringKey, key1})
+		res12, err := client.SUnionStore(context.Background(), key4, []string{stringKey, key1})
 		assert.Equal(suite.T(), int64(0), res12)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// overwrite destination when destination is not a set
-		res13, err := client.SUnionStore(stringKey, []string{key1, key3})
+		res13, err := client.SUnionStore(context.Background(), stringKey, []string{key1, key3})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(7), res13)
 
 		// check that the key is now empty
-		res14, err := client.SMembers(stringKey)
+		res14, err := client.SMembers(context.Background(), stringKey)
 		assert.NoError(t, err)
 		assert.Len(t, res14, 7)
 		assert.True(t, reflect.DeepEqual(res14, expected2))
@@ -1867,11 +1965,11 @@ func (suite *GlideTestSuite) TestSMembers() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2", "member3"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SMembers(key)
+		res2, err := client.SMembers(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), res2, 3)
 	})
@@ -1881,7 +1979,7 @@ func (suite *GlideTestSuite) TestSMembers_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.SMembers(key)
+		res, err := client.SMembers(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res)
 	})
@@ -1892,11 +1990,11 @@ func (suite *GlideTestSuite) TestSCard() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2", "member3"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SCard(key)
+		res2, err := client.SCard(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res2)
 	})
@@ -1906,7 +2004,7 @@ func (suite *GlideTestSuite) TestSCard_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.SCard(key)
+		res, err := client.SCard(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 	})
@@ -1917,11 +2015,11 @@ func (suite *GlideTestSuite) TestSIsMember() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2", "member3"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SIsMember(key, "member2")
+		res2, err := client.SIsMember(context.Background(), key, "member2")
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res2)
 	})
@@ -1931,7 +2029,7 @@ func (suite *GlideTestSuite) TestSIsMember_WithNotExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.SIsMember(key, "member2")
+		res, err := client.SIsMember(context.Background(), key, "member2")
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res)
 	})
@@ -1942,11 +2040,11 @@ func (suite *GlideTestSuite) TestSIsMember_WithNotExistingMember() {
 		key := uuid.NewString()
 		members := []string{"member1", "member2", "member3"}
 
-		res1, err := client.SAdd(key, members)
+		res1, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SIsMember(key, "nonExistingMember")
+		res2, err := client.SIsMember(context.Background(), key, "nonExistingMember")
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res2)
 	})
@@ -1957,15 +2055,15 @@ func (suite *GlideTestSuite) TestSDiff() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		res1, err := client.SAdd(key1, []string{"a", "b", "c", "d"})
+		res1, err := client.SAdd(context.Background(), key1, []string{"a", "b", "c", "d"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.SAdd(key2, []string{"c", "d", "e"})
+		res2, err := client.SAdd(context.Background(), key2, []string{"c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res2)
 
-		result, err := client.SDiff([]string{key1, key2})
+		result, err := client.SDiff(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]struct{}{"a": {}, "b": {}}, result)
 	})
@@ -1976,7 +2074,7 @@ func (suite *GlideTestSuite) TestSDiff_WithNotExistingKey() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		result, err := client.SDiff([]string{key1, key2})
+		result, err := client.SDiff(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), result)
 	})
@@ -1987,11 +2085,11 @@ func (suite *GlideTestSuite) TestSDiff_WithSingleKeyExist() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		res1, err := client.SAdd(key1, []string{"a", "b", "c"})
+		res1, err := client.SAdd(context.Background(), key1, []string{"a", "b", "c"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SDiff([]string{key1, key2})
+		res2, err := client.SDiff(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]struct{}{"a": {}, "b": {}, "c": {}}, res2)
 	})
@@ -2003,19 +2101,19 @@ func (suite *GlideTestSuite) TestSDiffStore() {
 		key2 := "{key}-2-" + uuid.NewString()
 		key3 := "{key}-3-" + uuid.NewString()
 
-		res1, err := client.SAdd(key1, []string{"a", "b", "c"})
+		res1, err := client.SAdd(context.Background(), key1, []string{"a", "b", "c"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SAdd(key2, []string{"c", "d", "e"})
+		res2, err := client.SAdd(context.Background(), key2, []string{"c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res2)
 
-		res3, err := client.SDiffStore(key3, []string{key1, key2})
+		res3, err := client.SDiffStore(context.Background(), key3, []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res3)
 
-		members, err := client.SMembers(key3)
+		members, err := client.SMembers(context.Background(), key3)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]struct{}{"a": {}, "b": {}}, members)
 	})
@@ -2027,11 +2125,11 @@ func (suite *GlideTestSuite) TestSDiffStore_WithNotExistingKeys() {
 		key2 := "{key}-2-" + uuid.NewString()
 		key3 := "{key}-3-" + uuid.NewString()
 
-		res, err := client.SDiffStore(key3, []string{key1, key2})
+		res, err := client.SDiffStore(context.Background(), key3, []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 
-		members, err := client.SMembers(key3)
+		members, err := client.SMembers(context.Background(), key3)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), members)
 	})
@@ -2042,15 +2140,15 @@ func (suite *GlideTestSuite) TestSinter() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		res1, err := client.SAdd(key1, []string{"a", "b", "c", "d"})
+		res1, err := client.SAdd(context.Background(), key1, []string{"a", "b", "c", "d"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.SAdd(key2, []string{"c", "d", "e"})
+		res2, err := client.SAdd(context.Background(), key2, []string{"c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res2)
 
-		members, err := client.SInter([]string{key1, key2})
+		members, err := client.SInter(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]struct{}{"c": {}, "d": {}}, members)
 	})
@@ -2061,7 +2159,7 @@ func (suite *GlideTestSuite) TestSinter_WithNotExistingKeys() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		members, err := client.SInter([]string{key1, key2})
+		members, err := client.SInter(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), members)
 	})
@@ -2078,73 +2176,73 @@ func (suite *GlideTestSuite) TestSinterStore() {
 		memberArray2 := []string{"c", "d", "e"}
 		t := suite.T()
 
-		res1, err := client.SAdd(key1, memberArray1)
+		res1, err := client.SAdd(context.Background(), key1, memberArray1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res1)
 
-		res2, err := client.SAdd(key2, memberArray2)
+		res2, err := client.SAdd(context.Background(), key2, memberArray2)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res2)
 
 		// store in new key
-		res3, err := client.SInterStore(key3, []string{key1, key2})
+		res3, err := client.SInterStore(context.Background(), key3, []string{key1, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), res3)
 
-		res4, err := client.SMembers(key3)
+		res4, err := client.SMembers(context.Background(), key3)
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]struct{}{"c": {}}, res4)
 
 		// overwrite existing set, which is also a source set
-		res5, err := client.SInterStore(key2, []string{key1, key2})
+		res5, err := client.SInterStore(context.Background(), key2, []string{key1, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), res5)
 
-		res6, err := client.SMembers(key2)
+		res6, err := client.SMembers(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]struct{}{"c": {}}, res6)
 
 		// source set is the same as the existing set
-		res7, err := client.SInterStore(key1, []string{key2})
+		res7, err := client.SInterStore(context.Background(), key1, []string{key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), res7)
 
-		res8, err := client.SMembers(key2)
+		res8, err := client.SMembers(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]struct{}{"c": {}}, res8)
 
 		// intersection with non-existing key
-		res9, err := client.SInterStore(key1, []string{key2, nonExistingKey})
+		res9, err := client.SInterStore(context.Background(), key1, []string{key2, nonExistingKey})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res9)
 
 		// check that the key is now empty
-		members1, err := client.SMembers(key1)
+		members1, err := client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Empty(t, members1)
 
 		// invalid argument - key list must not be empty
-		res10, err := client.SInterStore(key3, []string{})
+		res10, err := client.SInterStore(context.Background(), key3, []string{})
 		assert.Equal(suite.T(), int64(0), res10)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// non-set key
-		_, err = client.Set(stringKey, "value")
+		_, err = client.Set(context.Background(), stringKey, "value")
 		assert.NoError(t, err)
 
-		res11, err := client.SInterStore(key3, []string{stringKey})
+		res11, err := client.SInterStore(context.Background(), key3, []string{stringKey})
 		assert.Equal(suite.T(), int64(0), res11)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// overwrite the non-set key
-		res12, err := client.SInterStore(stringKey, []string{key2})
+		res12, err := client.SInterStore(context.Background(), stringKey, []string{key2})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), res12)
 
 		// check that the key is now empty
-		res13, err := client.SMembers(stringKey)
+		res13, err := client.SMembers(context.Background(), stringKey)
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]struct{}{"c": {}}, res13)
 	})
@@ -2157,19 +2255,19 @@ func (suite *GlideTestSuite) TestSInterCard() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		res1, err := client.SAdd(key1, []string{"one", "two", "three", "four"})
+		res1, err := client.SAdd(context.Background(), key1, []string{"one", "two", "three", "four"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		result1, err := client.SInterCard([]string{key1, key2})
+		result1, err := client.SInterCard(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), result1)
 
-		res2, err := client.SAdd(key2, []string{"two", "three", "four", "five"})
+		res2, err := client.SAdd(context.Background(), key2, []string{"two", "three", "four", "five"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		result2, err := client.SInterCard([]string{key1, key2})
+		result2, err := client.SInterCard(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), result2)
 	})
@@ -2182,19 +2280,19 @@ func (suite *GlideTestSuite) TestSInterCardLimit() {
 		key1 := "{key}-1-" + uuid.NewString()
 		key2 := "{key}-2-" + uuid.NewString()
 
-		res1, err := client.SAdd(key1, []string{"one", "two", "three", "four"})
+		res1, err := client.SAdd(context.Background(), key1, []string{"one", "two", "three", "four"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.SAdd(key2, []string{"two", "three", "four", "five"})
+		res2, err := client.SAdd(context.Background(), key2, []string{"two", "three", "four", "five"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		result1, err := client.SInterCardLimit([]string{key1, key2}, 2)
+		result1, err := client.SInterCardLimit(context.Background(), []string{key1, key2}, 2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), result1)
 
-		result2, err := client.SInterCardLimit([]string{key1, key2}, 4)
+		result2, err := client.SInterCardLimit(context.Background(), []string{key1, key2}, 4)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), result2)
 	})
@@ -2204,11 +2302,11 @@ func (suite *GlideTestSuite) TestSRandMember() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res, err := client.SAdd(key, []string{"one"})
+		res, err := client.SAdd(context.Background(), key, []string{"one"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		member, err := client.SRandMember(key)
+		member, err := client.SRandMember(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "one", member.Value())
 		assert.False(suite.T(), member.IsNil())
@@ -2220,16 +2318,16 @@ func (suite *GlideTestSuite) TestSPop() {
 		key := uuid.NewString()
 		members := []string{"value1", "value2", "value3"}
 
-		res, err := client.SAdd(key, members)
+		res, err := client.SAdd(context.Background(), key, members)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
-		popMember, err := client.SPop(key)
+		popMember, err := client.SPop(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Contains(suite.T(), members, popMember.Value())
 		assert.False(suite.T(), popMember.IsNil())
 
-		remainingMembers, err := client.SMembers(key)
+		remainingMembers, err := client.SMembers(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), remainingMembers, 2)
 		assert.NotContains(suite.T(), remainingMembers, popMember)
@@ -2240,16 +2338,16 @@ func (suite *GlideTestSuite) TestSPop_LastMember() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		res1, err := client.SAdd(key, []string{"lastValue"})
+		res1, err := client.SAdd(context.Background(), key, []string{"lastValue"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res1)
 
-		popMember, err := client.SPop(key)
+		popMember, err := client.SPop(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "lastValue", popMember.Value())
 		assert.False(suite.T(), popMember.IsNil())
 
-		remainingMembers, err := client.SMembers(key)
+		remainingMembers, err := client.SMembers(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), remainingMembers)
 	})
@@ -2261,26 +2359,26 @@ func (suite *GlideTestSuite) TestSMIsMember() {
 		stringKey := uuid.NewString()
 		nonExistingKey := uuid.NewString()
 
-		res1, err1 := client.SAdd(key1, []string{"one", "two"})
+		res1, err1 := client.SAdd(context.Background(), key1, []string{"one", "two"})
 		assert.Nil(suite.T(), err1)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err2 := client.SMIsMember(key1, []string{"two", "three"})
+		res2, err2 := client.SMIsMember(context.Background(), key1, []string{"two", "three"})
 		assert.Nil(suite.T(), err2)
 		assert.Equal(suite.T(), []bool{true, false}, res2)
 
-		res3, err3 := client.SMIsMember(nonExistingKey, []string{"two"})
+		res3, err3 := client.SMIsMember(context.Background(), nonExistingKey, []string{"two"})
 		assert.Nil(suite.T(), err3)
 		assert.Equal(suite.T(), []bool{false}, res3)
 
 		// invalid argument - member list must not be empty
-		_, err4 := client.SMIsMember(key1, []string{})
+		_, err4 := client.SMIsMember(context.Background(), key1, []string{})
 		assert.NotNil(suite.T(), err4)
 		assert.IsType(suite.T(), &errors.RequestError{}, err4)
 
 		// source key exists, but it is not a set
-		suite.verifyOK(client.Set(stringKey, "value"))
-		_, err5 := client.SMIsMember(stringKey, []string{"two"})
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
+		_, err5 := client.SMIsMember(context.Background(), stringKey, []string{"two"})
 		assert.NotNil(suite.T(), err5)
 		assert.IsType(suite.T(), &errors.RequestError{}, err5)
 	})
@@ -2307,34 +2405,34 @@ func (suite *GlideTestSuite) TestSUnion() {
 			"c": {},
 		}
 
-		res1, err := client.SAdd(key1, memberList1)
+		res1, err := client.SAdd(context.Background(), key1, memberList1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res1)
 
-		res2, err := client.SAdd(key2, memberList2)
+		res2, err := client.SAdd(context.Background(), key2, memberList2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		res3, err := client.SUnion([]string{key1, key2})
+		res3, err := client.SUnion(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), reflect.DeepEqual(res3, expected1))
 
-		res4, err := client.SUnion([]string{key3})
+		res4, err := client.SUnion(context.Background(), []string{key3})
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res4)
 
-		res5, err := client.SUnion([]string{key1, key3})
+		res5, err := client.SUnion(context.Background(), []string{key1, key3})
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), reflect.DeepEqual(res5, expected2))
 
 		// Exceptions with empty keys
-		res6, err := client.SUnion([]string{})
+		res6, err := client.SUnion(context.Background(), []string{})
 		assert.Nil(suite.T(), res6)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Exception with a non-set key
-		suite.verifyOK(client.Set(nonSetKey, "value"))
-		res7, err := client.SUnion([]string{nonSetKey, key1})
+		suite.verifyOK(client.Set(context.Background(), nonSetKey, "value"))
+		res7, err := client.SUnion(context.Background(), []string{nonSetKey, key1})
 		assert.Nil(suite.T(), res7)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -2351,89 +2449,89 @@ func (suite *GlideTestSuite) TestSMove() {
 		memberArray2 := []string{"2", "3"}
 		t := suite.T()
 
-		res1, err := client.SAdd(key1, memberArray1)
+		res1, err := client.SAdd(context.Background(), key1, memberArray1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res1)
 
-		res2, err := client.SAdd(key2, memberArray2)
+		res2, err := client.SAdd(context.Background(), key2, memberArray2)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res2)
 
 		// move an element
-		res3, err := client.SMove(key1, key2, "1")
+		res3, err := client.SMove(context.Background(), key1, key2, "1")
 		assert.NoError(t, err)
 		assert.True(t, res3)
 
-		res4, err := client.SMembers(key1)
+		res4, err := client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"2": {}, "3": {}}, res4)
 
-		res5, err := client.SMembers(key2)
+		res5, err := client.SMembers(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"1": {}, "2": {}, "3": {}}, res5)
 
 		// moved element already exists in the destination set
-		res6, err := client.SMove(key2, key1, "2")
+		res6, err := client.SMove(context.Background(), key2, key1, "2")
 		assert.NoError(t, err)
 		assert.True(t, res6)
 
-		res7, err := client.SMembers(key1)
+		res7, err := client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"2": {}, "3": {}}, res7)
 
-		res8, err := client.SMembers(key2)
+		res8, err := client.SMembers(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"1": {}, "3": {}}, res8)
 
 		// attempt to move from a non-existing key
-		res9, err := client.SMove(nonExistingKey, key1, "4")
+		res9, err := client.SMove(context.Background(), nonExistingKey, key1, "4")
 		assert.NoError(t, err)
 		assert.False(t, res9)
 
-		res10, err := client.SMembers(key1)
+		res10, err := client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"2": {}, "3": {}}, res10)
 
 		// move to a new set
-		res11, err := client.SMove(key1, key3, "2")
+		res11, err := client.SMove(context.Background(), key1, key3, "2")
 		assert.NoError(t, err)
 		assert.True(t, res11)
 
-		res12, err := client.SMembers(key1)
+		res12, err := client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"3": {}}, res12)
 
-		res13, err := client.SMembers(key3)
+		res13, err := client.SMembers(context.Background(), key3)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"2": {}}, res13)
 
 		// attempt to move a missing element
-		res14, err := client.SMove(key1, key3, "42")
+		res14, err := client.SMove(context.Background(), key1, key3, "42")
 		assert.NoError(t, err)
 		assert.False(t, res14)
 
-		res12, err = client.SMembers(key1)
+		res12, err = client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"3": {}}, res12)
 
-		res13, err = client.SMembers(key3)
+		res13, err = client.SMembers(context.Background(), key3)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"2": {}}, res13)
 
 		// moving missing element to missing key
-		res15, err := client.SMove(key1, nonExistingKey, "42")
+		res15, err := client.SMove(context.Background(), key1, nonExistingKey, "42")
 		assert.NoError(t, err)
 		assert.False(t, res15)
 
-		res12, err = client.SMembers(key1)
+		res12, err = client.SMembers(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(suite.T(), map[string]struct{}{"3": {}}, res12)
 
 		// key exists but is not contain a set
-		_, err = client.Set(stringKey, "value")
+		_, err = client.Set(context.Background(), stringKey, "value")
 		assert.NoError(t, err)
 
-		_, err = client.SMove(stringKey, key1, "_")
+		_, err = client.SMove(context.Background(), stringKey, key1, "_")
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -2458,50 +2556,50 @@ func (suite *GlideTestSuite) TestSScan() {
 		}
 
 		// empty set
-		resCursor, resCollection, err := client.SScan(key1, initialCursor)
+		resCursor, resCollection, err := client.SScan(context.Background(), key1, initialCursor)
 		assert.NoError(t, err)
 		assert.Equal(t, initialCursor, resCursor)
 		assert.Empty(t, resCollection)
 
 		// negative cursor
 		if suite.serverVersion < "8.0.0" {
-			resCursor, resCollection, err = client.SScan(key1, "-1")
+			resCursor, resCollection, err = client.SScan(context.Background(), key1, "-1")
 			assert.NoError(t, err)
 			assert.Equal(t, initialCursor, resCursor)
 			assert.Empty(t, resCollection)
 		} else {
-			_, _, err = client.SScan(key1, "-1")
+			_, _, err = client.SScan(context.Background(), key1, "-1")
 			assert.NotNil(suite.T(), err)
 			assert.IsType(suite.T(), &errors.RequestError{}, err)
 		}
 
 		// result contains the whole set
-		res, err := client.SAdd(key1, charMembers)
+		res, err := client.SAdd(context.Background(), key1, charMembers)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(len(charMembers)), res)
-		resCursor, resCollection, err = client.SScan(key1, initialCursor)
+		resCursor, resCollection, err = client.SScan(context.Background(), key1, initialCursor)
 		assert.NoError(t, err)
 		assert.Equal(t, initialCursor, resCursor)
 		assert.Equal(t, len(charMembers), len(resCollection))
 		assert.True(t, isSubset(resCollection, charMembers))
 
 		opts := options.NewBaseScanOptions().SetMatch("a")
-		resCursor, resCollection, err = client.SScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.SScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(t, err)
 		assert.Equal(t, initialCursor, resCursor)
 		assert.True(t, isSubset(resCollection, []string{"a"}))
 
 		// result contains a subset of the key
-		res, err = client.SAdd(key1, numMembers)
+		res, err = client.SAdd(context.Background(), key1, numMembers)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(50000), res)
-		resCursor, resCollection, err = client.SScan(key1, "0")
+		resCursor, resCollection, err = client.SScan(context.Background(), key1, "0")
 		assert.NoError(t, err)
 		resultCollection := resCollection
 
 		// 0 is returned for the cursor of the last iteration
 		for resCursor != "0" {
-			nextCursor, nextCol, err := client.SScan(key1, resCursor)
+			nextCursor, nextCol, err := client.SScan(context.Background(), key1, resCursor)
 			assert.NoError(t, err)
 			assert.NotEqual(t, nextCursor, resCursor)
 			assert.False(t, isSubset(resultCollection, nextCol))
@@ -2514,31 +2612,31 @@ func (suite *GlideTestSuite) TestSScan() {
 
 		// test match pattern
 		opts = options.NewBaseScanOptions().SetMatch("*")
-		resCursor, resCollection, err = client.SScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.SScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(t, err)
 		assert.NotEqual(t, initialCursor, resCursor)
 		assert.GreaterOrEqual(t, len(resCollection), defaultCount)
 
 		// test count
 		opts = options.NewBaseScanOptions().SetCount(20)
-		resCursor, resCollection, err = client.SScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.SScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(t, err)
 		assert.NotEqual(t, initialCursor, resCursor)
 		assert.GreaterOrEqual(t, len(resCollection), 20)
 
 		// test count with match, returns a non-empty array
 		opts = options.NewBaseScanOptions().SetMatch("1*").SetCount(20)
-		resCursor, resCollection, err = client.SScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.SScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(t, err)
 		assert.NotEqual(t, initialCursor, resCursor)
 		assert.GreaterOrEqual(t, len(resCollection), 0)
 
 		// exceptions
 		// non-set key
-		_, err = client.Set(key2, "test")
+		_, err = client.Set(context.Background(), key2, "test")
 		assert.NoError(t, err)
 
-		_, _, err = client.SScan(key2, initialCursor)
+		_, _, err = client.SScan(context.Background(), key2, initialCursor)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -2549,22 +2647,22 @@ func (suite *GlideTestSuite) TestLRange() {
 		list := []string{"value4", "value3", "value2", "value1"}
 		key := uuid.NewString()
 
-		res1, err := client.LPush(key, list)
+		res1, err := client.LPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.LRange(key, int64(0), int64(-1))
+		res2, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value1", "value2", "value3", "value4"}, res2)
 
-		res3, err := client.LRange("non_existing_key", int64(0), int64(-1))
+		res3, err := client.LRange(context.Background(), "non_existing_key", int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res3)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res4, err := client.LRange(key2, int64(0), int64(1))
+		res4, err := client.LRange(context.Background(), key2, int64(0), int64(1))
 		assert.Nil(suite.T(), res4)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2576,28 +2674,28 @@ func (suite *GlideTestSuite) TestLIndex() {
 		list := []string{"value4", "value3", "value2", "value1"}
 		key := uuid.NewString()
 
-		res1, err := client.LPush(key, list)
+		res1, err := client.LPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.LIndex(key, int64(0))
+		res2, err := client.LIndex(context.Background(), key, int64(0))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "value1", res2.Value())
 		assert.False(suite.T(), res2.IsNil())
 
-		res3, err := client.LIndex(key, int64(-1))
+		res3, err := client.LIndex(context.Background(), key, int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "value4", res3.Value())
 		assert.False(suite.T(), res3.IsNil())
 
-		res4, err := client.LIndex("non_existing_key", int64(0))
+		res4, err := client.LIndex(context.Background(), "non_existing_key", int64(0))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res4)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res5, err := client.LIndex(key2, int64(0))
+		res5, err := client.LIndex(context.Background(), key2, int64(0))
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res5)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2609,26 +2707,26 @@ func (suite *GlideTestSuite) TestLTrim() {
 		list := []string{"value4", "value3", "value2", "value1"}
 		key := uuid.NewString()
 
-		res1, err := client.LPush(key, list)
+		res1, err := client.LPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		suite.verifyOK(client.LTrim(key, int64(0), int64(1)))
+		suite.verifyOK(client.LTrim(context.Background(), key, int64(0), int64(1)))
 
-		res2, err := client.LRange(key, int64(0), int64(-1))
+		res2, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value1", "value2"}, res2)
 
-		suite.verifyOK(client.LTrim(key, int64(4), int64(2)))
+		suite.verifyOK(client.LTrim(context.Background(), key, int64(4), int64(2)))
 
-		res3, err := client.LRange(key, int64(0), int64(-1))
+		res3, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res3)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res4, err := client.LIndex(key2, int64(0))
+		res4, err := client.LIndex(context.Background(), key2, int64(0))
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res4)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2640,22 +2738,22 @@ func (suite *GlideTestSuite) TestLLen() {
 		list := []string{"value4", "value3", "value2", "value1"}
 		key := uuid.NewString()
 
-		res1, err := client.LPush(key, list)
+		res1, err := client.LPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.LLen(key)
+		res2, err := client.LLen(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		res3, err := client.LLen("non_existing_key")
+		res3, err := client.LLen(context.Background(), "non_existing_key")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res3)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res4, err := client.LLen(key2)
+		res4, err := client.LLen(context.Background(), key2)
 		assert.Equal(suite.T(), int64(0), res4)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2667,32 +2765,32 @@ func (suite *GlideTestSuite) TestLRem() {
 		list := []string{"value1", "value2", "value1", "value1", "value2"}
 		key := uuid.NewString()
 
-		res1, err := client.LPush(key, list)
+		res1, err := client.LPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res1)
 
-		res2, err := client.LRem(key, 2, "value1")
+		res2, err := client.LRem(context.Background(), key, 2, "value1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res2)
-		res3, err := client.LRange(key, int64(0), int64(-1))
+		res3, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value2", "value2", "value1"}, res3)
 
-		res4, err := client.LRem(key, -1, "value2")
+		res4, err := client.LRem(context.Background(), key, -1, "value2")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res4)
-		res5, err := client.LRange(key, int64(0), int64(-1))
+		res5, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value2", "value1"}, res5)
 
-		res6, err := client.LRem(key, 0, "value2")
+		res6, err := client.LRem(context.Background(), key, 0, "value2")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res6)
-		res7, err := client.LRange(key, int64(0), int64(-1))
+		res7, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value1"}, res7)
 
-		res8, err := client.LRem("non_existing_key", 0, "value")
+		res8, err := client.LRem(context.Background(), "non_existing_key", 0, "value")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res8)
 	})
@@ -2703,36 +2801,36 @@ func (suite *GlideTestSuite) TestRPopAndRPopCount() {
 		list := []string{"value1", "value2", "value3", "value4"}
 		key := uuid.NewString()
 
-		res1, err := client.RPush(key, list)
+		res1, err := client.RPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.RPop(key)
+		res2, err := client.RPop(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "value4", res2.Value())
 		assert.False(suite.T(), res2.IsNil())
 
-		res3, err := client.RPopCount(key, int64(2))
+		res3, err := client.RPopCount(context.Background(), key, int64(2))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value3", "value2"}, res3)
 
-		res4, err := client.RPop("non_existing_key")
+		res4, err := client.RPop(context.Background(), "non_existing_key")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res4)
 
-		res5, err := client.RPopCount("non_existing_key", int64(2))
+		res5, err := client.RPopCount(context.Background(), "non_existing_key", int64(2))
 		assert.Nil(suite.T(), res5)
 		assert.Nil(suite.T(), err)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res6, err := client.RPop(key2)
+		res6, err := client.RPop(context.Background(), key2)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res6)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res7, err := client.RPopCount(key2, int64(2))
+		res7, err := client.RPopCount(context.Background(), key2, int64(2))
 		assert.Nil(suite.T(), res7)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2744,34 +2842,34 @@ func (suite *GlideTestSuite) TestLInsert() {
 		list := []string{"value1", "value2", "value3", "value4"}
 		key := uuid.NewString()
 
-		res1, err := client.RPush(key, list)
+		res1, err := client.RPush(context.Background(), key, list)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res1)
 
-		res2, err := client.LInsert(key, options.Before, "value2", "value1.5")
+		res2, err := client.LInsert(context.Background(), key, options.Before, "value2", "value1.5")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res2)
 
-		res3, err := client.LInsert(key, options.After, "value3", "value3.5")
+		res3, err := client.LInsert(context.Background(), key, options.After, "value3", "value3.5")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(6), res3)
 
-		res4, err := client.LRange(key, int64(0), int64(-1))
+		res4, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value1", "value1.5", "value2", "value3", "value3.5", "value4"}, res4)
 
-		res5, err := client.LInsert("non_existing_key", options.Before, "pivot", "elem")
+		res5, err := client.LInsert(context.Background(), "non_existing_key", options.Before, "pivot", "elem")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res5)
 
-		res6, err := client.LInsert(key, options.Before, "value5", "value6")
+		res6, err := client.LInsert(context.Background(), key, options.Before, "value5", "value6")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-1), res6)
 
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "value"))
+		suite.verifyOK(client.Set(context.Background(), key2, "value"))
 
-		res7, err := client.LInsert(key2, options.Before, "value5", "value6")
+		res7, err := client.LInsert(context.Background(), key2, options.Before, "value5", "value6")
 		assert.Equal(suite.T(), int64(0), res7)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2783,49 +2881,95 @@ func (suite *GlideTestSuite) TestBLPop() {
 		listKey1 := "{listKey}-1-" + uuid.NewString()
 		listKey2 := "{listKey}-2-" + uuid.NewString()
 
-		res1, err := client.LPush(listKey1, []string{"value1", "value2"})
+		res1, err := client.LPush(context.Background(), listKey1, []string{"value1", "value2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.BLPop([]string{listKey1, listKey2}, float64(0.5))
+		res2, err := client.BLPop(context.Background(), []string{listKey1, listKey2}, float64(0.5))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{listKey1, "value2"}, res2)
 
-		res3, err := client.BLPop([]string{listKey2}, float64(1.0))
+		res3, err := client.BLPop(context.Background(), []string{listKey2}, float64(1.0))
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res3)
 
 		key := uuid.NewString()
-		suite.verifyOK(client.Set(key, "value"))
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
 
-		res4, err := client.BLPop([]string{key}, float64(1.0))
+		res4, err := client.BLPop(context.Background(), []string{key}, float64(1.0))
 		assert.Nil(suite.T(), res4)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
 }
 
+func (suite *GlideTestSuite) TestCommandWithCancelledContext() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, err := client.Set(ctx, uuid.NewString(), "value")
+		assert.Equal(suite.T(), "", res)
+		assert.ErrorIs(suite.T(), err, context.Canceled)
+	})
+}
+
+func (suite *GlideTestSuite) TestCommandWithContextDeadline() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := "{listKey}-" + uuid.NewString()
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		// BLPOP blocks for longer than the deadline, so the command is abandoned once the context expires.
+		res, err := client.BLPop(ctx, []string{key}, float64(2.0))
+		assert.Nil(suite.T(), res)
+		assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+		// an abandoned request is no longer pending, even though its response was not received yet
+		assert.Equal(suite.T(), 0, client.Statistics().PendingRequests)
+
+		// the client remains usable after an abandoned request
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+	})
+}
+
+func (suite *GlideTestSuite) TestCloseClientWithAbandonedRequest() {
+	client := suite.client(suite.defaultClientConfig())
+	goroutines := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	// BLPOP blocks until the client is closed, since nothing is pushed to the list
+	_, err := client.BLPop(ctx, []string{uuid.NewString()}, 0)
+	assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+
+	// the request is released by Close, so the goroutine waiting for its response exits
+	client.Close()
+	assert.Eventually(suite.T(), func() bool {
+		return runtime.NumGoroutine() <= goroutines
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
 func (suite *GlideTestSuite) TestBRPop() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		listKey1 := "{listKey}-1-" + uuid.NewString()
 		listKey2 := "{listKey}-2-" + uuid.NewString()
 
-		res1, err := client.LPush(listKey1, []string{"value1", "value2"})
+		res1, err := client.LPush(context.Background(), listKey1, []string{"value1", "value2"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res1)
 
-		res2, err := client.BRPop([]string{listKey1, listKey2}, float64(0.5))
+		res2, err := client.BRPop(context.Background(), []string{listKey1, listKey2}, float64(0.5))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{listKey1, "value1"}, res2)
 
-		res3, err := client.BRPop([]string{listKey2}, float64(1.0))
+		res3, err := client.BRPop(context.Background(), []string{listKey2}, float64(1.0))
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res3)
 
 		key := uuid.NewString()
-		suite.verifyOK(client.Set(key, "value"))
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
 
-		res4, err := client.BRPop([]string{key}, float64(1.0))
+		res4, err := client.BRPop(context.Background(), []string{key}, float64(1.0))
 		assert.Nil(suite.T(), res4)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2838,34 +2982,34 @@ func (suite *GlideTestSuite) TestRPushX() {
 		key2 := uuid.NewString()
 		key3 := uuid.NewString()
 
-		res1, err := client.RPush(key1, []string{"value1"})
+		res1, err := client.RPush(context.Background(), key1, []string{"value1"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res1)
 
-		res2, err := client.RPushX(key1, []string{"value2", "value3", "value4"})
+		res2, err := client.RPushX(context.Background(), key1, []string{"value2", "value3", "value4"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		res3, err := client.LRange(key1, int64(0), int64(-1))
+		res3, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value1", "value2", "value3", "value4"}, res3)
 
-		res4, err := client.RPushX(key2, []string{"value1"})
+		res4, err := client.RPushX(context.Background(), key2, []string{"value1"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res4)
 
-		res5, err := client.LRange(key2, int64(0), int64(-1))
+		res5, err := client.LRange(context.Background(), key2, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res5)
 
-		suite.verifyOK(client.Set(key3, "value"))
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
 
-		res6, err := client.RPushX(key3, []string{"value1"})
+		res6, err := client.RPushX(context.Background(), key3, []string{"value1"})
 		assert.Equal(suite.T(), int64(0), res6)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res7, err := client.RPushX(key2, []string{})
+		res7, err := client.RPushX(context.Background(), key2, []string{})
 		assert.Equal(suite.T(), int64(0), res7)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2878,34 +3022,34 @@ func (suite *GlideTestSuite) TestLPushX() {
 		key2 := uuid.NewString()
 		key3 := uuid.NewString()
 
-		res1, err := client.LPush(key1, []string{"value1"})
+		res1, err := client.LPush(context.Background(), key1, []string{"value1"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res1)
 
-		res2, err := client.LPushX(key1, []string{"value2", "value3", "value4"})
+		res2, err := client.LPushX(context.Background(), key1, []string{"value2", "value3", "value4"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		res3, err := client.LRange(key1, int64(0), int64(-1))
+		res3, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"value4", "value3", "value2", "value1"}, res3)
 
-		res4, err := client.LPushX(key2, []string{"value1"})
+		res4, err := client.LPushX(context.Background(), key2, []string{"value1"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res4)
 
-		res5, err := client.LRange(key2, int64(0), int64(-1))
+		res5, err := client.LRange(context.Background(), key2, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Empty(suite.T(), res5)
 
-		suite.verifyOK(client.Set(key3, "value"))
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
 
-		res6, err := client.LPushX(key3, []string{"value1"})
+		res6, err := client.LPushX(context.Background(), key3, []string{"value1"})
 		assert.Equal(suite.T(), int64(0), res6)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res7, err := client.LPushX(key2, []string{})
+		res7, err := client.LPushX(context.Background(), key2, []string{})
 		assert.Equal(suite.T(), int64(0), res7)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2921,22 +3065,22 @@ func (suite *GlideTestSuite) TestLMPopAndLMPopCount() {
 		key2 := "{key}-2" + uuid.NewString()
 		key3 := "{key}-3" + uuid.NewString()
 
-		res1, err := client.LMPop([]string{key1}, options.Left)
+		res1, err := client.LMPop(context.Background(), []string{key1}, options.Left)
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res1)
 
-		res2, err := client.LMPopCount([]string{key1}, options.Left, int64(1))
+		res2, err := client.LMPopCount(context.Background(), []string{key1}, options.Left, int64(1))
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res2)
 
-		res3, err := client.LPush(key1, []string{"one", "two", "three", "four", "five"})
+		res3, err := client.LPush(context.Background(), key1, []string{"one", "two", "three", "four", "five"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res3)
-		res4, err := client.LPush(key2, []string{"one", "two", "three", "four", "five"})
+		res4, err := client.LPush(context.Background(), key2, []string{"one", "two", "three", "four", "five"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res4)
 
-		res5, err := client.LMPop([]string{key1}, options.Left)
+		res5, err := client.LMPop(context.Background(), []string{key1}, options.Left)
 		assert.Nil(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -2944,7 +3088,7 @@ func (suite *GlideTestSuite) TestLMPopAndLMPopCount() {
 			res5,
 		)
 
-		res6, err := client.LMPopCount([]string{key2, key1}, options.Right, int64(2))
+		res6, err := client.LMPopCount(context.Background(), []string{key2, key1}, options.Right, int64(2))
 		assert.Nil(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -2954,14 +3098,14 @@ func (suite *GlideTestSuite) TestLMPopAndLMPopCount() {
 			res6,
 		)
 
-		suite.verifyOK(client.Set(key3, "value"))
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
 
-		res7, err := client.LMPop([]string{key3}, options.Left)
+		res7, err := client.LMPop(context.Background(), []string{key3}, options.Left)
 		assert.Nil(suite.T(), res7)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res8, err := client.LMPop([]string{key3}, "Invalid")
+		res8, err := client.LMPop(context.Background(), []string{key3}, "Invalid")
 		assert.Nil(suite.T(), res8)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -2977,22 +3121,22 @@ func (suite *GlideTestSuite) TestBLMPopAndBLMPopCount() {
 		key2 := "{key}-2" + uuid.NewString()
 		key3 := "{key}-3" + uuid.NewString()
 
-		res1, err := client.BLMPop([]string{key1}, options.Left, float64(0.1))
+		res1, err := client.BLMPop(context.Background(), []string{key1}, options.Left, float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res1)
 
-		res2, err := client.BLMPopCount([]string{key1}, options.Left, int64(1), float64(0.1))
+		res2, err := client.BLMPopCount(context.Background(), []string{key1}, options.Left, int64(1), float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), res2)
 
-		res3, err := client.LPush(key1, []string{"one", "two", "three", "four", "five"})
+		res3, err := client.LPush(context.Background(), key1, []string{"one", "two", "three", "four", "five"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res3)
-		res4, err := client.LPush(key2, []string{"one", "two", "three", "four", "five"})
+		res4, err := client.LPush(context.Background(), key2, []string{"one", "two", "three", "four", "five"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res4)
 
-		res5, err := client.BLMPop([]string{key1}, options.Left, float64(0.1))
+		res5, err := client.BLMPop(context.Background(), []string{key1}, options.Left, float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -3000,7 +3144,7 @@ func (suite *GlideTestSuite) TestBLMPopAndBLMPopCount() {
 			res5,
 		)
 
-		res6, err := client.BLMPopCount([]string{key2, key1}, options.Right, int64(2), float64(0.1))
+		res6, err := client.BLMPopCount(context.Background(), []string{key2, key1}, options.Right, int64(2), float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -3010,9 +3154,9 @@ func (suite *GlideTestSuite) TestBLMPopAndBLMPopCount() {
 			res6,
 		)
 
-		suite.verifyOK(client.Set(key3, "value"))
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
 
-		res7, err := client.BLMPop([]string{key3}, options.Left, float64(0.1))
+		res7, err := client.BLMPop(context.Background(), []string{key3}, options.Left, float64(0.1))
 		assert.Nil(suite.T(), res7)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -3028,7 +3172,7 @@ func (suite *GlideTestSuite) TestBZMPopAndBZMPopWithOptions() {
 		key2 := "{key}-2" + uuid.NewString()
 		key3 := "{key}-3" + uuid.NewString()
 
-		res1, err := client.BZMPop([]string{key1}, options.MIN, float64(0.1))
+		res1, err := client.BZMPop(context.Background(), []string{key1}, options.MIN, float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res1.IsNil())
 
@@ -3038,15 +3182,16 @@ func (suite *GlideTestSuite) TestBZMPopAndBZMPopWithOptions() {
 			"three": 3.0,
 		}
 
-		res3, err := client.ZAdd(key1, membersScoreMap)
+		res3, err := client.ZAdd(context.Background(), key1, membersScoreMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res3)
-		res4, err := client.ZAdd(key2, membersScoreMap)
+		res4, err := client.ZAdd(context.Background(), key2, membersScoreMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res4)
 
 		// Try to pop the top 2 elements from key1
 		res5, err := client.BZMPopWithOptions(
+			context.Background(),
 			[]string{key1},
 			options.MAX,
 			float64(0.1),
@@ -3064,7 +3209,7 @@ func (suite *GlideTestSuite) TestBZMPopAndBZMPopWithOptions() {
 		)
 
 		// Try to pop the minimum value from key2
-		res6, err := client.BZMPop([]string{key2}, options.MIN, float64(0.1))
+		res6, err := client.BZMPop(context.Background(), []string{key2}, options.MIN, float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -3080,7 +3225,7 @@ func (suite *GlideTestSuite) TestBZMPopAndBZMPopWithOptions() {
 		)
 
 		// Pop the minimum value from multiple keys
-		res7, err := client.BZMPop([]string{key1, key2}, options.MIN, float64(0.1))
+		res7, err := client.BZMPop(context.Background(), []string{key1, key2}, options.MIN, float64(0.1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -3095,10 +3240,10 @@ func (suite *GlideTestSuite) TestBZMPopAndBZMPopWithOptions() {
 			res7,
 		)
 
-		suite.verifyOK(client.Set(key3, "value"))
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
 
 		// Popping a non-existent value in key3
-		res8, err := client.BZMPop([]string{key3}, options.MIN, float64(0.1))
+		res8, err := client.BZMPop(context.Background(), []string{key3}, options.MIN, float64(0.1))
 		assert.True(suite.T(), res8.IsNil())
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -3110,27 +3255,27 @@ func (suite *GlideTestSuite) TestLSet() {
 		key := uuid.NewString()
 		nonExistentKey := uuid.NewString()
 
-		_, err := client.LSet(nonExistentKey, int64(0), "zero")
+		_, err := client.LSet(context.Background(), nonExistentKey, int64(0), "zero")
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res2, err := client.LPush(key, []string{"four", "three", "two", "one"})
+		res2, err := client.LPush(context.Background(), key, []string{"four", "three", "two", "one"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
-		_, err = client.LSet(key, int64(10), "zero")
+		_, err = client.LSet(context.Background(), key, int64(10), "zero")
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		suite.verifyOK(client.LSet(key, int64(0), "zero"))
+		suite.verifyOK(client.LSet(context.Background(), key, int64(0), "zero"))
 
-		res5, err := client.LRange(key, int64(0), int64(-1))
+		res5, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"zero", "two", "three", "four"}, res5)
 
-		suite.verifyOK(client.LSet(key, int64(-1), "zero"))
+		suite.verifyOK(client.LSet(context.Background(), key, int64(-1), "zero"))
 
-		res7, err := client.LRange(key, int64(0), int64(-1))
+		res7, err := client.LRange(context.Background(), key, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"zero", "two", "three", "zero"}, res7)
 	})
@@ -3146,59 +3291,59 @@ func (suite *GlideTestSuite) TestLMove() {
 		nonExistentKey := "{key}-3" + uuid.NewString()
 		nonListKey := "{key}-4" + uuid.NewString()
 
-		res1, err := client.LMove(key1, key2, options.Left, options.Right)
+		res1, err := client.LMove(context.Background(), key1, key2, options.Left, options.Right)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res1)
 		assert.Nil(suite.T(), err)
 
-		res2, err := client.LPush(key1, []string{"four", "three", "two", "one"})
+		res2, err := client.LPush(context.Background(), key1, []string{"four", "three", "two", "one"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
 		// only source exists, only source elements gets popped, creates a list at nonExistingKey
-		res3, err := client.LMove(key1, nonExistentKey, options.Right, options.Left)
+		res3, err := client.LMove(context.Background(), key1, nonExistentKey, options.Right, options.Left)
 		assert.Equal(suite.T(), "four", res3.Value())
 		assert.Nil(suite.T(), err)
 
-		res4, err := client.LRange(key1, int64(0), int64(-1))
+		res4, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "two", "three"}, res4)
 
 		// source and destination are the same, performing list rotation, "one" gets popped and added back
-		res5, err := client.LMove(key1, key1, options.Left, options.Left)
+		res5, err := client.LMove(context.Background(), key1, key1, options.Left, options.Left)
 		assert.Equal(suite.T(), "one", res5.Value())
 		assert.Nil(suite.T(), err)
 
-		res6, err := client.LRange(key1, int64(0), int64(-1))
+		res6, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "two", "three"}, res6)
 		// normal use case, "three" gets popped and added to the left of destination
-		res7, err := client.LPush(key2, []string{"six", "five", "four"})
+		res7, err := client.LPush(context.Background(), key2, []string{"six", "five", "four"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res7)
 
-		res8, err := client.LMove(key1, key2, options.Right, options.Left)
+		res8, err := client.LMove(context.Background(), key1, key2, options.Right, options.Left)
 		assert.Equal(suite.T(), "three", res8.Value())
 		assert.Nil(suite.T(), err)
 
-		res9, err := client.LRange(key1, int64(0), int64(-1))
+		res9, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "two"}, res9)
-		res10, err := client.LRange(key2, int64(0), int64(-1))
+		res10, err := client.LRange(context.Background(), key2, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"three", "four", "five", "six"}, res10)
 
 		// source exists but is not a list type key
-		suite.verifyOK(client.Set(nonListKey, "value"))
+		suite.verifyOK(client.Set(context.Background(), nonListKey, "value"))
 
-		res11, err := client.LMove(nonListKey, key1, options.Left, options.Left)
+		res11, err := client.LMove(context.Background(), nonListKey, key1, options.Left, options.Left)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res11)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// destination exists but is not a list type key
-		suite.verifyOK(client.Set(nonListKey, "value"))
+		suite.verifyOK(client.Set(context.Background(), nonListKey, "value"))
 
-		res12, err := client.LMove(key1, nonListKey, options.Left, options.Left)
+		res12, err := client.LMove(context.Background(), key1, nonListKey, options.Left, options.Left)
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res12)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -3210,22 +3355,22 @@ func (suite *GlideTestSuite) TestExists() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 		// Test 1: Check if an existing key returns 1
-		suite.verifyOK(client.Set(key, initialValue))
-		result, err := client.Exists([]string{key})
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+		result, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), result, "The key should exist")
 
 		// Test 2: Check if a non-existent key returns 0
-		result, err = client.Exists([]string{"nonExistentKey"})
+		result, err = client.Exists(context.Background(), []string{"nonExistentKey"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), result, "The non-existent key should not exist")
 
 		// Test 3: Multiple keys, some exist, some do not
 		existingKey := uuid.New().String()
 		testKey := uuid.New().String()
-		suite.verifyOK(client.Set(existingKey, value))
-		suite.verifyOK(client.Set(testKey, value))
-		result, err = client.Exists([]string{testKey, existingKey, "anotherNonExistentKey"})
+		suite.verifyOK(client.Set(context.Background(), existingKey, value))
+		suite.verifyOK(client.Set(context.Background(), testKey, value))
+		result, err = client.Exists(context.Background(), []string{testKey, existingKey, "anotherNonExistentKey"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), result, "Two keys should exist")
 	})
@@ -3236,15 +3381,15 @@ func (suite *GlideTestSuite) TestExpire() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		result, err := client.Expire(key, 1)
+		result, err := client.Expire(context.Background(), key, 1)
 		assert.Nil(suite.T(), err, "Expected no error from Expire command")
 		assert.True(suite.T(), result, "Expire command should return true when expiry is set")
 
 		time.Sleep(1500 * time.Millisecond)
 
-		resultGet, err := client.Get(key)
+		resultGet, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err, "Expected no error from Get command after expiry")
 		assert.Equal(suite.T(), "", resultGet.Value(), "Key should be expired and return empty value")
 	})
@@ -3254,7 +3399,7 @@ func (suite *GlideTestSuite) TestExpire_KeyDoesNotExist() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		// Trying to set an expiry on a non-existent key
-		result, err := client.Expire(key, 1)
+		result, err := client.Expire(context.Background(), key, 1)
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), result)
 	})
@@ -3266,19 +3411,19 @@ func (suite *GlideTestSuite) TestExpireWithOptions_HasNoExpiry() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		result, err := client.ExpireWithOptions(key, 2, options.HasNoExpiry)
+		result, err := client.ExpireWithOptions(context.Background(), key, 2, options.HasNoExpiry)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), result)
 
 		time.Sleep(2500 * time.Millisecond)
 
-		resultGet, err := client.Get(key)
+		resultGet, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", resultGet.Value())
 
-		result, err = client.ExpireWithOptions(key, 1, options.HasNoExpiry)
+		result, err = client.ExpireWithOptions(context.Background(), key, 1, options.HasNoExpiry)
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), result)
 	})
@@ -3290,19 +3435,19 @@ func (suite *GlideTestSuite) TestExpireWithOptions_HasExistingExpiry() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resexp, err := client.ExpireWithOptions(key, 20, options.HasNoExpiry)
+		resexp, err := client.ExpireWithOptions(context.Background(), key, 20, options.HasNoExpiry)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resexp)
 
-		resultExpire, err := client.ExpireWithOptions(key, 1, options.HasExistingExpiry)
+		resultExpire, err := client.ExpireWithOptions(context.Background(), key, 1, options.HasExistingExpiry)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		time.Sleep(2 * time.Second)
 
-		resultExpireTest, err := client.Exists([]string{key})
+		resultExpireTest, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 
 		assert.Equal(suite.T(), int64(0), resultExpireTest)
@@ -3314,17 +3459,17 @@ func (suite *GlideTestSuite) TestExpireWithOptions_NewExpiryGreaterThanCurrent()
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resultExpire, err := client.ExpireWithOptions(key, 2, options.HasNoExpiry)
+		resultExpire, err := client.ExpireWithOptions(context.Background(), key, 2, options.HasNoExpiry)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
-		resultExpire, err = client.ExpireWithOptions(key, 5, options.NewExpiryGreaterThanCurrent)
+		resultExpire, err = client.ExpireWithOptions(context.Background(), key, 5, options.NewExpiryGreaterThanCurrent)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 		time.Sleep(6 * time.Second)
-		resultExpireTest, err := client.Exists([]string{key})
+		resultExpireTest, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExpireTest)
 	})
@@ -3336,24 +3481,24 @@ func (suite *GlideTestSuite) TestExpireWithOptions_NewExpiryLessThanCurrent() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resultExpire, err := client.ExpireWithOptions(key, 10, options.HasNoExpiry)
+		resultExpire, err := client.ExpireWithOptions(context.Background(), key, 10, options.HasNoExpiry)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
-		resultExpire, err = client.ExpireWithOptions(key, 5, options.NewExpiryLessThanCurrent)
+		resultExpire, err = client.ExpireWithOptions(context.Background(), key, 5, options.NewExpiryLessThanCurrent)
 		assert.Nil(suite.T(), err)
 
 		assert.True(suite.T(), resultExpire)
 
-		resultExpire, err = client.ExpireWithOptions(key, 15, options.NewExpiryGreaterThanCurrent)
+		resultExpire, err = client.ExpireWithOptions(context.Background(), key, 15, options.NewExpiryGreaterThanCurrent)
 		assert.Nil(suite.T(), err)
 
 		assert.True(suite.T(), resultExpire)
 
 		time.Sleep(16 * time.Second)
-		resultExpireTest, err := client.Exists([]string{key})
+		resultExpireTest, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExpireTest)
 	})
@@ -3364,17 +3509,22 @@ func (suite *GlideTestSuite) TestExpireAtWithOptions_HasNoExpiry() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		futureTimestamp := time.Now().Add(10 * time.Second).Unix()
 
-		resultExpire, err := client.ExpireAtWithOptions(key, futureTimestamp, options.HasNoExpiry)
+		resultExpire, err := client.ExpireAtWithOptions(context.Background(), key, futureTimestamp, options.HasNoExpiry)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
-		resultExpireAt, err := client.ExpireAt(key, futureTimestamp)
+		resultExpireAt, err := client.ExpireAt(context.Background(), key, futureTimestamp)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireAt)
-		resultExpireWithOptions, err := client.ExpireAtWithOptions(key, futureTimestamp+10, options.HasNoExpiry)
+		resultExpireWithOptions, err := client.ExpireAtWithOptions(
+			context.Background(),
+			key,
+			futureTimestamp+10,
+			options.HasNoExpiry,
+		)
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), resultExpireWithOptions)
 	})
@@ -3385,14 +3535,19 @@ func (suite *GlideTestSuite) TestExpireAtWithOptions_HasExistingExpiry() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		futureTimestamp := time.Now().Add(10 * time.Second).Unix()
-		resultExpireAt, err := client.ExpireAt(key, futureTimestamp)
+		resultExpireAt, err := client.ExpireAt(context.Background(), key, futureTimestamp)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireAt)
 
-		resultExpireWithOptions, err := client.ExpireAtWithOptions(key, futureTimestamp+10, options.HasExistingExpiry)
+		resultExpireWithOptions, err := client.ExpireAtWithOptions(
+			context.Background(),
+			key,
+			futureTimestamp+10,
+			options.HasExistingExpiry,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 	})
@@ -3404,15 +3559,16 @@ func (suite *GlideTestSuite) TestExpireAtWithOptions_NewExpiryGreaterThanCurrent
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		futureTimestamp := time.Now().Add(10 * time.Second).Unix()
-		resultExpireAt, err := client.ExpireAt(key, futureTimestamp)
+		resultExpireAt, err := client.ExpireAt(context.Background(), key, futureTimestamp)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireAt)
 
 		newFutureTimestamp := time.Now().Add(20 * time.Second).Unix()
 		resultExpireWithOptions, err := client.ExpireAtWithOptions(
+			context.Background(),
 			key,
 			newFutureTimestamp,
 			options.NewExpiryGreaterThanCurrent,
@@ -3428,20 +3584,25 @@ func (suite *GlideTestSuite) TestExpireAtWithOptions_NewExpiryLessThanCurrent()
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		futureTimestamp := time.Now().Add(10 * time.Second).Unix()
-		resultExpireAt, err := client.ExpireAt(key, futureTimestamp)
+		resultExpireAt, err := client.ExpireAt(context.Background(), key, futureTimestamp)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireAt)
 
 		newFutureTimestamp := time.Now().Add(5 * time.Second).Unix()
-		resultExpireWithOptions, err := client.ExpireAtWithOptions(key, newFutureTimestamp, options.NewExpiryLessThanCurrent)
+		resultExpireWithOptions, err := client.ExpireAtWithOptions(
+			context.Background(),
+			key,
+			newFutureTimestamp,
+			options.NewExpiryLessThanCurrent,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(5 * time.Second)
-		resultExpireAtTest, err := client.Exists([]string{key})
+		resultExpireAtTest, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 
 		assert.Equal(suite.T(), int64(0), resultExpireAtTest)
@@ -3453,14 +3614,14 @@ func (suite *GlideTestSuite) TestPExpire() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resultExpire, err := client.PExpire(key, 500)
+		resultExpire, err := client.PExpire(context.Background(), key, 500)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		time.Sleep(600 * time.Millisecond)
-		resultExpireCheck, err := client.Exists([]string{key})
+		resultExpireCheck, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExpireCheck)
 	})
@@ -3472,21 +3633,26 @@ func (suite *GlideTestSuite) TestPExpireWithOptions_HasExistingExpiry() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		initialExpire := 500
-		resultExpire, err := client.PExpire(key, int64(initialExpire))
+		resultExpire, err := client.PExpire(context.Background(), key, int64(initialExpire))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		newExpire := 1000
 
-		resultExpireWithOptions, err := client.PExpireWithOptions(key, int64(newExpire), options.HasExistingExpiry)
+		resultExpireWithOptions, err := client.PExpireWithOptions(
+			context.Background(),
+			key,
+			int64(newExpire),
+			options.HasExistingExpiry,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(1100 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3498,16 +3664,21 @@ func (suite *GlideTestSuite) TestPExpireWithOptions_HasNoExpiry() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		newExpire := 500
 
-		resultExpireWithOptions, err := client.PExpireWithOptions(key, int64(newExpire), options.HasNoExpiry)
+		resultExpireWithOptions, err := client.PExpireWithOptions(
+			context.Background(),
+			key,
+			int64(newExpire),
+			options.HasNoExpiry,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(600 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3519,21 +3690,26 @@ func (suite *GlideTestSuite) TestPExpireWithOptions_NewExpiryGreaterThanCurrent(
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		initialExpire := 500
-		resultExpire, err := client.PExpire(key, int64(initialExpire))
+		resultExpire, err := client.PExpire(context.Background(), key, int64(initialExpire))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		newExpire := 1000
 
-		resultExpireWithOptions, err := client.PExpireWithOptions(key, int64(newExpire), options.NewExpiryGreaterThanCurrent)
+		resultExpireWithOptions, err := client.PExpireWithOptions(
+			context.Background(),
+			key,
+			int64(newExpire),
+			options.NewExpiryGreaterThanCurrent,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(1100 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3545,21 +3721,26 @@ func (suite *GlideTestSuite) TestPExpireWithOptions_NewExpiryLessThanCurrent() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		initialExpire := 500
-		resultExpire, err := client.PExpire(key, int64(initialExpire))
+		resultExpire, err := client.PExpire(context.Background(), key, int64(initialExpire))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		newExpire := 200
 
-		resultExpireWithOptions, err := client.PExpireWithOptions(key, int64(newExpire), options.NewExpiryLessThanCurrent)
+		resultExpireWithOptions, err := client.PExpireWithOptions(
+			context.Background(),
+			key,
+			int64(newExpire),
+			options.NewExpiryLessThanCurrent,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(600 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3569,17 +3750,17 @@ func (suite *GlideTestSuite) TestPExpireAt() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		expireAfterMilliseconds := time.Now().Unix() * 1000
-		resultPExpireAt, err := client.PExpireAt(key, expireAfterMilliseconds)
+		resultPExpireAt, err := client.PExpireAt(context.Background(), key, expireAfterMilliseconds)
 		assert.Nil(suite.T(), err)
 
 		assert.True(suite.T(), resultPExpireAt)
 
 		time.Sleep(6 * time.Second)
 
-		resultpExists, err := client.Exists([]string{key})
+		resultpExists, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultpExists)
 	})
@@ -3591,16 +3772,16 @@ func (suite *GlideTestSuite) TestPExpireAtWithOptions_HasNoExpiry() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		timestamp := time.Now().Unix() * 1000
-		result, err := client.PExpireAtWithOptions(key, timestamp, options.HasNoExpiry)
+		result, err := client.PExpireAtWithOptions(context.Background(), key, timestamp, options.HasNoExpiry)
 
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), result)
 
 		time.Sleep(2 * time.Second)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3612,19 +3793,24 @@ func (suite *GlideTestSuite) TestPExpireAtWithOptions_HasExistingExpiry() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 		initialExpire := 500
-		resultExpire, err := client.PExpire(key, int64(initialExpire))
+		resultExpire, err := client.PExpire(context.Background(), key, int64(initialExpire))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 		newExpire := time.Now().Unix()*1000 + 1000
 
-		resultExpireWithOptions, err := client.PExpireAtWithOptions(key, newExpire, options.HasExistingExpiry)
+		resultExpireWithOptions, err := client.PExpireAtWithOptions(
+			context.Background(),
+			key,
+			newExpire,
+			options.HasExistingExpiry,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(1100 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3636,21 +3822,26 @@ func (suite *GlideTestSuite) TestPExpireAtWithOptions_NewExpiryGreaterThanCurren
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		initialExpire := time.Now().UnixMilli() + 1000
-		resultExpire, err := client.PExpireAt(key, initialExpire)
+		resultExpire, err := client.PExpireAt(context.Background(), key, initialExpire)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		newExpire := time.Now().UnixMilli() + 2000
 
-		resultExpireWithOptions, err := client.PExpireAtWithOptions(key, newExpire, options.NewExpiryGreaterThanCurrent)
+		resultExpireWithOptions, err := client.PExpireAtWithOptions(
+			context.Background(),
+			key,
+			newExpire,
+			options.NewExpiryGreaterThanCurrent,
+		)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(2100 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3662,22 +3853,27 @@ func (suite *GlideTestSuite) TestPExpireAtWithOptions_NewExpiryLessThanCurrent()
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		initialExpire := 1000
-		resultExpire, err := client.PExpire(key, int64(initialExpire))
+		resultExpire, err := client.PExpire(context.Background(), key, int64(initialExpire))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpire)
 
 		newExpire := time.Now().Unix()*1000 + 500
 
-		resultExpireWithOptions, err := client.PExpireAtWithOptions(key, newExpire, options.NewExpiryLessThanCurrent)
+		resultExpireWithOptions, err := client.PExpireAtWithOptions(
+			context.Background(),
+			key,
+			newExpire,
+			options.NewExpiryLessThanCurrent,
+		)
 		assert.Nil(suite.T(), err)
 
 		assert.True(suite.T(), resultExpireWithOptions)
 
 		time.Sleep(1100 * time.Millisecond)
-		resultExist, err := client.Exists([]string{key})
+		resultExist, err := client.Exists(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultExist)
 	})
@@ -3689,24 +3885,24 @@ func (suite *GlideTestSuite) TestExpireTime() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		result, err := client.Get(key)
+		result, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), value, result.Value())
 
 		expireTime := time.Now().Unix() + 3
-		resultExpAt, err := client.ExpireAt(key, expireTime)
+		resultExpAt, err := client.ExpireAt(context.Background(), key, expireTime)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpAt)
 
-		resexptime, err := client.ExpireTime(key)
+		resexptime, err := client.ExpireTime(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), expireTime, resexptime)
 
 		time.Sleep(4 * time.Second)
 
-		resultAfterExpiry, err := client.Get(key)
+		resultAfterExpiry, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", resultAfterExpiry.Value())
 	})
@@ -3718,7 +3914,7 @@ func (suite *GlideTestSuite) TestExpireTime_KeyDoesNotExist() {
 		key := uuid.New().String()
 
 		// Call ExpireTime on a key that doesn't exist
-		expiryResult, err := client.ExpireTime(key)
+		expiryResult, err := client.ExpireTime(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-2), expiryResult)
 	})
@@ -3730,24 +3926,24 @@ func (suite *GlideTestSuite) TestPExpireTime() {
 		key := uuid.New().String()
 		value := uuid.New().String()
 
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		result, err := client.Get(key)
+		result, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), value, result.Value())
 
 		pexpireTime := time.Now().UnixMilli() + 3000
-		resultExpAt, err := client.PExpireAt(key, pexpireTime)
+		resultExpAt, err := client.PExpireAt(context.Background(), key, pexpireTime)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resultExpAt)
 
-		respexptime, err := client.PExpireTime(key)
+		respexptime, err := client.PExpireTime(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), pexpireTime, respexptime)
 
 		time.Sleep(4 * time.Second)
 
-		resultAfterExpiry, err := client.Get(key)
+		resultAfterExpiry, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "", resultAfterExpiry.Value())
 	})
@@ -3762,19 +3958,19 @@ func (suite *GlideTestSuite) Test_ZCard() {
 			"three": 3.0,
 		}
 		t := suite.T()
-		res1, err := client.ZAdd(key, membersScores)
+		res1, err := client.ZAdd(context.Background(), key, membersScores)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), res1)
 
-		res2, err := client.ZCard(key)
+		res2, err := client.ZCard(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), res2)
 
-		res3, err := client.ZRem(key, []string{"one"})
+		res3, err := client.ZRem(context.Background(), key, []string{"one"})
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), res3)
 
-		res4, err := client.ZCard(key)
+		res4, err := client.ZCard(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(2), res4)
 	})
@@ -3786,7 +3982,7 @@ func (suite *GlideTestSuite) TestPExpireTime_KeyDoesNotExist() {
 		key := uuid.New().String()
 
 		// Call ExpireTime on a key that doesn't exist
-		expiryResult, err := client.PExpireTime(key)
+		expiryResult, err := client.PExpireTime(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-2), expiryResult)
 	})
@@ -3796,12 +3992,12 @@ func (suite *GlideTestSuite) TestTTL_WithValidKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resExpire, err := client.Expire(key, 1)
+		resExpire, err := client.Expire(context.Background(), key, 1)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resExpire)
-		resTTL, err := client.TTL(key)
+		resTTL, err := client.TTL(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), resTTL)
 	})
@@ -3811,15 +4007,15 @@ func (suite *GlideTestSuite) TestTTL_WithExpiredKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resExpire, err := client.Expire(key, 1)
+		resExpire, err := client.Expire(context.Background(), key, 1)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resExpire)
 
 		time.Sleep(2 * time.Second)
 
-		resTTL, err := client.TTL(key)
+		resTTL, err := client.TTL(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(-2), resTTL)
 	})
@@ -3829,13 +4025,13 @@ func (suite *GlideTestSuite) TestPTTL_WithValidKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resExpire, err := client.Expire(key, 1)
+		resExpire, err := client.Expire(context.Background(), key, 1)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resExpire)
 
-		resPTTL, err := client.PTTL(key)
+		resPTTL, err := client.PTTL(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Greater(suite.T(), resPTTL, int64(900))
 	})
@@ -3845,15 +4041,15 @@ func (suite *GlideTestSuite) TestPTTL_WithExpiredKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		value := uuid.New().String()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resExpire, err := client.Expire(key, 1)
+		resExpire, err := client.Expire(context.Background(), key, 1)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resExpire)
 
 		time.Sleep(2 * time.Second)
 
-		resPTTL, err := client.PTTL(key)
+		resPTTL, err := client.PTTL(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), resPTTL, int64(-2))
 	})
@@ -3862,7 +4058,7 @@ func (suite *GlideTestSuite) TestPTTL_WithExpiredKey() {
 func (suite *GlideTestSuite) TestPfAdd_SuccessfulAddition() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		res, err := client.PfAdd(key, []string{"a", "b", "c", "d", "e"})
+		res, err := client.PfAdd(context.Background(), key, []string{"a", "b", "c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 	})
@@ -3873,25 +4069,25 @@ func (suite *GlideTestSuite) TestPfAdd_DuplicateElements() {
 		key := uuid.New().String()
 
 		// case : Add elements and add same elements again
-		res, err := client.PfAdd(key, []string{"a", "b", "c", "d", "e"})
+		res, err := client.PfAdd(context.Background(), key, []string{"a", "b", "c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		res2, err := client.PfAdd(key, []string{"a", "b", "c", "d", "e"})
+		res2, err := client.PfAdd(context.Background(), key, []string{"a", "b", "c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res2)
 
 		// case : (mixed elements) add new elements with 1 duplicate elements
-		res1, err := client.PfAdd(key, []string{"f", "g", "h"})
+		res1, err := client.PfAdd(context.Background(), key, []string{"f", "g", "h"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res1)
 
-		res2, err = client.PfAdd(key, []string{"i", "j", "g"})
+		res2, err = client.PfAdd(context.Background(), key, []string{"i", "j", "g"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res2)
 
 		// case : add empty array(no elements to the HyperLogLog)
-		res, err = client.PfAdd(key, []string{})
+		res, err = client.PfAdd(context.Background(), key, []string{})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 	})
@@ -3900,11 +4096,11 @@ func (suite *GlideTestSuite) TestPfAdd_DuplicateElements() {
 func (suite *GlideTestSuite) TestPfCount_SingleKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		res, err := client.PfAdd(key, []string{"i", "j", "g"})
+		res, err := client.PfAdd(context.Background(), key, []string{"i", "j", "g"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		resCount, err := client.PfCount([]string{key})
+		resCount, err := client.PfCount(context.Background(), []string{key})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), resCount)
 	})
@@ -3915,15 +4111,15 @@ func (suite *GlideTestSuite) TestPfCount_MultipleKeys() {
 		key1 := uuid.New().String() + "{group}"
 		key2 := uuid.New().String() + "{group}"
 
-		res, err := client.PfAdd(key1, []string{"a", "b", "c"})
+		res, err := client.PfAdd(context.Background(), key1, []string{"a", "b", "c"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		res, err = client.PfAdd(key2, []string{"c", "d", "e"})
+		res, err = client.PfAdd(context.Background(), key2, []string{"c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		resCount, err := client.PfCount([]string{key1, key2})
+		resCount, err := client.PfCount(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), resCount)
 	})
@@ -3934,7 +4130,7 @@ func (suite *GlideTestSuite) TestPfCount_NoExistingKeys() {
 		key1 := uuid.New().String() + "{group}"
 		key2 := uuid.New().String() + "{group}"
 
-		resCount, err := client.PfCount([]string{key1, key2})
+		resCount, err := client.PfCount(context.Background(), []string{key1, key2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resCount)
 	})
@@ -3946,19 +4142,19 @@ func (suite *GlideTestSuite) TestPfMerge() {
 		source2 := uuid.New().String() + "{group}"
 		destination := uuid.New().String() + "{group}"
 
-		res, err := client.PfAdd(source1, []string{"a", "b", "c"})
+		res, err := client.PfAdd(context.Background(), source1, []string{"a", "b", "c"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		res, err = client.PfAdd(source2, []string{"c", "d", "e"})
+		res, err = client.PfAdd(context.Background(), source2, []string{"c", "d", "e"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		result, err := client.PfMerge(destination, []string{source1, source2})
+		result, err := client.PfMerge(context.Background(), destination, []string{source1, source2})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result)
 
-		count, err := client.PfCount([]string{destination})
+		count, err := client.PfCount(context.Background(), []string{destination})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), count)
 	})
@@ -3969,15 +4165,15 @@ func (suite *GlideTestSuite) TestPfMerge_SingleSource() {
 		source := uuid.New().String() + "{group}"
 		destination := uuid.New().String() + "{group}"
 
-		res, err := client.PfAdd(source, []string{"a", "b", "c"})
+		res, err := client.PfAdd(context.Background(), source, []string{"a", "b", "c"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		result, err := client.PfMerge(destination, []string{source})
+		result, err := client.PfMerge(context.Background(), destination, []string{source})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result)
 
-		count, err := client.PfCount([]string{destination})
+		count, err := client.PfCount(context.Background(), []string{destination})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), count)
 	})
@@ -3988,11 +4184,11 @@ func (suite *GlideTestSuite) TestPfMerge_NonExistentSource() {
 		nonExistentKey := uuid.New().String() + "{group}"
 		destination := uuid.New().String() + "{group}"
 
-		result, err := client.PfMerge(destination, []string{nonExistentKey})
+		result, err := client.PfMerge(context.Background(), destination, []string{nonExistentKey})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result)
 
-		count, err := client.PfCount([]string{destination})
+		count, err := client.PfCount(context.Background(), []string{destination})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), count)
 	})
@@ -4001,13 +4197,13 @@ func (suite *GlideTestSuite) TestPfMerge_NonExistentSource() {
 func (suite *GlideTestSuite) TestSortWithOptions_AscendingOrder() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"b", "a", "c"})
+		client.LPush(context.Background(), key, []string{"b", "a", "c"})
 
 		options := options.NewSortOptions().
 			SetOrderBy(options.ASC).
 			SetIsAlpha(true)
 
-		sortResult, err := client.SortWithOptions(key, *options)
+		sortResult, err := client.SortWithOptions(context.Background(), key, *options)
 
 		assert.Nil(suite.T(), err)
 
@@ -4023,14 +4219,14 @@ func (suite *GlideTestSuite) TestSortWithOptions_AscendingOrder() {
 func (suite *GlideTestSuite) TestSortWithOptions_DescendingOrder() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"b", "a", "c"})
+		client.LPush(context.Background(), key, []string{"b", "a", "c"})
 
 		options := options.NewSortOptions().
 			SetOrderBy(options.DESC).
 			SetIsAlpha(true).
 			SetSortLimit(0, 3)
 
-		sortResult, err := client.SortWithOptions(key, *options)
+		sortResult, err := client.SortWithOptions(context.Background(), key, *options)
 
 		assert.Nil(suite.T(), err)
 
@@ -4047,9 +4243,9 @@ func (suite *GlideTestSuite) TestSortWithOptions_DescendingOrder() {
 func (suite *GlideTestSuite) TestSort_SuccessfulSort() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"3", "1", "2"})
+		client.LPush(context.Background(), key, []string{"3", "1", "2"})
 
-		sortResult, err := client.Sort(key)
+		sortResult, err := client.Sort(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 
@@ -4067,15 +4263,15 @@ func (suite *GlideTestSuite) TestSortStore_BasicSorting() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := "{listKey}" + uuid.New().String()
 		sortedKey := "{listKey}" + uuid.New().String()
-		client.LPush(key, []string{"10", "2", "5", "1", "4"})
+		client.LPush(context.Background(), key, []string{"10", "2", "5", "1", "4"})
 
-		result, err := client.SortStore(key, sortedKey)
+		result, err := client.SortStore(context.Background(), key, sortedKey)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), result)
 		assert.Equal(suite.T(), int64(5), result)
 
-		sortedValues, err := client.LRange(sortedKey, 0, -1)
+		sortedValues, err := client.LRange(context.Background(), sortedKey, 0, -1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"1", "2", "4", "5", "10"}, sortedValues)
 	})
@@ -4083,7 +4279,7 @@ func (suite *GlideTestSuite) TestSortStore_BasicSorting() {
 
 func (suite *GlideTestSuite) TestSortStore_ErrorHandling() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
-		result, err := client.SortStore("{listKey}nonExistingKey", "{listKey}mydestinationKey")
+		result, err := client.SortStore(context.Background(), "{listKey}nonExistingKey", "{listKey}mydestinationKey")
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), result)
@@ -4094,16 +4290,16 @@ func (suite *GlideTestSuite) TestSortStoreWithOptions_DescendingOrder() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := "{key}" + uuid.New().String()
 		sortedKey := "{key}" + uuid.New().String()
-		client.LPush(key, []string{"30", "20", "10", "40", "50"})
+		client.LPush(context.Background(), key, []string{"30", "20", "10", "40", "50"})
 
 		options := options.NewSortOptions().SetOrderBy(options.DESC).SetIsAlpha(false)
-		result, err := client.SortStoreWithOptions(key, sortedKey, *options)
+		result, err := client.SortStoreWithOptions(context.Background(), key, sortedKey, *options)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), result)
 		assert.Equal(suite.T(), int64(5), result)
 
-		sortedValues, err := client.LRange(sortedKey, 0, -1)
+		sortedValues, err := client.LRange(context.Background(), sortedKey, 0, -1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"50", "40", "30", "20", "10"}, sortedValues)
 	})
@@ -4113,16 +4309,16 @@ func (suite *GlideTestSuite) TestSortStoreWithOptions_AlphaSorting() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := "{listKey}" + uuid.New().String()
 		sortedKey := "{listKey}" + uuid.New().String()
-		client.LPush(key, []string{"apple", "banana", "cherry", "date", "elderberry"})
+		client.LPush(context.Background(), key, []string{"apple", "banana", "cherry", "date", "elderberry"})
 
 		options := options.NewSortOptions().SetIsAlpha(true)
-		result, err := client.SortStoreWithOptions(key, sortedKey, *options)
+		result, err := client.SortStoreWithOptions(context.Background(), key, sortedKey, *options)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), result)
 		assert.Equal(suite.T(), int64(5), result)
 
-		sortedValues, err := client.LRange(sortedKey, 0, -1)
+		sortedValues, err := client.LRange(context.Background(), sortedKey, 0, -1)
 		resultList := []string{"apple", "banana", "cherry", "date", "elderberry"}
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), resultList, sortedValues)
@@ -4133,16 +4329,16 @@ func (suite *GlideTestSuite) TestSortStoreWithOptions_Limit() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := "{listKey}" + uuid.New().String()
 		sortedKey := "{listKey}" + uuid.New().String()
-		client.LPush(key, []string{"10", "20", "30", "40", "50"})
+		client.LPush(context.Background(), key, []string{"10", "20", "30", "40", "50"})
 
 		options := options.NewSortOptions().SetSortLimit(1, 3)
-		result, err := client.SortStoreWithOptions(key, sortedKey, *options)
+		result, err := client.SortStoreWithOptions(context.Background(), key, sortedKey, *options)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), result)
 		assert.Equal(suite.T(), int64(3), result)
 
-		sortedValues, err := client.LRange(sortedKey, 0, -1)
+		sortedValues, err := client.LRange(context.Background(), sortedKey, 0, -1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"20", "30", "40"}, sortedValues)
 	})
@@ -4152,9 +4348,9 @@ func (suite *GlideTestSuite) TestSortReadOnly_SuccessfulSort() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"3", "1", "2"})
+		client.LPush(context.Background(), key, []string{"3", "1", "2"})
 
-		sortResult, err := client.SortReadOnly(key)
+		sortResult, err := client.SortReadOnly(context.Background(), key)
 
 		assert.Nil(suite.T(), err)
 
@@ -4172,14 +4368,14 @@ func (suite *GlideTestSuite) TestSortReadyOnlyWithOptions_DescendingOrder() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"b", "a", "c"})
+		client.LPush(context.Background(), key, []string{"b", "a", "c"})
 
 		options := options.NewSortOptions().
 			SetOrderBy(options.DESC).
 			SetIsAlpha(true).
 			SetSortLimit(0, 3)
 
-		sortResult, err := client.SortReadOnlyWithOptions(key, *options)
+		sortResult, err := client.SortReadOnlyWithOptions(context.Background(), key, *options)
 
 		assert.Nil(suite.T(), err)
 
@@ -4202,60 +4398,60 @@ func (suite *GlideTestSuite) TestBLMove() {
 		nonExistentKey := "{key}-3" + uuid.NewString()
 		nonListKey := "{key}-4" + uuid.NewString()
 
-		res1, err := client.BLMove(key1, key2, options.Left, options.Right, float64(0.1))
+		res1, err := client.BLMove(context.Background(), key1, key2, options.Left, options.Right, float64(0.1))
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res1)
 		assert.Nil(suite.T(), err)
 
-		res2, err := client.LPush(key1, []string{"four", "three", "two", "one"})
+		res2, err := client.LPush(context.Background(), key1, []string{"four", "three", "two", "one"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), res2)
 
 		// only source exists, only source elements gets popped, creates a list at nonExistingKey
-		res3, err := client.BLMove(key1, nonExistentKey, options.Right, options.Left, float64(0.1))
+		res3, err := client.BLMove(context.Background(), key1, nonExistentKey, options.Right, options.Left, float64(0.1))
 		assert.Equal(suite.T(), "four", res3.Value())
 		assert.Nil(suite.T(), err)
 
-		res4, err := client.LRange(key1, int64(0), int64(-1))
+		res4, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "two", "three"}, res4)
 
 		// source and destination are the same, performing list rotation, "one" gets popped and added back
-		res5, err := client.BLMove(key1, key1, options.Left, options.Left, float64(0.1))
+		res5, err := client.BLMove(context.Background(), key1, key1, options.Left, options.Left, float64(0.1))
 		assert.Equal(suite.T(), "one", res5.Value())
 		assert.Nil(suite.T(), err)
 
-		res6, err := client.LRange(key1, int64(0), int64(-1))
+		res6, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "two", "three"}, res6)
 		// normal use case, "three" gets popped and added to the left of destination
-		res7, err := client.LPush(key2, []string{"six", "five", "four"})
+		res7, err := client.LPush(context.Background(), key2, []string{"six", "five", "four"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res7)
 
-		res8, err := client.BLMove(key1, key2, options.Right, options.Left, float64(0.1))
+		res8, err := client.BLMove(context.Background(), key1, key2, options.Right, options.Left, float64(0.1))
 		assert.Equal(suite.T(), "three", res8.Value())
 		assert.Nil(suite.T(), err)
 
-		res9, err := client.LRange(key1, int64(0), int64(-1))
+		res9, err := client.LRange(context.Background(), key1, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "two"}, res9)
 
-		res10, err := client.LRange(key2, int64(0), int64(-1))
+		res10, err := client.LRange(context.Background(), key2, int64(0), int64(-1))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"three", "four", "five", "six"}, res10)
 
 		// source exists but is not a list type key
-		suite.verifyOK(client.Set(nonListKey, "value"))
+		suite.verifyOK(client.Set(context.Background(), nonListKey, "value"))
 
-		res11, err := client.BLMove(nonListKey, key1, options.Left, options.Left, float64(0.1))
+		res11, err := client.BLMove(context.Background(), nonListKey, key1, options.Left, options.Left, float64(0.1))
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res11)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// destination exists but is not a list type key
-		suite.verifyOK(client.Set(nonListKey, "value"))
+		suite.verifyOK(client.Set(context.Background(), nonListKey, "value"))
 
-		res12, err := client.BLMove(key1, nonListKey, options.Left, options.Left, float64(0.1))
+		res12, err := client.BLMove(context.Background(), key1, nonListKey, options.Left, options.Left, float64(0.1))
 		assert.Equal(suite.T(), api.CreateNilStringResult(), res12)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -4268,18 +4464,18 @@ func (suite *GlideTestSuite) TestDel_MultipleKeys() {
 		key2 := "testKey2_" + uuid.New().String()
 		key3 := "testKey3_" + uuid.New().String()
 
-		suite.verifyOK(client.Set(key1, initialValue))
-		suite.verifyOK(client.Set(key2, initialValue))
-		suite.verifyOK(client.Set(key3, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key1, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key2, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key3, initialValue))
 
-		deletedCount, err := client.Del([]string{key1, key2, key3})
+		deletedCount, err := client.Del(context.Background(), []string{key1, key2, key3})
 
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), deletedCount)
 
-		result1, err1 := client.Get(key1)
-		result2, err2 := client.Get(key2)
-		result3, err3 := client.Get(key3)
+		result1, err1 := client.Get(context.Background(), key1)
+		result2, err2 := client.Get(context.Background(), key2)
+		result3, err3 := client.Get(context.Background(), key3)
 
 		assert.Nil(suite.T(), err1)
 		assert.True(suite.T(), result1.IsNil())
@@ -4296,17 +4492,17 @@ func (suite *GlideTestSuite) TestType() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		// Test 1: Check if the value is string
 		keyName := "{keyName}" + uuid.NewString()
-		suite.verifyOK(client.Set(keyName, initialValue))
-		result, err := client.Type(keyName)
+		suite.verifyOK(client.Set(context.Background(), keyName, initialValue))
+		result, err := client.Type(context.Background(), keyName)
 		assert.Nil(suite.T(), err)
 		assert.IsType(suite.T(), result, "string", "Value is string")
 
 		// Test 2: Check if the value is list
 		key1 := "{keylist}-1" + uuid.NewString()
-		resultLPush, err := client.LPush(key1, []string{"one", "two", "three"})
+		resultLPush, err := client.LPush(context.Background(), key1, []string{"one", "two", "three"})
 		assert.Equal(suite.T(), int64(3), resultLPush)
 		assert.Nil(suite.T(), err)
-		resultType, err := client.Type(key1)
+		resultType, err := client.Type(context.Background(), key1)
 		assert.Nil(suite.T(), err)
 		assert.IsType(suite.T(), resultType, "list", "Value is list")
 	})
@@ -4317,14 +4513,14 @@ func (suite *GlideTestSuite) TestTouch() {
 		// Test 1: Check if an touch valid key
 		keyName := "{keyName}" + uuid.NewString()
 		keyName1 := "{keyName1}" + uuid.NewString()
-		suite.verifyOK(client.Set(keyName, initialValue))
-		suite.verifyOK(client.Set(keyName1, "anotherValue"))
-		result, err := client.Touch([]string{keyName, keyName1})
+		suite.verifyOK(client.Set(context.Background(), keyName, initialValue))
+		suite.verifyOK(client.Set(context.Background(), keyName1, "anotherValue"))
+		result, err := client.Touch(context.Background(), []string{keyName, keyName1})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), result, "The touch should be 2")
 
 		// Test 2: Check if an touch invalid key
-		resultInvalidKey, err := client.Touch([]string{"invalidKey", "invalidKey1"})
+		resultInvalidKey, err := client.Touch(context.Background(), []string{"invalidKey", "invalidKey1"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultInvalidKey, "The touch should be 0")
 	})
@@ -4335,14 +4531,14 @@ func (suite *GlideTestSuite) TestUnlink() {
 		// Test 1: Check if an unlink valid key
 		keyName := "{keyName}" + uuid.NewString()
 		keyName1 := "{keyName1}" + uuid.NewString()
-		suite.verifyOK(client.Set(keyName, initialValue))
-		suite.verifyOK(client.Set(keyName1, "anotherValue"))
-		resultValidKey, err := client.Unlink([]string{keyName, keyName1})
+		suite.verifyOK(client.Set(context.Background(), keyName, initialValue))
+		suite.verifyOK(client.Set(context.Background(), keyName1, "anotherValue"))
+		resultValidKey, err := client.Unlink(context.Background(), []string{keyName, keyName1})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), resultValidKey, "The unlink should be 2")
 
 		// Test 2: Check if an unlink for invalid key
-		resultInvalidKey, err := client.Unlink([]string{"invalidKey2", "invalidKey3"})
+		resultInvalidKey, err := client.Unlink(context.Background(), []string{"invalidKey2", "invalidKey3"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultInvalidKey, "The unlink should be 0")
 	})
@@ -4354,12 +4550,12 @@ func (suite *GlideTestSuite) TestRename() {
 		key := "{keyName}" + uuid.NewString()
 		initialValueRename := "TestRename_RenameValue"
 		newRenameKey := "{newkeyName}" + uuid.NewString()
-		suite.verifyOK(client.Set(key, initialValueRename))
-		client.Rename(key, newRenameKey)
+		suite.verifyOK(client.Set(context.Background(), key, initialValueRename))
+		client.Rename(context.Background(), key, newRenameKey)
 
 		// Test 2 Check if the rename command return false if the key/newkey is invalid.
 		key1 := "{keyName}" + uuid.NewString()
-		res1, err := client.Rename(key1, "invalidKey")
+		res1, err := client.Rename(context.Background(), key1, "invalidKey")
 		assert.Equal(suite.T(), "", res1)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
@@ -4371,17 +4567,17 @@ func (suite *GlideTestSuite) TestRenameNX() {
 		// Test 1 Check if the RenameNX command return true if key was renamed to newKey
 		key := "{keyName}" + uuid.NewString()
 		key2 := "{keyName}" + uuid.NewString()
-		suite.verifyOK(client.Set(key, initialValue))
-		res1, err := client.RenameNX(key, key2)
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+		res1, err := client.RenameNX(context.Background(), key, key2)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res1)
 
 		// Test 2 Check if the RenameNX command return false if newKey already exists.
 		key3 := "{keyName}" + uuid.NewString()
 		key4 := "{keyName}" + uuid.NewString()
-		suite.verifyOK(client.Set(key3, initialValue))
-		suite.verifyOK(client.Set(key4, initialValue))
-		res2, err := client.RenameNX(key3, key4)
+		suite.verifyOK(client.Set(context.Background(), key3, initialValue))
+		suite.verifyOK(client.Set(context.Background(), key4, initialValue))
+		res2, err := client.RenameNX(context.Background(), key3, key4)
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res2)
 	})
@@ -4391,26 +4587,26 @@ func (suite *GlideTestSuite) TestXAdd() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 		// stream does not exist
-		res, err := client.XAdd(key, [][]string{{"field1", "value1"}, {"field1", "value2"}})
+		res, err := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}, {"field1", "value2"}})
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res.IsNil())
 		// don't check the value, because it contains server's timestamp
 
 		// adding data to existing stream
-		res, err = client.XAdd(key, [][]string{{"field3", "value3"}})
+		res, err = client.XAdd(context.Background(), key, [][]string{{"field3", "value3"}})
 		assert.Nil(suite.T(), err)
 		assert.False(suite.T(), res.IsNil())
 
 		// incorrect input
-		_, err = client.XAdd(key, [][]string{})
+		_, err = client.XAdd(context.Background(), key, [][]string{})
 		assert.NotNil(suite.T(), err)
-		_, err = client.XAdd(key, [][]string{{"1", "2", "3"}})
+		_, err = client.XAdd(context.Background(), key, [][]string{{"1", "2", "3"}})
 		assert.NotNil(suite.T(), err)
 
 		// key is not a string
 		key = uuid.NewString()
-		client.Set(key, "abc")
-		_, err = client.XAdd(key, [][]string{{"f", "v"}})
+		client.Set(context.Background(), key, "abc")
+		_, err = client.XAdd(context.Background(), key, [][]string{{"f", "v"}})
 		assert.NotNil(suite.T(), err)
 	})
 }
@@ -4420,6 +4616,7 @@ func (suite *GlideTestSuite) TestXAddWithOptions() {
 		key := uuid.NewString()
 		// stream does not exist
 		res, err := client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"field1", "value1"}},
 			*options.NewXAddOptions().SetDontMakeNewStream(),
@@ -4428,14 +4625,20 @@ func (suite *GlideTestSuite) TestXAddWithOptions() {
 		assert.True(suite.T(), res.IsNil())
 
 		// adding data to with given ID
-		res, err = client.XAddWithOptions(key, [][]string{{"field1", "value1"}}, *options.NewXAddOptions().SetId("0-1"))
+		res, err = client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{"field1", "value1"}},
+			*options.NewXAddOptions().SetId("0-1"),
+		)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "0-1", res.Value())
 
-		client.XAdd(key, [][]string{{"field2", "value2"}})
+		client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 		// TODO run XLen there
 		// this will trim the first entry.
 		res, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"field3", "value3"}},
 			*options.NewXAddOptions().SetTrimOptions(options.NewXTrimOptionsWithMaxLen(2).SetExactTrimming()),
@@ -4453,9 +4656,9 @@ func sendWithCustomCommand(suite *GlideTestSuite, client api.BaseClient, args []
 	var err error
 	switch c := client.(type) {
 	case api.GlideClientCommands:
-		res, err = c.CustomCommand(args)
+		res, err = c.CustomCommand(context.Background(), args)
 	case api.GlideClusterClientCommands:
-		res, err = c.CustomCommand(args)
+		res, err = c.CustomCommand(context.Background(), args)
 	default:
 		suite.FailNow(errMsg)
 	}
@@ -4483,6 +4686,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 		)
 
 		xadd, err := client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"entry1_field1", "entry1_value1"}, {"entry1_field2", "entry1_value2"}},
 			*options.NewXAddOptions().SetId("0-1"),
@@ -4490,6 +4694,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-1", xadd.Value())
 		xadd, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"entry2_field1", "entry2_value1"}},
 			*options.NewXAddOptions().SetId("0-2"),
@@ -4497,7 +4702,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-2", xadd.Value())
 
-		xreadgroup, err := client.XReadGroup(group, consumer, map[string]string{key: ">"})
+		xreadgroup, err := client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key: {
@@ -4507,7 +4712,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 		}, xreadgroup)
 
 		opts := options.NewXAutoClaimOptions().SetCount(1)
-		xautoclaim, err := client.XAutoClaimWithOptions(key, group, consumer, 0, "0-0", *opts)
+		xautoclaim, err := client.XAutoClaimWithOptions(context.Background(), key, group, consumer, 0, "0-0", *opts)
 		assert.NoError(suite.T(), err)
 		var deletedEntries []string
 		if suite.serverVersion >= "7.0.0" {
@@ -4525,7 +4730,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 			xautoclaim,
 		)
 
-		justId, err := client.XAutoClaimJustId(key, group, consumer, 0, "0-0")
+		justId, err := client.XAutoClaimJustId(context.Background(), key, group, consumer, 0, "0-0")
 		assert.NoError(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -4539,6 +4744,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 
 		// add one more entry
 		xadd, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"entry3_field1", "entry3_value1"}},
 			*options.NewXAddOptions().SetId("0-3"),
@@ -4547,7 +4753,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 		assert.Equal(suite.T(), "0-3", xadd.Value())
 
 		// incorrect IDs - response is empty
-		xautoclaim, err = client.XAutoClaim(key, group, consumer, 0, "5-0")
+		xautoclaim, err = client.XAutoClaim(context.Background(), key, group, consumer, 0, "5-0")
 		assert.NoError(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -4559,7 +4765,7 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 			xautoclaim,
 		)
 
-		justId, err = client.XAutoClaimJustId(key, group, consumer, 0, "5-0")
+		justId, err = client.XAutoClaimJustId(context.Background(), key, group, consumer, 0, "5-0")
 		assert.NoError(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -4573,8 +4779,8 @@ func (suite *GlideTestSuite) TestXAutoClaim() {
 
 		// key exists, but it is not a stream
 		key2 := uuid.New().String()
-		suite.verifyOK(client.Set(key2, key2))
-		_, err = client.XAutoClaim(key2, "_", "_", 0, "_")
+		suite.verifyOK(client.Set(context.Background(), key2, key2))
+		_, err = client.XAutoClaim(context.Background(), key2, "_", "_", 0, "_")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
 }
@@ -4600,15 +4806,15 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 			"Can't send XGROUP CREATECONSUMER as a custom command",
 		)
 
-		entry1, err := client.XAdd(key1, [][]string{{"a", "b"}})
+		entry1, err := client.XAdd(context.Background(), key1, [][]string{{"a", "b"}})
 		assert.NoError(suite.T(), err)
 		assert.False(suite.T(), entry1.IsNil())
-		entry2, err := client.XAdd(key1, [][]string{{"c", "d"}})
+		entry2, err := client.XAdd(context.Background(), key1, [][]string{{"c", "d"}})
 		assert.NoError(suite.T(), err)
 		assert.False(suite.T(), entry2.IsNil())
 
 		// read the entire stream for the consumer and mark messages as pending
-		res, err := client.XReadGroup(group, consumer, map[string]string{key1: ">"})
+		res, err := client.XReadGroup(context.Background(), group, consumer, map[string]string{key1: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key1: {
@@ -4621,7 +4827,7 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 		sendWithCustomCommand(suite, client, []string{"xdel", key1, entry1.Value()}, "Can't send XDEL as a custom command")
 
 		// now xreadgroup returns one empty entry and one non-empty entry
-		res, err = client.XReadGroup(group, consumer, map[string]string{key1: "0"})
+		res, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key1: "0"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key1: {
@@ -4631,15 +4837,15 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 		}, res)
 
 		// try to read new messages only
-		res, err = client.XReadGroup(group, consumer, map[string]string{key1: ">"})
+		res, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key1: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Nil(suite.T(), res)
 
 		// add a message and read it with ">"
-		entry3, err := client.XAdd(key1, [][]string{{"e", "f"}})
+		entry3, err := client.XAdd(context.Background(), key1, [][]string{{"e", "f"}})
 		assert.NoError(suite.T(), err)
 		assert.False(suite.T(), entry3.IsNil())
-		res, err = client.XReadGroup(group, consumer, map[string]string{key1: ">"})
+		res, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key1: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key1: {
@@ -4662,7 +4868,7 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 		)
 
 		// read both keys
-		res, err = client.XReadGroup(group, consumer, map[string]string{key1: "0", key2: "0"})
+		res, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key1: "0", key2: "0"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key1: {
@@ -4675,20 +4881,20 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 
 		// error cases:
 		// key does not exist
-		_, err = client.XReadGroup("_", "_", map[string]string{key3: "0"})
+		_, err = client.XReadGroup(context.Background(), "_", "_", map[string]string{key3: "0"})
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 		// key is not a stream
-		suite.verifyOK(client.Set(key3, uuid.New().String()))
-		_, err = client.XReadGroup("_", "_", map[string]string{key3: "0"})
+		suite.verifyOK(client.Set(context.Background(), key3, uuid.New().String()))
+		_, err = client.XReadGroup(context.Background(), "_", "_", map[string]string{key3: "0"})
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
-		del, err := client.Del([]string{key3})
+		del, err := client.Del(context.Background(), []string{key3})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), del)
 		// group and consumer don't exist
-		xadd, err := client.XAdd(key3, [][]string{{"a", "b"}})
+		xadd, err := client.XAdd(context.Background(), key3, [][]string{{"a", "b"}})
 		assert.NoError(suite.T(), err)
 		assert.NotNil(suite.T(), xadd)
-		_, err = client.XReadGroup("_", "_", map[string]string{key3: "0"})
+		_, err = client.XReadGroup(context.Background(), "_", "_", map[string]string{key3: "0"})
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 		// consumer don't exist
 		sendWithCustomCommand(
@@ -4697,7 +4903,7 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 			[]string{"xgroup", "create", key3, group, "0-0"},
 			"Can't send XGROUP CREATE as a custom command",
 		)
-		res, err = client.XReadGroup(group, "_", map[string]string{key3: "0"})
+		res, err = client.XReadGroup(context.Background(), group, "_", map[string]string{key3: "0"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{key3: {}}, res)
 	})
@@ -4710,11 +4916,12 @@ func (suite *GlideTestSuite) TestXRead() {
 		key3 := "{xread}" + uuid.NewString()
 
 		// key does not exist
-		read, err := client.XRead(map[string]string{key1: "0-0"})
+		read, err := client.XRead(context.Background(), map[string]string{key1: "0-0"})
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), read)
 
 		res, err := client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{"k1_field1", "k1_value1"}, {"k1_field1", "k1_value2"}},
 			*options.NewXAddOptions().SetId("0-1"),
@@ -4723,6 +4930,7 @@ func (suite *GlideTestSuite) TestXRead() {
 		assert.False(suite.T(), res.IsNil())
 
 		res, err = client.XAddWithOptions(
+			context.Background(),
 			key2,
 			[][]string{{"k2_field1", "k2_value1"}},
 			*options.NewXAddOptions().SetId("2-0"),
@@ -4731,11 +4939,11 @@ func (suite *GlideTestSuite) TestXRead() {
 		assert.False(suite.T(), res.IsNil())
 
 		// reading ID which does not exist yet
-		read, err = client.XRead(map[string]string{key1: "100-500"})
+		read, err = client.XRead(context.Background(), map[string]string{key1: "100-500"})
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), read)
 
-		read, err = client.XRead(map[string]string{key1: "0-0", key2: "0-0"})
+		read, err = client.XRead(context.Background(), map[string]string{key1: "0-0", key2: "0-0"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key1: {
@@ -4747,8 +4955,8 @@ func (suite *GlideTestSuite) TestXRead() {
 		}, read)
 
 		// Key exists, but it is not a stream
-		client.Set(key3, "xread")
-		_, err = client.XRead(map[string]string{key1: "0-0", key3: "0-0"})
+		client.Set(context.Background(), key3, "xread")
+		_, err = client.XRead(context.Background(), map[string]string{key1: "0-0", key3: "0-0"})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
@@ -4764,6 +4972,7 @@ func (suite *GlideTestSuite) TestXRead() {
 				WithUseTLS(suite.tls))
 		}
 		read, err = testClient.XReadWithOptions(
+			context.Background(),
 			map[string]string{key1: "0-1"},
 			*options.NewXReadOptions().SetBlock(1000),
 		)
@@ -4774,7 +4983,11 @@ func (suite *GlideTestSuite) TestXRead() {
 		// but we wrap the test with timeout to avoid test failing or stuck forever
 		finished := make(chan bool)
 		go func() {
-			_, err := testClient.XReadWithOptions(map[string]string{key1: "0-1"}, *options.NewXReadOptions().SetBlock(0))
+			_, err := testClient.XReadWithOptions(
+				context.Background(),
+				map[string]string{key1: "0-1"},
+				*options.NewXReadOptions().SetBlock(0),
+			)
 			assert.IsType(suite.T(), &errors.ClosingError{}, err)
 			finished <- true
 		}()
@@ -4796,6 +5009,7 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 
 		// Setup: Create stream with 3 entries, create consumer group, read entries to add them to the Pending Entries List
 		xadd, err := client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"f0", "v0"}},
 			*options.NewXAddOptions().SetId("1-0"),
@@ -4803,6 +5017,7 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "1-0", xadd.Value())
 		xadd, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"f1", "v1"}},
 			*options.NewXAddOptions().SetId("1-1"),
@@ -4810,6 +5025,7 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "1-1", xadd.Value())
 		xadd, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"f2", "v2"}},
 			*options.NewXAddOptions().SetId("1-2"),
@@ -4824,7 +5040,7 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 			"Can't send XGROUP CREATE as a custom command",
 		)
 
-		xreadgroup, err := client.XReadGroup(group, consumer, map[string]string{key: ">"})
+		xreadgroup, err := client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key: {
@@ -4836,20 +5052,20 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 
 		// Sanity check: xreadgroup should not return more entries since they're all already in the
 		// Pending Entries List.
-		xreadgroup, err = client.XReadGroup(group, consumer, map[string]string{key: ">"})
+		xreadgroup, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Nil(suite.T(), xreadgroup)
 
 		// Reset the last delivered ID for the consumer group to "1-1"
 		if suite.serverVersion < "7.0.0" {
-			suite.verifyOK(client.XGroupSetId(key, group, "1-1"))
+			suite.verifyOK(client.XGroupSetId(context.Background(), key, group, "1-1"))
 		} else {
 			opts := options.NewXGroupSetIdOptionsOptions().SetEntriesRead(42)
-			suite.verifyOK(client.XGroupSetIdWithOptions(key, group, "1-1", *opts))
+			suite.verifyOK(client.XGroupSetIdWithOptions(context.Background(), key, group, "1-1", *opts))
 		}
 
 		// xreadgroup should only return entry 1-2 since we reset the last delivered ID to 1-1
-		xreadgroup, err = client.XReadGroup(group, consumer, map[string]string{key: ">"})
+		xreadgroup, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key: {
@@ -4858,20 +5074,20 @@ func (suite *GlideTestSuite) TestXGroupSetId() {
 		}, xreadgroup)
 
 		// An error is raised if XGROUP SETID is called with a non-existing key
-		_, err = client.XGroupSetId(uuid.NewString(), group, "1-1")
+		_, err = client.XGroupSetId(context.Background(), uuid.NewString(), group, "1-1")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// An error is raised if XGROUP SETID is called with a non-existing group
-		_, err = client.XGroupSetId(key, uuid.NewString(), "1-1")
+		_, err = client.XGroupSetId(context.Background(), key, uuid.NewString(), "1-1")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Setting the ID to a non-existing ID is allowed
-		suite.verifyOK(client.XGroupSetId(key, group, "99-99"))
+		suite.verifyOK(client.XGroupSetId(context.Background(), key, group, "99-99"))
 
 		// key exists, but is not a stream
 		key = uuid.NewString()
-		suite.verifyOK(client.Set(key, "xgroup setid"))
-		_, err = client.XGroupSetId(key, group, "1-1")
+		suite.verifyOK(client.Set(context.Background(), key, "xgroup setid"))
+		_, err = client.XGroupSetId(context.Background(), key, group, "1-1")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
 }
@@ -4889,25 +5105,25 @@ func (suite *GlideTestSuite) TestZAddAndZAddIncr() {
 		}
 		t := suite.T()
 
-		res, err := client.ZAdd(key, membersScoreMap)
+		res, err := client.ZAdd(context.Background(), key, membersScoreMap)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), res)
 
-		resIncr, err := client.ZAddIncr(key, "one", float64(2))
+		resIncr, err := client.ZAddIncr(context.Background(), key, "one", float64(2))
 		assert.Nil(t, err)
 		assert.Equal(t, float64(3), resIncr.Value())
 
 		// exceptions
 		// non-sortedset key
-		_, err = client.Set(key2, "test")
+		_, err = client.Set(context.Background(), key2, "test")
 		assert.NoError(t, err)
 
-		_, err = client.ZAdd(key2, membersScoreMap)
+		_, err = client.ZAdd(context.Background(), key2, membersScoreMap)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// wrong key type for zaddincr
-		_, err = client.ZAddIncr(key2, "one", float64(2))
+		_, err = client.ZAddIncr(context.Background(), key2, "one", float64(2))
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
@@ -4915,19 +5131,19 @@ func (suite *GlideTestSuite) TestZAddAndZAddIncr() {
 		onlyIfExistsOpts := options.NewZAddOptions().SetConditionalChange(options.OnlyIfExists)
 		onlyIfDoesNotExistOpts := options.NewZAddOptions().SetConditionalChange(options.OnlyIfDoesNotExist)
 
-		res, err = client.ZAddWithOptions(key3, membersScoreMap, *onlyIfExistsOpts)
+		res, err = client.ZAddWithOptions(context.Background(), key3, membersScoreMap, *onlyIfExistsOpts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 
-		res, err = client.ZAddWithOptions(key3, membersScoreMap, *onlyIfDoesNotExistOpts)
+		res, err = client.ZAddWithOptions(context.Background(), key3, membersScoreMap, *onlyIfDoesNotExistOpts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
-		resIncr, err = client.ZAddIncrWithOptions(key3, "one", 5, *onlyIfDoesNotExistOpts)
+		resIncr, err = client.ZAddIncrWithOptions(context.Background(), key3, "one", 5, *onlyIfDoesNotExistOpts)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resIncr.IsNil())
 
-		resIncr, err = client.ZAddIncrWithOptions(key3, "one", 5, *onlyIfExistsOpts)
+		resIncr, err = client.ZAddIncrWithOptions(context.Background(), key3, "one", 5, *onlyIfExistsOpts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), float64(6), resIncr.Value())
 
@@ -4938,7 +5154,7 @@ func (suite *GlideTestSuite) TestZAddAndZAddIncr() {
 			"three": 3.0,
 		}
 
-		res, err = client.ZAdd(key4, membersScoreMap2)
+		res, err = client.ZAdd(context.Background(), key4, membersScoreMap2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
@@ -4949,19 +5165,19 @@ func (suite *GlideTestSuite) TestZAddAndZAddIncr() {
 		gtOptsChanged, _ := options.NewZAddOptions().SetUpdateOptions(options.ScoreGreaterThanCurrent).SetChanged(true)
 		ltOptsChanged, _ := options.NewZAddOptions().SetUpdateOptions(options.ScoreLessThanCurrent).SetChanged(true)
 
-		res, err = client.ZAddWithOptions(key4, membersScoreMap2, *gtOptsChanged)
+		res, err = client.ZAddWithOptions(context.Background(), key4, membersScoreMap2, *gtOptsChanged)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		res, err = client.ZAddWithOptions(key4, membersScoreMap2, *ltOptsChanged)
+		res, err = client.ZAddWithOptions(context.Background(), key4, membersScoreMap2, *ltOptsChanged)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 
-		resIncr, err = client.ZAddIncrWithOptions(key4, "one", -3, *ltOpts)
+		resIncr, err = client.ZAddIncrWithOptions(context.Background(), key4, "one", -3, *ltOpts)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), float64(7), resIncr.Value())
 
-		resIncr, err = client.ZAddIncrWithOptions(key4, "one", -3, *gtOpts)
+		resIncr, err = client.ZAddIncrWithOptions(context.Background(), key4, "one", -3, *gtOpts)
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), resIncr.IsNil())
 	})
@@ -4973,26 +5189,26 @@ func (suite *GlideTestSuite) TestZincrBy() {
 		key2 := uuid.New().String()
 
 		// key does not exist
-		res1, err := client.ZIncrBy(key1, 2.5, "value1")
+		res1, err := client.ZIncrBy(context.Background(), key1, 2.5, "value1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), 2.5, res1)
 
 		// key exists, but value doesn't
-		res2, err := client.ZIncrBy(key1, -3.3, "value2")
+		res2, err := client.ZIncrBy(context.Background(), key1, -3.3, "value2")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), -3.3, res2)
 
 		// updating existing value in existing key
-		res3, err := client.ZIncrBy(key1, 1.0, "value1")
+		res3, err := client.ZIncrBy(context.Background(), key1, 1.0, "value1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), 3.5, res3)
 
 		// Key exists, but it is not a sorted set
-		res4, err := client.SAdd(key2, []string{"one", "two"})
+		res4, err := client.SAdd(context.Background(), key2, []string{"one", "two"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res4)
 
-		_, err = client.ZIncrBy(key2, 0.5, "_")
+		_, err = client.ZIncrBy(context.Background(), key2, 0.5, "_")
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5005,35 +5221,35 @@ func (suite *GlideTestSuite) TestBZPopMin() {
 		key3 := "{zset}-2-" + uuid.NewString()
 
 		// Add elements to key1
-		zaddResult1, err := client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 1.5})
+		zaddResult1, err := client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 1.5})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), zaddResult1)
 
 		// Add elements to key2
-		zaddResult2, err := client.ZAdd(key2, map[string]float64{"c": 2.0})
+		zaddResult2, err := client.ZAdd(context.Background(), key2, map[string]float64{"c": 2.0})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), zaddResult2)
 
 		// Pop minimum element from key1 and key2
-		bzpopminResult1, err := client.BZPopMin([]string{key1, key2}, float64(.5))
+		bzpopminResult1, err := client.BZPopMin(context.Background(), []string{key1, key2}, float64(.5))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.KeyWithMemberAndScore{Key: key1, Member: "a", Score: 1.0}, bzpopminResult1.Value())
 
 		// Attempt to pop from non-existent key3
-		bzpopminResult2, err := client.BZPopMin([]string{key3}, float64(1))
+		bzpopminResult2, err := client.BZPopMin(context.Background(), []string{key3}, float64(1))
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), bzpopminResult2.IsNil())
 
 		// Pop minimum element from key2
-		bzpopminResult3, err := client.BZPopMin([]string{key3, key2}, float64(.5))
+		bzpopminResult3, err := client.BZPopMin(context.Background(), []string{key3, key2}, float64(.5))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), api.KeyWithMemberAndScore{Key: key2, Member: "c", Score: 2.0}, bzpopminResult3.Value())
 
 		// Set key3 to a non-sorted set value
-		suite.verifyOK(client.Set(key3, "value"))
+		suite.verifyOK(client.Set(context.Background(), key3, "value"))
 
 		// Attempt to pop from key3 which is not a sorted set
-		_, err = client.BZPopMin([]string{key3}, float64(.5))
+		_, err = client.BZPopMin(context.Background(), []string{key3}, float64(.5))
 		if assert.Error(suite.T(), err) {
 			assert.IsType(suite.T(), &errors.RequestError{}, err)
 		}
@@ -5050,23 +5266,23 @@ func (suite *GlideTestSuite) TestZPopMin() {
 			"three": 3.0,
 		}
 
-		res, err := client.ZAdd(key1, memberScoreMap)
+		res, err := client.ZAdd(context.Background(), key1, memberScoreMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
-		res2, err := client.ZPopMin(key1)
+		res2, err := client.ZPopMin(context.Background(), key1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": float64(1)}, res2)
 
-		res3, err := client.ZPopMinWithOptions(key1, *options.NewZPopOptions().SetCount(2))
+		res3, err := client.ZPopMinWithOptions(context.Background(), key1, *options.NewZPopOptions().SetCount(2))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"two": float64(2), "three": float64(3)}, res3)
 
 		// non sorted set key
-		_, err = client.Set(key2, "test")
+		_, err = client.Set(context.Background(), key2, "test")
 		assert.Nil(suite.T(), err)
 
-		_, err = client.ZPopMin(key2)
+		_, err = client.ZPopMin(context.Background(), key2)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5081,23 +5297,23 @@ func (suite *GlideTestSuite) TestZPopMax() {
 			"two":   2.0,
 			"three": 3.0,
 		}
-		res, err := client.ZAdd(key1, memberScoreMap)
+		res, err := client.ZAdd(context.Background(), key1, memberScoreMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
-		res2, err := client.ZPopMax(key1)
+		res2, err := client.ZPopMax(context.Background(), key1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"three": float64(3)}, res2)
 
-		res3, err := client.ZPopMaxWithOptions(key1, *options.NewZPopOptions().SetCount(2))
+		res3, err := client.ZPopMaxWithOptions(context.Background(), key1, *options.NewZPopOptions().SetCount(2))
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"two": float64(2), "one": float64(1)}, res3)
 
 		// non sorted set key
-		_, err = client.Set(key2, "test")
+		_, err = client.Set(context.Background(), key2, "test")
 		assert.Nil(suite.T(), err)
 
-		_, err = client.ZPopMax(key2)
+		_, err = client.ZPopMax(context.Background(), key2)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5111,29 +5327,29 @@ func (suite *GlideTestSuite) TestZRem() {
 			"two":   2.0,
 			"three": 3.0,
 		}
-		res, err := client.ZAdd(key, memberScoreMap)
+		res, err := client.ZAdd(context.Background(), key, memberScoreMap)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
 		// no members to remove
-		_, err = client.ZRem(key, []string{})
+		_, err = client.ZRem(context.Background(), key, []string{})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		res, err = client.ZRem(key, []string{"one"})
+		res, err = client.ZRem(context.Background(), key, []string{"one"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
 		// TODO: run ZCard there
-		res, err = client.ZRem(key, []string{"one", "two", "three"})
+		res, err = client.ZRem(context.Background(), key, []string{"one", "two", "three"})
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// non sorted set key
-		_, err = client.Set(key, "test")
+		_, err = client.Set(context.Background(), key, "test")
 		assert.Nil(suite.T(), err)
 
-		_, err = client.ZRem(key, []string{"value"})
+		_, err = client.ZRem(context.Background(), key, []string{"value"})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5148,18 +5364,18 @@ func (suite *GlideTestSuite) TestZRange() {
 			"b": 2.0,
 			"c": 3.0,
 		}
-		_, err := client.ZAdd(key, memberScoreMap)
+		_, err := client.ZAdd(context.Background(), key, memberScoreMap)
 		assert.NoError(t, err)
 		// index [0:1]
-		res, err := client.ZRange(key, options.NewRangeByIndexQuery(0, 1))
+		res, err := client.ZRange(context.Background(), key, options.NewRangeByIndexQuery(0, 1))
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"a", "b"}, res)
 		// index [0:-1] (all)
-		res, err = client.ZRange(key, options.NewRangeByIndexQuery(0, -1))
+		res, err = client.ZRange(context.Background(), key, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"a", "b", "c"}, res)
 		// index [3:1] (none)
-		res, err = client.ZRange(key, options.NewRangeByIndexQuery(3, 1))
+		res, err = client.ZRange(context.Background(), key, options.NewRangeByIndexQuery(3, 1))
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 		// score [-inf:3]
@@ -5167,14 +5383,14 @@ func (suite *GlideTestSuite) TestZRange() {
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true))
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"a", "b", "c"}, res)
 		// score [-inf:3)
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, false))
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"a", "b"}, res)
 		// score (3:-inf] reverse
@@ -5182,7 +5398,7 @@ func (suite *GlideTestSuite) TestZRange() {
 			options.NewScoreBoundary(3, false),
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity)).
 			SetReverse()
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"b", "a"}, res)
 		// score [-inf:+inf] limit 1 2
@@ -5190,7 +5406,7 @@ func (suite *GlideTestSuite) TestZRange() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity)).
 			SetLimit(1, 2)
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"b", "c"}, res)
 		// score [-inf:3) reverse (none)
@@ -5198,21 +5414,21 @@ func (suite *GlideTestSuite) TestZRange() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true)).
 			SetReverse()
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 		// score [+inf:3) (none)
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 			options.NewScoreBoundary(3, false))
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 		// lex [-:c)
 		query = options.NewRangeByLexQuery(
 			options.NewInfiniteLexBoundary(options.NegativeInfinity),
 			options.NewLexBoundary("c", false))
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"a", "b"}, res)
 		// lex [+:-] reverse limit 1 2
@@ -5220,7 +5436,7 @@ func (suite *GlideTestSuite) TestZRange() {
 			options.NewInfiniteLexBoundary(options.PositiveInfinity),
 			options.NewInfiniteLexBoundary(options.NegativeInfinity)).
 			SetReverse().SetLimit(1, 2)
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"b", "a"}, res)
 		// lex (c:-] reverse
@@ -5228,14 +5444,14 @@ func (suite *GlideTestSuite) TestZRange() {
 			options.NewLexBoundary("c", false),
 			options.NewInfiniteLexBoundary(options.NegativeInfinity)).
 			SetReverse()
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"b", "a"}, res)
 		// lex [+:c] (none)
 		query = options.NewRangeByLexQuery(
 			options.NewInfiniteLexBoundary(options.PositiveInfinity),
 			options.NewLexBoundary("c", true))
-		res, err = client.ZRange(key, query)
+		res, err = client.ZRange(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 	})
@@ -5250,10 +5466,10 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 			"b": 2.0,
 			"c": 3.0,
 		}
-		_, err := client.ZAdd(key, memberScoreMap)
+		_, err := client.ZAdd(context.Background(), key, memberScoreMap)
 		assert.NoError(t, err)
 		// index [0:1]
-		res, err := client.ZRangeWithScores(key, options.NewRangeByIndexQuery(0, 1))
+		res, err := client.ZRangeWithScores(context.Background(), key, options.NewRangeByIndexQuery(0, 1))
 		expected := map[string]float64{
 			"a": float64(1.0),
 			"b": float64(2.0),
@@ -5261,7 +5477,7 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 		assert.NoError(t, err)
 		assert.Equal(t, expected, res)
 		// index [0:-1] (all)
-		res, err = client.ZRangeWithScores(key, options.NewRangeByIndexQuery(0, -1))
+		res, err = client.ZRangeWithScores(context.Background(), key, options.NewRangeByIndexQuery(0, -1))
 		expected = map[string]float64{
 			"a": float64(1.0),
 			"b": float64(2.0),
@@ -5270,14 +5486,14 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 		assert.NoError(t, err)
 		assert.Equal(t, expected, res)
 		// index [3:1] (none)
-		res, err = client.ZRangeWithScores(key, options.NewRangeByIndexQuery(3, 1))
+		res, err = client.ZRangeWithScores(context.Background(), key, options.NewRangeByIndexQuery(3, 1))
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 		// score [-inf:3]
 		query := options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true))
-		res, err = client.ZRangeWithScores(key, query)
+		res, err = client.ZRangeWithScores(context.Background(), key, query)
 		expected = map[string]float64{
 			"a": float64(1.0),
 			"b": float64(2.0),
@@ -5289,7 +5505,7 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, false))
-		res, err = client.ZRangeWithScores(key, query)
+		res, err = client.ZRangeWithScores(context.Background(), key, query)
 		expected = map[string]float64{
 			"a": float64(1.0),
 			"b": float64(2.0),
@@ -5301,7 +5517,7 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 			options.NewScoreBoundary(3, false),
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity)).
 			SetReverse()
-		res, err = client.ZRangeWithScores(key, query)
+		res, err = client.ZRangeWithScores(context.Background(), key, query)
 		expected = map[string]float64{
 			"b": float64(2.0),
 			"a": float64(1.0),
@@ -5313,7 +5529,7 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity)).
 			SetLimit(1, 2)
-		res, err = client.ZRangeWithScores(key, query)
+		res, err = client.ZRangeWithScores(context.Background(), key, query)
 		expected = map[string]float64{
 			"b": float64(2.0),
 			"c": float64(3.0),
@@ -5325,14 +5541,14 @@ func (suite *GlideTestSuite) TestZRangeWithScores() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true)).
 			SetReverse()
-		res, err = client.ZRangeWithScores(key, query)
+		res, err = client.ZRangeWithScores(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 		// score [+inf:3) (none)
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 			options.NewScoreBoundary(3, false))
-		res, err = client.ZRangeWithScores(key, query)
+		res, err = client.ZRangeWithScores(context.Background(), key, query)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 	})
@@ -5348,26 +5564,26 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 			"b": 2.0,
 			"c": 3.0,
 		}
-		_, err := client.ZAdd(key, memberScoreMap)
+		_, err := client.ZAdd(context.Background(), key, memberScoreMap)
 		assert.NoError(t, err)
 		// index [0:1]
-		res, err := client.ZRangeStore(dest, key, options.NewRangeByIndexQuery(0, 1))
+		res, err := client.ZRangeStore(context.Background(), dest, key, options.NewRangeByIndexQuery(0, 1))
 		assert.NoError(t, err)
-		res1, err := client.ZRange(dest, options.NewRangeByIndexQuery(0, 1))
+		res1, err := client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, 1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"a", "b"}, res1)
 		// index [0:-1] (all)
-		res, err = client.ZRangeStore(dest, key, options.NewRangeByIndexQuery(0, -1))
+		res, err = client.ZRangeStore(context.Background(), dest, key, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res)
 		assert.Equal(t, []string{"a", "b", "c"}, res1)
 		// index [3:1] (none)
-		res, err = client.ZRangeStore(dest, key, options.NewRangeByIndexQuery(3, 1))
+		res, err = client.ZRangeStore(context.Background(), dest, key, options.NewRangeByIndexQuery(3, 1))
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(3, 1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(3, 1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res)
 		assert.Equal(t, 0, len(res1))
@@ -5376,9 +5592,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true))
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, query)
+		res1, err = client.ZRange(context.Background(), dest, query)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), res)
 		assert.Equal(t, []string{"a", "b", "c"}, res1)
@@ -5386,9 +5602,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, false))
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, query)
+		res1, err = client.ZRange(context.Background(), dest, query)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"a", "b"}, res1)
@@ -5397,9 +5613,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 			options.NewScoreBoundary(3, false),
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity)).
 			SetReverse()
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, query)
+		res1, err = client.ZRange(context.Background(), dest, query)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"b", "a"}, res1)
@@ -5408,9 +5624,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity)).
 			SetLimit(1, 2)
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"b", "c"}, res1)
@@ -5419,9 +5635,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true)).
 			SetReverse()
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res)
 		assert.Equal(t, 0, len(res1))
@@ -5429,9 +5645,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 		query = options.NewRangeByScoreQuery(
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 			options.NewScoreBoundary(3, false))
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res)
 		assert.Equal(t, 0, len(res1))
@@ -5439,9 +5655,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 		query = options.NewRangeByLexQuery(
 			options.NewInfiniteLexBoundary(options.NegativeInfinity),
 			options.NewLexBoundary("c", false))
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"a", "b"}, res1)
@@ -5450,9 +5666,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 			options.NewInfiniteLexBoundary(options.PositiveInfinity),
 			options.NewInfiniteLexBoundary(options.NegativeInfinity)).
 			SetReverse().SetLimit(1, 2)
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"a", "b"}, res1)
@@ -5461,9 +5677,9 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 			options.NewLexBoundary("c", false),
 			options.NewInfiniteLexBoundary(options.NegativeInfinity)).
 			SetReverse()
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res)
 		assert.Equal(t, []string{"a", "b"}, res1)
@@ -5471,14 +5687,14 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 		query = options.NewRangeByLexQuery(
 			options.NewInfiniteLexBoundary(options.PositiveInfinity),
 			options.NewLexBoundary("c", true))
-		res, err = client.ZRangeStore(dest, key, query)
+		res, err = client.ZRangeStore(context.Background(), dest, key, query)
 		assert.NoError(t, err)
-		res1, err = client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		res1, err = client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res)
 		assert.Equal(t, 0, len(res1))
 		// Pull from non-existent source
-		res, err = client.ZRangeStore(dest, "{key}nonExistent", query)
+		res, err = client.ZRangeStore(context.Background(), dest, "{key}nonExistent", query)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), res)
 	})
@@ -5489,24 +5705,24 @@ func (suite *GlideTestSuite) TestPersist() {
 		// Test 1: Check if persist command removes the expiration time of a key.
 		keyName := "{keyName}" + uuid.NewString()
 		t := suite.T()
-		suite.verifyOK(client.Set(keyName, initialValue))
-		resultExpire, err := client.Expire(keyName, 300)
+		suite.verifyOK(client.Set(context.Background(), keyName, initialValue))
+		resultExpire, err := client.Expire(context.Background(), keyName, 300)
 		assert.Nil(t, err)
 		assert.True(t, resultExpire)
-		resultPersist, err := client.Persist(keyName)
+		resultPersist, err := client.Persist(context.Background(), keyName)
 		assert.Nil(t, err)
 		assert.True(t, resultPersist)
 
 		// Test 2: Check if persist command return false if key that doesnt have associated timeout.
 		keyNoExp := "{keyName}" + uuid.NewString()
-		suite.verifyOK(client.Set(keyNoExp, initialValue))
-		resultPersistNoExp, err := client.Persist(keyNoExp)
+		suite.verifyOK(client.Set(context.Background(), keyNoExp, initialValue))
+		resultPersistNoExp, err := client.Persist(context.Background(), keyNoExp)
 		assert.Nil(t, err)
 		assert.False(t, resultPersistNoExp)
 
 		// Test 3: Check if persist command return false if key not exist.
 		keyInvalid := "{invalidkey_forPersistTest}" + uuid.NewString()
-		resultInvalidKey, err := client.Persist(keyInvalid)
+		resultInvalidKey, err := client.Persist(context.Background(), keyInvalid)
 		assert.Nil(t, err)
 		assert.False(t, resultInvalidKey)
 	})
@@ -5516,30 +5732,30 @@ func (suite *GlideTestSuite) TestZRank() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		stringKey := uuid.New().String()
-		client.ZAdd(key, map[string]float64{"one": 1.5, "two": 2.0, "three": 3.0})
-		res, err := client.ZRank(key, "two")
+		client.ZAdd(context.Background(), key, map[string]float64{"one": 1.5, "two": 2.0, "three": 3.0})
+		res, err := client.ZRank(context.Background(), key, "two")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res.Value())
 
 		if suite.serverVersion >= "7.2.0" {
-			res2Rank, res2Score, err := client.ZRankWithScore(key, "one")
+			res2Rank, res2Score, err := client.ZRankWithScore(context.Background(), key, "one")
 			assert.Nil(suite.T(), err)
 			assert.Equal(suite.T(), int64(0), res2Rank.Value())
 			assert.Equal(suite.T(), float64(1.5), res2Score.Value())
-			res4Rank, res4Score, err := client.ZRankWithScore(key, "non-existing-member")
+			res4Rank, res4Score, err := client.ZRankWithScore(context.Background(), key, "non-existing-member")
 			assert.Nil(suite.T(), err)
 			assert.True(suite.T(), res4Rank.IsNil())
 			assert.True(suite.T(), res4Score.IsNil())
 		}
 
-		res3, err := client.ZRank(key, "non-existing-member")
+		res3, err := client.ZRank(context.Background(), key, "non-existing-member")
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res3.IsNil())
 
 		// key exists, but it is not a set
-		suite.verifyOK(client.Set(stringKey, "value"))
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
 
-		_, err = client.ZRank(stringKey, "value")
+		_, err = client.ZRank(context.Background(), stringKey, "value")
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5549,30 +5765,30 @@ func (suite *GlideTestSuite) TestZRevRank() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		stringKey := uuid.New().String()
-		client.ZAdd(key, map[string]float64{"one": 1.5, "two": 2.0, "three": 3.0})
-		res, err := client.ZRevRank(key, "two")
+		client.ZAdd(context.Background(), key, map[string]float64{"one": 1.5, "two": 2.0, "three": 3.0})
+		res, err := client.ZRevRank(context.Background(), key, "two")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res.Value())
 
 		if suite.serverVersion >= "7.2.0" {
-			res2Rank, res2Score, err := client.ZRevRankWithScore(key, "one")
+			res2Rank, res2Score, err := client.ZRevRankWithScore(context.Background(), key, "one")
 			assert.Nil(suite.T(), err)
 			assert.Equal(suite.T(), int64(2), res2Rank.Value())
 			assert.Equal(suite.T(), float64(1.5), res2Score.Value())
-			res4Rank, res4Score, err := client.ZRevRankWithScore(key, "non-existing-member")
+			res4Rank, res4Score, err := client.ZRevRankWithScore(context.Background(), key, "non-existing-member")
 			assert.Nil(suite.T(), err)
 			assert.True(suite.T(), res4Rank.IsNil())
 			assert.True(suite.T(), res4Score.IsNil())
 		}
 
-		res3, err := client.ZRevRank(key, "non-existing-member")
+		res3, err := client.ZRevRank(context.Background(), key, "non-existing-member")
 		assert.Nil(suite.T(), err)
 		assert.True(suite.T(), res3.IsNil())
 
 		// key exists, but it is not a set
-		suite.verifyOK(client.Set(stringKey, "value"))
+		suite.verifyOK(client.Set(context.Background(), stringKey, "value"))
 
-		_, err = client.ZRevRank(stringKey, "value")
+		_, err = client.ZRevRank(context.Background(), stringKey, "value")
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5586,6 +5802,7 @@ func (suite *GlideTestSuite) Test_XAdd_XLen_XTrim() {
 		field2 := uuid.NewString()
 		t := suite.T()
 		xAddResult, err := client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{field1, "foo"}, {field2, "bar"}},
 			*options.NewXAddOptions().SetDontMakeNewStream(),
@@ -5594,6 +5811,7 @@ func (suite *GlideTestSuite) Test_XAdd_XLen_XTrim() {
 		assert.True(t, xAddResult.IsNil())
 
 		xAddResult, err = client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{field1, "foo1"}, {field2, "bar1"}},
 			*options.NewXAddOptions().SetId("0-1"),
@@ -5602,18 +5820,20 @@ func (suite *GlideTestSuite) Test_XAdd_XLen_XTrim() {
 		assert.Equal(t, xAddResult.Value(), "0-1")
 
 		xAddResult, err = client.XAdd(
+			context.Background(),
 			key1,
 			[][]string{{field1, "foo2"}, {field2, "bar2"}},
 		)
 		assert.NoError(t, err)
 		assert.False(t, xAddResult.IsNil())
 
-		xLenResult, err := client.XLen(key1)
+		xLenResult, err := client.XLen(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), xLenResult)
 
 		// Trim the first entry.
 		xAddResult, err = client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{field1, "foo3"}, {field2, "bar2"}},
 			*options.NewXAddOptions().SetTrimOptions(
@@ -5623,12 +5843,13 @@ func (suite *GlideTestSuite) Test_XAdd_XLen_XTrim() {
 		assert.NotNil(t, xAddResult.Value())
 		assert.NoError(t, err)
 		id := xAddResult.Value()
-		xLenResult, err = client.XLen(key1)
+		xLenResult, err = client.XLen(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), xLenResult)
 
 		// Trim the second entry.
 		xAddResult, err = client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{field1, "foo4"}, {field2, "bar4"}},
 			*options.NewXAddOptions().SetTrimOptions(
@@ -5637,38 +5858,40 @@ func (suite *GlideTestSuite) Test_XAdd_XLen_XTrim() {
 		)
 		assert.NoError(t, err)
 		assert.NotNil(t, xAddResult.Value())
-		xLenResult, err = client.XLen(key1)
+		xLenResult, err = client.XLen(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), xLenResult)
 
 		// Test xtrim to remove 1 element
 		xTrimResult, err := client.XTrim(
+			context.Background(),
 			key1,
 			*options.NewXTrimOptionsWithMaxLen(1).SetExactTrimming(),
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), xTrimResult)
-		xLenResult, err = client.XLen(key1)
+		xLenResult, err = client.XLen(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), xLenResult)
 
 		// Key does not exist - returns 0
 		xTrimResult, err = client.XTrim(
+			context.Background(),
 			key2,
 			*options.NewXTrimOptionsWithMaxLen(1).SetExactTrimming(),
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), xTrimResult)
-		xLenResult, err = client.XLen(key2)
+		xLenResult, err = client.XLen(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), xLenResult)
 
 		// Throw Exception: Key exists - but it is not a stream
-		suite.verifyOK(client.Set(key2, "xtrimtest"))
-		_, err = client.XTrim(key2, *options.NewXTrimOptionsWithMinId("0-1"))
+		suite.verifyOK(client.Set(context.Background(), key2, "xtrimtest"))
+		_, err = client.XTrim(context.Background(), key2, *options.NewXTrimOptionsWithMinId("0-1"))
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
-		_, err = client.XLen(key2)
+		_, err = client.XLen(context.Background(), key2)
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -5686,28 +5909,28 @@ func (suite *GlideTestSuite) Test_ZScore() {
 			"three": 3.0,
 		}
 
-		zAddResult, err := client.ZAdd(key1, membersScores)
+		zAddResult, err := client.ZAdd(context.Background(), key1, membersScores)
 		assert.NoError(t, err)
 		assert.Equal(t, zAddResult, int64(3))
 
-		zScoreResult, err := client.ZScore(key1, "one")
+		zScoreResult, err := client.ZScore(context.Background(), key1, "one")
 		assert.NoError(t, err)
 		assert.Equal(t, zScoreResult.Value(), float64(1.0))
 
-		zScoreResult, err = client.ZScore(key1, "non_existing_member")
+		zScoreResult, err = client.ZScore(context.Background(), key1, "non_existing_member")
 		assert.NoError(t, err)
 		assert.True(t, zScoreResult.IsNil())
 
-		zScoreResult, err = client.ZScore("non_existing_key", "non_existing_member")
+		zScoreResult, err = client.ZScore(context.Background(), "non_existing_key", "non_existing_member")
 		assert.NoError(t, err)
 		assert.True(t, zScoreResult.IsNil())
 
 		// Key exists, but it is not a set
-		setResult, err := client.Set(key2, "bar")
+		setResult, err := client.Set(context.Background(), key2, "bar")
 		assert.NoError(t, err)
 		assert.Equal(t, setResult, "OK")
 
-		_, err = client.ZScore(key2, "one")
+		_, err = client.ZScore(context.Background(), key2, "one")
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -5723,7 +5946,7 @@ func (suite *GlideTestSuite) TestZCount() {
 			"three": 3.0,
 		}
 		t := suite.T()
-		res1, err := client.ZAdd(key1, membersScores)
+		res1, err := client.ZAdd(context.Background(), key1, membersScores)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), res1)
 
@@ -5732,14 +5955,14 @@ func (suite *GlideTestSuite) TestZCount() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 		)
-		zCountResult, err := client.ZCount(key1, *zCountRange)
+		zCountResult, err := client.ZCount(context.Background(), key1, *zCountRange)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), zCountResult)
 		zCountRange = options.NewZCountRange(
 			options.NewInclusiveScoreBoundary(math.Inf(-1)),
 			options.NewInclusiveScoreBoundary(math.Inf(+1)),
 		)
-		zCountResult, err = client.ZCount(key1, *zCountRange)
+		zCountResult, err = client.ZCount(context.Background(), key1, *zCountRange)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), zCountResult)
 
@@ -5748,7 +5971,7 @@ func (suite *GlideTestSuite) TestZCount() {
 			options.NewScoreBoundary(1, false),
 			options.NewScoreBoundary(3, true),
 		)
-		zCountResult, err = client.ZCount(key1, *zCountRange)
+		zCountResult, err = client.ZCount(context.Background(), key1, *zCountRange)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(2), zCountResult)
 
@@ -5757,7 +5980,7 @@ func (suite *GlideTestSuite) TestZCount() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewScoreBoundary(3, true),
 		)
-		zCountResult, err = client.ZCount(key1, *zCountRange)
+		zCountResult, err = client.ZCount(context.Background(), key1, *zCountRange)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(3), zCountResult)
 
@@ -5766,7 +5989,7 @@ func (suite *GlideTestSuite) TestZCount() {
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 			options.NewInclusiveScoreBoundary(3),
 		)
-		zCountResult, err = client.ZCount(key1, *zCountRange)
+		zCountResult, err = client.ZCount(context.Background(), key1, *zCountRange)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(0), zCountResult)
 
@@ -5775,18 +5998,18 @@ func (suite *GlideTestSuite) TestZCount() {
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 		)
-		zCountResult, err = client.ZCount("non_existing_key", *zCountRange)
+		zCountResult, err = client.ZCount(context.Background(), "non_existing_key", *zCountRange)
 		assert.Nil(t, err)
 		assert.Equal(t, int64(0), zCountResult)
 
 		// Key exists, but it is not a set
-		setResult, _ := client.Set(key2, "value")
+		setResult, _ := client.Set(context.Background(), key2, "value")
 		assert.Equal(t, setResult, "OK")
 		zCountRange = options.NewZCountRange(
 			options.NewInfiniteScoreBoundary(options.NegativeInfinity),
 			options.NewInfiniteScoreBoundary(options.PositiveInfinity),
 		)
-		_, err = client.ZCount(key2, *zCountRange)
+		_, err = client.ZCount(context.Background(), key2, *zCountRange)
 		assert.NotNil(t, err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -5802,6 +6025,7 @@ func (suite *GlideTestSuite) Test_XDel() {
 		t := suite.T()
 
 		xAddResult, err := client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{"f1", "foo1"}, {"f2", "bar2"}},
 			*options.NewXAddOptions().SetId(streamId1),
@@ -5810,6 +6034,7 @@ func (suite *GlideTestSuite) Test_XDel() {
 		assert.Equal(t, xAddResult.Value(), streamId1)
 
 		xAddResult, err = client.XAddWithOptions(
+			context.Background(),
 			key1,
 			[][]string{{"f1", "foo1"}, {"f2", "bar2"}},
 			*options.NewXAddOptions().SetId(streamId2),
@@ -5817,25 +6042,25 @@ func (suite *GlideTestSuite) Test_XDel() {
 		assert.NoError(t, err)
 		assert.Equal(t, xAddResult.Value(), streamId2)
 
-		xLenResult, err := client.XLen(key1)
+		xLenResult, err := client.XLen(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Equal(t, xLenResult, int64(2))
 
 		// Deletes one stream id, and ignores anything invalid:
-		xDelResult, err := client.XDel(key1, []string{streamId1, streamId3})
+		xDelResult, err := client.XDel(context.Background(), key1, []string{streamId1, streamId3})
 		assert.NoError(t, err)
 		assert.Equal(t, xDelResult, int64(1))
 
-		xDelResult, err = client.XDel(key2, []string{streamId3})
+		xDelResult, err = client.XDel(context.Background(), key2, []string{streamId3})
 		assert.NoError(t, err)
 		assert.Equal(t, xDelResult, int64(0))
 
 		// Throws error: Key exists - but it is not a stream
-		setResult, err := client.Set(key2, "xdeltest")
+		setResult, err := client.Set(context.Background(), key2, "xdeltest")
 		assert.NoError(t, err)
 		assert.Equal(t, "OK", setResult)
 
-		_, err = client.XDel(key2, []string{streamId3})
+		_, err = client.XDel(context.Background(), key2, []string{streamId3})
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -5863,29 +6088,29 @@ func (suite *GlideTestSuite) TestZScan() {
 		}
 
 		// Empty set
-		resCursor, resCollection, err := client.ZScan(key1, initialCursor)
+		resCursor, resCollection, err := client.ZScan(context.Background(), key1, initialCursor)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), initialCursor, resCursor)
 		assert.Empty(suite.T(), resCollection)
 
 		// Negative cursor
 		if suite.serverVersion >= "8.0.0" {
-			_, _, err = client.ZScan(key1, "-1")
+			_, _, err = client.ZScan(context.Background(), key1, "-1")
 			assert.NotNil(suite.T(), err)
 			assert.IsType(suite.T(), &errors.RequestError{}, err)
 		} else {
-			resCursor, resCollection, err = client.ZScan(key1, "-1")
+			resCursor, resCollection, err = client.ZScan(context.Background(), key1, "-1")
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), initialCursor, resCursor)
 			assert.Empty(suite.T(), resCollection)
 		}
 
 		// Result contains the whole set
-		res, err := client.ZAdd(key1, charMap)
+		res, err := client.ZAdd(context.Background(), key1, charMap)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(5), res)
 
-		resCursor, resCollection, err = client.ZScan(key1, initialCursor)
+		resCursor, resCollection, err = client.ZScan(context.Background(), key1, initialCursor)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), initialCursor, resCursor)
 		assert.Equal(suite.T(), len(charMap)*2, len(resCollection))
@@ -5906,24 +6131,24 @@ func (suite *GlideTestSuite) TestZScan() {
 		assert.True(suite.T(), isSubset(charMapValues, resultValueSet))
 
 		opts := options.NewZScanOptions().SetMatch("a")
-		resCursor, resCollection, err = client.ZScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.ZScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), initialCursor, resCursor)
 		assert.Equal(suite.T(), resCollection, []string{"a", "0"})
 
 		// Result contains a subset of the key
-		res, err = client.ZAdd(key1, numberMap)
+		res, err = client.ZAdd(context.Background(), key1, numberMap)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(50000), res)
 
-		resCursor, resCollection, err = client.ZScan(key1, "0")
+		resCursor, resCollection, err = client.ZScan(context.Background(), key1, "0")
 		assert.NoError(suite.T(), err)
 		resultCollection := resCollection
 		resKeys := []string{}
 
 		// 0 is returned for the cursor of the last iteration
 		for resCursor != "0" {
-			nextCursor, nextCol, err := client.ZScan(key1, resCursor)
+			nextCursor, nextCol, err := client.ZScan(context.Background(), key1, resCursor)
 			assert.NoError(suite.T(), err)
 			assert.NotEqual(suite.T(), nextCursor, resCursor)
 			assert.False(suite.T(), isSubset(resultCollection, nextCol))
@@ -5941,21 +6166,21 @@ func (suite *GlideTestSuite) TestZScan() {
 
 		// Test match pattern
 		opts = options.NewZScanOptions().SetMatch("*")
-		resCursor, resCollection, err = client.ZScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.ZScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(suite.T(), err)
 		assert.NotEqual(suite.T(), initialCursor, resCursor)
 		assert.GreaterOrEqual(suite.T(), len(resCollection), defaultCount)
 
 		// test count
 		opts = options.NewZScanOptions().SetCount(20)
-		resCursor, resCollection, err = client.ZScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.ZScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(suite.T(), err)
 		assert.NotEqual(suite.T(), initialCursor, resCursor)
 		assert.GreaterOrEqual(suite.T(), len(resCollection), 20)
 
 		// test count with match, returns a non-empty array
 		opts = options.NewZScanOptions().SetMatch("1*").SetCount(20)
-		resCursor, resCollection, err = client.ZScanWithOptions(key1, initialCursor, *opts)
+		resCursor, resCollection, err = client.ZScanWithOptions(context.Background(), key1, initialCursor, *opts)
 		assert.NoError(suite.T(), err)
 		assert.NotEqual(suite.T(), initialCursor, resCursor)
 		assert.GreaterOrEqual(suite.T(), len(resCollection), 0)
@@ -5963,7 +6188,7 @@ func (suite *GlideTestSuite) TestZScan() {
 		// Test NoScores option for Redis 8.0.0+
 		if suite.serverVersion >= "8.0.0" {
 			opts = options.NewZScanOptions().SetNoScores(true)
-			resCursor, resCollection, err = client.ZScanWithOptions(key1, initialCursor, *opts)
+			resCursor, resCollection, err = client.ZScanWithOptions(context.Background(), key1, initialCursor, *opts)
 			assert.NoError(suite.T(), err)
 			cursor, err := strconv.ParseInt(resCursor, 10, 64)
 			assert.NoError(suite.T(), err)
@@ -5978,22 +6203,22 @@ func (suite *GlideTestSuite) TestZScan() {
 		// Test exceptions
 		// Non-set key
 		stringKey := uuid.New().String()
-		setRes, err := client.Set(stringKey, "test")
+		setRes, err := client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "OK", setRes)
 
-		_, _, err = client.ZScan(stringKey, initialCursor)
+		_, _, err = client.ZScan(context.Background(), stringKey, initialCursor)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		opts = options.NewZScanOptions().SetMatch("test").SetCount(1)
-		_, _, err = client.ZScanWithOptions(stringKey, initialCursor, *opts)
+		_, _, err = client.ZScanWithOptions(context.Background(), stringKey, initialCursor, *opts)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Negative count
 		opts = options.NewZScanOptions().SetCount(-1)
-		_, _, err = client.ZScanWithOptions(key1, "-1", *opts)
+		_, _, err = client.ZScanWithOptions(context.Background(), key1, "-1", *opts)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -6019,36 +6244,36 @@ func (suite *GlideTestSuite) TestXPending() {
 
 			command := []string{"XGroup", "Create", key, groupName, zeroStreamId, "MKSTREAM"}
 
-			resp, err := client.CustomCommand(command)
+			resp, err := client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), "OK", resp.(string))
 
 			command = []string{"XGroup", "CreateConsumer", key, groupName, consumer1}
-			resp, err = client.CustomCommand(command)
+			resp, err = client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), resp.(bool))
 
 			command = []string{"XGroup", "CreateConsumer", key, groupName, consumer2}
-			resp, err = client.CustomCommand(command)
+			resp, err = client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), resp.(bool))
 
-			streamid_1, err := client.XAdd(key, [][]string{{"field1", "value1"}})
+			streamid_1, err := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 			assert.NoError(suite.T(), err)
-			streamid_2, err := client.XAdd(key, [][]string{{"field2", "value2"}})
+			streamid_2, err := client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 			assert.NoError(suite.T(), err)
 
-			_, err = client.XReadGroup(groupName, consumer1, map[string]string{key: ">"})
+			_, err = client.XReadGroup(context.Background(), groupName, consumer1, map[string]string{key: ">"})
 			assert.NoError(suite.T(), err)
 
-			_, err = client.XAdd(key, [][]string{{"field3", "value3"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field3", "value3"}})
 			assert.NoError(suite.T(), err)
-			_, err = client.XAdd(key, [][]string{{"field4", "value4"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field4", "value4"}})
 			assert.NoError(suite.T(), err)
-			streamid_5, err := client.XAdd(key, [][]string{{"field5", "value5"}})
+			streamid_5, err := client.XAdd(context.Background(), key, [][]string{{"field5", "value5"}})
 			assert.NoError(suite.T(), err)
 
-			_, err = client.XReadGroup(groupName, consumer2, map[string]string{key: ">"})
+			_, err = client.XReadGroup(context.Background(), groupName, consumer2, map[string]string{key: ">"})
 			assert.NoError(suite.T(), err)
 
 			expectedSummary := api.XPendingSummary{
@@ -6062,7 +6287,7 @@ func (suite *GlideTestSuite) TestXPending() {
 			}
 
 			// 2. Act
-			summaryResult, err := client.XPending(key, groupName)
+			summaryResult, err := client.XPending(context.Background(), key, groupName)
 
 			// 3a. Assert that we get 5 messages in total, 2 for consumer1 and 3 for consumer2
 			assert.NoError(suite.T(), err)
@@ -6074,6 +6299,7 @@ func (suite *GlideTestSuite) TestXPending() {
 
 			// 3b. Assert that we get 2 details for consumer1 that includes
 			detailResult, _ := client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -6093,36 +6319,36 @@ func (suite *GlideTestSuite) TestXPending() {
 
 			command := []string{"XGroup", "Create", key, groupName, zeroStreamId, "MKSTREAM"}
 
-			resp, err := client.CustomCommand(command)
+			resp, err := client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), "OK", resp.SingleValue().(string))
 
 			command = []string{"XGroup", "CreateConsumer", key, groupName, consumer1}
-			resp, err = client.CustomCommand(command)
+			resp, err = client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), resp.SingleValue().(bool))
 
 			command = []string{"XGroup", "CreateConsumer", key, groupName, consumer2}
-			resp, err = client.CustomCommand(command)
+			resp, err = client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), resp.SingleValue().(bool))
 
-			streamid_1, err := client.XAdd(key, [][]string{{"field1", "value1"}})
+			streamid_1, err := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 			assert.NoError(suite.T(), err)
-			streamid_2, err := client.XAdd(key, [][]string{{"field2", "value2"}})
+			streamid_2, err := client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 			assert.NoError(suite.T(), err)
 
-			_, err = client.XReadGroup(groupName, consumer1, map[string]string{key: ">"})
+			_, err = client.XReadGroup(context.Background(), groupName, consumer1, map[string]string{key: ">"})
 			assert.NoError(suite.T(), err)
 
-			_, err = client.XAdd(key, [][]string{{"field3", "value3"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field3", "value3"}})
 			assert.NoError(suite.T(), err)
-			_, err = client.XAdd(key, [][]string{{"field4", "value4"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field4", "value4"}})
 			assert.NoError(suite.T(), err)
-			streamid_5, err := client.XAdd(key, [][]string{{"field5", "value5"}})
+			streamid_5, err := client.XAdd(context.Background(), key, [][]string{{"field5", "value5"}})
 			assert.NoError(suite.T(), err)
 
-			_, err = client.XReadGroup(groupName, consumer2, map[string]string{key: ">"})
+			_, err = client.XReadGroup(context.Background(), groupName, consumer2, map[string]string{key: ">"})
 			assert.NoError(suite.T(), err)
 
 			expectedSummary := api.XPendingSummary{
@@ -6136,7 +6362,7 @@ func (suite *GlideTestSuite) TestXPending() {
 			}
 
 			// 2. Act
-			summaryResult, err := client.XPending(key, groupName)
+			summaryResult, err := client.XPending(context.Background(), key, groupName)
 
 			// 3a. Assert that we get 5 messages in total, 2 for consumer1 and 3 for consumer2
 			assert.NoError(suite.T(), err)
@@ -6148,6 +6374,7 @@ func (suite *GlideTestSuite) TestXPending() {
 
 			// 3b. Assert that we get 2 details for consumer1 that includes
 			detailResult, _ := client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10).SetConsumer(consumer1),
@@ -6195,6 +6422,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			suite.verifyOK(
 				client.XGroupCreateWithOptions(
+					context.Background(),
 					key,
 					groupName,
 					zeroStreamId,
@@ -6203,34 +6431,40 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			)
 
 			command := []string{"XGroup", "CreateConsumer", key, groupName, consumer1}
-			resp, err := client.CustomCommand(command)
+			resp, err := client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), resp.(bool))
 
-			_, err = client.XAdd(key, [][]string{{"field1", "value1"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 			assert.NoError(suite.T(), err)
-			_, err = client.XAdd(key, [][]string{{"field2", "value2"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 			assert.NoError(suite.T(), err)
 
 			// no pending messages yet...
-			summaryResult, err := client.XPending(key, groupName)
+			summaryResult, err := client.XPending(context.Background(), key, groupName)
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), int64(0), summaryResult.NumOfMessages)
 
-			detailResult, err := client.XPendingWithOptions(key, groupName, *options.NewXPendingOptions("-", "+", 10))
+			detailResult, err := client.XPendingWithOptions(
+				context.Background(),
+				key,
+				groupName,
+				*options.NewXPendingOptions("-", "+", 10),
+			)
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), 0, len(detailResult))
 
 			// read the entire stream for the consumer and mark messages as pending
-			_, err = client.XReadGroup(groupName, consumer1, map[string]string{key: ">"})
+			_, err = client.XReadGroup(context.Background(), groupName, consumer1, map[string]string{key: ">"})
 			assert.NoError(suite.T(), err)
 
 			// sanity check - expect some results:
-			summaryResult, err = client.XPending(key, groupName)
+			summaryResult, err = client.XPending(context.Background(), key, groupName)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), summaryResult.NumOfMessages > 0)
 
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 1).SetConsumer(consumer1),
@@ -6240,6 +6474,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// returns empty if + before -
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("+", "-", 10).SetConsumer(consumer1),
@@ -6249,6 +6484,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// min idletime of 100 seconds shouldn't produce any results
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10).SetMinIdleTime(100000),
@@ -6258,6 +6494,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// invalid consumer - no results
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10).SetConsumer(invalidConsumer),
@@ -6267,6 +6504,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// Return an error when range bound is not a valid ID
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("invalid-id", "+", 10),
@@ -6275,6 +6513,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "invalid-id", 10),
@@ -6284,6 +6523,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// invalid count should return no results
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", -1),
@@ -6293,6 +6533,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// Return an error when an invalid group is provided
 			_, err = client.XPending(
+				context.Background(),
 				key,
 				"invalid-group",
 			)
@@ -6302,6 +6543,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// non-existent key throws a RequestError (NOGROUP)
 			_, err = client.XPending(
+				context.Background(),
 				missingKey,
 				groupName,
 			)
@@ -6310,6 +6552,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.True(suite.T(), strings.Contains(err.Error(), "NOGROUP"))
 
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				missingKey,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10),
@@ -6319,8 +6562,9 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.True(suite.T(), strings.Contains(err.Error(), "NOGROUP"))
 
 			// Key exists, but it is not a stream
-			_, _ = client.Set(nonStreamKey, "bar")
+			_, _ = client.Set(context.Background(), nonStreamKey, "bar")
 			_, err = client.XPending(
+				context.Background(),
 				nonStreamKey,
 				groupName,
 			)
@@ -6329,6 +6573,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.True(suite.T(), strings.Contains(err.Error(), "WRONGTYPE"))
 
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				nonStreamKey,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10),
@@ -6350,6 +6595,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			suite.verifyOK(
 				client.XGroupCreateWithOptions(
+					context.Background(),
 					key,
 					groupName,
 					zeroStreamId,
@@ -6358,34 +6604,40 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			)
 
 			command := []string{"XGroup", "CreateConsumer", key, groupName, consumer1}
-			resp, err := client.CustomCommand(command)
+			resp, err := client.CustomCommand(context.Background(), command)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), resp.SingleValue().(bool))
 
-			_, err = client.XAdd(key, [][]string{{"field1", "value1"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 			assert.NoError(suite.T(), err)
-			_, err = client.XAdd(key, [][]string{{"field2", "value2"}})
+			_, err = client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 			assert.NoError(suite.T(), err)
 
 			// no pending messages yet...
-			summaryResult, err := client.XPending(key, groupName)
+			summaryResult, err := client.XPending(context.Background(), key, groupName)
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), int64(0), summaryResult.NumOfMessages)
 
-			detailResult, err := client.XPendingWithOptions(key, groupName, *options.NewXPendingOptions("-", "+", 10))
+			detailResult, err := client.XPendingWithOptions(
+				context.Background(),
+				key,
+				groupName,
+				*options.NewXPendingOptions("-", "+", 10),
+			)
 			assert.NoError(suite.T(), err)
 			assert.Equal(suite.T(), 0, len(detailResult))
 
 			// read the entire stream for the consumer and mark messages as pending
-			_, err = client.XReadGroup(groupName, consumer1, map[string]string{key: ">"})
+			_, err = client.XReadGroup(context.Background(), groupName, consumer1, map[string]string{key: ">"})
 			assert.NoError(suite.T(), err)
 
 			// sanity check - expect some results:
-			summaryResult, err = client.XPending(key, groupName)
+			summaryResult, err = client.XPending(context.Background(), key, groupName)
 			assert.NoError(suite.T(), err)
 			assert.True(suite.T(), summaryResult.NumOfMessages > 0)
 
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 1).SetConsumer(consumer1),
@@ -6395,6 +6647,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// returns empty if + before -
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("+", "-", 10).SetConsumer(consumer1),
@@ -6404,6 +6657,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// min idletime of 100 seconds shouldn't produce any results
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10).SetMinIdleTime(100000),
@@ -6413,6 +6667,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// invalid consumer - no results
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10).SetConsumer(invalidConsumer),
@@ -6422,6 +6677,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// Return an error when range bound is not a valid ID
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("invalid-id", "+", 10),
@@ -6430,6 +6686,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "invalid-id", 10),
@@ -6439,6 +6696,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// invalid count should return no results
 			detailResult, err = client.XPendingWithOptions(
+				context.Background(),
 				key,
 				groupName,
 				*options.NewXPendingOptions("-", "+", -1),
@@ -6448,6 +6706,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// Return an error when an invalid group is provided
 			_, err = client.XPending(
+				context.Background(),
 				key,
 				"invalid-group",
 			)
@@ -6457,6 +6716,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 
 			// non-existent key throws a RequestError (NOGROUP)
 			_, err = client.XPending(
+				context.Background(),
 				missingKey,
 				groupName,
 			)
@@ -6465,6 +6725,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.True(suite.T(), strings.Contains(err.Error(), "NOGROUP"))
 
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				missingKey,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10),
@@ -6474,8 +6735,9 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.True(suite.T(), strings.Contains(err.Error(), "NOGROUP"))
 
 			// Key exists, but it is not a stream
-			_, _ = client.Set(nonStreamKey, "bar")
+			_, _ = client.Set(context.Background(), nonStreamKey, "bar")
 			_, err = client.XPending(
+				context.Background(),
 				nonStreamKey,
 				groupName,
 			)
@@ -6484,6 +6746,7 @@ func (suite *GlideTestSuite) TestXPendingFailures() {
 			assert.True(suite.T(), strings.Contains(err.Error(), "WRONGTYPE"))
 
 			_, err = client.XPendingWithOptions(
+				context.Background(),
 				nonStreamKey,
 				groupName,
 				*options.NewXPendingOptions("-", "+", 10),
@@ -6514,43 +6777,43 @@ func (suite *GlideTestSuite) TestXGroupCreate_XGroupDestroy() {
 		id := "0-1"
 
 		// Stream not created results in error
-		_, err := client.XGroupCreate(key, group, id)
+		_, err := client.XGroupCreate(context.Background(), key, group, id)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Stream with option to create creates stream & Group
 		opts := options.NewXGroupCreateOptions().SetMakeStream()
-		suite.verifyOK(client.XGroupCreateWithOptions(key, group, id, *opts))
+		suite.verifyOK(client.XGroupCreateWithOptions(context.Background(), key, group, id, *opts))
 
 		// ...and again results in BUSYGROUP error, because group names must be unique
-		_, err = client.XGroupCreate(key, group, id)
+		_, err = client.XGroupCreate(context.Background(), key, group, id)
 		assert.ErrorContains(suite.T(), err, "BUSYGROUP")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Stream Group can be destroyed returns: true
-		destroyed, err := client.XGroupDestroy(key, group)
+		destroyed, err := client.XGroupDestroy(context.Background(), key, group)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), destroyed)
 
 		// ...and again results in: false
-		destroyed, err = client.XGroupDestroy(key, group)
+		destroyed, err = client.XGroupDestroy(context.Background(), key, group)
 		assert.NoError(suite.T(), err)
 		assert.False(suite.T(), destroyed)
 
 		// ENTRIESREAD option was added in valkey 7.0.0
 		opts = options.NewXGroupCreateOptions().SetEntriesRead(100)
 		if suite.serverVersion >= "7.0.0" {
-			suite.verifyOK(client.XGroupCreateWithOptions(key, group, id, *opts))
+			suite.verifyOK(client.XGroupCreateWithOptions(context.Background(), key, group, id, *opts))
 		} else {
-			_, err = client.XGroupCreateWithOptions(key, group, id, *opts)
+			_, err = client.XGroupCreateWithOptions(context.Background(), key, group, id, *opts)
 			assert.Error(suite.T(), err)
 			assert.IsType(suite.T(), &errors.RequestError{}, err)
 		}
 
 		// key is not a stream
 		key = uuid.NewString()
-		suite.verifyOK(client.Set(key, id))
-		_, err = client.XGroupCreate(key, group, id)
+		suite.verifyOK(client.Set(context.Background(), key, id))
+		_, err = client.XGroupCreate(context.Background(), key, group, id)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -6562,14 +6825,14 @@ func (suite *GlideTestSuite) TestObjectEncoding() {
 		key := "{keyName}" + uuid.NewString()
 		value1 := "Hello"
 		t := suite.T()
-		suite.verifyOK(client.Set(key, value1))
-		resultObjectEncoding, err := client.ObjectEncoding(key)
+		suite.verifyOK(client.Set(context.Background(), key, value1))
+		resultObjectEncoding, err := client.ObjectEncoding(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, "embstr", resultObjectEncoding.Value(), "The result should be embstr")
 
 		// Test 2: Check object encoding command for non existing key
 		key2 := "{keyName}" + uuid.NewString()
-		resultDumpNull, err := client.ObjectEncoding(key2)
+		resultDumpNull, err := client.ObjectEncoding(context.Background(), key2)
 		assert.Nil(t, err)
 		assert.Equal(t, "", resultDumpNull.Value())
 	})
@@ -6581,23 +6844,23 @@ func (suite *GlideTestSuite) TestDumpRestore() {
 		key := "testKey1_" + uuid.New().String()
 		value := "hello"
 		t := suite.T()
-		suite.verifyOK(client.Set(key, value))
-		resultDump, err := client.Dump(key)
+		suite.verifyOK(client.Set(context.Background(), key, value))
+		resultDump, err := client.Dump(context.Background(), key)
 		assert.Nil(t, err)
 		assert.NotNil(t, resultDump)
-		deletedCount, err := client.Del([]string{key})
+		deletedCount, err := client.Del(context.Background(), []string{key})
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), deletedCount)
-		result_test1, err := client.Restore(key, int64(0), resultDump.Value())
+		result_test1, err := client.Restore(context.Background(), key, int64(0), resultDump.Value())
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result_test1.Value())
-		resultGetRestoreKey, err := client.Get(key)
+		resultGetRestoreKey, err := client.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGetRestoreKey.Value())
 
 		// Test 2: Check dump command for non existing key
 		key1 := "{keyName}" + uuid.NewString()
-		resultDumpNull, err := client.Dump(key1)
+		resultDumpNull, err := client.Dump(context.Background(), key1)
 		assert.Nil(t, err)
 		assert.Equal(t, "", resultDumpNull.Value())
 	})
@@ -6608,57 +6871,57 @@ func (suite *GlideTestSuite) TestRestoreWithOptions() {
 		key := "testKey1_" + uuid.New().String()
 		value := "hello"
 		t := suite.T()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
-		resultDump, err := client.Dump(key)
+		resultDump, err := client.Dump(context.Background(), key)
 		assert.Nil(t, err)
 		assert.NotNil(t, resultDump)
 
 		// Test 1: Check restore command with restoreOptions REPLACE modifier
-		deletedCount, err := client.Del([]string{key})
+		deletedCount, err := client.Del(context.Background(), []string{key})
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), deletedCount)
 		optsReplace := options.NewRestoreOptions().SetReplace()
-		result_test1, err := client.RestoreWithOptions(key, int64(0), resultDump.Value(), *optsReplace)
+		result_test1, err := client.RestoreWithOptions(context.Background(), key, int64(0), resultDump.Value(), *optsReplace)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result_test1.Value())
-		resultGetRestoreKey, err := client.Get(key)
+		resultGetRestoreKey, err := client.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGetRestoreKey.Value())
 
 		// Test 2: Check restore command with restoreOptions ABSTTL modifier
-		delete_test2, err := client.Del([]string{key})
+		delete_test2, err := client.Del(context.Background(), []string{key})
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), delete_test2)
 		opts_test2 := options.NewRestoreOptions().SetABSTTL()
-		result_test2, err := client.RestoreWithOptions(key, int64(0), resultDump.Value(), *opts_test2)
+		result_test2, err := client.RestoreWithOptions(context.Background(), key, int64(0), resultDump.Value(), *opts_test2)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result_test2.Value())
-		resultGet_test2, err := client.Get(key)
+		resultGet_test2, err := client.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet_test2.Value())
 
 		// Test 3: Check restore command with restoreOptions FREQ modifier
-		delete_test3, err := client.Del([]string{key})
+		delete_test3, err := client.Del(context.Background(), []string{key})
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), delete_test3)
 		opts_test3 := options.NewRestoreOptions().SetEviction(options.FREQ, 10)
-		result_test3, err := client.RestoreWithOptions(key, int64(0), resultDump.Value(), *opts_test3)
+		result_test3, err := client.RestoreWithOptions(context.Background(), key, int64(0), resultDump.Value(), *opts_test3)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result_test3.Value())
-		resultGet_test3, err := client.Get(key)
+		resultGet_test3, err := client.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet_test3.Value())
 
 		// Test 4: Check restore command with restoreOptions IDLETIME modifier
-		delete_test4, err := client.Del([]string{key})
+		delete_test4, err := client.Del(context.Background(), []string{key})
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), delete_test4)
 		opts_test4 := options.NewRestoreOptions().SetEviction(options.IDLETIME, 10)
-		result_test4, err := client.RestoreWithOptions(key, int64(0), resultDump.Value(), *opts_test4)
+		result_test4, err := client.RestoreWithOptions(context.Background(), key, int64(0), resultDump.Value(), *opts_test4)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "OK", result_test4.Value())
-		resultGet_test4, err := client.Get(key)
+		resultGet_test4, err := client.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet_test4.Value())
 	})
@@ -6673,40 +6936,40 @@ func (suite *GlideTestSuite) TestZRemRangeByRank() {
 			"two":   2.0,
 			"three": 3.0,
 		}
-		zAddResult, err := client.ZAdd(key1, membersScores)
+		zAddResult, err := client.ZAdd(context.Background(), key1, membersScores)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zAddResult)
 
 		// Incorrect range start > stop
-		zRemRangeByRankResult, err := client.ZRemRangeByRank(key1, 2, 1)
+		zRemRangeByRankResult, err := client.ZRemRangeByRank(context.Background(), key1, 2, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), zRemRangeByRankResult)
 
 		// Remove first two members
-		zRemRangeByRankResult, err = client.ZRemRangeByRank(key1, 0, 1)
+		zRemRangeByRankResult, err = client.ZRemRangeByRank(context.Background(), key1, 0, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), zRemRangeByRankResult)
 
 		// Remove all members
-		zRemRangeByRankResult, err = client.ZRemRangeByRank(key1, 0, 10)
+		zRemRangeByRankResult, err = client.ZRemRangeByRank(context.Background(), key1, 0, 10)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), zRemRangeByRankResult)
 
-		zRangeWithScoresResult, err := client.ZRangeWithScores(key1, options.NewRangeByIndexQuery(0, -1))
+		zRangeWithScoresResult, err := client.ZRangeWithScores(context.Background(), key1, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), 0, len(zRangeWithScoresResult))
 
 		// Non-existing key
-		zRemRangeByRankResult, err = client.ZRemRangeByRank("non_existing_key", 0, 10)
+		zRemRangeByRankResult, err = client.ZRemRangeByRank(context.Background(), "non_existing_key", 0, 10)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), zRemRangeByRankResult)
 
 		// Key exists, but it is not a set
-		setResult, err := client.Set(stringKey, "test")
+		setResult, err := client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "OK", setResult)
 
-		_, err = client.ZRemRangeByRank(stringKey, 0, 10)
+		_, err = client.ZRemRangeByRank(context.Background(), stringKey, 0, 10)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -6718,12 +6981,13 @@ func (suite *GlideTestSuite) TestZRemRangeByLex() {
 		stringKey := uuid.New().String()
 
 		// Add members to the set
-		zAddResult, err := client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
+		zAddResult, err := client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0, "d": 4.0})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), zAddResult)
 
 		// min > max
 		zRemRangeByLexResult, err := client.ZRemRangeByLex(
+			context.Background(),
 			key1,
 			*options.NewRangeByLexQuery(options.NewLexBoundary("d", false), options.NewLexBoundary("a", false)),
 		)
@@ -6732,6 +6996,7 @@ func (suite *GlideTestSuite) TestZRemRangeByLex() {
 
 		// Remove members with lexicographical range
 		zRemRangeByLexResult, err = client.ZRemRangeByLex(
+			context.Background(),
 			key1,
 			*options.NewRangeByLexQuery(options.NewLexBoundary("a", false), options.NewLexBoundary("c", true)),
 		)
@@ -6739,6 +7004,7 @@ func (suite *GlideTestSuite) TestZRemRangeByLex() {
 		assert.Equal(suite.T(), int64(2), zRemRangeByLexResult)
 
 		zRemRangeByLexResult, err = client.ZRemRangeByLex(
+			context.Background(),
 			key1,
 			*options.NewRangeByLexQuery(options.NewLexBoundary("d", true), options.NewInfiniteLexBoundary(options.PositiveInfinity)),
 		)
@@ -6747,6 +7013,7 @@ func (suite *GlideTestSuite) TestZRemRangeByLex() {
 
 		// Non-existing key
 		zRemRangeByLexResult, err = client.ZRemRangeByLex(
+			context.Background(),
 			"non_existing_key",
 			*options.NewRangeByLexQuery(options.NewLexBoundary("a", false), options.NewLexBoundary("c", false)),
 		)
@@ -6754,11 +7021,12 @@ func (suite *GlideTestSuite) TestZRemRangeByLex() {
 		assert.Equal(suite.T(), int64(0), zRemRangeByLexResult)
 
 		// Key exists, but it is not a set
-		setResult, err := client.Set(stringKey, "test")
+		setResult, err := client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "OK", setResult)
 
 		_, err = client.ZRemRangeByLex(
+			context.Background(),
 			stringKey,
 			*options.NewRangeByLexQuery(options.NewLexBoundary("a", false), options.NewLexBoundary("c", false)),
 		)
@@ -6773,12 +7041,17 @@ func (suite *GlideTestSuite) TestZRemRangeByScore() {
 		stringKey := uuid.New().String()
 
 		// Add members to the set
-		zAddResult, err := client.ZAdd(key1, map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0})
+		zAddResult, err := client.ZAdd(
+			context.Background(),
+			key1,
+			map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0, "four": 4.0},
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), zAddResult)
 
 		// min > max
 		zRemRangeByScoreResult, err := client.ZRemRangeByScore(
+			context.Background(),
 			key1,
 			*options.NewRangeByScoreQuery(options.NewScoreBoundary(2.0, false), options.NewScoreBoundary(1.0, false)),
 		)
@@ -6787,6 +7060,7 @@ func (suite *GlideTestSuite) TestZRemRangeByScore() {
 
 		// Remove members with score range
 		zRemRangeByScoreResult, err = client.ZRemRangeByScore(
+			context.Background(),
 			key1,
 			*options.NewRangeByScoreQuery(options.NewScoreBoundary(1.0, false), options.NewScoreBoundary(3.0, true)),
 		)
@@ -6795,6 +7069,7 @@ func (suite *GlideTestSuite) TestZRemRangeByScore() {
 
 		// Remove all members
 		zRemRangeByScoreResult, err = client.ZRemRangeByScore(
+			context.Background(),
 			key1,
 			*options.NewRangeByScoreQuery(options.NewScoreBoundary(1.0, false), options.NewScoreBoundary(10.0, true)),
 		)
@@ -6803,6 +7078,7 @@ func (suite *GlideTestSuite) TestZRemRangeByScore() {
 
 		// Non-existing key
 		zRemRangeByScoreResult, err = client.ZRemRangeByScore(
+			context.Background(),
 			"non_existing_key",
 			*options.NewRangeByScoreQuery(options.NewScoreBoundary(1.0, false), options.NewScoreBoundary(10.0, true)),
 		)
@@ -6810,11 +7086,12 @@ func (suite *GlideTestSuite) TestZRemRangeByScore() {
 		assert.Equal(suite.T(), int64(0), zRemRangeByScoreResult)
 
 		// Key exists, but it is not a set
-		setResult, err := client.Set(stringKey, "test")
+		setResult, err := client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "OK", setResult)
 
 		_, err = client.ZRemRangeByScore(
+			context.Background(),
 			stringKey,
 			*options.NewRangeByScoreQuery(options.NewScoreBoundary(1.0, false), options.NewScoreBoundary(10.0, true)),
 		)
@@ -6828,11 +7105,11 @@ func (suite *GlideTestSuite) TestZMScore() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.NewString()
 
-		zAddResult, err := client.ZAdd(key, map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
+		zAddResult, err := client.ZAdd(context.Background(), key, map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zAddResult)
 
-		res, err := client.ZMScore(key, []string{"one", "three", "two"})
+		res, err := client.ZMScore(context.Background(), key, []string{"one", "three", "two"})
 		expected := []api.Result[float64]{
 			api.CreateFloat64Result(1),
 			api.CreateFloat64Result(3),
@@ -6842,7 +7119,7 @@ func (suite *GlideTestSuite) TestZMScore() {
 		assert.Equal(suite.T(), expected, res)
 
 		// not existing members
-		res, err = client.ZMScore(key, []string{"nonExistingMember", "two", "nonExistingMember"})
+		res, err = client.ZMScore(context.Background(), key, []string{"nonExistingMember", "two", "nonExistingMember"})
 		expected = []api.Result[float64]{
 			api.CreateNilFloat64Result(),
 			api.CreateFloat64Result(2),
@@ -6852,7 +7129,7 @@ func (suite *GlideTestSuite) TestZMScore() {
 		assert.Equal(suite.T(), expected, res)
 
 		// not existing key
-		res, err = client.ZMScore(uuid.NewString(), []string{"one", "three", "two"})
+		res, err = client.ZMScore(context.Background(), uuid.NewString(), []string{"one", "three", "two"})
 		expected = []api.Result[float64]{
 			api.CreateNilFloat64Result(),
 			api.CreateNilFloat64Result(),
@@ -6862,13 +7139,13 @@ func (suite *GlideTestSuite) TestZMScore() {
 		assert.Equal(suite.T(), expected, res)
 
 		// invalid arg - member list must not be empty
-		_, err = client.ZMScore(key, []string{})
+		_, err = client.ZMScore(context.Background(), key, []string{})
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// key exists, but it is not a sorted set
 		key2 := uuid.NewString()
-		suite.verifyOK(client.Set(key2, "ZMScore"))
-		_, err = client.ZMScore(key2, []string{"one"})
+		suite.verifyOK(client.Set(context.Background(), key2, "ZMScore"))
+		_, err = client.ZMScore(context.Background(), key2, []string{"one"})
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
 }
@@ -6880,33 +7157,33 @@ func (suite *GlideTestSuite) TestZRandMember() {
 		key2 := uuid.NewString()
 		members := []string{"one", "two"}
 
-		zadd, err := client.ZAdd(key1, map[string]float64{"one": 1.0, "two": 2.0})
+		zadd, err := client.ZAdd(context.Background(), key1, map[string]float64{"one": 1.0, "two": 2.0})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), zadd)
 
-		randomMember, err := client.ZRandMember(key1)
+		randomMember, err := client.ZRandMember(context.Background(), key1)
 		assert.NoError(t, err)
 		assert.Contains(t, members, randomMember.Value())
 
 		// unique values are expected as count is positive
-		randomMembers, err := client.ZRandMemberWithCount(key1, 4)
+		randomMembers, err := client.ZRandMemberWithCount(context.Background(), key1, 4)
 		assert.NoError(t, err)
 		assert.ElementsMatch(t, members, randomMembers)
 
-		membersAndScores, err := client.ZRandMemberWithCountWithScores(key1, 4)
+		membersAndScores, err := client.ZRandMemberWithCountWithScores(context.Background(), key1, 4)
 		expectedMembersAndScores := []api.MemberAndScore{{Member: "one", Score: 1}, {Member: "two", Score: 2}}
 		assert.NoError(t, err)
 		assert.ElementsMatch(t, expectedMembersAndScores, membersAndScores)
 
 		// Duplicate values are expected as count is negative
-		randomMembers, err = client.ZRandMemberWithCount(key1, -4)
+		randomMembers, err = client.ZRandMemberWithCount(context.Background(), key1, -4)
 		assert.NoError(t, err)
 		assert.Len(t, randomMembers, 4)
 		for _, member := range randomMembers {
 			assert.Contains(t, members, member)
 		}
 
-		membersAndScores, err = client.ZRandMemberWithCountWithScores(key1, -4)
+		membersAndScores, err = client.ZRandMemberWithCountWithScores(context.Background(), key1, -4)
 		assert.NoError(t, err)
 		assert.Len(t, membersAndScores, 4)
 		for _, memberAndScore := range membersAndScores {
@@ -6914,23 +7191,23 @@ func (suite *GlideTestSuite) TestZRandMember() {
 		}
 
 		// non existing key should return null or empty array
-		randomMember, err = client.ZRandMember(key2)
+		randomMember, err = client.ZRandMember(context.Background(), key2)
 		assert.NoError(t, err)
 		assert.True(t, randomMember.IsNil())
-		randomMembers, err = client.ZRandMemberWithCount(key2, -4)
+		randomMembers, err = client.ZRandMemberWithCount(context.Background(), key2, -4)
 		assert.NoError(t, err)
 		assert.Len(t, randomMembers, 0)
-		membersAndScores, err = client.ZRandMemberWithCountWithScores(key2, -4)
+		membersAndScores, err = client.ZRandMemberWithCountWithScores(context.Background(), key2, -4)
 		assert.NoError(t, err)
 		assert.Len(t, membersAndScores, 0)
 
 		// Key exists, but is not a set
-		suite.verifyOK(client.Set(key2, "ZRandMember"))
-		_, err = client.ZRandMember(key2)
+		suite.verifyOK(client.Set(context.Background(), key2, "ZRandMember"))
+		_, err = client.ZRandMember(context.Background(), key2)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
-		_, err = client.ZRandMemberWithCount(key2, 2)
+		_, err = client.ZRandMemberWithCount(context.Background(), key2, 2)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
-		_, err = client.ZRandMemberWithCountWithScores(key2, 2)
+		_, err = client.ZRandMemberWithCountWithScores(context.Background(), key2, 2)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
 }
@@ -6942,19 +7219,19 @@ func (suite *GlideTestSuite) TestObjectIdleTime() {
 		value := "hello"
 		sleepSec := int64(5)
 		t := suite.T()
-		suite.verifyOK(defaultClient.Set(key, value))
+		suite.verifyOK(defaultClient.Set(context.Background(), key, value))
 		keyValueMap := map[string]string{
 			"maxmemory-policy": "noeviction",
 		}
-		suite.verifyOK(defaultClient.ConfigSet(keyValueMap))
-		resultConfig, err := defaultClient.ConfigGet([]string{"maxmemory-policy"})
+		suite.verifyOK(defaultClient.ConfigSet(context.Background(), keyValueMap))
+		resultConfig, err := defaultClient.ConfigGet(context.Background(), []string{"maxmemory-policy"})
 		assert.Nil(t, err, "Failed to get configuration")
 		assert.Equal(t, keyValueMap, resultConfig, "Configuration mismatch for maxmemory-policy")
-		resultGet, err := defaultClient.Get(key)
+		resultGet, err := defaultClient.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet.Value())
 		time.Sleep(time.Duration(sleepSec) * time.Second)
-		resultIdleTime, err := defaultClient.ObjectIdleTime(key)
+		resultIdleTime, err := defaultClient.ObjectIdleTime(context.Background(), key)
 		assert.Nil(t, err)
 		assert.GreaterOrEqual(t, resultIdleTime.Value(), sleepSec-1)
 	})
@@ -6965,11 +7242,11 @@ func (suite *GlideTestSuite) TestObjectRefCount() {
 		key := "testKey1_" + uuid.New().String()
 		value := "hello"
 		t := suite.T()
-		suite.verifyOK(client.Set(key, value))
-		resultGetRestoreKey, err := client.Get(key)
+		suite.verifyOK(client.Set(context.Background(), key, value))
+		resultGetRestoreKey, err := client.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGetRestoreKey.Value())
-		resultObjectRefCount, err := client.ObjectRefCount(key)
+		resultObjectRefCount, err := client.ObjectRefCount(context.Background(), key)
 		assert.Nil(t, err)
 		assert.GreaterOrEqual(t, resultObjectRefCount.Value(), int64(1))
 	})
@@ -6981,23 +7258,23 @@ func (suite *GlideTestSuite) TestObjectFreq() {
 		key := "testKey1_" + uuid.New().String()
 		value := "hello"
 		t := suite.T()
-		suite.verifyOK(defaultClient.Set(key, value))
+		suite.verifyOK(defaultClient.Set(context.Background(), key, value))
 		keyValueMap := map[string]string{
 			"maxmemory-policy": "volatile-lfu",
 		}
-		suite.verifyOK(defaultClient.ConfigSet(keyValueMap))
-		resultConfig, err := defaultClient.ConfigGet([]string{"maxmemory-policy"})
+		suite.verifyOK(defaultClient.ConfigSet(context.Background(), keyValueMap))
+		resultConfig, err := defaultClient.ConfigGet(context.Background(), []string{"maxmemory-policy"})
 		assert.Nil(t, err, "Failed to get configuration")
 		assert.Equal(t, keyValueMap, resultConfig, "Configuration mismatch for maxmemory-policy")
 		sleepSec := int64(5)
 		time.Sleep(time.Duration(sleepSec) * time.Second)
-		resultGet, err := defaultClient.Get(key)
+		resultGet, err := defaultClient.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet.Value())
-		resultGet2, err := defaultClient.Get(key)
+		resultGet2, err := defaultClient.Get(context.Background(), key)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet2.Value())
-		resultObjFreq, err := defaultClient.ObjectFreq(key)
+		resultObjFreq, err := defaultClient.ObjectFreq(context.Background(), key)
 		assert.Nil(t, err)
 		assert.GreaterOrEqual(t, resultObjFreq.Value(), int64(2))
 	})
@@ -7007,18 +7284,18 @@ func (suite *GlideTestSuite) TestSortWithOptions_ExternalWeights() {
 	suite.SkipIfServerVersionLowerThanBy("8.1.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"item1", "item2", "item3"})
+		client.LPush(context.Background(), key, []string{"item1", "item2", "item3"})
 
-		client.Set("weight_item1", "3")
-		client.Set("weight_item2", "1")
-		client.Set("weight_item3", "2")
+		client.Set(context.Background(), "weight_item1", "3")
+		client.Set(context.Background(), "weight_item2", "1")
+		client.Set(context.Background(), "weight_item3", "2")
 
 		options := options.NewSortOptions().
 			SetByPattern("weight_*").
 			SetOrderBy(options.ASC).
 			SetIsAlpha(false)
 
-		sortResult, err := client.SortWithOptions(key, *options)
+		sortResult, err := client.SortWithOptions(context.Background(), key, *options)
 
 		assert.Nil(suite.T(), err)
 		resultList := []api.Result[string]{
@@ -7035,11 +7312,11 @@ func (suite *GlideTestSuite) TestSortWithOptions_GetPatterns() {
 	suite.SkipIfServerVersionLowerThanBy("8.1.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"item1", "item2", "item3"})
+		client.LPush(context.Background(), key, []string{"item1", "item2", "item3"})
 
-		client.Set("object_item1", "Object_1")
-		client.Set("object_item2", "Object_2")
-		client.Set("object_item3", "Object_3")
+		client.Set(context.Background(), "object_item1", "Object_1")
+		client.Set(context.Background(), "object_item2", "Object_2")
+		client.Set(context.Background(), "object_item3", "Object_3")
 
 		options := options.NewSortOptions().
 			SetByPattern("weight_*").
@@ -7047,7 +7324,7 @@ func (suite *GlideTestSuite) TestSortWithOptions_GetPatterns() {
 			SetIsAlpha(false).
 			AddGetPattern("object_*")
 
-		sortResult, err := client.SortWithOptions(key, *options)
+		sortResult, err := client.SortWithOptions(context.Background(), key, *options)
 
 		assert.Nil(suite.T(), err)
 
@@ -7065,15 +7342,15 @@ func (suite *GlideTestSuite) TestSortWithOptions_SuccessfulSortByWeightAndGet()
 	suite.SkipIfServerVersionLowerThanBy("8.1.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.LPush(key, []string{"item1", "item2", "item3"})
+		client.LPush(context.Background(), key, []string{"item1", "item2", "item3"})
 
-		client.Set("weight_item1", "10")
-		client.Set("weight_item2", "5")
-		client.Set("weight_item3", "15")
+		client.Set(context.Background(), "weight_item1", "10")
+		client.Set(context.Background(), "weight_item2", "5")
+		client.Set(context.Background(), "weight_item3", "15")
 
-		client.Set("object_item1", "Object 1")
-		client.Set("object_item2", "Object 2")
-		client.Set("object_item3", "Object 3")
+		client.Set(context.Background(), "object_item1", "Object 1")
+		client.Set(context.Background(), "object_item2", "Object 2")
+		client.Set(context.Background(), "object_item3", "Object 3")
 
 		options := options.NewSortOptions().
 			SetOrderBy(options.ASC).
@@ -7082,7 +7359,7 @@ func (suite *GlideTestSuite) TestSortWithOptions_SuccessfulSortByWeightAndGet()
 			AddGetPattern("object_*").
 			AddGetPattern("#")
 
-		sortResult, err := client.SortWithOptions(key, *options)
+		sortResult, err := client.SortWithOptions(context.Background(), key, *options)
 
 		assert.Nil(suite.T(), err)
 
@@ -7097,15 +7374,15 @@ func (suite *GlideTestSuite) TestSortWithOptions_SuccessfulSortByWeightAndGet()
 
 		assert.Equal(suite.T(), resultList, sortResult)
 
-		objectItem2, err := client.Get("object_item2")
+		objectItem2, err := client.Get(context.Background(), "object_item2")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Object 2", objectItem2.Value())
 
-		objectItem1, err := client.Get("object_item1")
+		objectItem1, err := client.Get(context.Background(), "object_item1")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Object 1", objectItem1.Value())
 
-		objectItem3, err := client.Get("object_item3")
+		objectItem3, err := client.Get(context.Background(), "object_item3")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Object 3", objectItem3.Value())
 
@@ -7120,22 +7397,22 @@ func (suite *GlideTestSuite) TestSortStoreWithOptions_ByPattern() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := "{listKey}" + uuid.New().String()
 		sortedKey := "{listKey}" + uuid.New().String()
-		client.LPush(key, []string{"a", "b", "c", "d", "e"})
-		client.Set("{listKey}weight_a", "5")
-		client.Set("{listKey}weight_b", "2")
-		client.Set("{listKey}weight_c", "3")
-		client.Set("{listKey}weight_d", "1")
-		client.Set("{listKey}weight_e", "4")
+		client.LPush(context.Background(), key, []string{"a", "b", "c", "d", "e"})
+		client.Set(context.Background(), "{listKey}weight_a", "5")
+		client.Set(context.Background(), "{listKey}weight_b", "2")
+		client.Set(context.Background(), "{listKey}weight_c", "3")
+		client.Set(context.Background(), "{listKey}weight_d", "1")
+		client.Set(context.Background(), "{listKey}weight_e", "4")
 
 		options := options.NewSortOptions().SetByPattern("{listKey}weight_*")
 
-		result, err := client.SortStoreWithOptions(key, sortedKey, *options)
+		result, err := client.SortStoreWithOptions(context.Background(), key, sortedKey, *options)
 
 		assert.Nil(suite.T(), err)
 		assert.NotNil(suite.T(), result)
 		assert.Equal(suite.T(), int64(5), result)
 
-		sortedValues, err := client.LRange(sortedKey, 0, -1)
+		sortedValues, err := client.LRange(context.Background(), sortedKey, 0, -1)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []string{"d", "b", "c", "e", "a"}, sortedValues)
 	})
@@ -7155,49 +7432,49 @@ func (suite *GlideTestSuite) TestXGroupStreamCommands() {
 			[]string{"xgroup", "create", key, groupName, zeroStreamId, "MKSTREAM"},
 			"Can't send XGROUP CREATE as a custom command",
 		)
-		respBool, err := client.XGroupCreateConsumer(key, groupName, consumerName)
+		respBool, err := client.XGroupCreateConsumer(context.Background(), key, groupName, consumerName)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), respBool)
 
 		// create a consumer for a group that doesn't exist should result in a NOGROUP error
-		_, err = client.XGroupCreateConsumer(key, "non-existent-group", consumerName)
+		_, err = client.XGroupCreateConsumer(context.Background(), key, "non-existent-group", consumerName)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 		assert.True(suite.T(), strings.Contains(err.Error(), "NOGROUP"))
 
 		// create consumer that already exists should return false
-		respBool, err = client.XGroupCreateConsumer(key, groupName, consumerName)
+		respBool, err = client.XGroupCreateConsumer(context.Background(), key, groupName, consumerName)
 		assert.NoError(suite.T(), err)
 		assert.False(suite.T(), respBool)
 
 		// Delete a consumer that hasn't been created should return 0
-		respInt64, err := client.XGroupDelConsumer(key, groupName, "non-existent-consumer")
+		respInt64, err := client.XGroupDelConsumer(context.Background(), key, groupName, "non-existent-consumer")
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), respInt64)
 
 		// Add two stream entries
-		streamId1, err := client.XAdd(key, [][]string{{"field1", "value1"}})
+		streamId1, err := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 		assert.NoError(suite.T(), err)
-		streamId2, err := client.XAdd(key, [][]string{{"field2", "value2"}})
+		streamId2, err := client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 		assert.NoError(suite.T(), err)
 
 		// read the stream for the consumer and mark messages as pending
 		expectedGroup := map[string]map[string][][]string{
 			key: {streamId1.Value(): {{"field1", "value1"}}, streamId2.Value(): {{"field2", "value2"}}},
 		}
-		actualGroup, err := client.XReadGroup(groupName, consumerName, map[string]string{key: ">"})
+		actualGroup, err := client.XReadGroup(context.Background(), groupName, consumerName, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), reflect.DeepEqual(expectedGroup, actualGroup),
 			"Expected and actual results do not match",
 		)
 
 		// delete one of the streams using XDel
-		respInt64, err = client.XDel(key, []string{streamId1.Value()})
+		respInt64, err = client.XDel(context.Background(), key, []string{streamId1.Value()})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), respInt64)
 
 		// xreadgroup should return one empty stream and one non-empty stream
-		resp, err := client.XReadGroup(groupName, consumerName, map[string]string{key: zeroStreamId})
+		resp, err := client.XReadGroup(context.Background(), groupName, consumerName, map[string]string{key: zeroStreamId})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]map[string][][]string{
 			key: {
@@ -7207,48 +7484,48 @@ func (suite *GlideTestSuite) TestXGroupStreamCommands() {
 		}, resp)
 
 		// add a new stream entry
-		streamId3, err := client.XAdd(key, [][]string{{"field3", "value3"}})
+		streamId3, err := client.XAdd(context.Background(), key, [][]string{{"field3", "value3"}})
 		assert.NoError(suite.T(), err)
 		assert.NotNil(suite.T(), streamId3)
 
 		// xack that streamid1 and streamid2 have been processed
-		xackResult, err := client.XAck(key, groupName, []string{streamId1.Value(), streamId2.Value()})
+		xackResult, err := client.XAck(context.Background(), key, groupName, []string{streamId1.Value(), streamId2.Value()})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), xackResult)
 
 		// Delete the consumer group and expect 0 pending messages
-		respInt64, err = client.XGroupDelConsumer(key, groupName, consumerName)
+		respInt64, err = client.XGroupDelConsumer(context.Background(), key, groupName, consumerName)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), respInt64)
 
 		// xack streamid_1, and streamid_2 already received returns 0L
-		xackResult, err = client.XAck(key, groupName, []string{streamId1.Value(), streamId2.Value()})
+		xackResult, err = client.XAck(context.Background(), key, groupName, []string{streamId1.Value(), streamId2.Value()})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), xackResult)
 
 		// Consume the last message with the previously deleted consumer (creates the consumer anew)
-		resp, err = client.XReadGroup(groupName, consumerName, map[string]string{key: ">"})
+		resp, err = client.XReadGroup(context.Background(), groupName, consumerName, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), 1, len(resp[key]))
 
 		// Use non existent group, so xack streamid_3 returns 0
-		xackResult, err = client.XAck(key, "non-existent-group", []string{streamId3.Value()})
+		xackResult, err = client.XAck(context.Background(), key, "non-existent-group", []string{streamId3.Value()})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), xackResult)
 
 		// Delete the consumer group and expect 1 pending message
-		respInt64, err = client.XGroupDelConsumer(key, groupName, consumerName)
+		respInt64, err = client.XGroupDelConsumer(context.Background(), key, groupName, consumerName)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), respInt64)
 
 		// Set a string key, and expect an error when you try to create or delete a consumer group
-		_, err = client.Set(stringKey, "test")
+		_, err = client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
-		_, err = client.XGroupCreateConsumer(stringKey, groupName, consumerName)
+		_, err = client.XGroupCreateConsumer(context.Background(), stringKey, groupName, consumerName)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		_, err = client.XGroupDelConsumer(stringKey, groupName, consumerName)
+		_, err = client.XGroupDelConsumer(context.Background(), stringKey, groupName, consumerName)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -7260,16 +7537,21 @@ func (suite *GlideTestSuite) TestXInfoStream() {
 		group := uuid.NewString()
 		consumer := uuid.NewString()
 
-		xadd, err := client.XAddWithOptions(key, [][]string{{"a", "b"}, {"c", "d"}}, *options.NewXAddOptions().SetId("1-0"))
+		xadd, err := client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{"a", "b"}, {"c", "d"}},
+			*options.NewXAddOptions().SetId("1-0"),
+		)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "1-0", xadd.Value())
 
-		suite.verifyOK(client.XGroupCreate(key, group, "0-0"))
+		suite.verifyOK(client.XGroupCreate(context.Background(), key, group, "0-0"))
 
-		_, err = client.XReadGroup(group, consumer, map[string]string{key: ">"})
+		_, err = client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 
-		infoSmall, err := client.XInfoStream(key)
+		infoSmall, err := client.XInfoStream(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), infoSmall["length"])
 		assert.Equal(suite.T(), int64(1), infoSmall["groups"])
@@ -7277,11 +7559,20 @@ func (suite *GlideTestSuite) TestXInfoStream() {
 		assert.Equal(suite.T(), expectedEntry, infoSmall["first-entry"])
 		assert.Equal(suite.T(), expectedEntry, infoSmall["last-entry"])
 
-		xadd, err = client.XAddWithOptions(key, [][]string{{"e", "f"}}, *options.NewXAddOptions().SetId("1-1"))
+		xadd, err = client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{"e", "f"}},
+			*options.NewXAddOptions().SetId("1-1"),
+		)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "1-1", xadd.Value())
 
-		infoFull, err := client.XInfoStreamFullWithOptions(key, options.NewXInfoStreamOptionsOptions().SetCount(1))
+		infoFull, err := client.XInfoStreamFullWithOptions(
+			context.Background(),
+			key,
+			options.NewXInfoStreamOptionsOptions().SetCount(1),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), infoFull["length"])
 
@@ -7313,6 +7604,7 @@ func (suite *GlideTestSuite) TestXInfoConsumers() {
 		consumer2 := uuid.NewString()
 
 		xadd, err := client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}},
 			*options.NewXAddOptions().SetId("0-1"),
@@ -7320,19 +7612,26 @@ func (suite *GlideTestSuite) TestXInfoConsumers() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-1", xadd.Value())
 		xadd, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}},
 			*options.NewXAddOptions().SetId("0-2"),
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-2", xadd.Value())
-		xadd, err = client.XAddWithOptions(key, [][]string{{"e3_f1", "e3_v1"}}, *options.NewXAddOptions().SetId("0-3"))
+		xadd, err = client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{"e3_f1", "e3_v1"}},
+			*options.NewXAddOptions().SetId("0-3"),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-3", xadd.Value())
 
-		suite.verifyOK(client.XGroupCreate(key, group, "0-0"))
+		suite.verifyOK(client.XGroupCreate(context.Background(), key, group, "0-0"))
 
 		xReadGroup, err := client.XReadGroupWithOptions(
+			context.Background(),
 			group,
 			consumer1,
 			map[string]string{key: ">"},
@@ -7348,7 +7647,7 @@ func (suite *GlideTestSuite) TestXInfoConsumers() {
 
 		// Sleep to ensure the idle time value and inactive time value returned by xinfo_consumers is > 0
 		time.Sleep(2000 * time.Millisecond)
-		info, err := client.XInfoConsumers(key, group)
+		info, err := client.XInfoConsumers(context.Background(), key, group)
 		assert.NoError(suite.T(), err)
 		assert.Len(suite.T(), info, 1)
 		assert.Equal(suite.T(), consumer1, info[0].Name)
@@ -7361,11 +7660,11 @@ func (suite *GlideTestSuite) TestXInfoConsumers() {
 			assert.True(suite.T(), info[0].Inactive.IsNil())
 		}
 
-		respBool, err := client.XGroupCreateConsumer(key, group, consumer2)
+		respBool, err := client.XGroupCreateConsumer(context.Background(), key, group, consumer2)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), respBool)
 
-		xReadGroup, err = client.XReadGroup(group, consumer2, map[string]string{key: ">"})
+		xReadGroup, err = client.XReadGroup(context.Background(), group, consumer2, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		expectedResult = map[string]map[string][][]string{
 			key: {
@@ -7376,30 +7675,30 @@ func (suite *GlideTestSuite) TestXInfoConsumers() {
 		assert.Equal(suite.T(), expectedResult, xReadGroup)
 
 		// Verify that xinfo_consumers contains info for 2 consumers now
-		info, err = client.XInfoConsumers(key, group)
+		info, err = client.XInfoConsumers(context.Background(), key, group)
 		assert.NoError(suite.T(), err)
 		assert.Len(suite.T(), info, 2)
 
 		// Passing a non-existing key raises an error
 		key = uuid.NewString()
-		_, err = client.XInfoConsumers(key, "_")
+		_, err = client.XInfoConsumers(context.Background(), key, "_")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// key exists, but it is not a stream
-		suite.verifyOK(client.Set(key, key))
-		_, err = client.XInfoConsumers(key, "_")
+		suite.verifyOK(client.Set(context.Background(), key, key))
+		_, err = client.XInfoConsumers(context.Background(), key, "_")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Passing a non-existing group raises an error
 		key = uuid.NewString()
-		_, err = client.XAdd(key, [][]string{{"a", "b"}})
+		_, err = client.XAdd(context.Background(), key, [][]string{{"a", "b"}})
 		assert.NoError(suite.T(), err)
-		_, err = client.XInfoConsumers(key, "_")
+		_, err = client.XInfoConsumers(context.Background(), key, "_")
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// no consumers yet
-		suite.verifyOK(client.XGroupCreate(key, group, "0-0"))
-		info, err = client.XInfoConsumers(key, group)
+		suite.verifyOK(client.XGroupCreate(context.Background(), key, group, "0-0"))
+		info, err = client.XInfoConsumers(context.Background(), key, group)
 		assert.NoError(suite.T(), err)
 		assert.Empty(suite.T(), info)
 	})
@@ -7411,10 +7710,18 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 		group := uuid.NewString()
 		consumer := uuid.NewString()
 
-		suite.verifyOK(client.XGroupCreateWithOptions(key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream()))
+		suite.verifyOK(
+			client.XGroupCreateWithOptions(
+				context.Background(),
+				key,
+				group,
+				"0-0",
+				*options.NewXGroupCreateOptions().SetMakeStream(),
+			),
+		)
 
 		// one empty group exists
-		xinfo, err := client.XInfoGroups(key)
+		xinfo, err := client.XInfoGroups(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		if suite.serverVersion < "7.0.0" {
 			assert.Equal(suite.T(), []api.XInfoGroupInfo{
@@ -7441,6 +7748,7 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 		}
 
 		xadd, err := client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"e1_f1", "e1_v1"}, {"e1_f2", "e1_v2"}},
 			*options.NewXAddOptions().SetId("0-1"),
@@ -7448,18 +7756,24 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-1", xadd.Value())
 		xadd, err = client.XAddWithOptions(
+			context.Background(),
 			key,
 			[][]string{{"e2_f1", "e2_v1"}, {"e2_f2", "e2_v2"}},
 			*options.NewXAddOptions().SetId("0-2"),
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-2", xadd.Value())
-		xadd, err = client.XAddWithOptions(key, [][]string{{"e3_f1", "e3_v1"}}, *options.NewXAddOptions().SetId("0-3"))
+		xadd, err = client.XAddWithOptions(
+			context.Background(),
+			key,
+			[][]string{{"e3_f1", "e3_v1"}},
+			*options.NewXAddOptions().SetId("0-3"),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "0-3", xadd.Value())
 
 		// same as previous check, bug lag = 3, there are 3 messages unread
-		xinfo, err = client.XInfoGroups(key)
+		xinfo, err = client.XInfoGroups(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		if suite.serverVersion < "7.0.0" {
 			assert.Equal(suite.T(), []api.XInfoGroupInfo{
@@ -7485,7 +7799,7 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 			}, xinfo)
 		}
 
-		xReadGroup, err := client.XReadGroup(group, consumer, map[string]string{key: ">"})
+		xReadGroup, err := client.XReadGroup(context.Background(), group, consumer, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		expectedResult := map[string]map[string][][]string{
 			key: {
@@ -7497,7 +7811,7 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 		assert.Equal(suite.T(), expectedResult, xReadGroup)
 
 		// after reading, `lag` is reset, and `pending`, consumer count and last ID are set
-		xinfo, err = client.XInfoGroups(key)
+		xinfo, err = client.XInfoGroups(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		if suite.serverVersion < "7.0.0" {
 			assert.Equal(suite.T(), []api.XInfoGroupInfo{
@@ -7523,12 +7837,12 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 			}, xinfo)
 		}
 
-		xack, err := client.XAck(key, group, []string{"0-1"})
+		xack, err := client.XAck(context.Background(), key, group, []string{"0-1"})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), xack)
 
 		// once message ack'ed, pending counter decreased
-		xinfo, err = client.XInfoGroups(key)
+		xinfo, err = client.XInfoGroups(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		if suite.serverVersion < "7.0.0" {
 			assert.Equal(suite.T(), []api.XInfoGroupInfo{
@@ -7556,20 +7870,20 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 
 		// Passing a non-existing key raises an error
 		key = uuid.NewString()
-		_, err = client.XInfoGroups(key)
+		_, err = client.XInfoGroups(context.Background(), key)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// key exists, but it is not a stream
-		suite.verifyOK(client.Set(key, key))
-		_, err = client.XInfoGroups(key)
+		suite.verifyOK(client.Set(context.Background(), key, key))
+		_, err = client.XInfoGroups(context.Background(), key)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// create a second stream
 		key = uuid.NewString()
-		_, err = client.XAdd(key, [][]string{{"1", "2"}})
+		_, err = client.XAdd(context.Background(), key, [][]string{{"1", "2"}})
 		assert.NoError(suite.T(), err)
 		// no group yet exists
-		xinfo, err = client.XInfoGroups(key)
+		xinfo, err = client.XInfoGroups(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Empty(suite.T(), xinfo)
 	})
@@ -7579,11 +7893,11 @@ func (suite *GlideTestSuite) TestSetBit_SetSingleBit() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		var resultInt64 int64
-		resultInt64, err := client.SetBit(key, 7, 1)
+		resultInt64, err := client.SetBit(context.Background(), key, 7, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultInt64)
 
-		result, err := client.Get(key)
+		result, err := client.Get(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Contains(suite.T(), result.Value(), "\x01")
 	})
@@ -7593,11 +7907,11 @@ func (suite *GlideTestSuite) TestSetBit_SetAndCheckPreviousBit() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		var resultInt64 int64
-		resultInt64, err := client.SetBit(key, 7, 1)
+		resultInt64, err := client.SetBit(context.Background(), key, 7, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultInt64)
 
-		resultInt64, err = client.SetBit(key, 7, 0)
+		resultInt64, err = client.SetBit(context.Background(), key, 7, 0)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), resultInt64)
 	})
@@ -7608,15 +7922,15 @@ func (suite *GlideTestSuite) TestSetBit_SetMultipleBits() {
 		key := uuid.New().String()
 		var resultInt64 int64
 
-		resultInt64, err := client.SetBit(key, 3, 1)
+		resultInt64, err := client.SetBit(context.Background(), key, 3, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultInt64)
 
-		resultInt64, err = client.SetBit(key, 5, 1)
+		resultInt64, err = client.SetBit(context.Background(), key, 5, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), resultInt64)
 
-		result, err := client.Get(key)
+		result, err := client.Get(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		value := result.Value()
 
@@ -7629,14 +7943,14 @@ func (suite *GlideTestSuite) TestSetBit_SetMultipleBits() {
 func (suite *GlideTestSuite) TestWait() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "test")
+		client.Set(context.Background(), key, "test")
 		// Test 1:  numberOfReplicas (2)
-		resultInt64, err := client.Wait(2, 2000)
+		resultInt64, err := client.Wait(context.Background(), 2, 2000)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), resultInt64 >= 2)
 
 		// Test 2: Invalid timeout (negative)
-		_, err = client.Wait(2, -1)
+		_, err = client.Wait(context.Background(), 2, -1)
 
 		// Assert error and message for invalid timeout
 		assert.NotNil(suite.T(), err)
@@ -7649,9 +7963,9 @@ func (suite *GlideTestSuite) TestGetBit_ExistingKey_ValidOffset() {
 		offset := int64(7)
 		value := int64(1)
 
-		client.SetBit(key, offset, value)
+		client.SetBit(context.Background(), key, offset, value)
 
-		result, err := client.GetBit(key, offset)
+		result, err := client.GetBit(context.Background(), key, offset)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), value, result)
 	})
@@ -7662,7 +7976,7 @@ func (suite *GlideTestSuite) TestGetBit_NonExistentKey() {
 		key := uuid.New().String()
 		offset := int64(10)
 
-		result, err := client.GetBit(key, offset)
+		result, err := client.GetBit(context.Background(), key, offset)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), result)
 	})
@@ -7673,7 +7987,7 @@ func (suite *GlideTestSuite) TestGetBit_InvalidOffset() {
 		key := uuid.New().String()
 		invalidOffset := int64(-1)
 
-		_, err := client.GetBit(key, invalidOffset)
+		_, err := client.GetBit(context.Background(), key, invalidOffset)
 		assert.NotNil(suite.T(), err)
 	})
 }
@@ -7682,10 +7996,10 @@ func (suite *GlideTestSuite) TestBitCount_ExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 		for i := int64(0); i < 8; i++ {
-			client.SetBit(key, i, 1)
+			client.SetBit(context.Background(), key, i, 1)
 		}
 
-		result, err := client.BitCount(key)
+		result, err := client.BitCount(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(8), result)
 	})
@@ -7695,7 +8009,7 @@ func (suite *GlideTestSuite) TestBitCount_ZeroBits() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
 
-		result, err := client.BitCount(key)
+		result, err := client.BitCount(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), result)
 	})
@@ -7706,13 +8020,13 @@ func (suite *GlideTestSuite) TestBitCountWithOptions_StartEnd() {
 		key := uuid.New().String()
 		value := "TestBitCountWithOptions_StartEnd"
 
-		client.Set(key, value)
+		client.Set(context.Background(), key, value)
 
 		opts := options.NewBitCountOptions().
 			SetStart(1).
 			SetEnd(5)
 
-		result, err := client.BitCountWithOptions(key, *opts)
+		result, err := client.BitCountWithOptions(context.Background(), key, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(19), result)
 	})
@@ -7724,14 +8038,14 @@ func (suite *GlideTestSuite) TestBitCountWithOptions_StartEndByte() {
 		key := uuid.New().String()
 		value := "TestBitCountWithOptions_StartEnd"
 
-		client.Set(key, value)
+		client.Set(context.Background(), key, value)
 
 		opts := options.NewBitCountOptions().
 			SetStart(1).
 			SetEnd(5).
 			SetBitmapIndexType(options.BYTE)
 
-		result, err := client.BitCountWithOptions(key, *opts)
+		result, err := client.BitCountWithOptions(context.Background(), key, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(19), result)
 	})
@@ -7743,14 +8057,14 @@ func (suite *GlideTestSuite) TestBitCountWithOptions_StartEndBit() {
 		key := uuid.New().String()
 		value := "TestBitCountWithOptions_StartEnd"
 
-		client.Set(key, value)
+		client.Set(context.Background(), key, value)
 
 		opts := options.NewBitCountOptions().
 			SetStart(1).
 			SetEnd(5).
 			SetBitmapIndexType(options.BIT)
 
-		result, err := client.BitCountWithOptions(key, *opts)
+		result, err := client.BitCountWithOptions(context.Background(), key, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), result)
 	})
@@ -7762,17 +8076,17 @@ func (suite *GlideTestSuite) TestBitOp_AND() {
 		bitopkey2 := "{bitop_test}" + uuid.New().String()
 		destKey := "{bitop_test}" + uuid.New().String()
 
-		_, err := client.Set(bitopkey1, "foobar")
+		_, err := client.Set(context.Background(), bitopkey1, "foobar")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.Set(bitopkey2, "abcdef")
+		_, err = client.Set(context.Background(), bitopkey2, "abcdef")
 		assert.NoError(suite.T(), err)
 
-		result, err := client.BitOp(options.AND, destKey, []string{bitopkey1, bitopkey2})
+		result, err := client.BitOp(context.Background(), options.AND, destKey, []string{bitopkey1, bitopkey2})
 		assert.NoError(suite.T(), err)
 		assert.GreaterOrEqual(suite.T(), result, int64(0))
 
-		bitResult, err := client.Get(destKey)
+		bitResult, err := client.Get(context.Background(), destKey)
 		assert.NoError(suite.T(), err)
 		assert.NotEmpty(suite.T(), bitResult.Value())
 	})
@@ -7784,17 +8098,17 @@ func (suite *GlideTestSuite) TestBitOp_OR() {
 		key2 := "{bitop_test}" + uuid.New().String()
 		destKey := "{bitop_test}" + uuid.New().String()
 
-		_, err := client.Set(key1, "foo")
+		_, err := client.Set(context.Background(), key1, "foo")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.Set(key2, "bar")
+		_, err = client.Set(context.Background(), key2, "bar")
 		assert.NoError(suite.T(), err)
 
-		result, err := client.BitOp(options.OR, destKey, []string{key1, key2})
+		result, err := client.BitOp(context.Background(), options.OR, destKey, []string{key1, key2})
 		assert.NoError(suite.T(), err)
 		assert.GreaterOrEqual(suite.T(), result, int64(0))
 
-		bitResult, err := client.Get(destKey)
+		bitResult, err := client.Get(context.Background(), destKey)
 		assert.NoError(suite.T(), err)
 		assert.NotEmpty(suite.T(), bitResult.Value())
 	})
@@ -7806,17 +8120,17 @@ func (suite *GlideTestSuite) TestBitOp_XOR() {
 		key2 := "{bitop_test}" + uuid.New().String()
 		destKey := "{bitop_test}" + uuid.New().String()
 
-		_, err := client.Set(key1, "foo")
+		_, err := client.Set(context.Background(), key1, "foo")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.Set(key2, "bar")
+		_, err = client.Set(context.Background(), key2, "bar")
 		assert.NoError(suite.T(), err)
 
-		result, err := client.BitOp(options.XOR, destKey, []string{key1, key2})
+		result, err := client.BitOp(context.Background(), options.XOR, destKey, []string{key1, key2})
 		assert.NoError(suite.T(), err)
 		assert.GreaterOrEqual(suite.T(), result, int64(0))
 
-		bitResult, err := client.Get(destKey)
+		bitResult, err := client.Get(context.Background(), destKey)
 		assert.NoError(suite.T(), err)
 		assert.NotEmpty(suite.T(), bitResult.Value())
 	})
@@ -7827,14 +8141,14 @@ func (suite *GlideTestSuite) TestBitOp_NOT() {
 		srcKey := "{bitop_test}" + uuid.New().String()
 		destKey := "{bitop_test}" + uuid.New().String()
 
-		_, err := client.Set(srcKey, "foobar")
+		_, err := client.Set(context.Background(), srcKey, "foobar")
 		assert.NoError(suite.T(), err)
 
-		result, err := client.BitOp(options.NOT, destKey, []string{srcKey})
+		result, err := client.BitOp(context.Background(), options.NOT, destKey, []string{srcKey})
 		assert.NoError(suite.T(), err)
 		assert.GreaterOrEqual(suite.T(), result, int64(0))
 
-		bitResult, err := client.Get(destKey)
+		bitResult, err := client.Get(context.Background(), destKey)
 		assert.NoError(suite.T(), err)
 		assert.NotEmpty(suite.T(), bitResult.Value())
 	})
@@ -7846,22 +8160,22 @@ func (suite *GlideTestSuite) TestBitOp_InvalidArguments() {
 		key1 := "{bitop_test}" + uuid.New().String()
 		key2 := "{bitop_test}" + uuid.New().String()
 
-		_, err := client.Set(key1, "foo")
+		_, err := client.Set(context.Background(), key1, "foo")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.Set(key2, "bar")
+		_, err = client.Set(context.Background(), key2, "bar")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.BitOp(options.AND, destKey, []string{key1})
+		_, err = client.BitOp(context.Background(), options.AND, destKey, []string{key1})
 		assert.NotNil(suite.T(), err)
 
-		_, err = client.BitOp(options.OR, destKey, []string{key1})
+		_, err = client.BitOp(context.Background(), options.OR, destKey, []string{key1})
 		assert.NotNil(suite.T(), err)
 
-		_, err = client.BitOp(options.XOR, destKey, []string{key1})
+		_, err = client.BitOp(context.Background(), options.XOR, destKey, []string{key1})
 		assert.NotNil(suite.T(), err)
 
-		_, err = client.BitOp(options.NOT, destKey, []string{key1, key2})
+		_, err = client.BitOp(context.Background(), options.NOT, destKey, []string{key1, key2})
 		assert.NotNil(suite.T(), err)
 	})
 }
@@ -7876,6 +8190,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		consumer2 := "consumer-2-" + uuid.New().String()
 
 		resp, err := client.XGroupCreateWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			zeroStreamId,
@@ -7884,22 +8199,22 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "OK", resp)
 
-		respBool, err := client.XGroupCreateConsumer(key, groupName, consumer1)
+		respBool, err := client.XGroupCreateConsumer(context.Background(), key, groupName, consumer1)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), respBool)
 
-		respBool, err = client.XGroupCreateConsumer(key, groupName, consumer2)
+		respBool, err = client.XGroupCreateConsumer(context.Background(), key, groupName, consumer2)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), respBool)
 
 		// Add two stream entries for consumer 1
-		streamid_1, err := client.XAdd(key, [][]string{{"field1", "value1"}})
+		streamid_1, err := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 		assert.NoError(suite.T(), err)
-		streamid_2, err := client.XAdd(key, [][]string{{"field2", "value2"}})
+		streamid_2, err := client.XAdd(context.Background(), key, [][]string{{"field2", "value2"}})
 		assert.NoError(suite.T(), err)
 
 		// Read the stream entries for consumer 1 and mark messages as pending
-		xReadGroupResult1, err := client.XReadGroup(groupName, consumer1, map[string]string{key: ">"})
+		xReadGroupResult1, err := client.XReadGroup(context.Background(), groupName, consumer1, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		expectedResult := map[string]map[string][][]string{
 			key: {
@@ -7910,15 +8225,15 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.Equal(suite.T(), expectedResult, xReadGroupResult1)
 
 		// Add 3 more stream entries for consumer 2
-		streamid_3, err := client.XAdd(key, [][]string{{"field3", "value3"}})
+		streamid_3, err := client.XAdd(context.Background(), key, [][]string{{"field3", "value3"}})
 		assert.NoError(suite.T(), err)
-		streamid_4, err := client.XAdd(key, [][]string{{"field4", "value4"}})
+		streamid_4, err := client.XAdd(context.Background(), key, [][]string{{"field4", "value4"}})
 		assert.NoError(suite.T(), err)
-		streamid_5, err := client.XAdd(key, [][]string{{"field5", "value5"}})
+		streamid_5, err := client.XAdd(context.Background(), key, [][]string{{"field5", "value5"}})
 		assert.NoError(suite.T(), err)
 
 		// read the entire stream for consumer 2 and mark messages as pending
-		xReadGroupResult2, err := client.XReadGroup(groupName, consumer2, map[string]string{key: ">"})
+		xReadGroupResult2, err := client.XReadGroup(context.Background(), groupName, consumer2, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		expectedResult2 := map[string]map[string][][]string{
 			key: {
@@ -7938,7 +8253,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 				{ConsumerName: consumer2, MessageCount: 3},
 			},
 		}
-		summaryResult, err := client.XPending(key, groupName)
+		summaryResult, err := client.XPending(context.Background(), key, groupName)
 		assert.NoError(suite.T(), err)
 		assert.True(
 			suite.T(),
@@ -7949,6 +8264,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		// ensure idle time > 0
 		time.Sleep(2000 * time.Millisecond)
 		pendingResultExtended, err := client.XPendingWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			*options.NewXPendingOptions("-", "+", 10),
@@ -7980,6 +8296,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 
 		// use claim to claim stream 3 and 5 for consumer 1
 		claimResult, err := client.XClaim(
+			context.Background(),
 			key,
 			groupName,
 			consumer1,
@@ -7994,6 +8311,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.Equal(suite.T(), expectedClaimResult, claimResult)
 
 		claimResultJustId, err := client.XClaimJustId(
+			context.Background(),
 			key,
 			groupName,
 			consumer1,
@@ -8004,11 +8322,12 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.Equal(suite.T(), []string{streamid_3.Value(), streamid_5.Value()}, claimResultJustId)
 
 		// add one more stream
-		streamid_6, err := client.XAdd(key, [][]string{{"field6", "value6"}})
+		streamid_6, err := client.XAdd(context.Background(), key, [][]string{{"field6", "value6"}})
 		assert.NoError(suite.T(), err)
 
 		// using force, we can xclaim the message without reading it
 		claimResult, err = client.XClaimWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			consumer1,
@@ -8020,6 +8339,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.Equal(suite.T(), map[string][][]string{streamid_6.Value(): {{"field6", "value6"}}}, claimResult)
 
 		forcePendingResult, err := client.XPendingWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			*options.NewXPendingOptions(streamid_6.Value(), streamid_6.Value(), 1),
@@ -8032,12 +8352,14 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 
 		// acknowledge streams 2, 3, 4 and 6 and remove them from xpending results
 		xackResult, err := client.XAck(
+			context.Background(),
 			key, groupName,
 			[]string{streamid_2.Value(), streamid_3.Value(), streamid_4.Value(), streamid_6.Value()})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), xackResult)
 
 		pendingResultExtended, err = client.XPendingWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			*options.NewXPendingOptions(streamid_3.Value(), "+", 10),
@@ -8048,6 +8370,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.Equal(suite.T(), consumer1, pendingResultExtended[0].ConsumerName)
 
 		pendingResultExtended, err = client.XPendingWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			*options.NewXPendingOptions("-", "("+streamid_5.Value(), 10),
@@ -8058,6 +8381,7 @@ func (suite *GlideTestSuite) TestXPendingAndXClaim() {
 		assert.Equal(suite.T(), consumer1, pendingResultExtended[0].ConsumerName)
 
 		pendingResultExtended, err = client.XPendingWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			*options.NewXPendingOptions("-", "+", 10).SetMinIdleTime(1).SetConsumer(consumer1),
@@ -8077,6 +8401,7 @@ func (suite *GlideTestSuite) TestXClaimFailure() {
 
 		// create group and consumer for the group
 		groupCreateResult, err := client.XGroupCreateWithOptions(
+			context.Background(),
 			key,
 			groupName,
 			zeroStreamId,
@@ -8085,37 +8410,38 @@ func (suite *GlideTestSuite) TestXClaimFailure() {
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), "OK", groupCreateResult)
 
-		consumerCreateResult, err := client.XGroupCreateConsumer(key, groupName, consumer1)
+		consumerCreateResult, err := client.XGroupCreateConsumer(context.Background(), key, groupName, consumer1)
 		assert.NoError(suite.T(), err)
 		assert.True(suite.T(), consumerCreateResult)
 
 		// Add stream entry and mark as pending
-		streamid_1, err := client.XAdd(key, [][]string{{"field1", "value1"}})
+		streamid_1, err := client.XAdd(context.Background(), key, [][]string{{"field1", "value1"}})
 		assert.NoError(suite.T(), err)
 		assert.NotNil(suite.T(), streamid_1)
 
-		readGroupResult, err := client.XReadGroup(groupName, consumer1, map[string]string{key: ">"})
+		readGroupResult, err := client.XReadGroup(context.Background(), groupName, consumer1, map[string]string{key: ">"})
 		assert.NoError(suite.T(), err)
 		assert.NotNil(suite.T(), readGroupResult)
 
 		// claim with invalid stream entry IDs
-		_, err = client.XClaimJustId(key, groupName, consumer1, int64(1), []string{"invalid-stream-id"})
+		_, err = client.XClaimJustId(context.Background(), key, groupName, consumer1, int64(1), []string{"invalid-stream-id"})
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// claim with empty stream entry IDs returns empty map
-		claimResult, err := client.XClaimJustId(key, groupName, consumer1, int64(1), []string{})
+		claimResult, err := client.XClaimJustId(context.Background(), key, groupName, consumer1, int64(1), []string{})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), []string{}, claimResult)
 
 		// non existent key causes a RequestError
 		claimOptions := options.NewXClaimOptions().SetIdleTime(1)
-		_, err = client.XClaim(stringKey, groupName, consumer1, int64(1), []string{streamid_1.Value()})
+		_, err = client.XClaim(context.Background(), stringKey, groupName, consumer1, int64(1), []string{streamid_1.Value()})
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 		assert.Contains(suite.T(), err.Error(), "NOGROUP")
 
 		_, err = client.XClaimWithOptions(
+			context.Background(),
 			stringKey,
 			groupName,
 			consumer1,
@@ -8127,12 +8453,20 @@ func (suite *GlideTestSuite) TestXClaimFailure() {
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 		assert.Contains(suite.T(), err.Error(), "NOGROUP")
 
-		_, err = client.XClaimJustId(stringKey, groupName, consumer1, int64(1), []string{streamid_1.Value()})
+		_, err = client.XClaimJustId(
+			context.Background(),
+			stringKey,
+			groupName,
+			consumer1,
+			int64(1),
+			[]string{streamid_1.Value()},
+		)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 		assert.Contains(suite.T(), err.Error(), "NOGROUP")
 
 		_, err = client.XClaimJustIdWithOptions(
+			context.Background(),
 			stringKey,
 			groupName,
 			consumer1,
@@ -8145,13 +8479,14 @@ func (suite *GlideTestSuite) TestXClaimFailure() {
 		assert.Contains(suite.T(), err.Error(), "NOGROUP")
 
 		// key exists, but is not a stream
-		_, err = client.Set(stringKey, "test")
+		_, err = client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
-		_, err = client.XClaim(stringKey, groupName, consumer1, int64(1), []string{streamid_1.Value()})
+		_, err = client.XClaim(context.Background(), stringKey, groupName, consumer1, int64(1), []string{streamid_1.Value()})
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.XClaimWithOptions(
+			context.Background(),
 			stringKey,
 			groupName,
 			consumer1,
@@ -8162,11 +8497,19 @@ func (suite *GlideTestSuite) TestXClaimFailure() {
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
-		_, err = client.XClaimJustId(stringKey, groupName, consumer1, int64(1), []string{streamid_1.Value()})
+		_, err = client.XClaimJustId(
+			context.Background(),
+			stringKey,
+			groupName,
+			consumer1,
+			int64(1),
+			[]string{streamid_1.Value()},
+		)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.XClaimJustIdWithOptions(
+			context.Background(),
 			stringKey,
 			groupName,
 			consumer1,
@@ -8185,15 +8528,15 @@ func (suite *GlideTestSuite) TestCopy() {
 		key2 := "{key}" + uuid.New().String()
 		value := "hello"
 		t := suite.T()
-		suite.verifyOK(client.Set(key, value))
+		suite.verifyOK(client.Set(context.Background(), key, value))
 
 		// Test 1: Check the copy command
-		resultCopy, err := client.Copy(key, key2)
+		resultCopy, err := client.Copy(context.Background(), key, key2)
 		assert.Nil(t, err)
 		assert.True(t, resultCopy)
 
 		// Test 2: Check if the value stored at the source is same with destination key.
-		resultGet, err := client.Get(key2)
+		resultGet, err := client.Get(context.Background(), key2)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet.Value())
 	})
@@ -8205,17 +8548,17 @@ func (suite *GlideTestSuite) TestCopyWithOptions() {
 		key2 := "{key}" + uuid.New().String()
 		value := "hello"
 		t := suite.T()
-		suite.verifyOK(client.Set(key, value))
-		suite.verifyOK(client.Set(key2, "World"))
+		suite.verifyOK(client.Set(context.Background(), key, value))
+		suite.verifyOK(client.Set(context.Background(), key2, "World"))
 
 		// Test 1: Check the copy command with options
 		optsCopy := options.NewCopyOptions().SetReplace()
-		resultCopy, err := client.CopyWithOptions(key, key2, *optsCopy)
+		resultCopy, err := client.CopyWithOptions(context.Background(), key, key2, *optsCopy)
 		assert.Nil(t, err)
 		assert.True(t, resultCopy)
 
 		// Test 2: Check if the value stored at the source is same with destination key.
-		resultGet, err := client.Get(key2)
+		resultGet, err := client.Get(context.Background(), key2)
 		assert.Nil(t, err)
 		assert.Equal(t, value, resultGet.Value())
 	})
@@ -8231,6 +8574,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// add stream entries
 		streamId1, err := client.XAdd(
+			context.Background(),
 			key,
 			[][]string{{"field1", "value1"}},
 		)
@@ -8238,18 +8582,20 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.NotNil(suite.T(), streamId1)
 
 		streamId2, err := client.XAdd(
+			context.Background(),
 			key,
 			[][]string{{"field2", "value2"}},
 		)
 		assert.NoError(suite.T(), err)
 		assert.NotNil(suite.T(), streamId2)
 
-		xlenResult, err := client.XLen(key)
+		xlenResult, err := client.XLen(context.Background(), key)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), xlenResult)
 
 		// get everything from the stream
 		xrangeResult, err := client.XRange(
+			context.Background(),
 			key,
 			negativeInfinity,
 			positiveInfinity,
@@ -8266,6 +8612,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// get everything from the stream in reverse
 		xrevrangeResult, err := client.XRevRange(
+			context.Background(),
 			key,
 			positiveInfinity,
 			negativeInfinity,
@@ -8282,6 +8629,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// returns empty map if + before -
 		xrangeResult, err = client.XRange(
+			context.Background(),
 			key,
 			positiveInfinity,
 			negativeInfinity,
@@ -8291,6 +8639,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// rev search returns empty if - before +
 		xrevrangeResult, err = client.XRevRange(
+			context.Background(),
 			key,
 			negativeInfinity,
 			positiveInfinity,
@@ -8299,6 +8648,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.Empty(suite.T(), xrevrangeResult)
 
 		streamId3, err := client.XAdd(
+			context.Background(),
 			key,
 			[][]string{{"field3", "value3"}},
 		)
@@ -8307,6 +8657,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// get the newest stream entry
 		xrangeResult, err = client.XRangeWithOptions(
+			context.Background(),
 			key,
 			options.NewStreamBoundary(streamId2.Value(), false),
 			positiveInfinity,
@@ -8323,6 +8674,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// doing the same with rev search
 		xrevrangeResult, err = client.XRevRangeWithOptions(
+			context.Background(),
 			key,
 			positiveInfinity,
 			options.NewStreamBoundary(streamId2.Value(), false),
@@ -8339,6 +8691,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// both xrange and xrevrange return nil with a zero/negative count
 		xrangeResult, err = client.XRangeWithOptions(
+			context.Background(),
 			key,
 			negativeInfinity,
 			positiveInfinity,
@@ -8348,6 +8701,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.Empty(suite.T(), xrangeResult)
 
 		xrevrangeResult, err = client.XRevRangeWithOptions(
+			context.Background(),
 			key,
 			positiveInfinity,
 			negativeInfinity,
@@ -8357,11 +8711,16 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.Empty(suite.T(), xrevrangeResult)
 
 		// xrange and xrevrange against an empty stream
-		xdelResult, err := client.XDel(key, []string{streamId1.Value(), streamId2.Value(), streamId3.Value()})
+		xdelResult, err := client.XDel(
+			context.Background(),
+			key,
+			[]string{streamId1.Value(), streamId2.Value(), streamId3.Value()},
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), xdelResult)
 
 		xrangeResult, err = client.XRange(
+			context.Background(),
 			key,
 			negativeInfinity,
 			positiveInfinity,
@@ -8370,6 +8729,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.Empty(suite.T(), xrangeResult)
 
 		xrevrangeResult, err = client.XRevRange(
+			context.Background(),
 			key,
 			positiveInfinity,
 			negativeInfinity,
@@ -8379,6 +8739,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// xrange and xrevrange against a non-existent stream
 		xrangeResult, err = client.XRange(
+			context.Background(),
 			key2,
 			negativeInfinity,
 			positiveInfinity,
@@ -8387,6 +8748,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.Empty(suite.T(), xrangeResult)
 
 		xrevrangeResult, err = client.XRevRange(
+			context.Background(),
 			key2,
 			positiveInfinity,
 			negativeInfinity,
@@ -8395,9 +8757,10 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.Empty(suite.T(), xrevrangeResult)
 
 		// xrange and xrevrange against a non-stream key
-		_, err = client.Set(stringKey, "test")
+		_, err = client.Set(context.Background(), stringKey, "test")
 		assert.NoError(suite.T(), err)
 		_, err = client.XRange(
+			context.Background(),
 			stringKey,
 			negativeInfinity,
 			positiveInfinity,
@@ -8406,6 +8769,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.XRevRange(
+			context.Background(),
 			stringKey,
 			positiveInfinity,
 			negativeInfinity,
@@ -8415,6 +8779,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 
 		// xrange and xrevrange when range bound is not a valid id
 		_, err = client.XRange(
+			context.Background(),
 			key,
 			options.NewStreamBoundary("invalid-id", false),
 			positiveInfinity,
@@ -8423,6 +8788,7 @@ func (suite *GlideTestSuite) TestXRangeAndXRevRange() {
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.XRevRange(
+			context.Background(),
 			key,
 			options.NewStreamBoundary("invalid-id", false),
 			negativeInfinity,
@@ -8440,12 +8806,12 @@ func (suite *GlideTestSuite) TestBitField_GetAndIncrBy() {
 			options.NewBitFieldIncrBy(options.SignedInt, 5, 100, 1),
 		}
 
-		result1, err := client.BitField(key, commands)
+		result1, err := client.BitField(context.Background(), key, commands)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), result1, 1)
 		firstValue := result1[0].Value()
 
-		result2, err := client.BitField(key, commands)
+		result2, err := client.BitField(context.Background(), key, commands)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), result2, 1)
 		assert.Equal(suite.T(), firstValue+1, result2[0].Value())
@@ -8454,7 +8820,7 @@ func (suite *GlideTestSuite) TestBitField_GetAndIncrBy() {
 			options.NewBitFieldGet(options.SignedInt, 5, 100),
 		}
 
-		getResult, err := client.BitField(key, getCommands)
+		getResult, err := client.BitField(context.Background(), key, getCommands)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), getResult, 1)
 		assert.Equal(suite.T(), result2[0].Value(), getResult[0].Value())
@@ -8471,7 +8837,7 @@ func (suite *GlideTestSuite) TestBitField_Overflow() {
 			options.NewBitFieldIncrBy(options.UnsignedInt, 2, 0, 2),
 		}
 
-		satResult, err := client.BitField(key1, satCommands)
+		satResult, err := client.BitField(context.Background(), key1, satCommands)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), satResult, 2)
 
@@ -8486,7 +8852,7 @@ func (suite *GlideTestSuite) TestBitField_Overflow() {
 			options.NewBitFieldIncrBy(options.UnsignedInt, 2, 0, 1),
 		}
 
-		wrapResult, err := client.BitField(key2, wrapCommands)
+		wrapResult, err := client.BitField(context.Background(), key2, wrapCommands)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), wrapResult, 2)
 
@@ -8501,7 +8867,7 @@ func (suite *GlideTestSuite) TestBitField_Overflow() {
 			options.NewBitFieldIncrBy(options.UnsignedInt, 2, 0, 1),
 		}
 
-		failResult, err := client.BitField(key3, failCommands)
+		failResult, err := client.BitField(context.Background(), key3, failCommands)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), failResult, 2)
 
@@ -8520,7 +8886,7 @@ func (suite *GlideTestSuite) TestBitField_MultipleOperations() {
 			options.NewBitFieldIncrBy(options.UnsignedInt, 8, 0, 5),
 		}
 
-		result, err := client.BitField(key, commands)
+		result, err := client.BitField(context.Background(), key, commands)
 
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), result, 3)
@@ -8534,8 +8900,8 @@ func (suite *GlideTestSuite) TestBitField_MultipleOperations() {
 func (suite *GlideTestSuite) TestBitPos_ExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "\x10")
-		result, err := client.BitPos(key, 1)
+		client.Set(context.Background(), key, "\x10")
+		result, err := client.BitPos(context.Background(), key, 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), result)
 	})
@@ -8544,7 +8910,7 @@ func (suite *GlideTestSuite) TestBitPos_ExistingKey() {
 func (suite *GlideTestSuite) TestBitPos_NonExistingKey() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		result, err := client.BitPos(key, 0)
+		result, err := client.BitPos(context.Background(), key, 0)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), result)
 	})
@@ -8553,13 +8919,13 @@ func (suite *GlideTestSuite) TestBitPos_NonExistingKey() {
 func (suite *GlideTestSuite) TestBitPosWithOptions_StartEnd() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "\x00\x01\x80")
+		client.Set(context.Background(), key, "\x00\x01\x80")
 
 		opts := options.NewBitPosOptions().
 			SetStart(0).
 			SetEnd(1)
 
-		result, err := client.BitPosWithOptions(key, 1, *opts)
+		result, err := client.BitPosWithOptions(context.Background(), key, 1, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(15), result)
 	})
@@ -8569,14 +8935,14 @@ func (suite *GlideTestSuite) TestBitPosWithOptions_BitmapIndexType() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "\x00\x02\x00")
+		client.Set(context.Background(), key, "\x00\x02\x00")
 
 		opts := options.NewBitPosOptions().
 			SetStart(1).
 			SetEnd(2).
 			SetBitmapIndexType(options.BYTE)
 
-		result, err := client.BitPosWithOptions(key, 1, *opts)
+		result, err := client.BitPosWithOptions(context.Background(), key, 1, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(14), result)
 	})
@@ -8586,14 +8952,14 @@ func (suite *GlideTestSuite) TestBitPosWithOptions_BitIndexType() {
 	suite.SkipIfServerVersionLowerThanBy("7.0.0")
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "\x00\x10\x00")
+		client.Set(context.Background(), key, "\x00\x10\x00")
 
 		opts := options.NewBitPosOptions().
 			SetStart(10).
 			SetEnd(14).
 			SetBitmapIndexType(options.BIT)
 
-		result, err := client.BitPosWithOptions(key, 1, *opts)
+		result, err := client.BitPosWithOptions(context.Background(), key, 1, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(11), result)
 	})
@@ -8602,9 +8968,9 @@ func (suite *GlideTestSuite) TestBitPosWithOptions_BitIndexType() {
 func (suite *GlideTestSuite) TestBitPos_FindBitZero() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "\xFF\xF7")
+		client.Set(context.Background(), key, "\xFF\xF7")
 
-		result, err := client.BitPos(key, 0)
+		result, err := client.BitPos(context.Background(), key, 0)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(12), result)
 	})
@@ -8613,13 +8979,13 @@ func (suite *GlideTestSuite) TestBitPos_FindBitZero() {
 func (suite *GlideTestSuite) TestBitPosWithOptions_NegativeEnd() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := uuid.New().String()
-		client.Set(key, "\x00\x01\x80")
+		client.Set(context.Background(), key, "\x00\x01\x80")
 
 		opts := options.NewBitPosOptions().
 			SetStart(0).
 			SetEnd(-2)
 
-		result, err := client.BitPosWithOptions(key, 1, *opts)
+		result, err := client.BitPosWithOptions(context.Background(), key, 1, *opts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(15), result)
 	})
@@ -8634,7 +9000,7 @@ func (suite *GlideTestSuite) TestBitField_Failures() {
 			options.NewBitFieldGet(options.UnsignedInt, 64, 0),
 		}
 
-		_, err := client.BitField(key, invalidUnsignedCommands)
+		_, err := client.BitField(context.Background(), key, invalidUnsignedCommands)
 		assert.NotNil(suite.T(), err)
 
 		// Test invalid bit size for signed
@@ -8642,7 +9008,7 @@ func (suite *GlideTestSuite) TestBitField_Failures() {
 			options.NewBitFieldGet(options.SignedInt, 65, 0),
 		}
 
-		_, err = client.BitField(key, invalidSignedCommands)
+		_, err = client.BitField(context.Background(), key, invalidSignedCommands)
 		assert.NotNil(suite.T(), err)
 	})
 }
@@ -8655,19 +9021,19 @@ func (suite *GlideTestSuite) TestBitFieldRO_BasicOperation() {
 		setCommands := []options.BitFieldSubCommands{
 			options.NewBitFieldSet(options.SignedInt, 8, 16, value),
 		}
-		_, err := client.BitField(key, setCommands)
+		_, err := client.BitField(context.Background(), key, setCommands)
 		assert.Nil(suite.T(), err)
 
 		getNormalCommands := []options.BitFieldSubCommands{
 			options.NewBitFieldGet(options.SignedInt, 8, 16),
 		}
-		getNormal, err := client.BitField(key, getNormalCommands)
+		getNormal, err := client.BitField(context.Background(), key, getNormalCommands)
 		assert.Nil(suite.T(), err)
 
 		getROCommands := []options.BitFieldROCommands{
 			options.NewBitFieldGet(options.SignedInt, 8, 16),
 		}
-		getRO, err := client.BitFieldRO(key, getROCommands)
+		getRO, err := client.BitFieldRO(context.Background(), key, getROCommands)
 		assert.Nil(suite.T(), err)
 
 		assert.Equal(suite.T(), getNormal[0].Value(), getRO[0].Value())
@@ -8686,7 +9052,7 @@ func (suite *GlideTestSuite) TestBitFieldRO_MultipleGets() {
 			options.NewBitFieldSet(options.SignedInt, 8, 8, value2),
 		}
 
-		_, err := client.BitField(key, setCommands)
+		_, err := client.BitField(context.Background(), key, setCommands)
 		assert.Nil(suite.T(), err)
 
 		getNormalCommands := []options.BitFieldSubCommands{
@@ -8694,7 +9060,7 @@ func (suite *GlideTestSuite) TestBitFieldRO_MultipleGets() {
 			options.NewBitFieldGet(options.SignedInt, 8, 8),
 		}
 
-		getNormal, err := client.BitField(key, getNormalCommands)
+		getNormal, err := client.BitField(context.Background(), key, getNormalCommands)
 		assert.Nil(suite.T(), err)
 
 		getROCommands := []options.BitFieldROCommands{
@@ -8702,7 +9068,7 @@ func (suite *GlideTestSuite) TestBitFieldRO_MultipleGets() {
 			options.NewBitFieldGet(options.SignedInt, 8, 8),
 		}
 
-		getRO, err := client.BitFieldRO(key, getROCommands)
+		getRO, err := client.BitFieldRO(context.Background(), key, getROCommands)
 		assert.Nil(suite.T(), err)
 
 		assert.Equal(suite.T(),
@@ -8729,21 +9095,23 @@ func (suite *GlideTestSuite) TestZInter() {
 		}
 
 		// Add members to sorted sets
-		res, err := client.ZAdd(key1, memberScoreMap1)
+		res, err := client.ZAdd(context.Background(), key1, memberScoreMap1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		res, err = client.ZAdd(key2, memberScoreMap2)
+		res, err = client.ZAdd(context.Background(), key2, memberScoreMap2)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// intersection results are aggregated by the max score of elements
-		zinterResult, err := client.ZInter(options.KeyArray{Keys: []string{key1, key2}})
+		zinterResult, err := client.ZInter(context.Background(), options.KeyArray{Keys: []string{key1, key2}})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), []string{"two"}, zinterResult)
 
 		// intersection with scores
-		zinterWithScoresResult, err := client.ZInterWithScores(options.KeyArray{Keys: []string{key1, key2}},
+		zinterWithScoresResult, err := client.ZInterWithScores(
+			context.Background(),
+			options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
@@ -8751,6 +9119,7 @@ func (suite *GlideTestSuite) TestZInter() {
 
 		// intersect results with max aggregate
 		zinterWithMaxAggregateResult, err := client.ZInterWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateMax),
 		)
@@ -8759,6 +9128,7 @@ func (suite *GlideTestSuite) TestZInter() {
 
 		// intersect results with min aggregate
 		zinterWithMinAggregateResult, err := client.ZInterWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateMin),
 		)
@@ -8767,6 +9137,7 @@ func (suite *GlideTestSuite) TestZInter() {
 
 		// intersect results with sum aggregate
 		zinterWithSumAggregateResult, err := client.ZInterWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateSum),
 		)
@@ -8775,6 +9146,7 @@ func (suite *GlideTestSuite) TestZInter() {
 
 		// Scores are multiplied by a 2.0 weight for key1 and key2 during aggregation
 		zinterWithWeightedKeysResult, err := client.ZInterWithScores(
+			context.Background(),
 			options.WeightedKeys{
 				KeyWeightPairs: []options.KeyWeightPair{
 					{Key: key1, Weight: 2.0},
@@ -8788,6 +9160,7 @@ func (suite *GlideTestSuite) TestZInter() {
 
 		// non-existent key - empty intersection
 		zinterWithNonExistentKeyResult, err := client.ZInterWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key3}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateSum),
 		)
@@ -8795,21 +9168,22 @@ func (suite *GlideTestSuite) TestZInter() {
 		assert.Empty(suite.T(), zinterWithNonExistentKeyResult)
 
 		// empty key list - request error
-		_, err = client.ZInterWithScores(options.KeyArray{Keys: []string{}},
+		_, err = client.ZInterWithScores(context.Background(), options.KeyArray{Keys: []string{}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateSum),
 		)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// key exists but not a set
-		_, err = client.Set(key3, "value")
+		_, err = client.Set(context.Background(), key3, "value")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.ZInter(options.KeyArray{Keys: []string{key1, key3}})
+		_, err = client.ZInter(context.Background(), options.KeyArray{Keys: []string{key1, key3}})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.ZInterWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key3}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateSum),
 		)
@@ -8836,62 +9210,62 @@ func (suite *GlideTestSuite) TestZInterStore() {
 		}
 
 		// Add members to sorted sets
-		res, err := client.ZAdd(key1, memberScoreMap1)
+		res, err := client.ZAdd(context.Background(), key1, memberScoreMap1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		res, err = client.ZAdd(key2, memberScoreMap2)
+		res, err = client.ZAdd(context.Background(), key2, memberScoreMap2)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), res)
 
 		// Store the intersection of key1 and key2 in key3
-		res, err = client.ZInterStore(key3, options.KeyArray{Keys: []string{key1, key2}})
+		res, err = client.ZInterStore(context.Background(), key3, options.KeyArray{Keys: []string{key1, key2}})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// checking stored intersection result
-		zrangeResult, err := client.ZRangeWithScores(key3, query)
+		zrangeResult, err := client.ZRangeWithScores(context.Background(), key3, query)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": 2.5, "two": 4.5}, zrangeResult)
 
 		// Store the intersection of key1 and key2 in key4 with max aggregate
-		res, err = client.ZInterStoreWithOptions(key3, options.KeyArray{Keys: []string{key1, key2}},
+		res, err = client.ZInterStoreWithOptions(context.Background(), key3, options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateMax),
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// checking stored intersection result with max aggregate
-		zrangeResult, err = client.ZRangeWithScores(key3, query)
+		zrangeResult, err = client.ZRangeWithScores(context.Background(), key3, query)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.5, "two": 2.5}, zrangeResult)
 
 		// Store the intersection of key1 and key2 in key5 with min aggregate
-		res, err = client.ZInterStoreWithOptions(key3, options.KeyArray{Keys: []string{key1, key2}},
+		res, err = client.ZInterStoreWithOptions(context.Background(), key3, options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateMin),
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// checking stored intersection result with min aggregate
-		zrangeResult, err = client.ZRangeWithScores(key3, query)
+		zrangeResult, err = client.ZRangeWithScores(context.Background(), key3, query)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 2.0}, zrangeResult)
 
 		// Store the intersection of key1 and key2 in key6 with sum aggregate
-		res, err = client.ZInterStoreWithOptions(key3, options.KeyArray{Keys: []string{key1, key2}},
+		res, err = client.ZInterStoreWithOptions(context.Background(), key3, options.KeyArray{Keys: []string{key1, key2}},
 			*options.NewZInterOptions().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// checking stored intersection result with sum aggregate (same as default aggregate)
-		zrangeResult, err = client.ZRangeWithScores(key3, query)
+		zrangeResult, err = client.ZRangeWithScores(context.Background(), key3, query)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": 2.5, "two": 4.5}, zrangeResult)
 
 		// Store the intersection of key1 and key2 in key3 with 2.0 weights
-		res, err = client.ZInterStore(key3, options.WeightedKeys{
+		res, err = client.ZInterStore(context.Background(), key3, options.WeightedKeys{
 			KeyWeightPairs: []options.KeyWeightPair{
 				{Key: key1, Weight: 2.0},
 				{Key: key2, Weight: 2.0},
@@ -8901,13 +9275,13 @@ func (suite *GlideTestSuite) TestZInterStore() {
 		assert.Equal(suite.T(), int64(2), res)
 
 		// checking stored intersection result with weighted keys
-		zrangeResult, err = client.ZRangeWithScores(key3, query)
+		zrangeResult, err = client.ZRangeWithScores(context.Background(), key3, query)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": 5.0, "two": 9.0}, zrangeResult)
 
 		// Store the intersection of key1 with 1.0 weight and key2 with -2.0 weight in key3 with 2.0 weights
 		// and min aggregate
-		res, err = client.ZInterStoreWithOptions(key3, options.WeightedKeys{
+		res, err = client.ZInterStoreWithOptions(context.Background(), key3, options.WeightedKeys{
 			KeyWeightPairs: []options.KeyWeightPair{
 				{Key: key1, Weight: 1.0},
 				{Key: key2, Weight: -2.0},
@@ -8919,15 +9293,15 @@ func (suite *GlideTestSuite) TestZInterStore() {
 		assert.Equal(suite.T(), int64(2), res)
 
 		// checking stored intersection result with weighted keys
-		zrangeResult, err = client.ZRangeWithScores(key3, query)
+		zrangeResult, err = client.ZRangeWithScores(context.Background(), key3, query)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64{"one": -3.0, "two": -5.0}, zrangeResult)
 
 		// key exists but not a set
-		_, err = client.Set(key4, "value")
+		_, err = client.Set(context.Background(), key4, "value")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.ZInterStore(key3, options.KeyArray{Keys: []string{key1, key4}})
+		_, err = client.ZInterStore(context.Background(), key3, options.KeyArray{Keys: []string{key1, key4}})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -8959,45 +9333,45 @@ func (suite *GlideTestSuite) TestZDiff() {
 			"four":  4.0,
 		}
 
-		zAddResult1, err := client.ZAdd(key1, membersScores1)
+		zAddResult1, err := client.ZAdd(context.Background(), key1, membersScores1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), zAddResult1)
-		zAddResult2, err := client.ZAdd(key2, membersScores2)
+		zAddResult2, err := client.ZAdd(context.Background(), key2, membersScores2)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), zAddResult2)
-		zAddResult3, err := client.ZAdd(key3, membersScores3)
+		zAddResult3, err := client.ZAdd(context.Background(), key3, membersScores3)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(4), zAddResult3)
 
-		zDiffResult, err := client.ZDiff([]string{key1, key2})
+		zDiffResult, err := client.ZDiff(context.Background(), []string{key1, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"one", "three"}, zDiffResult)
-		zDiffResult, err = client.ZDiff([]string{key1, key3})
+		zDiffResult, err = client.ZDiff(context.Background(), []string{key1, key3})
 		assert.NoError(t, err)
 		assert.Equal(t, []string{}, zDiffResult)
-		zDiffResult, err = client.ZDiff([]string{nonExistentKey, key3})
+		zDiffResult, err = client.ZDiff(context.Background(), []string{nonExistentKey, key3})
 		assert.NoError(t, err)
 		assert.Equal(t, []string{}, zDiffResult)
 
-		zDiffResultWithScores, err := client.ZDiffWithScores([]string{key1, key2})
+		zDiffResultWithScores, err := client.ZDiffWithScores(context.Background(), []string{key1, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{"one": 1.0, "three": 3.0}, zDiffResultWithScores)
-		zDiffResultWithScores, err = client.ZDiffWithScores([]string{key1, key3})
+		zDiffResultWithScores, err = client.ZDiffWithScores(context.Background(), []string{key1, key3})
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{}, zDiffResultWithScores)
-		zDiffResultWithScores, err = client.ZDiffWithScores([]string{nonExistentKey, key3})
+		zDiffResultWithScores, err = client.ZDiffWithScores(context.Background(), []string{nonExistentKey, key3})
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{}, zDiffResultWithScores)
 
 		// Key exists, but it is not a set
-		setResult, _ := client.Set(nonExistentKey, "bar")
+		setResult, _ := client.Set(context.Background(), nonExistentKey, "bar")
 		assert.Equal(t, setResult, "OK")
 
-		_, err = client.ZDiff([]string{nonExistentKey, key2})
+		_, err = client.ZDiff(context.Background(), []string{nonExistentKey, key2})
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 
-		_, err = client.ZDiffWithScores([]string{nonExistentKey, key2})
+		_, err = client.ZDiffWithScores(context.Background(), []string{nonExistentKey, key2})
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -9030,50 +9404,50 @@ func (suite *GlideTestSuite) TestZDiffStore() {
 			"four":  4.0,
 		}
 
-		zAddResult1, err := client.ZAdd(key1, membersScores1)
+		zAddResult1, err := client.ZAdd(context.Background(), key1, membersScores1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), zAddResult1)
-		zAddResult2, err := client.ZAdd(key2, membersScores2)
+		zAddResult2, err := client.ZAdd(context.Background(), key2, membersScores2)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), zAddResult2)
-		zAddResult3, err := client.ZAdd(key3, membersScores3)
+		zAddResult3, err := client.ZAdd(context.Background(), key3, membersScores3)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(4), zAddResult3)
 
-		zDiffStoreResult, err := client.ZDiffStore(key4, []string{key1, key2})
+		zDiffStoreResult, err := client.ZDiffStore(context.Background(), key4, []string{key1, key2})
 		assert.NoError(t, err)
 		assert.Equal(t, zDiffStoreResult, int64(2))
-		zRangeWithScoreResult, err := client.ZRangeWithScores(key4, options.NewRangeByIndexQuery(0, -1))
+		zRangeWithScoreResult, err := client.ZRangeWithScores(context.Background(), key4, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{"one": 1.0, "three": 3.0}, zRangeWithScoreResult)
 
-		zDiffStoreResult, err = client.ZDiffStore(key4, []string{key3, key2, key1})
+		zDiffStoreResult, err = client.ZDiffStore(context.Background(), key4, []string{key3, key2, key1})
 		assert.NoError(t, err)
 		assert.Equal(t, zDiffStoreResult, int64(1))
-		zRangeWithScoreResult, err = client.ZRangeWithScores(key4, options.NewRangeByIndexQuery(0, -1))
+		zRangeWithScoreResult, err = client.ZRangeWithScores(context.Background(), key4, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{"four": 4.0}, zRangeWithScoreResult)
 
-		zDiffStoreResult, err = client.ZDiffStore(key4, []string{key1, key3})
+		zDiffStoreResult, err = client.ZDiffStore(context.Background(), key4, []string{key1, key3})
 		assert.NoError(t, err)
 		assert.Equal(t, zDiffStoreResult, int64(0))
-		zRangeWithScoreResult, err = client.ZRangeWithScores(key4, options.NewRangeByIndexQuery(0, -1))
+		zRangeWithScoreResult, err = client.ZRangeWithScores(context.Background(), key4, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{}, zRangeWithScoreResult)
 
 		// Non-Existing key
-		zDiffStoreResult, err = client.ZDiffStore(key4, []string{key5, key1})
+		zDiffStoreResult, err = client.ZDiffStore(context.Background(), key4, []string{key5, key1})
 		assert.NoError(t, err)
 		assert.Equal(t, zDiffStoreResult, int64(0))
-		zRangeWithScoreResult, err = client.ZRangeWithScores(key4, options.NewRangeByIndexQuery(0, -1))
+		zRangeWithScoreResult, err = client.ZRangeWithScores(context.Background(), key4, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(t, err)
 		assert.Equal(t, map[string]float64{}, zRangeWithScoreResult)
 
 		// Key exists, but it is not a set
-		setResult, err := client.Set(key5, "bar")
+		setResult, err := client.Set(context.Background(), key5, "bar")
 		assert.NoError(t, err)
 		assert.Equal(t, setResult, "OK")
-		_, err = client.ZDiffStore(key4, []string{key5, key1})
+		_, err = client.ZDiffStore(context.Background(), key4, []string{key5, key1})
 		assert.NotNil(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -9095,20 +9469,22 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 		}
 
 		// Add members to sorted sets
-		res, err := client.ZAdd(key1, memberScoreMap1)
+		res, err := client.ZAdd(context.Background(), key1, memberScoreMap1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		res, err = client.ZAdd(key2, memberScoreMap2)
+		res, err = client.ZAdd(context.Background(), key2, memberScoreMap2)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		zUnionResult, err := client.ZUnion(options.KeyArray{Keys: []string{key1, key2}})
+		zUnionResult, err := client.ZUnion(context.Background(), options.KeyArray{Keys: []string{key1, key2}})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), []string{"one", "three", "two"}, zUnionResult)
 
 		// Union with scores
-		zUnionWithScoresResult, err := client.ZUnionWithScores(options.KeyArray{Keys: []string{key1, key2}},
+		zUnionWithScoresResult, err := client.ZUnionWithScores(
+			context.Background(),
+			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
@@ -9116,6 +9492,7 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 
 		// Union results with max aggregate
 		zUnionWithMaxAggregateResult, err := client.ZUnionWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateMax),
 		)
@@ -9124,6 +9501,7 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 
 		// Union results with min aggregate
 		zUnionWithMinAggregateResult, err := client.ZUnionWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateMin),
 		)
@@ -9132,6 +9510,7 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 
 		// Union results with sum aggregate
 		zUnionWithSumAggregateResult, err := client.ZUnionWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
@@ -9140,6 +9519,7 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 
 		// Scores are multiplied by a 2.0 weight for key1 and key2 during aggregation
 		zUnionWithWeightedKeysResult, err := client.ZUnionWithScores(
+			context.Background(),
 			options.WeightedKeys{
 				KeyWeightPairs: []options.KeyWeightPair{
 					{Key: key1, Weight: 3.0},
@@ -9153,6 +9533,7 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 
 		// non-existent key - empty union
 		zUnionWithNonExistentKeyResult, err := client.ZUnionWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key3}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
@@ -9160,21 +9541,22 @@ func (suite *GlideTestSuite) TestZUnionAndZUnionWithScores() {
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 2.0}, zUnionWithNonExistentKeyResult)
 
 		// empty key list - empty union
-		zUnionWithEmptyKeyArray, err := client.ZUnionWithScores(options.KeyArray{Keys: []string{}},
+		zUnionWithEmptyKeyArray, err := client.ZUnionWithScores(context.Background(), options.KeyArray{Keys: []string{}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NotNil(suite.T(), err)
 		assert.Empty(suite.T(), zUnionWithEmptyKeyArray)
 
 		// key exists but not a set
-		_, err = client.Set(key3, "value")
+		_, err = client.Set(context.Background(), key3, "value")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.ZUnion(options.KeyArray{Keys: []string{key1, key3}})
+		_, err = client.ZUnion(context.Background(), options.KeyArray{Keys: []string{key1, key3}})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.ZUnionWithScores(
+			context.Background(),
 			options.KeyArray{Keys: []string{key1, key3}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
@@ -9200,69 +9582,76 @@ func (suite *GlideTestSuite) TestZUnionStoreAndZUnionStoreWithOptions() {
 		}
 
 		// Add members to sorted sets
-		res, err := client.ZAdd(key1, memberScoreMap1)
+		res, err := client.ZAdd(context.Background(), key1, memberScoreMap1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		res, err = client.ZAdd(key2, memberScoreMap2)
+		res, err = client.ZAdd(context.Background(), key2, memberScoreMap2)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		zUnionStoreResult, err := client.ZUnionStore(dest, options.KeyArray{Keys: []string{key1, key2}})
+		zUnionStoreResult, err := client.ZUnionStore(context.Background(), dest, options.KeyArray{Keys: []string{key1, key2}})
 		assert.NoError(suite.T(), err)
-		zRangeZUnionDest, err := client.ZRange(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeZUnionDest, err := client.ZRange(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zUnionStoreResult)
 		assert.Equal(suite.T(), []string{"one", "three", "two"}, zRangeZUnionDest)
 
 		// Union with scores
-		zUnionStoreWithScoresResult, err := client.ZUnionStoreWithOptions(dest, options.KeyArray{Keys: []string{key1, key2}},
+		zUnionStoreWithScoresResult, err := client.ZUnionStoreWithOptions(
+			context.Background(),
+			dest,
+			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
-		zRangeDest, err := client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err := client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zUnionStoreWithScoresResult)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 5.5, "three": 3.0}, zRangeDest)
 
 		// Union results with max aggregate
 		zUnionStoreWithMaxAggregateResult, err := client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateMax),
 		)
 		assert.NoError(suite.T(), err)
-		zRangeDest, err = client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err = client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zUnionStoreWithMaxAggregateResult)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 3.5, "three": 3.0}, zRangeDest)
 
 		// Union results with min aggregate
 		zUnionStoreWithMinAggregateResult, err := client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateMin),
 		)
 		assert.NoError(suite.T(), err)
-		zRangeDest, err = client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err = client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zUnionStoreWithMinAggregateResult)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 2.0, "three": 3.0}, zRangeDest)
 
 		// Union results with sum aggregate
 		zUnionStoreWithSumAggregateResult, err := client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.KeyArray{Keys: []string{key1, key2}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
-		zRangeDest, err = client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err = client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zUnionStoreWithSumAggregateResult)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 5.5, "three": 3.0}, zRangeDest)
 
 		// Scores are multiplied by a 2.0 weight for key1 and key2 during aggregation
 		zUnionStoreWithWeightedKeysResult, err := client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.WeightedKeys{
 				KeyWeightPairs: []options.KeyWeightPair{
@@ -9273,46 +9662,49 @@ func (suite *GlideTestSuite) TestZUnionStoreAndZUnionStoreWithOptions() {
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
-		zRangeDest, err = client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err = client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zUnionStoreWithWeightedKeysResult)
 		assert.Equal(suite.T(), map[string]float64{"one": 3.0, "two": 13.0, "three": 6.0}, zRangeDest)
 
 		// non-existent key - empty union
 		zUnionStoreWithNonExistentKeyResult, err := client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.KeyArray{Keys: []string{key1, key3}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NoError(suite.T(), err)
-		zRangeDest, err = client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err = client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), zUnionStoreWithNonExistentKeyResult)
 		assert.Equal(suite.T(), map[string]float64{"one": 1.0, "two": 2.0}, zRangeDest)
 
 		// empty key list - empty union
-		_, err = client.ZRem(dest, []string{"one", "two"}) // Flush previous results
+		_, err = client.ZRem(context.Background(), dest, []string{"one", "two"}) // Flush previous results
 		assert.NoError(suite.T(), err)
 		zUnionStoreWithEmptyKeyArray, err := client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.KeyArray{Keys: []string{}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
 		)
 		assert.NotNil(suite.T(), err)
-		zRangeDest, err = client.ZRangeWithScores(dest, options.NewRangeByIndexQuery(0, -1))
+		zRangeDest, err = client.ZRangeWithScores(context.Background(), dest, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), zUnionStoreWithEmptyKeyArray)
 		assert.Empty(suite.T(), zRangeDest)
 
 		// key exists but not a set
-		_, err = client.Set(key3, "value")
+		_, err = client.Set(context.Background(), key3, "value")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.ZUnionStore(dest, options.KeyArray{Keys: []string{key1, key3}})
+		_, err = client.ZUnionStore(context.Background(), dest, options.KeyArray{Keys: []string{key1, key3}})
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		_, err = client.ZUnionStoreWithOptions(
+			context.Background(),
 			dest,
 			options.KeyArray{Keys: []string{key1, key3}},
 			options.NewZUnionOptionsBuilder().SetAggregate(options.AggregateSum),
@@ -9340,39 +9732,55 @@ func (suite *GlideTestSuite) TestZInterCard() {
 			"d": 3.0,
 		}
 
-		zAddResult1, err := client.ZAdd(key1, membersScores1)
+		zAddResult1, err := client.ZAdd(context.Background(), key1, membersScores1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zAddResult1)
 
-		zAddResult2, err := client.ZAdd(key2, membersScores2)
+		zAddResult2, err := client.ZAdd(context.Background(), key2, membersScores2)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(3), zAddResult2)
 
-		res, err := client.ZInterCard([]string{key1, key2})
+		res, err := client.ZInterCard(context.Background(), []string{key1, key2})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		res, err = client.ZInterCard([]string{key1, key3})
+		res, err = client.ZInterCard(context.Background(), []string{key1, key3})
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), res)
 
-		res, err = client.ZInterCardWithOptions([]string{key1, key2}, options.NewZInterCardOptions().SetLimit(0))
+		res, err = client.ZInterCardWithOptions(
+			context.Background(),
+			[]string{key1, key2},
+			options.NewZInterCardOptions().SetLimit(0),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
-		res, err = client.ZInterCardWithOptions([]string{key1, key2}, options.NewZInterCardOptions().SetLimit(1))
+		res, err = client.ZInterCardWithOptions(
+			context.Background(),
+			[]string{key1, key2},
+			options.NewZInterCardOptions().SetLimit(1),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(1), res)
 
-		res, err = client.ZInterCardWithOptions([]string{key1, key2}, options.NewZInterCardOptions().SetLimit(3))
+		res, err = client.ZInterCardWithOptions(
+			context.Background(),
+			[]string{key1, key2},
+			options.NewZInterCardOptions().SetLimit(3),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(2), res)
 
 		// key exists but not a set
-		_, err = client.Set(key3, "bar")
+		_, err = client.Set(context.Background(), key3, "bar")
 		assert.NoError(suite.T(), err)
 
-		_, err = client.ZInterCardWithOptions([]string{key1, key3}, options.NewZInterCardOptions().SetLimit(3))
+		_, err = client.ZInterCardWithOptions(
+			context.Background(),
+			[]string{key1, key3},
+			options.NewZInterCardOptions().SetLimit(3),
+		)
 		assert.NotNil(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
@@ -9386,10 +9794,11 @@ func (suite *GlideTestSuite) TestZLexCount() {
 		key2 := "{testKey}:3-" + uuid.New().String()
 
 		// add members to sorted sets
-		client.ZAdd(key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0})
+		client.ZAdd(context.Background(), key1, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0})
 
 		// count members in range a exclusive to c inclusive
 		result, err := client.ZLexCount(
+			context.Background(),
 			key1,
 			options.NewRangeByLexQuery(
 				options.NewLexBoundary("a", false),
@@ -9401,6 +9810,7 @@ func (suite *GlideTestSuite) TestZLexCount() {
 
 		// count members in range negative to positive infinity
 		result, err = client.ZLexCount(
+			context.Background(),
 			key1,
 			options.NewRangeByLexQuery(
 				options.NewInfiniteLexBoundary("-"),
@@ -9412,6 +9822,7 @@ func (suite *GlideTestSuite) TestZLexCount() {
 
 		// count members in range negative infinity to c inclusive
 		result, err = client.ZLexCount(
+			context.Background(),
 			key1,
 			options.NewRangeByLexQuery(
 				options.NewInfiniteLexBoundary("-"),
@@ -9423,6 +9834,7 @@ func (suite *GlideTestSuite) TestZLexCount() {
 
 		// non-existent key
 		result, err = client.ZLexCount(
+			context.Background(),
 			key2,
 			options.NewRangeByLexQuery(
 				options.NewLexBoundary("a", false),
@@ -9433,10 +9845,11 @@ func (suite *GlideTestSuite) TestZLexCount() {
 		assert.Equal(t, int64(0), result)
 
 		// key exists but not a set
-		_, err = client.Set(key2, "value")
+		_, err = client.Set(context.Background(), key2, "value")
 		assert.NoError(t, err)
 
 		_, err = client.ZLexCount(
+			context.Background(),
 			key2,
 			options.NewRangeByLexQuery(
 				options.NewLexBoundary("a", false),
@@ -9460,7 +9873,7 @@ func (suite *GlideTestSuite) TestGeoAdd() {
 			"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 		}
 
-		result, err := client.GeoAdd(key1, membersToCoordinates)
+		result, err := client.GeoAdd(context.Background(), key1, membersToCoordinates)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), result)
 
@@ -9469,6 +9882,7 @@ func (suite *GlideTestSuite) TestGeoAdd() {
 			"Catania": {Longitude: 15.087269, Latitude: 39},
 		}
 		result, err = client.GeoAddWithOptions(
+			context.Background(),
 			key1,
 			membersToCoordinates,
 			*options.NewGeoAddOptions().SetConditionalChange(options.OnlyIfDoesNotExist),
@@ -9478,6 +9892,7 @@ func (suite *GlideTestSuite) TestGeoAdd() {
 
 		// Test with XX option (only if exists)
 		result, err = client.GeoAddWithOptions(
+			context.Background(),
 			key1,
 			membersToCoordinates,
 			*options.NewGeoAddOptions().SetConditionalChange(options.OnlyIfExists),
@@ -9491,6 +9906,7 @@ func (suite *GlideTestSuite) TestGeoAdd() {
 			"Tel-Aviv": {Longitude: 32.0853, Latitude: 34.7818},
 		}
 		result, err = client.GeoAddWithOptions(
+			context.Background(),
 			key1,
 			membersToCoordinates,
 			*options.NewGeoAddOptions().SetChanged(true),
@@ -9499,10 +9915,11 @@ func (suite *GlideTestSuite) TestGeoAdd() {
 		assert.Equal(t, int64(2), result)
 
 		// Test error case with wrong key type
-		_, err = client.Set(key2, "bar")
+		_, err = client.Set(context.Background(), key2, "bar")
 		assert.NoError(t, err)
 
 		_, err = client.GeoAddWithOptions(
+			context.Background(),
 			key2,
 			membersToCoordinates,
 			*options.NewGeoAddOptions().SetChanged(true),
@@ -9529,28 +9946,28 @@ func (suite *GlideTestSuite) TestGeoDist() {
 			"Palermo": {Longitude: 13.361389, Latitude: 38.115556},
 			"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 		}
-		result, err := client.GeoAdd(key1, membersToCoordinates)
+		result, err := client.GeoAdd(context.Background(), key1, membersToCoordinates)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), result)
 
 		// assert correct result with default metric
-		actual, err := client.GeoDist(key1, member1, member2)
+		actual, err := client.GeoDist(context.Background(), key1, member1, member2)
 		assert.NoError(t, err)
 		assert.LessOrEqual(t, float64(math.Abs(actual.Value()-expected)), float64(delta))
 
 		// assert correct result with manual metric specification kilometers
-		actualKM, err := client.GeoDistWithUnit(key1, member1, member2, options.GeoUnitKilometers)
+		actualKM, err := client.GeoDistWithUnit(context.Background(), key1, member1, member2, options.GeoUnitKilometers)
 		assert.NoError(t, err)
 		assert.LessOrEqual(t, math.Abs(actualKM.Value()-expectedKM), delta)
 
 		// assert null result when member index is missing
-		actual, _ = client.GeoDist(key1, member1, member3)
+		actual, _ = client.GeoDist(context.Background(), key1, member1, member3)
 		assert.True(t, actual.IsNil())
 
 		// key exists but holds a non-ZSET value
-		_, err = client.Set(key2, "bar")
+		_, err = client.Set(context.Background(), key2, "bar")
 		assert.NoError(t, err)
-		_, err = client.GeoDist(key2, member1, member2)
+		_, err = client.GeoDist(context.Background(), key2, member1, member2)
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -9562,33 +9979,33 @@ func (suite *GlideTestSuite) TestGeoAdd_InvalidArgs() {
 		key := "{testKey}:3-" + uuid.New().String()
 
 		// Test empty members
-		_, err := client.GeoAdd(key, map[string]options.GeospatialData{})
+		_, err := client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{})
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 
 		// Test invalid longitude (-181)
-		_, err = client.GeoAdd(key, map[string]options.GeospatialData{
+		_, err = client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
 			"Place": {Longitude: -181, Latitude: 0},
 		})
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 
 		// Test invalid longitude (181)
-		_, err = client.GeoAdd(key, map[string]options.GeospatialData{
+		_, err = client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
 			"Place": {Longitude: 181, Latitude: 0},
 		})
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 
 		// Test invalid latitude (86)
-		_, err = client.GeoAdd(key, map[string]options.GeospatialData{
+		_, err = client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
 			"Place": {Longitude: 0, Latitude: 86},
 		})
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 
 		// Test invalid latitude (-86)
-		_, err = client.GeoAdd(key, map[string]options.GeospatialData{
+		_, err = client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
 			"Place": {Longitude: 0, Latitude: -86},
 		})
 		assert.Error(t, err)
@@ -9608,12 +10025,12 @@ func (suite *GlideTestSuite) TestGeoHash() {
 		}
 
 		// Add the coordinates
-		result, err := client.GeoAdd(key1, membersToCoordinates)
+		result, err := client.GeoAdd(context.Background(), key1, membersToCoordinates)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), result)
 
 		// Test getting geohash for multiple members
-		geoHashResults, err := client.GeoHash(key1, []string{"Palermo", "Catania"})
+		geoHashResults, err := client.GeoHash(context.Background(), key1, []string{"Palermo", "Catania"})
 		fmt.Println(geoHashResults)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(geoHashResults))
@@ -9621,15 +10038,15 @@ func (suite *GlideTestSuite) TestGeoHash() {
 		assert.Equal(t, geoHashResults[1], "sqdtr74hyu0")
 
 		// Test getting geohash for empty members
-		geoHashResults, err = client.GeoHash(key1, []string{})
+		geoHashResults, err = client.GeoHash(context.Background(), key1, []string{})
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(geoHashResults))
 
 		// Test with wrong key type
 		wrongKey := "{testKey}:3-" + uuid.New().String()
-		_, err = client.Set(wrongKey, "value")
+		_, err = client.Set(context.Background(), wrongKey, "value")
 		assert.NoError(t, err)
-		_, err = client.GeoHash(wrongKey, []string{"Palermo"})
+		_, err = client.GeoHash(context.Background(), wrongKey, []string{"Palermo"})
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -9639,8 +10056,8 @@ func (suite *GlideTestSuite) TestGetSet_SendLargeValues() {
 	suite.runWithDefaultClients(func(client api.BaseClient) {
 		key := suite.GenerateLargeUuid()
 		value := suite.GenerateLargeUuid()
-		suite.verifyOK(client.Set(key, value))
-		result, err := client.Get(key)
+		suite.verifyOK(client.Set(context.Background(), key, value))
+		result, err := client.Get(context.Background(), key)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), value, result.Value())
 	})
@@ -9664,12 +10081,12 @@ func (suite *GlideTestSuite) TestGeoPos() {
 			"Catania": {Longitude: 15.087269, Latitude: 37.502669},
 		}
 
-		result, err := client.GeoAdd(key1, membersCoordinates)
+		result, err := client.GeoAdd(context.Background(), key1, membersCoordinates)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), result)
 
 		// Get positions and verify
-		actual, err := client.GeoPos(key1, members)
+		actual, err := client.GeoPos(context.Background(), key1, members)
 		assert.NoError(t, err)
 
 		// Verify each coordinate with high precision
@@ -9687,10 +10104,10 @@ func (suite *GlideTestSuite) TestGeoPos() {
 		}
 
 		// Test error case with wrong key type
-		_, err = client.Set(key2, "geopos")
+		_, err = client.Set(context.Background(), key2, "geopos")
 		assert.NoError(t, err)
 
-		_, err = client.GeoPos(key2, members)
+		_, err = client.GeoPos(context.Background(), key2, members)
 		assert.Error(t, err)
 		assert.IsType(t, &errors.RequestError{}, err)
 	})
@@ -9750,7 +10167,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		}
 
 		// Add geospatial data
-		result, err := client.GeoAdd(key1, membersToCoordinates)
+		result, err := client.GeoAdd(context.Background(), key1, membersToCoordinates)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), result)
 
@@ -9761,7 +10178,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		searchShape := options.NewBoxSearchShape(400, 400, options.GeoUnitKilometers)
 		resultOpts := options.NewGeoSearchResultOptions().SetSortOrder(options.ASC)
 
-		results, err := client.GeoSearchWithResultOptions(key1, &searchOrigin, *searchShape, *resultOpts)
+		results, err := client.GeoSearchWithResultOptions(context.Background(), key1, &searchOrigin, *searchShape, *resultOpts)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), members, results)
 
@@ -9771,7 +10188,14 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 			SetWithHash(true).
 			SetWithCoord(true)
 
-		fullResults, err := client.GeoSearchWithFullOptions(key1, &searchOrigin, *searchShape, *resultOpts, *searchOpts)
+		fullResults, err := client.GeoSearchWithFullOptions(
+			context.Background(),
+			key1,
+			&searchOrigin,
+			*searchShape,
+			*resultOpts,
+			*searchOpts,
+		)
 		assert.NoError(suite.T(), err)
 		// Verify structure of results - exact values may vary slightly due to floating-point precision
 		assert.Equal(suite.T(), 4, len(fullResults))
@@ -9782,7 +10206,13 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 			SetSortOrder(options.ASC).
 			SetCount(1)
 
-		countResults, err := client.GeoSearchWithResultOptions(key1, &searchOrigin, *searchShape, *resultOptsWithCount)
+		countResults, err := client.GeoSearchWithResultOptions(
+			context.Background(),
+			key1,
+			&searchOrigin,
+			*searchShape,
+			*resultOptsWithCount,
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), 1, len(countResults))
 		assert.Equal(suite.T(), "Catania", countResults[0])
@@ -9804,6 +10234,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 			},
 		}
 		memberResults, err := client.GeoSearchWithFullOptions(
+			context.Background(),
 			key1,
 			&options.GeoMemberOrigin{Member: "Catania"},
 			*options.NewBoxSearchShape(meters, meters, options.GeoUnitMeters),
@@ -9817,6 +10248,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		feetValue := 400 * 3280.8399
 		feetShape := options.NewBoxSearchShape(feetValue, feetValue, options.GeoUnitFeet)
 		feetResult, err := client.GeoSearchWithFullOptions(
+			context.Background(),
 			key1,
 			&options.GeoMemberOrigin{Member: "Palermo"},
 			*feetShape,
@@ -9835,6 +10267,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		feetRadius := 200 * 3280.8399
 
 		feetResults, err := client.GeoSearchWithResultOptions(
+			context.Background(),
 			key1,
 			&options.GeoMemberOrigin{Member: "Catania"},
 			*options.NewCircleSearchShape(feetRadius, options.GeoUnitFeet),
@@ -9846,6 +10279,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		// Test search by radius with meters units from member
 		metersRadius := 200 * 1000
 		metersResults, err := client.GeoSearchWithResultOptions(
+			context.Background(),
 			key1,
 			&options.GeoMemberOrigin{Member: "Catania"},
 			*options.NewCircleSearchShape(float64(metersRadius), options.GeoUnitMeters),
@@ -9856,6 +10290,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 
 		// Test search by radius with miles units from geospatial data
 		milesResults, err := client.GeoSearchWithResultOptions(
+			context.Background(),
 			key1,
 			&options.GeoCoordOrigin{
 				GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37},
@@ -9868,6 +10303,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 
 		// Test search by radius with kilometers units, with limited count and all options
 		kmResults, err := client.GeoSearchWithFullOptions(
+			context.Background(),
 			key1,
 			&options.GeoCoordOrigin{
 				GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37},
@@ -9912,6 +10348,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 			},
 		}
 		anyResult, err := client.GeoSearchWithFullOptions(
+			context.Background(),
 			key1,
 			&options.GeoCoordOrigin{
 				GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37},
@@ -9926,6 +10363,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		// Test empty results - small area
 		smallShape := options.NewBoxSearchShape(50, 50, options.GeoUnitMeters)
 		emptyResults1, err := client.GeoSearchWithResultOptions(
+			context.Background(),
 			key1,
 			&options.GeoCoordOrigin{
 				GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37},
@@ -9939,6 +10377,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		// Test empty results - very small radius
 		tinyShape := options.NewCircleSearchShape(5, options.GeoUnitMeters)
 		emptyResults2, err := client.GeoSearchWithResultOptions(
+			context.Background(),
 			key1,
 			&options.GeoCoordOrigin{
 				GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37},
@@ -9952,6 +10391,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		// Test non-existing member error
 		nonExistingMemberOrigin := &options.GeoMemberOrigin{Member: "non-existing-member"}
 		_, err = client.GeoSearchWithResultOptions(
+			context.Background(),
 			key1,
 			nonExistingMemberOrigin,
 			*options.NewCircleSearchShape(100, options.GeoUnitMeters),
@@ -9961,9 +10401,10 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// Test wrong key type error
-		_, err = client.Set(key2, "nonZSETvalue")
+		_, err = client.Set(context.Background(), key2, "nonZSETvalue")
 		assert.NoError(suite.T(), err)
 		_, err = client.GeoSearchWithResultOptions(
+			context.Background(),
 			key2,
 			&options.GeoCoordOrigin{
 				GeospatialData: options.GeospatialData{Longitude: 15, Latitude: 37},
@@ -10006,7 +10447,7 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 			"Palermo": 3479099956230698.0,
 		}
 		// Add geospatial data
-		result, err := client.GeoAdd(sourceKey, membersToCoordinates)
+		result, err := client.GeoAdd(context.Background(), sourceKey, membersToCoordinates)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), result)
 
@@ -10016,17 +10457,18 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		}
 		boxShape := options.NewBoxSearchShape(400, 400, options.GeoUnitKilometers)
 
-		count, err := client.GeoSearchStore(destinationKey, sourceKey, searchOrigin, *boxShape)
+		count, err := client.GeoSearchStore(context.Background(), destinationKey, sourceKey, searchOrigin, *boxShape)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), count)
 
 		// Verify stored results
-		zRangeResult, err := client.ZRangeWithScores(destinationKey, options.NewRangeByIndexQuery(0, -1))
+		zRangeResult, err := client.ZRangeWithScores(context.Background(), destinationKey, options.NewRangeByIndexQuery(0, -1))
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), expectedMap, zRangeResult)
 
 		// Test storing results of a box search, unit: kilometers, from a geospatial data point, with distance
 		count, err = client.GeoSearchStoreWithInfoOptions(
+			context.Background(),
 			destinationKey,
 			sourceKey,
 			searchOrigin,
@@ -10037,12 +10479,17 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		assert.Equal(suite.T(), int64(4), count)
 
 		// Verify stored results with distance
-		zRangeResultWithDist, err := client.ZRangeWithScores(destinationKey, options.NewRangeByIndexQuery(0, -1))
+		zRangeResultWithDist, err := client.ZRangeWithScores(
+			context.Background(),
+			destinationKey,
+			options.NewRangeByIndexQuery(0, -1),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), expectedMap2, zRangeResultWithDist)
 
 		// Test storing results of a box search, unit: kilometers, from a geospatial data point, with count
 		count, err = client.GeoSearchStoreWithResultOptions(
+			context.Background(),
 			destinationKey,
 			sourceKey,
 			searchOrigin,
@@ -10053,7 +10500,11 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		assert.Equal(suite.T(), int64(2), count)
 
 		// Verify stored results with count
-		zRangeResultWithCount, err := client.ZRangeWithScores(destinationKey, options.NewRangeByIndexQuery(0, -1))
+		zRangeResultWithCount, err := client.ZRangeWithScores(
+			context.Background(),
+			destinationKey,
+			options.NewRangeByIndexQuery(0, -1),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(
 			suite.T(),
@@ -10064,6 +10515,7 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		// Test storing results of a radius search, unit: feet, from a member
 		feetValue := 200 * 3280.8399
 		count, err = client.GeoSearchStoreWithResultOptions(
+			context.Background(),
 			destinationKey,
 			sourceKey,
 			&options.GeoMemberOrigin{Member: "Catania"},
@@ -10074,12 +10526,17 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		assert.Equal(suite.T(), int64(2), count)
 
 		// Verify stored results with count
-		zRangeResultWithCount, err = client.ZRangeWithScores(destinationKey, options.NewRangeByIndexQuery(0, -1))
+		zRangeResultWithCount, err = client.ZRangeWithScores(
+			context.Background(),
+			destinationKey,
+			options.NewRangeByIndexQuery(0, -1),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), expectedMap3, zRangeResultWithCount)
 
 		// Test storing results of a search that returns 0 results
 		count, err = client.GeoSearchStore(
+			context.Background(),
 			destinationKey,
 			sourceKey,
 			searchOrigin,
@@ -10087,12 +10544,17 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(0), count)
-		zRangeResultZero, err := client.ZRangeWithScores(destinationKey, options.NewRangeByIndexQuery(0, -1))
+		zRangeResultZero, err := client.ZRangeWithScores(
+			context.Background(),
+			destinationKey,
+			options.NewRangeByIndexQuery(0, -1),
+		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), map[string]float64(map[string]float64{}), zRangeResultZero)
 
 		// Test storing results of a search with ANY option
 		count, err = client.GeoSearchStoreWithResultOptions(
+			context.Background(),
 			destinationKey,
 			sourceKey,
 			searchOrigin,
@@ -10101,7 +10563,11 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), int64(4), count)
-		zRangeResultANY, err := client.ZRangeWithScores(destinationKey, options.NewRangeByIndexQuery(0, -1))
+		zRangeResultANY, err := client.ZRangeWithScores(
+			context.Background(),
+			destinationKey,
+			options.NewRangeByIndexQuery(0, -1),
+		)
 		assert.NoError(suite.T(), err)
 		expectedANYResults := map[string]float64{
 			"Catania": 3479447370796909.0,
@@ -10113,14 +10579,14 @@ func (suite *GlideTestSuite) TestGeoSearchStore() {
 
 		// member does not exist
 		nonExistingMemberOrigin := &options.GeoMemberOrigin{Member: "non-existing-member"}
-		_, err = client.GeoSearchStore(destinationKey, sourceKey, nonExistingMemberOrigin, *boxShape)
+		_, err = client.GeoSearchStore(context.Background(), destinationKey, sourceKey, nonExistingMemberOrigin, *boxShape)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 
 		// key exists but holds a non-ZSET value
-		_, err = client.Set(key3, "nonZSETvalue")
+		_, err = client.Set(context.Background(), key3, "nonZSETvalue")
 		assert.NoError(suite.T(), err)
-		_, err = client.GeoSearchStore(destinationKey, key3, searchOrigin, *boxShape)
+		_, err = client.GeoSearchStore(context.Background(), destinationKey, key3, searchOrigin, *boxShape)
 		assert.Error(suite.T(), err)
 		assert.IsType(suite.T(), &errors.RequestError{}, err)
 	})
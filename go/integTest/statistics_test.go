@@ -0,0 +1,84 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// countingExporter is a StatisticsExporter counting the requests and the errors it records.
+type countingExporter struct {
+	requests atomic.Int64
+	errors   atomic.Int64
+}
+
+func (exporter *countingExporter) RecordRequest(_ time.Duration, err error) {
+	exporter.requests.Add(1)
+	if err != nil {
+		exporter.errors.Add(1)
+	}
+}
+
+func (suite *GlideTestSuite) TestClientStatistics() {
+	exporter := &countingExporter{}
+	standaloneClient := suite.client(suite.defaultClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClientConfiguration().WithStatisticsExporter(exporter)))
+	clusterClient := suite.clusterClient(suite.defaultClusterClientConfig().
+		WithAdvancedConfiguration(api.NewAdvancedGlideClusterClientConfiguration().WithStatisticsExporter(exporter)))
+
+	suite.runWithClients([]api.BaseClient{standaloneClient, clusterClient}, func(client api.BaseClient) {
+		exporter.requests.Store(0)
+		exporter.errors.Store(0)
+		key := uuid.NewString()
+
+		statistics := client.Statistics()
+		assert.Equal(suite.T(), 0, statistics.PendingRequests)
+		assert.Equal(suite.T(), uint64(0), statistics.TotalRequests)
+
+		_, err := client.Set(context.Background(), key, "value")
+		assert.NoError(suite.T(), err)
+		_, err = client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		// the key holds a string
+		_, err = client.LPush(context.Background(), key, []string{"value"})
+		assert.Error(suite.T(), err)
+
+		statistics = client.Statistics()
+		assert.Equal(suite.T(), 0, statistics.PendingRequests)
+		assert.Equal(suite.T(), uint64(3), statistics.TotalRequests)
+		assert.Equal(suite.T(), uint64(1), statistics.Errors.Request)
+		assert.Equal(suite.T(), uint64(1), statistics.Errors.Total())
+		assert.Equal(suite.T(), uint64(3), statistics.Latency.Count)
+		assert.Positive(suite.T(), statistics.Latency.Sum)
+
+		assert.Equal(suite.T(), int64(3), exporter.requests.Load())
+		assert.Equal(suite.T(), int64(1), exporter.errors.Load())
+	})
+}
+
+func (suite *GlideTestSuite) TestClientStatistics_failedBeforeSending() {
+	client := suite.client(suite.defaultClientConfig())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.Get(ctx, uuid.NewString())
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+
+	client.Close()
+	_, err = client.Get(context.Background(), uuid.NewString())
+	assert.IsType(suite.T(), &errors.ClosingError{}, err)
+
+	statistics := client.Statistics()
+	assert.Equal(suite.T(), uint64(2), statistics.TotalRequests)
+	assert.Equal(suite.T(), uint64(1), statistics.Errors.Cancelled)
+	assert.Equal(suite.T(), uint64(1), statistics.Errors.Closing)
+	// the latency of a cancelled request is unknown
+	assert.Equal(suite.T(), uint64(1), statistics.Latency.Count)
+}
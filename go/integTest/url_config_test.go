@@ -0,0 +1,60 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// connectionURL returns a connection URL for the given hosts, with the given path and options.
+func (suite *GlideTestSuite) connectionURL(hosts []api.NodeAddress, pathAndOptions string) string {
+	scheme := "valkey"
+	if suite.tls {
+		scheme = "valkeys"
+	}
+	addresses := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		addresses = append(addresses, fmt.Sprintf("%s:%d", host.Host, host.Port))
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, strings.Join(addresses, ","), pathAndOptions)
+}
+
+func (suite *GlideTestSuite) TestParseURL() {
+	clientConfig, err := api.ParseURL(
+		suite.connectionURL(suite.standaloneHosts, "/1?client_name=url_client&timeout=5000"),
+	)
+	assert.NoError(suite.T(), err)
+	client := suite.client(clientConfig)
+
+	name, err := client.ClientGetName(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "url_client", name)
+
+	info, err := client.CustomCommand(context.Background(), []string{"CLIENT", "INFO"})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), info, " db=1 ")
+}
+
+func (suite *GlideTestSuite) TestParseClusterURL() {
+	clientConfig, err := api.ParseClusterURL(
+		suite.connectionURL(suite.clusterHosts, "?client_name=url_client&timeout=5000"),
+	)
+	assert.NoError(suite.T(), err)
+	client := suite.clusterClient(clientConfig)
+
+	names, err := client.ClientGetNameWithOptions(
+		context.Background(),
+		options.RouteOption{Route: config.AllNodes},
+	)
+	assert.NoError(suite.T(), err)
+	for _, name := range names.MultiValue() {
+		assert.Equal(suite.T(), "url_client", name)
+	}
+}
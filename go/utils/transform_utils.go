@@ -14,6 +14,14 @@ func StringToBytes(s string) []byte {
 	return b
 }
 
+// Convert `b` of type `[]byte` into `string` without copying. `b` must not be modified while the string is in use.
+func BytesToString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(&b[0], len(b))
+}
+
 func IntToString(value int64) string {
 	return strconv.FormatInt(value, 10 /*base*/)
 }
@@ -99,3 +99,10 @@ func TestConcat(t *testing.T) {
 		})
 	}
 }
+
+func TestBytesToString(t *testing.T) {
+	assert.Equal(t, "", BytesToString(nil))
+	assert.Equal(t, "", BytesToString([]byte{}))
+	assert.Equal(t, "abc", BytesToString([]byte("abc")))
+	assert.Equal(t, "\x00\xff\x01", BytesToString([]byte{0x00, 0xff, 0x01}))
+}
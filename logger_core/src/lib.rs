@@ -3,6 +3,8 @@
  */
 use once_cell::sync::OnceCell;
 use std::{
+    cell::Cell,
+    fmt::Write,
     path::{Path, PathBuf},
     sync::RwLock,
 };
@@ -54,6 +56,74 @@ pub static INITIATE_ONCE: InitiateOnce = InitiateOnce {
     init_once: OnceCell::new(),
 };
 
+/// Receives the logs forwarded by [set_log_callback], with their level and message.
+pub type LogCallback = Box<dyn Fn(Level, &str) + Send + Sync>;
+
+// The callback receiving the logs of the given level or above, if set by [set_log_callback]
+static LOG_CALLBACK: RwLock<Option<(LevelFilter, LogCallback)>> = RwLock::new(None);
+
+thread_local! {
+    // Whether the current thread is running the log callback
+    static IN_LOG_CALLBACK: Cell<bool> = const { Cell::new(false) };
+}
+
+/// A layer forwarding the logs to the callback set by [set_log_callback], if there is one.
+struct CallbackLayer;
+
+impl<S: tracing::Subscriber> tracing_subscriber::Layer<S> for CallbackLayer {
+    fn on_event(
+        &self,
+        event: &tracing::Event<'_>,
+        _ctx: tracing_subscriber::layer::Context<'_, S>,
+    ) {
+        // ignore the logs emitted by the callback itself
+        if IN_LOG_CALLBACK.with(|in_callback| in_callback.get()) {
+            return;
+        }
+        let Ok(log_callback) = LOG_CALLBACK.read() else {
+            return;
+        };
+        let Some((level_filter, callback)) = log_callback.as_ref() else {
+            return;
+        };
+        let level = *event.metadata().level();
+        if level > *level_filter {
+            return;
+        }
+        let mut visitor = MessageVisitor(String::new());
+        event.record(&mut visitor);
+        IN_LOG_CALLBACK.with(|in_callback| in_callback.set(true));
+        callback(Level::from(level), &visitor.0);
+        IN_LOG_CALLBACK.with(|in_callback| in_callback.set(false));
+    }
+}
+
+/// Collects the message and the other fields of an event into a single string.
+struct MessageVisitor(String);
+
+impl MessageVisitor {
+    fn append(&mut self, field: &tracing::field::Field, value: std::fmt::Arguments) {
+        if !self.0.is_empty() {
+            self.0.push(' ');
+        }
+        let _ = if field.name() == "message" {
+            self.0.write_fmt(value)
+        } else {
+            write!(self.0, "{}={}", field.name(), value)
+        };
+    }
+}
+
+impl tracing::field::Visit for MessageVisitor {
+    fn record_str(&mut self, field: &tracing::field::Field, value: &str) {
+        self.append(field, format_args!("{value}"));
+    }
+
+    fn record_debug(&mut self, field: &tracing::field::Field, value: &dyn std::fmt::Debug) {
+        self.append(field, format_args!("{value:?}"));
+    }
+}
+
 const FILE_DIRECTORY: &str = "glide-logs";
 const ENV_GLIDE_LOG_DIR: &str = "GLIDE_LOG_DIR";
 
@@ -105,6 +175,18 @@ pub enum Level {
     Trace = 4,
     Off = 5,
 }
+impl From<tracing::Level> for Level {
+    fn from(level: tracing::Level) -> Self {
+        match level {
+            tracing::Level::ERROR => Level::Error,
+            tracing::Level::WARN => Level::Warn,
+            tracing::Level::INFO => Level::Info,
+            tracing::Level::DEBUG => Level::Debug,
+            _ => Level::Trace,
+        }
+    }
+}
+
 impl Level {
     fn to_filter(&self) -> filter::LevelFilter {
         match self {
@@ -137,7 +219,11 @@ pub fn create_directory_from_env(envname: &str) -> Option<String> {
 // In any of the calls to the function, including the first - resetting the existence loggers to the new setting
 // provided by using the global reloadable handle
 // The logger will save only logs of the given level or above.
+// Setting the logger replaces the callback set by [set_log_callback], if any.
 pub fn init(minimal_level: Option<Level>, file_name: Option<&str>) -> Level {
+    if let Ok(mut log_callback) = LOG_CALLBACK.write() {
+        *log_callback = None;
+    }
     let level = minimal_level.unwrap_or(Level::Warn);
     let level_filter = level.to_filter();
     let reloads = INITIATE_ONCE.init_once.get_or_init(|| {
@@ -179,6 +265,7 @@ pub fn init(minimal_level: Option<Level>, file_name: Option<&str>) -> Level {
         tracing_subscriber::registry()
             .with(stdout_layer)
             .with(file_layer)
+            .with(CallbackLayer)
             .with(targets_filter)
             .init();
 
@@ -227,6 +314,21 @@ pub fn init(minimal_level: Option<Level>, file_name: Option<&str>) -> Level {
     level
 }
 
+/// Forwards the logs of the given level or above to `callback`, instead of the console or a log file, and returns the
+/// level. The callback is replaced by the next call to [init] or [set_log_callback].
+///
+/// The callback is called synchronously on the thread emitting the log, so it should return quickly. It must not call
+/// [init] or [set_log_callback], and logging from it through this crate is ignored.
+pub fn set_log_callback(minimal_level: Option<Level>, callback: LogCallback) -> Level {
+    // disable the console and file logs
+    init(Some(Level::Off), None);
+    let level = minimal_level.unwrap_or(Level::Warn);
+    *LOG_CALLBACK
+        .write()
+        .expect("error setting the log callback") = Some((level.to_filter(), callback));
+    level
+}
+
 macro_rules! create_log {
     ($name:ident, $uppercase_level:tt) => {
         pub fn $name<Message: AsRef<str>, Identifier: AsRef<str>>(